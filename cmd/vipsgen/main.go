@@ -2,7 +2,9 @@ package main
 
 import (
 	"flag"
+	"io/fs"
 	"log"
+	"os"
 
 	"github.com/cshum/vipsgen/internal/generator"
 	"github.com/cshum/vipsgen/internal/introspection"
@@ -16,6 +18,8 @@ func main() {
 	templateDirFlag := flag.String("templates", "", "Template directory (uses embedded templates if not specified)")
 	isDebug := flag.Bool("debug", false, "Enable debug json output")
 	includeTest := flag.Bool("include-test", false, "Include test files in generated output")
+	operationsFlag := flag.String("operations", "", "File or comma-separated list of operations to generate (generates all if not specified)")
+	excludeFlag := flag.String("exclude", "", "File or comma-separated list of operations to exclude")
 
 	flag.Parse()
 
@@ -31,6 +35,7 @@ func main() {
 
 	var outputDir string
 	var loader generator.TemplateLoader
+	var templateFS fs.FS = templates.Templates
 	var funcMap = generator.GetTemplateFuncMap()
 
 	// Determine template source - use embedded by default, external if specified
@@ -41,6 +46,7 @@ func main() {
 		if err != nil {
 			log.Fatalf("Failed to create template loader: %v", err)
 		}
+		templateFS = os.DirFS(*templateDirFlag)
 		log.Printf("Using templates from: %s\n", *templateDirFlag)
 	} else {
 		// Use embedded templates by default
@@ -70,12 +76,33 @@ func main() {
 	operations := vipsIntrospection.DiscoverOperations()
 	log.Printf("Extracted %d operations from GObject Introspection\n", len(operations))
 
+	// Restrict operations to the requested include/exclude lists
+	includeOps, err := generator.ParseOperationList(*operationsFlag)
+	if err != nil {
+		log.Fatalf("Failed to parse operations: %v", err)
+	}
+	excludeOps, err := generator.ParseOperationList(*excludeFlag)
+	if err != nil {
+		log.Fatalf("Failed to parse excluded operations: %v", err)
+	}
+	requiredOps, err := generator.RequiredOperations(templateFS, operations, *includeTest)
+	if err != nil {
+		log.Fatalf("Failed to find required operations: %v", err)
+	}
+	operations = generator.FilterOperations(operations, includeOps, excludeOps, requiredOps)
+
 	// Get enum types
 	enumTypes := vipsIntrospection.DiscoverEnumTypes()
 	log.Printf("Discovered %d enum types\n", len(enumTypes))
 
+	// Load hand-written documentation from next to the templates
+	docs, err := generator.LoadOperationDocs(templateFS)
+	if err != nil {
+		log.Fatalf("Failed to load operation docs: %v", err)
+	}
+
 	// Create unified template data
-	templateData := generator.NewTemplateData(vipsVersion, operations, enumTypes, imageTypes, *includeTest)
+	templateData := generator.NewTemplateData(vipsVersion, operations, enumTypes, imageTypes, *includeTest, docs)
 
 	// Generate all code using the unified template data approach
 	if err := generator.Generate(loader, templateData, outputDir); err != nil {
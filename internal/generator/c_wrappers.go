@@ -132,31 +132,55 @@ func generateCFunctionDeclaration(op introspection.Operation) string {
 	return result.String()
 }
 
+// generateCVersionGuard returns the preprocessor condition for operations
+// introduced after the oldest supported libvips, or "" if none is needed
+func generateCVersionGuard(op introspection.Operation) string {
+	if op.MinVersion == "" {
+		return ""
+	}
+	var major, minor int
+	if _, err := fmt.Sscanf(op.MinVersion, "%d.%d", &major, &minor); err != nil {
+		return ""
+	}
+	return fmt.Sprintf("VIPSGEN_VERSION_AT_LEAST(%d, %d)", major, minor)
+}
+
 // generateCFunctionImplementation generates C implementations for vips operations
 func generateCFunctionImplementation(op introspection.Operation) string {
 	var result strings.Builder
 
 	if len(op.Arguments) == 0 {
 		result.WriteString(fmt.Sprintf("int vipsgen_%s() {\n", op.Name))
-		result.WriteString(fmt.Sprintf("    return vips_%s(NULL);\n}", op.Name))
 	} else {
 		result.WriteString(generateCFunctionSignature(op, true))
 		result.WriteString(" {\n")
+	}
 
-		result.WriteString(fmt.Sprintf("    return vips_%s(", op.Name))
-		for i, arg := range op.Arguments {
-			if i > 0 {
-				result.WriteString(", ")
-			}
-			if arg.IsSource {
-				result.WriteString("(VipsSource*) " + arg.Name)
-			} else if arg.IsTarget {
-				result.WriteString("(VipsTarget*) " + arg.Name)
-			} else {
-				result.WriteString(arg.Name)
-			}
+	var call strings.Builder
+	call.WriteString(fmt.Sprintf("    return vips_%s(", op.Name))
+	for _, arg := range op.Arguments {
+		if arg.IsSource {
+			call.WriteString("(VipsSource*) " + arg.Name)
+		} else if arg.IsTarget {
+			call.WriteString("(VipsTarget*) " + arg.Name)
+		} else {
+			call.WriteString(arg.Name)
 		}
-		result.WriteString(", NULL);\n}")
+		call.WriteString(", ")
+	}
+	call.WriteString("NULL);\n")
+
+	if guard := generateCVersionGuard(op); guard != "" {
+		// Operations newer than the libvips being compiled against have no C
+		// entry point, so fall back to a runtime "not supported" error
+		result.WriteString(fmt.Sprintf("#if %s\n", guard))
+		result.WriteString(call.String())
+		result.WriteString("#else\n")
+		result.WriteString(fmt.Sprintf("    return vipsgen_operation_unsupported(\"%s\");\n", op.Name))
+		result.WriteString("#endif\n}")
+	} else {
+		result.WriteString(call.String())
+		result.WriteString("}")
 	}
 
 	supportedOptionalOutputs := getSupportedOptionalOutputs(op)
@@ -9,6 +9,7 @@ import (
 	"testing"
 	"text/template"
 
+	"github.com/cshum/vipsgen/internal/introspection"
 	"github.com/cshum/vipsgen/internal/templates"
 )
 
@@ -167,3 +168,77 @@ func TestImageTestTemplateRenders(t *testing.T) {
 		}
 	}
 }
+
+func TestTypesTemplateRendersEnumString(t *testing.T) {
+	loader := NewFSTemplateLoader(templates.Templates, GetTemplateFuncMap())
+	tmpl, err := loader.LoadTemplate("types.go.tmpl")
+	if err != nil {
+		t.Fatalf("LoadTemplate returned error: %v", err)
+	}
+
+	data := &TemplateData{
+		VipsVersion: "8.17.0",
+		EnumTypes: []introspection.EnumTypeInfo{
+			{CName: "VipsKernel", GoName: "Kernel", Values: []introspection.EnumValueInfo{
+				{CName: "VIPS_KERNEL_NEAREST", GoName: "KernelNearest", Value: 0, Description: "nearest"},
+				{CName: "VIPS_KERNEL_LANCZOS3", GoName: "KernelLanczos3", Value: 5, Description: "lanczos3"},
+				{CName: "VIPS_KERNEL_LANCZOS3_ALIAS", GoName: "KernelLanczos3Alias", Value: 5, Description: "lanczos3-alias"},
+			}},
+		},
+	}
+	var out bytes.Buffer
+	if err := tmpl.Execute(&out, data); err != nil {
+		t.Fatalf("Execute returned error: %v", err)
+	}
+
+	rendered := out.String()
+	checks := []string{
+		"func (e Kernel) String() string {",
+		"\tcase KernelLanczos3:\n\t\treturn \"lanczos3\"\n",
+		"\treturn \"Kernel(\" + strconv.Itoa(int(e)) + \")\"\n",
+	}
+	for _, check := range checks {
+		if !strings.Contains(rendered, check) {
+			t.Fatalf("rendered template missing %q\n%s", check, rendered)
+		}
+	}
+	// Aliases of a value already named would repeat a switch case
+	if strings.Contains(rendered, "case KernelLanczos3Alias:") {
+		t.Fatalf("rendered template names an alias value\n%s", rendered)
+	}
+}
+
+func TestTypesTemplateRendersEnumParse(t *testing.T) {
+	loader := NewFSTemplateLoader(templates.Templates, GetTemplateFuncMap())
+	tmpl, err := loader.LoadTemplate("types.go.tmpl")
+	if err != nil {
+		t.Fatalf("LoadTemplate returned error: %v", err)
+	}
+
+	data := &TemplateData{
+		VipsVersion: "8.17.0",
+		EnumTypes: []introspection.EnumTypeInfo{
+			{CName: "VipsInterpretation", GoName: "Interpretation", Values: []introspection.EnumValueInfo{
+				{CName: "VIPS_INTERPRETATION_B_W", GoName: "InterpretationBW", Value: 1, Description: "b-w"},
+				{CName: "VIPS_INTERPRETATION_sRGB", GoName: "InterpretationSrgb", Value: 22, Description: "srgb"},
+			}},
+		},
+	}
+	var out bytes.Buffer
+	if err := tmpl.Execute(&out, data); err != nil {
+		t.Fatalf("Execute returned error: %v", err)
+	}
+
+	rendered := out.String()
+	checks := []string{
+		"func ParseInterpretation(s string) (Interpretation, error) {",
+		"\tswitch strings.ToLower(s) {\n",
+		"\tcase \"srgb\":\n\t\treturn InterpretationSrgb, nil\n",
+		"\treturn 0, fmt.Errorf(\"unknown Interpretation %q, valid values are: b-w, srgb\", s)\n",
+	}
+	for _, check := range checks {
+		if !strings.Contains(rendered, check) {
+			t.Fatalf("rendered template missing %q\n%s", check, rendered)
+		}
+	}
+}
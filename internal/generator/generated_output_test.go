@@ -0,0 +1,121 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/cshum/vipsgen/internal/introspection"
+)
+
+// checkedInMethod returns the method declaration named name from a generated file in the vips package
+func checkedInMethod(t *testing.T, file, name string) string {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("..", "..", "vips", file))
+	if err != nil {
+		t.Skipf("generated %s not available: %v", file, err)
+	}
+	src := string(data)
+	start := strings.Index(src, "func (r *Image) "+name+"(")
+	if start < 0 {
+		t.Fatalf("%s not found in %s", name, file)
+	}
+	end := strings.Index(src[start:], "\n}\n")
+	if end < 0 {
+		t.Fatalf("%s in %s is not terminated", name, file)
+	}
+	return src[start : start+end+2]
+}
+
+// renderImageMethod renders an image method the way image.go.tmpl declares it
+func renderImageMethod(op introspection.Operation) string {
+	return "func (r *Image) " + op.GoName + "(" + generateImageMethodParams(op) + ") (" +
+		generateImageMethodReturnTypes(op) + ") {\n\t" + generateImageMethodBody(op) + "\n}"
+}
+
+// TestGeneratedMethodsMatchGenerator checks that methods of the checked-in vips package,
+// generated from libvips 8.18, are what the generator emits for their operations,
+// so that checks the generator adds are not only present in hand-edited output
+func TestGeneratedMethodsMatchGenerator(t *testing.T) {
+	image := func(name, goName string) introspection.Argument {
+		return introspection.Argument{Name: name, GoName: goName, GoType: "*C.VipsImage", IsInput: true, IsImage: true}
+	}
+	for _, op := range []introspection.Operation{
+		{
+			Name:              "composite2",
+			GoName:            "Composite2",
+			HasThisImageInput: true,
+			HasOneImageOutput: true,
+			Arguments: []introspection.Argument{
+				image("base", "base"),
+				image("overlay", "overlay"),
+				{Name: "mode", GoName: "mode", GoType: "BlendMode", IsInput: true},
+			},
+			OptionalInputs: []introspection.Argument{
+				{Name: "x", GoName: "X", GoType: "int", IsInput: true},
+				{Name: "y", GoName: "Y", GoType: "int", IsInput: true},
+				{Name: "compositing_space", GoName: "CompositingSpace", GoType: "Interpretation", IsInput: true},
+				{Name: "premultiplied", GoName: "Premultiplied", GoType: "bool", IsInput: true},
+			},
+		},
+	} {
+		t.Run(op.Name, func(t *testing.T) {
+			if got, want := renderImageMethod(op), checkedInMethod(t, "image.go", op.GoName); got != want {
+				t.Fatalf("checked-in %s differs from the generator\n got: %q\nwant: %q", op.GoName, want, got)
+			}
+		})
+	}
+}
+
+// TestOperationVersionGuardsMatchGeneratedPackages checks the operation versions in
+// introspection against the vips, vips817 and vips816 packages generated from libvips 8.18, 8.17 and 8.16:
+// an operation missing from an older package must be guarded with the version of the first package that has it,
+// and falls back to vipsgen_operation_unsupported on older libvips
+func TestOperationVersionGuardsMatchGeneratedPackages(t *testing.T) {
+	definition := regexp.MustCompile(`(?m)^int vipsgen_(\w+)\(`)
+	guard := regexp.MustCompile(`(?m)^int vipsgen_(\w+)\([^{\n]*\{\n#if VIPSGEN_VERSION_AT_LEAST\((\d+), (\d+)\)\n`)
+	read := func(dir string) string {
+		data, err := os.ReadFile(filepath.Join("..", "..", dir, "vips.c"))
+		if err != nil {
+			t.Skipf("generated %s/vips.c not available: %v", dir, err)
+		}
+		return string(data)
+	}
+	defined := func(src string) map[string]bool {
+		names := map[string]bool{}
+		for _, m := range definition.FindAllStringSubmatch(src, -1) {
+			names[m[1]] = true
+		}
+		return names
+	}
+	latest := read("vips")
+	in816, in817 := defined(read("vips816")), defined(read("vips817"))
+	guards := map[string]string{}
+	for _, m := range guard.FindAllStringSubmatch(latest, -1) {
+		guards[m[1]] = m[2] + "." + m[3]
+	}
+
+	for name := range defined(latest) {
+		if strings.HasSuffix(name, "_with_options") {
+			continue
+		}
+		introduced := "8.18"
+		if in816[name] {
+			introduced = "8.16"
+		} else if in817[name] {
+			introduced = "8.17"
+		}
+		got, guarded := guards[name]
+		if introduced != "8.16" && got != introduced {
+			t.Errorf("%s first appears in libvips %s but is guarded with %q, update operationMinVersions", name, introduced, got)
+		}
+		if guarded && introduced == "8.16" && got > introduced {
+			t.Errorf("%s is in the libvips 8.16 package but is guarded with %s", name, got)
+		}
+		if guarded && !strings.Contains(latest, "#else\n    return vipsgen_operation_unsupported(\""+name+"\");\n#endif") {
+			t.Errorf("%s is guarded without the vipsgen_operation_unsupported fallback", name)
+		}
+	}
+}
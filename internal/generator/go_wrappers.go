@@ -106,6 +106,45 @@ func generateOutputErrorReturn(outputs []introspection.Argument, errorExpr strin
 	return "return " + strings.Join(returnValues, ", ") + ", " + errorExpr
 }
 
+// optionalModuleSaverPrefixes are savers that libvips can build as loadable
+// modules, so they may be missing at runtime even though they compiled.
+// AVIF is saved with heifsave, so it is covered by the heifsave prefix.
+var optionalModuleSaverPrefixes = []string{"heifsave", "jxlsave"}
+
+func isOptionalModuleSaver(op introspection.Operation) bool {
+	for _, prefix := range optionalModuleSaverPrefixes {
+		if strings.HasPrefix(op.Name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+type optionRange struct {
+	opPrefix string
+	name     string
+	min      int
+	max      int
+}
+
+// optionRanges bounds integer options validated before calling libvips,
+// keyed by operation name prefix and option name. Zero still means unset.
+var optionRanges = []optionRange{
+	{"gifsave", "bitdepth", 1, 8},
+}
+
+func findOptionRange(op introspection.Operation, opt introspection.Argument) (optionRange, bool) {
+	if opt.GoType != "int" {
+		return optionRange{}, false
+	}
+	for _, r := range optionRanges {
+		if strings.HasPrefix(op.Name, r.opPrefix) && opt.Name == r.name {
+			return r, true
+		}
+	}
+	return optionRange{}, false
+}
+
 // generateGoFunctionBody generates the shared body for Go wrapper functions
 func generateGoFunctionBody(op introspection.Operation, withOptions bool) string {
 	var result strings.Builder
@@ -125,6 +164,23 @@ func generateGoFunctionBody(op introspection.Operation, withOptions bool) string
 	result.WriteString(generateReturnTypes(op))
 	result.WriteString(") {\n\t")
 
+	// Savers from optional libvips modules check for the module up front,
+	// looking it up once rather than on every save
+	if isOptionalModuleSaver(op) {
+		result.WriteString(fmt.Sprintf("if err := requireOptionalOperation(\"%s\"); err != nil {\n\t\t%s\n\t}\n\t",
+			op.Name, generateErrorReturnForUtilityCall(op)))
+	}
+
+	// Out of range options are otherwise dropped by GLib with only a warning
+	if withOptions {
+		for _, opt := range op.OptionalInputs {
+			if r, ok := findOptionRange(op, opt); ok {
+				result.WriteString(fmt.Sprintf("if err := checkOptionRange(\"%s\", \"%s\", %s, %d, %d); err != nil {\n\t\t%s\n\t}\n\t",
+					op.Name, opt.Name, opt.GoName, r.min, r.max, generateErrorReturnForUtilityCall(op)))
+			}
+		}
+	}
+
 	// Variable declarations
 	result.WriteString(generateVarDeclarations(op, withOptions))
 	result.WriteString("\n\t")
@@ -139,7 +195,7 @@ func generateGoFunctionBody(op introspection.Operation, withOptions bool) string
 	result.WriteString("); err != 0 {\n\t\t")
 
 	// Error handling
-	result.WriteString(generateErrorReturn(op.HasOneImageOutput, op.HasBufferOutput, op.RequiredOutputs))
+	result.WriteString(generateErrorReturn(op.Name, op.HasOneImageOutput, op.HasBufferOutput, op.RequiredOutputs))
 	result.WriteString("\n\t}\n\t")
 
 	// Convert temporary C scalar outputs back into Go values.
@@ -155,16 +211,17 @@ func generateGoFunctionBody(op introspection.Operation, withOptions bool) string
 	return result.String()
 }
 
-// generateErrorReturn formats the error return statement for a function
-func generateErrorReturn(HasOneImageOutput, hasBufferOutput bool, outputs []introspection.Argument) string {
+// generateErrorReturn formats the error return statement for a function,
+// naming the operation in the returned error
+func generateErrorReturn(opName string, HasOneImageOutput, hasBufferOutput bool, outputs []introspection.Argument) string {
 	if HasOneImageOutput {
-		return "return nil, handleImageError(out)"
+		return fmt.Sprintf("return nil, handleImageError(%q, out)", opName)
 	} else if hasBufferOutput {
-		return "return nil, handleVipsError()"
+		return fmt.Sprintf("return nil, handleVipsError(%q)", opName)
 	} else if len(outputs) > 0 {
-		return generateOutputErrorReturn(outputs, "handleVipsError()")
+		return generateOutputErrorReturn(outputs, fmt.Sprintf("handleVipsError(%q)", opName))
 	} else {
-		return "return handleVipsError()"
+		return fmt.Sprintf("return handleVipsError(%q)", opName)
 	}
 }
 
@@ -119,6 +119,88 @@ func generateOptionsMultiOutputBody(resultVars []string, funcName string, callAr
 	)
 }
 
+// generateBlendModeChecks validates blend mode arguments up front, so that a
+// mode unknown to the libvips build fails with a clear error
+func generateBlendModeChecks(args []introspection.Argument, errorReturn string) string {
+	var checks string
+	for _, arg := range args {
+		var value string
+		switch arg.GoType {
+		case "BlendMode":
+			value = arg.GoName
+		case "[]BlendMode":
+			value = arg.GoName + "..."
+		default:
+			continue
+		}
+		checks += fmt.Sprintf(`if err := checkBlendMode(%s); err != nil {
+		return %s
+	}
+	`, value, errorReturn)
+	}
+	return checks
+}
+
+// generateFrequencyChecks validates the normalised frequency arguments of the
+// mask_* filter generators, where 1 is the highest frequency in the image
+func generateFrequencyChecks(op introspection.Operation, args []introspection.Argument, errorReturn string) string {
+	if !strings.HasPrefix(op.Name, "mask_") {
+		return ""
+	}
+	var checks string
+	for _, arg := range args {
+		if arg.GoType != "float64" || !strings.HasPrefix(arg.Name, "frequency_cutoff") {
+			continue
+		}
+		checks += fmt.Sprintf(`if %s < 0 || %s > 1 {
+		return %s, fmt.Errorf("%s: %s %%g is outside the range 0 to 1", %s)
+	}
+	`, arg.GoName, arg.GoName, errorReturn, op.Name, arg.GoName, arg.GoName)
+	}
+	return checks
+}
+
+// singleBandOperations are operations that only read the first band of their input,
+// so their image methods reject multi-band images instead of silently dropping bands
+var singleBandOperations = map[string]bool{
+	"falsecolour": true,
+}
+
+// generateSingleBandCheck rejects multi-band images for singleBandOperations
+func generateSingleBandCheck(op introspection.Operation) string {
+	if !singleBandOperations[op.Name] {
+		return ""
+	}
+	return fmt.Sprintf(`if bands := r.Bands(); bands != 1 {
+		return fmt.Errorf("%s: image has %%d bands, expected a single band image, e.g. from ExtractBand or Colourspace(InterpretationBW)", bands)
+	}
+	`, op.Name)
+}
+
+// optionSupportChecks name the check functions guarding optional arguments that
+// depend on how libvips was built, which libvips would otherwise ignore with a warning
+var optionSupportChecks = map[string]string{
+	"trellis_quant": "checkTrellisQuant",
+}
+
+// generateOptionSupportChecks fails early when a set option is not supported by the libvips build
+func generateOptionSupportChecks(op introspection.Operation, errorReturn string) string {
+	var checks string
+	for _, arg := range op.OptionalInputs {
+		check, ok := optionSupportChecks[arg.Name]
+		if !ok || arg.GoType != "bool" {
+			continue
+		}
+		checks += fmt.Sprintf(`if options != nil && options.%s {
+		if err := %s(); err != nil {
+			return %s
+		}
+	}
+	`, arg.GoName, check, errorReturn)
+	}
+	return checks
+}
+
 // generateImageMethodBody formats the body of an image method using improved argument detection
 func generateImageMethodBody(op introspection.Operation) string {
 	methodArgs := detectMethodArguments(op)
@@ -141,13 +223,14 @@ func generateImageMethodBody(op introspection.Operation) string {
 	}
 
 	if op.HasOneImageOutput {
-		var body string
+		body := generateSingleBandCheck(op)
+		body += generateBlendModeChecks(methodArgs, "err")
 
 		supportedOptionalOutputs := getSupportedOptionalOutputs(op)
 		if len(op.OptionalInputs) > 0 || len(supportedOptionalOutputs) > 0 {
 			optionsCallArgs := buildImageOptionsCallArgs(callArgs, op.OptionalInputs, supportedOptionalOutputs, imageOptionArgSafePointer)
 
-			body = fmt.Sprintf(`if options != nil {
+			body += fmt.Sprintf(`if options != nil {
 		out, err := %s(%s)
 		if err != nil {
 			return err
@@ -168,12 +251,12 @@ func generateImageMethodBody(op introspection.Operation) string {
 			strings.Join(callArgs, ", "))
 		return body
 	} else if op.HasBufferOutput {
-		var body string
+		body := generateOptionSupportChecks(op, "nil, err")
 
 		if len(op.OptionalInputs) > 0 {
 			optionsCallArgs := buildImageOptionsCallArgs(callArgs, op.OptionalInputs, nil, imageOptionArgSafePointer)
 
-			body = fmt.Sprintf(`if options != nil {
+			body += fmt.Sprintf(`if options != nil {
 		buf, err := %s(%s)
 		if err != nil {
 			return nil, err
@@ -306,13 +389,13 @@ func generateImageMethodBody(op introspection.Operation) string {
 			return body
 		}
 	} else {
-		var body string
+		body := generateOptionSupportChecks(op, "err")
 
 		supportedOptionalOutputs := getSupportedOptionalOutputs(op)
 		if len(op.OptionalInputs) > 0 || len(supportedOptionalOutputs) > 0 {
 			optionsCallArgs := buildImageOptionsCallArgs(callArgs, op.OptionalInputs, supportedOptionalOutputs, imageOptionArgSafePointer)
 
-			body = fmt.Sprintf(`if options != nil {
+			body += fmt.Sprintf(`if options != nil {
 		err := %s(%s)
 		if err != nil {
 			return err
@@ -360,6 +443,9 @@ func generateImageArgumentsComment(op introspection.Operation) string {
 			}
 		}
 	}
+	if op.DocNote != "" {
+		result.WriteString("\n//\n// " + strings.ReplaceAll(op.DocNote, "\n", "\n// "))
+	}
 	return result.String()
 }
 
@@ -518,9 +604,9 @@ func generateCreatorMethodBody(op introspection.Operation) string {
 		imageRefBuf = "buf"
 	}
 
-	var body string
-
-	body = "Startup(nil)\n\t"
+	body := "Startup(nil)\n\t"
+	body += generateBlendModeChecks(inputParams, "nil, err")
+	body += generateFrequencyChecks(op, inputParams, "nil")
 
 	if op.HasBufferInput {
 		if bufParam := getBufferParameter(op.RequiredInputs); bufParam != nil {
@@ -545,6 +631,10 @@ func generateCreatorMethodBody(op introspection.Operation) string {
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, %s, %s), nil
 	}
 	`,
@@ -558,6 +648,10 @@ func generateCreatorMethodBody(op introspection.Operation) string {
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, %s, %s), nil`,
 		goFuncName,
 		strings.Join(callArgs, ", "),
@@ -0,0 +1,94 @@
+package generator
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"strings"
+
+	"github.com/cshum/vipsgen/internal/introspection"
+)
+
+// OperationDocsFile is the data file next to the templates that holds
+// documentation the introspected descriptions lack
+const OperationDocsFile = "operation_docs.json"
+
+// OperationDocs holds hand-written documentation for generated operations
+type OperationDocs struct {
+	// Notes are usage notes appended to the doc comment of operations
+	Notes []OperationNote `json:"notes"`
+	// Arguments replace introspected argument descriptions that are misleading
+	Arguments []ArgumentDescription `json:"arguments"`
+}
+
+// OperationNote is a usage note for the operations it names, one entry per comment line
+type OperationNote struct {
+	Operations []string `json:"operations"`
+	Note       []string `json:"note"`
+}
+
+// ArgumentDescription replaces the description of an optional input of the
+// operations whose name starts with one of OperationPrefixes
+type ArgumentDescription struct {
+	OperationPrefixes []string `json:"operation_prefixes"`
+	Argument          string   `json:"argument"`
+	Description       string   `json:"description"`
+}
+
+// LoadOperationDocs reads OperationDocsFile from the templates in fsys.
+// Template directories extracted before it existed have no docs file,
+// which leaves the introspected documentation as is.
+func LoadOperationDocs(fsys fs.FS) (*OperationDocs, error) {
+	data, err := fs.ReadFile(fsys, OperationDocsFile)
+	if errors.Is(err, fs.ErrNotExist) {
+		log.Printf("Warning: %s not found, generating without operation docs\n", OperationDocsFile)
+		return &OperationDocs{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %v", OperationDocsFile, err)
+	}
+	var docs OperationDocs
+	if err := json.Unmarshal(data, &docs); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %v", OperationDocsFile, err)
+	}
+	return &docs, nil
+}
+
+// applyOperationDocs post-processes discovered operations to add the usage
+// notes and argument descriptions of docs
+func applyOperationDocs(operations []introspection.Operation, docs *OperationDocs) {
+	if docs == nil {
+		return
+	}
+	notes := make(map[string]string)
+	for _, note := range docs.Notes {
+		for _, name := range note.Operations {
+			notes[name] = strings.Join(note.Note, "\n")
+		}
+	}
+	for i, op := range operations {
+		operations[i].DocNote = notes[op.Name]
+		for _, override := range docs.Arguments {
+			if !override.matches(op.Name) {
+				continue
+			}
+			for j, opt := range op.OptionalInputs {
+				if opt.Name == override.Argument {
+					operations[i].OptionalInputs[j].Description = override.Description
+				}
+			}
+		}
+	}
+}
+
+// matches reports whether the operation name starts with one of the prefixes
+func (d ArgumentDescription) matches(name string) bool {
+	for _, prefix := range d.OperationPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,105 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/cshum/vipsgen/internal/introspection"
+	"github.com/cshum/vipsgen/internal/templates"
+)
+
+// embeddedOperationDocs loads the operation docs embedded with the templates
+func embeddedOperationDocs(t *testing.T) *OperationDocs {
+	t.Helper()
+	docs, err := LoadOperationDocs(templates.Templates)
+	if err != nil {
+		t.Fatalf("LoadOperationDocs returned error: %v", err)
+	}
+	return docs
+}
+
+// documentedOperation returns an image operation with the embedded operation docs applied
+func documentedOperation(t *testing.T, name, goName string) introspection.Operation {
+	t.Helper()
+	operations := []introspection.Operation{testImageOperation(name, goName)}
+	applyOperationDocs(operations, embeddedOperationDocs(t))
+	return operations[0]
+}
+
+func TestLoadOperationDocs(t *testing.T) {
+	docs, err := LoadOperationDocs(fstest.MapFS{})
+	if err != nil {
+		t.Fatalf("missing docs file should not be an error: %v", err)
+	}
+	if len(docs.Notes) != 0 || len(docs.Arguments) != 0 {
+		t.Fatalf("expected empty docs, got %+v", docs)
+	}
+
+	_, err = LoadOperationDocs(fstest.MapFS{OperationDocsFile: {Data: []byte(`{"notes": {}}`)}})
+	if err == nil || !strings.Contains(err.Error(), OperationDocsFile) {
+		t.Fatalf("expected a parse error naming %s, got %v", OperationDocsFile, err)
+	}
+}
+
+// TestOperationDocsMatchGeneratedPackages checks every key of the embedded operation docs
+// against the operations and optional arguments of the vips, vips817 and vips816 packages,
+// so that a renamed operation or argument does not silently drop its documentation
+func TestOperationDocsMatchGeneratedPackages(t *testing.T) {
+	prototype := regexp.MustCompile(`(?m)^int vipsgen_(\w+)\(([^)]*)\);`)
+	operations := map[string]bool{}
+	optionals := map[string]map[string]bool{}
+	for _, dir := range []string{"vips", "vips817", "vips816"} {
+		data, err := os.ReadFile(filepath.Join("..", "..", dir, "vips.h"))
+		if err != nil {
+			t.Skipf("generated %s/vips.h not available: %v", dir, err)
+		}
+		for _, m := range prototype.FindAllStringSubmatch(string(data), -1) {
+			name, ok := strings.CutSuffix(m[1], "_with_options")
+			if !ok {
+				operations[name] = true
+				continue
+			}
+			if optionals[name] == nil {
+				optionals[name] = map[string]bool{}
+			}
+			for _, param := range strings.Split(m[2], ", ") {
+				fields := strings.Fields(param)
+				optionals[name][strings.TrimLeft(fields[len(fields)-1], "*")] = true
+			}
+		}
+	}
+
+	docs := embeddedOperationDocs(t)
+	noted := map[string]bool{}
+	for _, note := range docs.Notes {
+		if len(note.Operations) == 0 || len(note.Note) == 0 {
+			t.Errorf("note %q needs operations and lines", note.Note)
+		}
+		for _, name := range note.Operations {
+			if !operations[name] {
+				t.Errorf("note for %s does not match any generated operation", name)
+			}
+			if noted[name] {
+				t.Errorf("%s has more than one note", name)
+			}
+			noted[name] = true
+		}
+	}
+	for _, override := range docs.Arguments {
+		for _, prefix := range override.OperationPrefixes {
+			var found bool
+			for name, args := range optionals {
+				if strings.HasPrefix(name, prefix) && args[override.Argument] {
+					found = true
+				}
+			}
+			if !found {
+				t.Errorf("description of %s does not match an optional argument of any operation starting with %s", override.Argument, prefix)
+			}
+		}
+	}
+}
@@ -0,0 +1,174 @@
+package generator
+
+import (
+	"bufio"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/cshum/vipsgen/internal/introspection"
+)
+
+var (
+	// templateIdentifier matches the identifiers in template code
+	templateIdentifier = regexp.MustCompile(`[A-Za-z_][A-Za-z0-9_]*`)
+	// templateMethodCall matches receiver.Method( calls in template code
+	templateMethodCall = regexp.MustCompile(`([A-Za-z_][A-Za-z0-9_]*)\.([A-Z][A-Za-z0-9]*)\(`)
+	// templateImport matches the quoted paths of an import block
+	templateImport = regexp.MustCompile(`(?m)^\s*"(?:[^"]*/)?([^"/]+)"$`)
+	// templateComment matches line comments, whose mentions of operations are not calls
+	templateComment = regexp.MustCompile(`(?m)//.*$`)
+)
+
+// RequiredOperations finds the operations that hand-written code in the
+// templates of fsys refers to, through their C or Go vipsgen wrappers,
+// creators, options types or image methods. They must always be generated, regardless
+// of include/exclude filters. Test templates are only scanned with includeTest,
+// when the generated tests must build too. A method of another type with the
+// name of an image method, such as WaitGroup.Add, keeps that operation too,
+// which errs on the side of a package that builds.
+func RequiredOperations(fsys fs.FS, operations []introspection.Operation, includeTest bool) (map[string]bool, error) {
+	identifiers := make(map[string]bool)
+	methods := make(map[string]bool)
+	err := fs.WalkDir(fsys, ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		name := d.Name()
+		if d.IsDir() || !strings.HasSuffix(name, ".tmpl") || (!includeTest && strings.HasSuffix(name, "_test.go.tmpl")) {
+			return nil
+		}
+		content, err := fs.ReadFile(fsys, path)
+		if err != nil {
+			return err
+		}
+		code := templateComment.ReplaceAllString(string(content), "")
+		for _, identifier := range templateIdentifier.FindAllString(code, -1) {
+			identifiers[identifier] = true
+		}
+		// Calls such as strings.Join( name a package, not an image method
+		packages := make(map[string]bool)
+		for _, match := range templateImport.FindAllStringSubmatch(code, -1) {
+			packages[match[1]] = true
+		}
+		for _, match := range templateMethodCall.FindAllStringSubmatch(code, -1) {
+			if !packages[match[1]] {
+				methods[match[2]] = true
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan templates for required operations: %v", err)
+	}
+
+	required := make(map[string]bool)
+	for _, op := range operations {
+		if identifiers["vipsgen_"+op.Name] ||
+			identifiers["vipsgen"+op.GoName] ||
+			identifiers["vipsgen"+op.GoName+"WithOptions"] ||
+			identifiers["New"+op.GoName] ||
+			identifiers[op.GoName+"Options"] ||
+			methods[op.GoName] {
+			required[op.Name] = true
+		}
+	}
+	return required, nil
+}
+
+// ParseOperationList parses operation names from either a path to a file,
+// with names separated by newlines or commas and # comments, or a comma
+// separated list. An empty value yields nil.
+func ParseOperationList(value string) ([]string, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil, nil
+	}
+
+	info, err := os.Stat(value)
+	if err != nil || info.IsDir() {
+		return splitOperationNames(value), nil
+	}
+
+	file, err := os.Open(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open operation list %s: %v", value, err)
+	}
+	defer file.Close()
+
+	var names []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+		names = append(names, splitOperationNames(line)...)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read operation list %s: %v", value, err)
+	}
+	return names, nil
+}
+
+func splitOperationNames(value string) []string {
+	var names []string
+	for _, name := range strings.Split(value, ",") {
+		name = strings.TrimPrefix(strings.TrimSpace(name), "vips_")
+		if name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// FilterOperations restricts operations to the include list when it is not
+// empty, then drops those in the exclude list. Required operations, see
+// RequiredOperations, are kept with a warning.
+func FilterOperations(operations []introspection.Operation, include, exclude []string, required map[string]bool) []introspection.Operation {
+	if len(include) == 0 && len(exclude) == 0 {
+		return operations
+	}
+
+	known := make(map[string]bool, len(operations))
+	for _, op := range operations {
+		known[op.Name] = true
+	}
+
+	includeSet := make(map[string]bool, len(include))
+	for _, name := range include {
+		if !known[name] {
+			log.Printf("Warning: included operation vips_%s was not discovered\n", name)
+		}
+		includeSet[name] = true
+	}
+
+	excludeSet := make(map[string]bool, len(exclude))
+	for _, name := range exclude {
+		if !known[name] {
+			log.Printf("Warning: excluded operation vips_%s was not discovered\n", name)
+		}
+		excludeSet[name] = true
+	}
+
+	var filtered []introspection.Operation
+	for _, op := range operations {
+		keep := len(includeSet) == 0 || includeSet[op.Name]
+		if excludeSet[op.Name] {
+			keep = false
+		}
+		if !keep && required[op.Name] {
+			log.Printf("Warning: operation vips_%s is used internally and cannot be dropped\n", op.Name)
+			keep = true
+		}
+		if keep {
+			filtered = append(filtered, op)
+		}
+	}
+
+	log.Printf("Filtered operations: %d of %d kept\n", len(filtered), len(operations))
+	return filtered
+}
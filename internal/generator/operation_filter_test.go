@@ -0,0 +1,221 @@
+package generator
+
+import (
+	"bytes"
+	"log"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/cshum/vipsgen/internal/introspection"
+	"github.com/cshum/vipsgen/internal/templates"
+)
+
+func testImageOperation(name, goName string) introspection.Operation {
+	in := introspection.Argument{Name: "in", GoName: "in", GoType: "*C.VipsImage", CType: "VipsImage*", IsInput: true, IsImage: true, IsRequired: true}
+	out := introspection.Argument{Name: "out", GoName: "out", GoType: "*C.VipsImage", CType: "VipsImage**", IsOutput: true, IsImage: true, IsRequired: true}
+	return introspection.Operation{
+		Name:              name,
+		GoName:            goName,
+		Description:       "vips_" + name + " test operation",
+		Arguments:         []introspection.Argument{in, out},
+		RequiredInputs:    []introspection.Argument{in},
+		RequiredOutputs:   []introspection.Argument{out},
+		HasThisImageInput: true,
+		HasImageOutput:    true,
+		HasOneImageOutput: true,
+	}
+}
+
+func operationNames(operations []introspection.Operation) []string {
+	var names []string
+	for _, op := range operations {
+		names = append(names, op.Name)
+	}
+	return names
+}
+
+func TestParseOperationListCommaSeparated(t *testing.T) {
+	got, err := ParseOperationList(" resize, vips_embed,,invert ")
+	if err != nil {
+		t.Fatalf("ParseOperationList returned error: %v", err)
+	}
+	want := []string{"resize", "embed", "invert"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected operations\n got: %#v\nwant: %#v", got, want)
+	}
+}
+
+func TestParseOperationListFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "operations.txt")
+	content := "# trimmed build\nresize\nembed, invert # inline comment\n\n"
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("WriteFile returned error: %v", err)
+	}
+
+	got, err := ParseOperationList(path)
+	if err != nil {
+		t.Fatalf("ParseOperationList returned error: %v", err)
+	}
+	want := []string{"resize", "embed", "invert"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected operations\n got: %#v\nwant: %#v", got, want)
+	}
+}
+
+func TestFilterOperationsKeepsRequiredOperations(t *testing.T) {
+	operations := []introspection.Operation{
+		testImageOperation("copy", "Copy"),
+		testImageOperation("embed", "Embed"),
+		testImageOperation("invert", "Invert"),
+		testImageOperation("sharpen", "Sharpen"),
+	}
+
+	var logs bytes.Buffer
+	log.SetOutput(&logs)
+	defer log.SetOutput(os.Stderr)
+
+	got := operationNames(FilterOperations(operations, nil, []string{"copy", "sharpen"}, map[string]bool{"copy": true, "invert": true}))
+	want := []string{"copy", "embed", "invert"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected operations\n got: %#v\nwant: %#v", got, want)
+	}
+	if !strings.Contains(logs.String(), "Warning: operation vips_copy is used internally and cannot be dropped") {
+		t.Fatalf("kept required operation copy without a warning\n%s", logs.String())
+	}
+	if strings.Contains(logs.String(), "vips_invert is used internally") {
+		t.Fatalf("warned about invert, which was not dropped\n%s", logs.String())
+	}
+}
+
+func TestGenerateOnlyIncludedOperations(t *testing.T) {
+	operations := []introspection.Operation{
+		testImageOperation("copy", "Copy"),
+		testImageOperation("embed", "Embed"),
+		testImageOperation("flatten", "Flatten"),
+		testImageOperation("resize", "Resize"),
+		testImageOperation("sharpen", "Sharpen"),
+	}
+	filtered := FilterOperations(operations, []string{"resize", "embed"}, nil, map[string]bool{"copy": true})
+
+	loader := NewFSTemplateLoader(templates.Templates, GetTemplateFuncMap())
+	tmpl, err := loader.LoadTemplate("image.go.tmpl")
+	if err != nil {
+		t.Fatalf("LoadTemplate returned error: %v", err)
+	}
+
+	var out bytes.Buffer
+	if err := tmpl.Execute(&out, &TemplateData{VipsVersion: "8.17.0", Operations: filtered}); err != nil {
+		t.Fatalf("Execute returned error: %v", err)
+	}
+	rendered := out.String()
+
+	for _, method := range []string{"Resize", "Embed", "Copy"} {
+		if !strings.Contains(rendered, "func (r *Image) "+method+"(") {
+			t.Fatalf("rendered template missing method %s", method)
+		}
+	}
+	for _, method := range []string{"Flatten", "Sharpen"} {
+		if strings.Contains(rendered, "func (r *Image) "+method+"(") {
+			t.Fatalf("rendered template unexpectedly contains method %s", method)
+		}
+	}
+}
+
+func TestGenerateResizeMultiPageOnlyWithResize(t *testing.T) {
+	loader := NewFSTemplateLoader(templates.Templates, GetTemplateFuncMap())
+	tmpl, err := loader.LoadTemplate("image.go.tmpl")
+	if err != nil {
+		t.Fatalf("LoadTemplate returned error: %v", err)
+	}
+	render := func(operations ...introspection.Operation) string {
+		var out bytes.Buffer
+		if err := tmpl.Execute(&out, &TemplateData{VipsVersion: "8.17.0", Operations: operations}); err != nil {
+			t.Fatalf("Execute returned error: %v", err)
+		}
+		return out.String()
+	}
+
+	if rendered := render(testImageOperation("copy", "Copy")); strings.Contains(rendered, "ResizeMultiPage") {
+		t.Fatalf("ResizeMultiPage is rendered without resize, whose ResizeOptions it takes")
+	}
+	if rendered := render(testImageOperation("resize", "Resize")); !strings.Contains(rendered, "func (r *Image) ResizeMultiPage(scale float64, options *ResizeOptions) error {\n\tif r.Height() == r.PageHeight() {") {
+		t.Fatalf("ResizeMultiPage is missing with resize")
+	}
+}
+
+func TestRequiredOperations(t *testing.T) {
+	fsys := fstest.MapFS{
+		"image.go.tmpl": {Data: []byte(`package vips
+
+import (
+	"strings"
+)
+
+// helper mentions Invert only in a comment
+func helper(r *Image) error {
+	if _, err := vipsgenEmbed(r.image, 0, 0, 1, 1); err != nil {
+		return err
+	}
+	_ = strings.Join(nil, "")
+	_ = &SharpenOptions{}
+	if _, err := NewBlack(1, 1, nil); err != nil {
+		return err
+	}
+	return r.Resize(1, nil)
+}
+`)},
+		"vips.c.tmpl":        {Data: []byte("int helper(VipsImage *in, VipsImage **out) { return vipsgen_copy(in, out); }\n")},
+		"image_test.go.tmpl": {Data: []byte("func TestFlip(t *testing.T) { img.Flip(DirectionHorizontal) }\n")},
+	}
+	black := introspection.Operation{Name: "black", GoName: "Black"}
+	operations := []introspection.Operation{
+		black,
+		testImageOperation("copy", "Copy"),
+		testImageOperation("embed", "Embed"),
+		testImageOperation("flip", "Flip"),
+		testImageOperation("invert", "Invert"),
+		testImageOperation("join", "Join"),
+		testImageOperation("resize", "Resize"),
+		testImageOperation("sharpen", "Sharpen"),
+	}
+
+	got, err := RequiredOperations(fsys, operations, false)
+	if err != nil {
+		t.Fatalf("RequiredOperations returned error: %v", err)
+	}
+	want := map[string]bool{"black": true, "copy": true, "embed": true, "resize": true, "sharpen": true}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected required operations\n got: %v\nwant: %v", got, want)
+	}
+
+	// Generated tests call methods too, so they must build with the package
+	got, err = RequiredOperations(fsys, operations, true)
+	if err != nil {
+		t.Fatalf("RequiredOperations returned error: %v", err)
+	}
+	want["flip"] = true
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected required operations with tests\n got: %v\nwant: %v", got, want)
+	}
+}
+
+func TestRequiredOperationsEmbeddedTemplates(t *testing.T) {
+	operations := []introspection.Operation{
+		testImageOperation("copy", "Copy"),
+		testImageOperation("embed", "Embed"),
+		testImageOperation("globalbalance", "Globalbalance"),
+		testImageOperation("resize", "Resize"),
+	}
+	got, err := RequiredOperations(templates.Templates, operations, false)
+	if err != nil {
+		t.Fatalf("RequiredOperations returned error: %v", err)
+	}
+	want := map[string]bool{"copy": true, "embed": true, "resize": true}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected required operations\n got: %v\nwant: %v", got, want)
+	}
+}
@@ -7,8 +7,147 @@ import (
 	"github.com/cshum/vipsgen/internal/introspection"
 )
 
-// generateOptionalInputsStruct generates a parameter struct for an operation
-func generateOptionalInputsStruct(op introspection.Operation) string {
+// commonSaveOptionsStruct is the struct embedded in the options of every saver,
+// holding the optional inputs that all savers share
+const commonSaveOptionsStruct = "CommonSaveOptions"
+
+// isSaveOperation reports whether the operation saves the image, e.g. vips_jpegsave_buffer.
+// vips_matrixprint is a saver that writes to stdout.
+func isSaveOperation(op introspection.Operation) bool {
+	return op.HasThisImageInput && (strings.Contains(op.Name, "save") || op.Name == "matrixprint")
+}
+
+// findCommonSaveOptions returns the optional inputs, in order, that every saver has
+// with the same type, or nil unless at least two savers share at least two of them
+func findCommonSaveOptions(operations []introspection.Operation) []introspection.Argument {
+	var common []introspection.Argument
+	savers := 0
+	for _, op := range operations {
+		if !isSaveOperation(op) {
+			continue
+		}
+		savers++
+		if savers == 1 {
+			common = append(common, op.OptionalInputs...)
+			continue
+		}
+		var shared []introspection.Argument
+		for _, arg := range common {
+			for _, opt := range op.OptionalInputs {
+				if opt.Name == arg.Name && opt.GoType == arg.GoType && opt.EnumType == arg.EnumType {
+					shared = append(shared, arg)
+					break
+				}
+			}
+		}
+		common = shared
+	}
+	if savers < 2 || len(common) < 2 {
+		return nil
+	}
+	return common
+}
+
+// embedsCommonSaveOptions reports whether the options struct of op embeds CommonSaveOptions
+func embedsCommonSaveOptions(op introspection.Operation, common []introspection.Argument) bool {
+	if len(common) == 0 || !isSaveOperation(op) {
+		return false
+	}
+	for _, arg := range common {
+		found := false
+		for _, opt := range op.OptionalInputs {
+			if opt.Name == arg.Name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// optionFieldType returns the Go type of an optional input field
+func optionFieldType(opt introspection.Argument) string {
+	if opt.IsEnum && opt.EnumType != "" {
+		return opt.EnumType
+	}
+	if opt.GoType == "*C.VipsImage" {
+		return "*Image"
+	} else if opt.GoType == "[]*C.VipsImage" {
+		return "[]*Image"
+	} else if opt.CType == "void*" {
+		return "[]byte"
+	}
+	return opt.GoType
+}
+
+// writeOptionField writes a documented optional input field
+func writeOptionField(result *strings.Builder, opt introspection.Argument) {
+	fieldName := strings.Title(opt.GoName)
+	if opt.Description != "" {
+		result.WriteString(fmt.Sprintf("\t// %s %s\n", fieldName, opt.Description))
+	}
+	result.WriteString(fmt.Sprintf("\t%s %s\n", fieldName, optionFieldType(opt)))
+}
+
+// optionDefaultValue returns the Go literal of a non-zero default value of an optional input
+func optionDefaultValue(opt introspection.Argument) (string, bool) {
+	switch v := opt.DefaultValue.(type) {
+	case enumConstant:
+		return string(v), true
+	case bool:
+		if v {
+			return fmt.Sprintf("%t", v), true
+		}
+	case int:
+		if v != 0 {
+			if opt.IsEnum && opt.EnumType != "" {
+				return fmt.Sprintf("%s(%d)", opt.EnumType, v), true
+			}
+			return fmt.Sprintf("%d", v), true
+		}
+	case float64:
+		if v != 0 {
+			return fmt.Sprintf("%g", v), true
+		}
+	case string:
+		if v != "" {
+			return fmt.Sprintf("%q", v), true
+		}
+	}
+	return "", false
+}
+
+// generateCommonSaveOptionsStruct generates the struct embedded in the options of every saver,
+// and the SaveOptions interface it implements
+func generateCommonSaveOptionsStruct(common []introspection.Argument) string {
+	if len(common) == 0 {
+		return ""
+	}
+	var result strings.Builder
+	result.WriteString(fmt.Sprintf("// %s optional arguments shared by all savers, embedded in the options of each saver\n", commonSaveOptionsStruct))
+	result.WriteString(fmt.Sprintf("type %s struct {\n", commonSaveOptionsStruct))
+	for _, opt := range common {
+		writeOptionField(&result, opt)
+	}
+	result.WriteString("}\n\n")
+
+	result.WriteString(fmt.Sprintf("// SaveOptions is implemented by the options of every saver through the embedded %s\n", commonSaveOptionsStruct))
+	result.WriteString("type SaveOptions interface {\n")
+	result.WriteString(fmt.Sprintf("\tCommonOptions() *%s\n", commonSaveOptionsStruct))
+	result.WriteString("}\n\n")
+
+	result.WriteString("// CommonOptions returns the optional arguments shared by all savers, e.g. to set Keep on the options of any saver\n")
+	result.WriteString(fmt.Sprintf("func (o *%s) CommonOptions() *%s {\n", commonSaveOptionsStruct, commonSaveOptionsStruct))
+	result.WriteString("\treturn o\n}\n")
+	return result.String()
+}
+
+// generateOptionalInputsStruct generates a parameter struct for an operation.
+// Savers embed CommonSaveOptions in place of the common optional inputs.
+func generateOptionalInputsStruct(op introspection.Operation, common []introspection.Argument) string {
 	supportedOptionalOutputs := getSupportedOptionalOutputs(op)
 	if len(op.OptionalInputs) == 0 && len(supportedOptionalOutputs) == 0 {
 		return ""
@@ -16,29 +155,25 @@ func generateOptionalInputsStruct(op introspection.Operation) string {
 	var result strings.Builder
 
 	structName := op.GoName + "Options"
+	shared := make(map[string]bool)
+	embedded := embedsCommonSaveOptions(op, common)
+	if embedded {
+		for _, arg := range common {
+			shared[arg.Name] = true
+		}
+	}
 
 	result.WriteString(fmt.Sprintf("// %s optional arguments for vips_%s\n", structName, op.Name))
 	result.WriteString(fmt.Sprintf("type %s struct {\n", structName))
 
 	for _, opt := range op.OptionalInputs {
-		fieldName := strings.Title(opt.GoName)
-		var fieldType string
-		if opt.GoType == "*C.VipsImage" {
-			fieldType = "*Image"
-		} else if opt.GoType == "[]*C.VipsImage" {
-			fieldType = "[]*Image"
-		} else if opt.CType == "void*" {
-			fieldType = "[]byte"
-		} else {
-			fieldType = opt.GoType
-		}
-		if opt.IsEnum && opt.EnumType != "" {
-			fieldType = opt.EnumType
+		if !shared[opt.Name] {
+			writeOptionField(&result, opt)
 		}
-		if opt.Description != "" {
-			result.WriteString(fmt.Sprintf("\t// %s %s\n", fieldName, opt.Description))
-		}
-		result.WriteString(fmt.Sprintf("\t%s %s\n", fieldName, fieldType))
+	}
+	if embedded {
+		result.WriteString(fmt.Sprintf("\t// %s optional arguments shared by all savers\n", commonSaveOptionsStruct))
+		result.WriteString(fmt.Sprintf("\t%s\n", commonSaveOptionsStruct))
 	}
 
 	if len(supportedOptionalOutputs) > 0 {
@@ -60,34 +195,24 @@ func generateOptionalInputsStruct(op introspection.Operation) string {
 		structName, op.Name))
 	result.WriteString(fmt.Sprintf("func Default%s() *%s {\n", structName, structName))
 	result.WriteString(fmt.Sprintf("\treturn &%s{\n", structName))
+	var sharedDefaults []string
 	for _, opt := range op.OptionalInputs {
+		value, ok := optionDefaultValue(opt)
+		if !ok {
+			continue
+		}
 		fieldName := strings.Title(opt.GoName)
-
-		if opt.DefaultValue != nil {
-			switch v := opt.DefaultValue.(type) {
-			case bool:
-				if v {
-					result.WriteString(fmt.Sprintf("\t\t%s: %t,\n", fieldName, v))
-				}
-			case int:
-				if v != 0 {
-					if opt.IsEnum && opt.EnumType != "" {
-						result.WriteString(fmt.Sprintf("\t\t%s: %s(%d),\n", fieldName, opt.EnumType, v))
-					} else {
-						result.WriteString(fmt.Sprintf("\t\t%s: %d,\n", fieldName, v))
-					}
-				}
-			case float64:
-				if v != 0 {
-					result.WriteString(fmt.Sprintf("\t\t%s: %g,\n", fieldName, v))
-				}
-			case string:
-				if v != "" {
-					result.WriteString(fmt.Sprintf("\t\t%s: %q,\n", fieldName, v))
-				}
-			}
+		if shared[opt.Name] {
+			sharedDefaults = append(sharedDefaults, fmt.Sprintf("\t\t\t%s: %s,\n", fieldName, value))
+		} else {
+			result.WriteString(fmt.Sprintf("\t\t%s: %s,\n", fieldName, value))
 		}
 	}
+	if len(sharedDefaults) > 0 {
+		result.WriteString(fmt.Sprintf("\t\t%s: %s{\n", commonSaveOptionsStruct, commonSaveOptionsStruct))
+		result.WriteString(strings.Join(sharedDefaults, ""))
+		result.WriteString("\t\t},\n")
+	}
 	result.WriteString("\t}\n}\n")
 
 	return result.String()
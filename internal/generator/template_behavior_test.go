@@ -1,6 +1,7 @@
 package generator
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/cshum/vipsgen/internal/introspection"
@@ -125,7 +126,7 @@ func TestGenerateCreatorMethodBodyBufferInputSnapshot(t *testing.T) {
 	}
 
 	got := generateCreatorMethodBody(op)
-	want := "Startup(nil)\n\tif len(buf) == 0 {\n\t\treturn nil, fmt.Errorf(\"jpegload_buffer: buffer is empty\")\n\t}\n\tif options != nil {\n\t\tvipsImage, err := vipsgenJpegloadBufferWithOptions(buf, options.Shrink, &options.Pages)\n\t\tif err != nil {\n\t\t\treturn nil, err\n\t\t}\n\t\treturn newImageRef(vipsImage, ImageTypeJpeg, buf), nil\n\t}\n\tvipsImage, err := vipsgenJpegloadBuffer(buf)\n\tif err != nil {\n\t\treturn nil, err\n\t}\n\treturn newImageRef(vipsImage, ImageTypeJpeg, buf), nil"
+	want := "Startup(nil)\n\tif len(buf) == 0 {\n\t\treturn nil, fmt.Errorf(\"jpegload_buffer: buffer is empty\")\n\t}\n\tif options != nil {\n\t\tvipsImage, err := vipsgenJpegloadBufferWithOptions(buf, options.Shrink, &options.Pages)\n\t\tif err != nil {\n\t\t\treturn nil, err\n\t\t}\n\t\tif err = checkImageLimits(vipsImage); err != nil {\n\t\t\tclearImage(vipsImage)\n\t\t\treturn nil, err\n\t\t}\n\t\treturn newImageRef(vipsImage, ImageTypeJpeg, buf), nil\n\t}\n\tvipsImage, err := vipsgenJpegloadBuffer(buf)\n\tif err != nil {\n\t\treturn nil, err\n\t}\n\tif err = checkImageLimits(vipsImage); err != nil {\n\t\tclearImage(vipsImage)\n\t\treturn nil, err\n\t}\n\treturn newImageRef(vipsImage, ImageTypeJpeg, buf), nil"
 
 	if got != want {
 		t.Fatalf("unexpected creator method body\n got: %q\nwant: %q", got, want)
@@ -146,7 +147,7 @@ func TestGenerateGoFunctionBodySingleScalarOutputSnapshot(t *testing.T) {
 	}
 
 	got := generateGoFunctionBody(op, false)
-	want := "// vipsgenAvg \nfunc vipsgenAvg(in *C.VipsImage) (float64, error) {\n\tvar out float64\n\tcout := new(C.double)\n\tif err := C.vipsgen_avg(in, cout); err != 0 {\n\t\treturn 0, handleVipsError()\n\t}\n\tout = float64(*cout)\n\treturn out, nil\n}"
+	want := "// vipsgenAvg \nfunc vipsgenAvg(in *C.VipsImage) (float64, error) {\n\tvar out float64\n\tcout := new(C.double)\n\tif err := C.vipsgen_avg(in, cout); err != 0 {\n\t\treturn 0, handleVipsError(\"avg\")\n\t}\n\tout = float64(*cout)\n\treturn out, nil\n}"
 
 	if got != want {
 		t.Fatalf("unexpected go wrapper body\n got: %q\nwant: %q", got, want)
@@ -252,3 +253,410 @@ func TestGenerateCFunctionImplementationPngSaveKeepsZeroAsUnsetSnapshot(t *testi
 		t.Fatalf("unexpected pngsave C function implementation\n got: %q\nwant: %q", got, want)
 	}
 }
+
+func TestGenerateCFunctionImplementationVersionGuardSnapshot(t *testing.T) {
+	op := introspection.Operation{
+		Name:       "remosaic",
+		MinVersion: "8.17",
+		Arguments: []introspection.Argument{
+			{Name: "in", CType: "VipsImage*", GoType: "*C.VipsImage", IsInput: true, IsImage: true},
+			{Name: "out", CType: "VipsImage**", GoType: "*C.VipsImage", IsOutput: true},
+		},
+		RequiredInputs: []introspection.Argument{
+			{Name: "in", CType: "VipsImage*", GoType: "*C.VipsImage", IsInput: true, IsImage: true},
+		},
+	}
+
+	got := generateCFunctionImplementation(op)
+	want := "int vipsgen_remosaic(VipsImage* in, VipsImage** out) {\n#if VIPSGEN_VERSION_AT_LEAST(8, 17)\n    return vips_remosaic(in, out, NULL);\n#else\n    return vipsgen_operation_unsupported(\"remosaic\");\n#endif\n}"
+
+	if got != want {
+		t.Fatalf("unexpected version guarded C function implementation\n got: %q\nwant: %q", got, want)
+	}
+}
+
+func TestNewTemplateDataDocumentsThumbnailNoRotate(t *testing.T) {
+	operations := []introspection.Operation{
+		{Name: "thumbnail_buffer", OptionalInputs: []introspection.Argument{
+			{Name: "no_rotate", GoName: "NoRotate", GoType: "bool", Description: "Don't use orientation tags to rotate image upright"},
+		}},
+		{Name: "rotate", OptionalInputs: []introspection.Argument{
+			{Name: "no_rotate", GoName: "NoRotate", GoType: "bool", Description: "unchanged"},
+		}},
+	}
+
+	data := NewTemplateData("8.17.0", operations, nil, nil, false, embeddedOperationDocs(t))
+
+	if got := data.Operations[0].OptionalInputs[0].Description; !strings.Contains(got, "apply to the upright image") {
+		t.Fatalf("unexpected thumbnail no_rotate description: %q", got)
+	}
+	if got := data.Operations[1].OptionalInputs[0].Description; got != "unchanged" {
+		t.Fatalf("unexpected description for non-thumbnail operation: %q", got)
+	}
+}
+
+func TestGenerateImageArgumentsCommentResizeNote(t *testing.T) {
+	op := documentedOperation(t, "resize", "Resize")
+
+	got := generateImageArgumentsComment(op)
+	want := "\n//\n// Images with straight alpha should be premultiplied before resizing and unpremultiplied after,\n// otherwise transparent pixels bleed into the edges as dark fringes."
+
+	if got != want {
+		t.Fatalf("unexpected resize comment\n got: %q\nwant: %q", got, want)
+	}
+}
+
+func TestGenerateImageArgumentsCommentJpegsaveBufferNote(t *testing.T) {
+	op := documentedOperation(t, "jpegsave_buffer", "JpegsaveBuffer")
+
+	got := generateImageArgumentsComment(op)
+	want := "\n//\n// JPEG has no alpha channel, so images with alpha are flattened onto Background,\n// black by default. Use Flatten or RemoveAlpha beforehand for more control."
+
+	if got != want {
+		t.Fatalf("unexpected jpegsave_buffer comment\n got: %q\nwant: %q", got, want)
+	}
+}
+
+func TestNewTemplateDataDocumentsWebpsaveNearLossless(t *testing.T) {
+	operations := []introspection.Operation{
+		{Name: "webpsave_buffer", OptionalInputs: []introspection.Argument{
+			{Name: "near_lossless", GoName: "NearLossless", GoType: "bool", Description: "Enable preprocessing in lossless mode (uses Q)"},
+		}},
+	}
+
+	data := NewTemplateData("8.17.0", operations, nil, nil, false, embeddedOperationDocs(t))
+
+	if got := data.Operations[0].OptionalInputs[0].Description; !strings.Contains(got, "Implies lossless encoding") {
+		t.Fatalf("unexpected webpsave near_lossless description: %q", got)
+	}
+}
+
+func TestNewTemplateDataDocumentsForeignSaveOptions(t *testing.T) {
+	operations := []introspection.Operation{
+		{Name: "jpegsave_buffer", OptionalInputs: []introspection.Argument{
+			{Name: "trellis_quant", GoName: "TrellisQuant", GoType: "bool", Description: "Apply trellis quantisation to each 8x8 block"},
+			{Name: "subsample_mode", GoName: "SubsampleMode", GoType: "Subsample", Description: "Select chroma subsample operation mode"},
+		}},
+		{Name: "dzsave_buffer", OptionalInputs: []introspection.Argument{
+			{Name: "container", GoName: "Container", GoType: "DzContainer", Description: "Pyramid container type"},
+		}},
+		{Name: "tiffsave_buffer", OptionalInputs: []introspection.Argument{
+			{Name: "pyramid", GoName: "Pyramid", GoType: "bool", Description: "Write a pyramidal tiff"},
+		}},
+	}
+
+	data := NewTemplateData("8.17.0", operations, nil, nil, false, embeddedOperationDocs(t))
+
+	if got := data.Operations[0].OptionalInputs[0].Description; !strings.Contains(got, "mozjpeg") || !strings.Contains(got, "ErrOperationNotSupported") {
+		t.Fatalf("trellis_quant description does not mention mozjpeg and the error: %q", got)
+	}
+	if got := data.Operations[0].OptionalInputs[1].Description; got != "Select chroma subsample operation mode" {
+		t.Fatalf("unexpected subsample_mode description: %q", got)
+	}
+	if got := data.Operations[1].OptionalInputs[0].Description; !strings.Contains(got, "saved as a zip") {
+		t.Fatalf("unexpected dzsave_buffer container description: %q", got)
+	}
+	if got := data.Operations[2].OptionalInputs[0].Description; !strings.Contains(got, "implies Tile") {
+		t.Fatalf("pyramid description does not mention tiling: %q", got)
+	}
+}
+
+func TestGenerateGoFunctionBodyOptionalModuleSaverGuard(t *testing.T) {
+	bufferSaver := func(name, goName string) introspection.Operation {
+		return introspection.Operation{
+			Name:            name,
+			GoName:          goName,
+			HasBufferOutput: true,
+			Arguments: []introspection.Argument{
+				{Name: "in", GoName: "in", GoType: "*C.VipsImage", CType: "VipsImage*", IsInput: true, IsImage: true},
+				{Name: "buf", GoName: "buf", GoType: "[]byte", CType: "void**", IsOutput: true, IsBuffer: true},
+				{Name: "len", GoName: "len", GoType: "int", CType: "size_t*", IsOutput: true},
+			},
+		}
+	}
+
+	guard := "func vipsgenHeifsaveBuffer(in *C.VipsImage) ([]byte, error) {\n\tif err := requireOptionalOperation(\"heifsave_buffer\"); err != nil {\n\t\treturn nil, err\n\t}\n"
+	if got := generateGoFunctionBody(bufferSaver("heifsave_buffer", "HeifsaveBuffer"), false); !strings.Contains(got, guard) {
+		t.Fatalf("heifsave_buffer wrapper is missing the module guard\n got: %q", got)
+	}
+	jxlsave := bufferSaver("jxlsave_buffer", "JxlsaveBuffer")
+	jxlsave.OptionalInputs = []introspection.Argument{
+		{Name: "lossless", GoName: "lossless", GoType: "bool", CType: "gboolean", IsInput: true},
+	}
+	guard = "func vipsgenJxlsaveBufferWithOptions(in *C.VipsImage, lossless bool) ([]byte, error) {\n\tif err := requireOptionalOperation(\"jxlsave_buffer\"); err != nil {\n\t\treturn nil, err\n\t}\n"
+	if got := generateGoFunctionBody(jxlsave, true); !strings.Contains(got, guard) {
+		t.Fatalf("jxlsave_buffer options wrapper is missing the module guard\n got: %q", got)
+	}
+	if got := generateGoFunctionBody(bufferSaver("webpsave_buffer", "WebpsaveBuffer"), true); strings.Contains(got, "requireOptionalOperation") {
+		t.Fatalf("webpsave_buffer wrapper should not be guarded\n got: %q", got)
+	}
+}
+
+func TestGenerateGoFunctionBodyOptionRangeCheck(t *testing.T) {
+	op := introspection.Operation{
+		Name:   "gifsave",
+		GoName: "Gifsave",
+		Arguments: []introspection.Argument{
+			{Name: "in", GoName: "in", GoType: "*C.VipsImage", CType: "VipsImage*", IsInput: true, IsImage: true},
+		},
+		OptionalInputs: []introspection.Argument{
+			{Name: "bitdepth", GoName: "bitdepth", GoType: "int", CType: "gint"},
+		},
+	}
+
+	got := generateGoFunctionBody(op, true)
+	want := "if err := checkOptionRange(\"gifsave\", \"bitdepth\", bitdepth, 1, 8); err != nil {\n\t\treturn err\n\t}\n"
+	if !strings.Contains(got, want) {
+		t.Fatalf("gifsave wrapper is missing the bitdepth range check\n got: %q", got)
+	}
+	if got := generateGoFunctionBody(op, false); strings.Contains(got, "checkOptionRange") {
+		t.Fatalf("wrapper without options should not check option ranges\n got: %q", got)
+	}
+}
+
+func TestGenerateImageArgumentsCommentRawsaveNote(t *testing.T) {
+	op := documentedOperation(t, "rawsave_buffer", "RawsaveBuffer")
+
+	got := generateImageArgumentsComment(op)
+	want := "\n//\n// The output has no header: Height rows of Width pixels, each with Bands interleaved samples\n// of SampleSize bytes in native byte order. Keep Width, Height, Bands and BandFormat to read it back."
+
+	if got != want {
+		t.Fatalf("unexpected rawsave_buffer comment\n got: %q\nwant: %q", got, want)
+	}
+}
+
+func TestGenerateOptionalInputsStructEmbedsCommonSaveOptions(t *testing.T) {
+	common := []introspection.Argument{
+		{Name: "keep", GoName: "keep", GoType: "int", IsEnum: true, EnumType: "Keep", Description: "Which metadata to retain"},
+		{Name: "background", GoName: "background", GoType: "[]float64", Description: "Background value"},
+		{Name: "page_height", GoName: "pageHeight", GoType: "int", Description: "Set page height for multipage save"},
+		{Name: "profile", GoName: "profile", GoType: "string", Description: "Filename of ICC profile to embed"},
+	}
+	saver := func(name, goName string, specific introspection.Argument) introspection.Operation {
+		return introspection.Operation{
+			Name:              name,
+			GoName:            goName,
+			HasThisImageInput: true,
+			OptionalInputs:    append([]introspection.Argument{specific}, common...),
+		}
+	}
+	jpegsave := saver("jpegsave_buffer", "JpegsaveBuffer",
+		introspection.Argument{Name: "Q", GoName: "q", GoType: "int", Description: "Q factor", DefaultValue: 75})
+	pngsave := saver("pngsave_buffer", "PngsaveBuffer",
+		introspection.Argument{Name: "compression", GoName: "compression", GoType: "int", Description: "Compression factor", DefaultValue: 6})
+	embed := introspection.Operation{
+		Name:              "embed",
+		GoName:            "Embed",
+		HasThisImageInput: true,
+		OptionalInputs:    []introspection.Argument{common[1]},
+	}
+
+	data := NewTemplateData("8.17.0", []introspection.Operation{embed, jpegsave, pngsave}, nil, nil, false, nil)
+	var names []string
+	for _, arg := range data.CommonSaveOptions {
+		names = append(names, arg.Name)
+	}
+	if got := strings.Join(names, ","); got != "keep,background,page_height,profile" {
+		t.Fatalf("unexpected common save options: %s", got)
+	}
+
+	got := generateOptionalInputsStruct(jpegsave, data.CommonSaveOptions)
+	for _, want := range []string{"\t// Q Q factor\n\tQ int\n", "\tCommonSaveOptions\n", "\t\tQ: 75,\n"} {
+		if !strings.Contains(got, want) {
+			t.Fatalf("JpegsaveBufferOptions missing %q\n%s", want, got)
+		}
+	}
+	if strings.Contains(got, "Keep Keep") || strings.Contains(got, "Profile string") {
+		t.Fatalf("JpegsaveBufferOptions repeats the common save options\n%s", got)
+	}
+
+	shared := generateCommonSaveOptionsStruct(data.CommonSaveOptions)
+	for _, want := range []string{"type CommonSaveOptions struct", "\tKeep Keep\n", "\tPageHeight int\n", "type SaveOptions interface"} {
+		if !strings.Contains(shared, want) {
+			t.Fatalf("CommonSaveOptions missing %q\n%s", want, shared)
+		}
+	}
+
+	// Other operations keep their own fields even when a saver shares them
+	if got := generateOptionalInputsStruct(embed, data.CommonSaveOptions); !strings.Contains(got, "\tBackground []float64\n") ||
+		strings.Contains(got, "CommonSaveOptions") {
+		t.Fatalf("EmbedOptions should not embed the common save options\n%s", got)
+	}
+
+	// A single saver has nothing to share
+	if common := findCommonSaveOptions([]introspection.Operation{jpegsave}); common != nil {
+		t.Fatalf("expected no common save options for a single saver, got %d", len(common))
+	}
+}
+
+func TestGenerateOptionalInputsStructDefaults(t *testing.T) {
+	enumTypes := []introspection.EnumTypeInfo{
+		{GoName: "Kernel", Values: []introspection.EnumValueInfo{
+			{GoName: "KernelNearest", Value: 0},
+			{GoName: "KernelLanczos3", Value: 5},
+		}},
+	}
+	operations := []introspection.Operation{
+		{Name: "jpegsave_buffer", GoName: "JpegsaveBuffer", HasThisImageInput: true, OptionalInputs: []introspection.Argument{
+			{Name: "Q", GoName: "q", GoType: "int", DefaultValue: 75},
+			{Name: "interlace", GoName: "interlace", GoType: "bool", DefaultValue: false},
+		}},
+		{Name: "resize", GoName: "Resize", HasThisImageInput: true, OptionalInputs: []introspection.Argument{
+			{Name: "kernel", GoName: "kernel", GoType: "int", IsEnum: true, EnumType: "Kernel", DefaultValue: 5},
+			{Name: "gap", GoName: "gap", GoType: "float64", DefaultValue: 2.0},
+			{Name: "vscale", GoName: "vscale", GoType: "float64", DefaultValue: 0.0},
+		}},
+		{Name: "reduce", GoName: "Reduce", HasThisImageInput: true, OptionalInputs: []introspection.Argument{
+			{Name: "kernel", GoName: "kernel", GoType: "int", IsEnum: true, EnumType: "Kernel", DefaultValue: 9},
+		}},
+	}
+	data := NewTemplateData("8.17.0", operations, enumTypes, nil, false, nil)
+
+	tests := []struct {
+		op      introspection.Operation
+		want    string
+		notWant string
+	}{
+		{data.Operations[0], "\treturn &JpegsaveBufferOptions{\n\t\tQ: 75,\n\t}\n", "Interlace:"},
+		{data.Operations[1], "\treturn &ResizeOptions{\n\t\tKernel: KernelLanczos3,\n\t\tGap: 2,\n\t}\n", "Vscale:"},
+		// Values without a Go constant stay numeric
+		{data.Operations[2], "\t\tKernel: Kernel(9),\n", ""},
+	}
+	for _, tt := range tests {
+		got := generateOptionalInputsStruct(tt.op, nil)
+		if !strings.Contains(got, tt.want) {
+			t.Fatalf("Default%sOptions missing %q\n%s", tt.op.GoName, tt.want, got)
+		}
+		if tt.notWant != "" && strings.Contains(got, tt.notWant) {
+			t.Fatalf("Default%sOptions unexpectedly sets %q\n%s", tt.op.GoName, tt.notWant, got)
+		}
+	}
+}
+
+func TestGenerateMethodBodiesCheckBlendModes(t *testing.T) {
+	composite2 := introspection.Operation{
+		GoName:            "Composite2",
+		HasOneImageOutput: true,
+		Arguments: []introspection.Argument{
+			{Name: "base", GoName: "base", GoType: "*C.VipsImage", IsInput: true, IsImage: true},
+			{Name: "overlay", GoName: "overlay", GoType: "*C.VipsImage", IsInput: true, IsImage: true},
+			{Name: "mode", GoName: "mode", GoType: "BlendMode", IsInput: true},
+		},
+	}
+	got := generateImageMethodBody(composite2)
+	want := "if err := checkBlendMode(mode); err != nil {\n\t\treturn err\n\t}\n\tout, err := vipsgenComposite2(r.image, overlay.image, mode)"
+	if !strings.HasPrefix(got, want) {
+		t.Fatalf("image method body does not check the blend mode first\n got: %q\nwant prefix: %q", got, want)
+	}
+
+	composite2.OptionalInputs = []introspection.Argument{
+		{Name: "x", GoName: "x", GoType: "int", IsInput: true},
+	}
+	got = generateImageMethodBody(composite2)
+	want = "if err := checkBlendMode(mode); err != nil {\n\t\treturn err\n\t}\n\tif options != nil {"
+	if !strings.HasPrefix(got, want) {
+		t.Fatalf("image method body with options does not check the blend mode first\n got: %q\nwant prefix: %q", got, want)
+	}
+
+	composite := introspection.Operation{
+		Name:   "composite",
+		GoName: "Composite",
+		RequiredInputs: []introspection.Argument{
+			{Name: "in", GoName: "in", GoType: "[]*C.VipsImage", IsInput: true},
+			{Name: "mode", GoName: "mode", GoType: "[]BlendMode", IsInput: true},
+		},
+	}
+	got = generateCreatorMethodBody(composite)
+	want = "Startup(nil)\n\tif err := checkBlendMode(mode...); err != nil {\n\t\treturn nil, err\n\t}\n\tvipsImage, err := vipsgenComposite(convertImagesToVipsImages(in), mode)"
+	if !strings.HasPrefix(got, want) {
+		t.Fatalf("creator body does not check the blend modes first\n got: %q\nwant prefix: %q", got, want)
+	}
+}
+
+func TestGenerateCreatorBodyChecksMaskFrequencies(t *testing.T) {
+	maskIdealBand := introspection.Operation{
+		Name:   "mask_ideal_band",
+		GoName: "MaskIdealBand",
+		RequiredInputs: []introspection.Argument{
+			{Name: "frequency_cutoff_x", GoName: "frequencyCutoffX", GoType: "float64", IsInput: true},
+			{Name: "frequency_cutoff_y", GoName: "frequencyCutoffY", GoType: "float64", IsInput: true},
+			{Name: "radius", GoName: "radius", GoType: "float64", IsInput: true},
+		},
+	}
+	got := generateCreatorMethodBody(maskIdealBand)
+	for _, name := range []string{"frequencyCutoffX", "frequencyCutoffY"} {
+		want := "if " + name + " < 0 || " + name + " > 1 {\n\t\treturn nil, fmt.Errorf(\"mask_ideal_band: " + name + " %g is outside the range 0 to 1\", " + name + ")\n\t}"
+		if !strings.Contains(got, want) {
+			t.Fatalf("creator body does not check %s\n got: %q", name, got)
+		}
+	}
+	if strings.Contains(got, "radius < 0") {
+		t.Fatalf("creator body unexpectedly checks radius\n got: %q", got)
+	}
+
+	sines := introspection.Operation{
+		Name:   "sines",
+		GoName: "Sines",
+		RequiredInputs: []introspection.Argument{
+			{Name: "frequency_cutoff", GoName: "frequencyCutoff", GoType: "float64", IsInput: true},
+		},
+	}
+	if got := generateCreatorMethodBody(sines); strings.Contains(got, "outside the range") {
+		t.Fatalf("non-mask creator body unexpectedly checks frequencies\n got: %q", got)
+	}
+}
+
+func TestGenerateImageMethodBodyChecksSingleBand(t *testing.T) {
+	falsecolour := introspection.Operation{
+		Name:              "falsecolour",
+		GoName:            "Falsecolour",
+		HasThisImageInput: true,
+		HasOneImageOutput: true,
+	}
+	got := generateImageMethodBody(falsecolour)
+	want := "if bands := r.Bands(); bands != 1 {\n\t\treturn fmt.Errorf(\"falsecolour: image has %d bands"
+	if !strings.HasPrefix(got, want) {
+		t.Fatalf("falsecolour body does not check bands first\n got: %q", got)
+	}
+
+	invert := introspection.Operation{
+		Name:              "invert",
+		GoName:            "Invert",
+		HasThisImageInput: true,
+		HasOneImageOutput: true,
+	}
+	if got := generateImageMethodBody(invert); strings.Contains(got, "r.Bands()") {
+		t.Fatalf("invert body unexpectedly checks bands\n got: %q", got)
+	}
+}
+
+func TestGenerateImageMethodBodyChecksTrellisQuant(t *testing.T) {
+	trellisQuant := introspection.Argument{Name: "trellis_quant", GoName: "TrellisQuant", GoType: "bool", IsInput: true}
+	jpegsaveBuffer := introspection.Operation{
+		Name:              "jpegsave_buffer",
+		GoName:            "JpegsaveBuffer",
+		HasThisImageInput: true,
+		HasBufferOutput:   true,
+		OptionalInputs:    []introspection.Argument{trellisQuant},
+	}
+	got := generateImageMethodBody(jpegsaveBuffer)
+	want := "if options != nil && options.TrellisQuant {\n\t\tif err := checkTrellisQuant(); err != nil {\n\t\t\treturn nil, err\n\t\t}\n\t}\n\tif options != nil {"
+	if !strings.HasPrefix(got, want) {
+		t.Fatalf("jpegsave_buffer body does not check trellis_quant first\n got: %q\nwant prefix: %q", got, want)
+	}
+
+	jpegsave := introspection.Operation{
+		Name:              "jpegsave",
+		GoName:            "Jpegsave",
+		HasThisImageInput: true,
+		RequiredInputs: []introspection.Argument{
+			{Name: "filename", GoName: "filename", GoType: "string", IsInput: true},
+		},
+		OptionalInputs: []introspection.Argument{trellisQuant},
+	}
+	got = generateImageMethodBody(jpegsave)
+	want = "if options != nil && options.TrellisQuant {\n\t\tif err := checkTrellisQuant(); err != nil {\n\t\t\treturn err\n\t\t}\n\t}\n\tif options != nil {"
+	if !strings.HasPrefix(got, want) {
+		t.Fatalf("jpegsave body does not check trellis_quant first\n got: %q\nwant prefix: %q", got, want)
+	}
+}
@@ -11,6 +11,8 @@ type TemplateData struct {
 	EnumTypes   []introspection.EnumTypeInfo
 	ImageTypes  []introspection.ImageTypeInfo
 	IncludeTest bool
+	// CommonSaveOptions are the optional inputs shared by all savers, embedded in their options
+	CommonSaveOptions []introspection.Argument
 }
 
 // NewTemplateData creates a new TemplateData structure with all needed information
@@ -20,14 +22,19 @@ func NewTemplateData(
 	enumTypes []introspection.EnumTypeInfo,
 	imageTypes []introspection.ImageTypeInfo,
 	includeTest bool,
+	docs *OperationDocs,
 ) *TemplateData {
 	applyEnumOverrides(enumTypes)
+	applyEnumDefaults(operations, enumTypes)
+	applyOperationDocs(operations, docs)
 	return &TemplateData{
 		VipsVersion: vipsVersion,
 		Operations:  operations,
 		EnumTypes:   enumTypes,
 		ImageTypes:  imageTypes,
 		IncludeTest: includeTest,
+
+		CommonSaveOptions: findCommonSaveOptions(operations),
 	}
 }
 
@@ -47,3 +54,33 @@ func applyEnumOverrides(enumTypes []introspection.EnumTypeInfo) {
 		}
 	}
 }
+
+// enumConstant is the Go constant name of an enum default value, e.g. KernelLanczos3
+type enumConstant string
+
+// applyEnumDefaults replaces the numeric default values of enum optional inputs
+// with the Go constant of that value, so Default*Options read as KernelLanczos3
+// rather than Kernel(5). Values without a matching constant are left numeric.
+func applyEnumDefaults(operations []introspection.Operation, enumTypes []introspection.EnumTypeInfo) {
+	constants := make(map[string]map[int]string, len(enumTypes))
+	for _, et := range enumTypes {
+		values := make(map[int]string, len(et.Values))
+		for _, v := range et.Values {
+			if _, ok := values[v.Value]; !ok {
+				values[v.Value] = v.GoName
+			}
+		}
+		constants[et.GoName] = values
+	}
+	for i, op := range operations {
+		for j, opt := range op.OptionalInputs {
+			value, ok := opt.DefaultValue.(int)
+			if !ok || !opt.IsEnum || value == 0 {
+				continue
+			}
+			if name, ok := constants[opt.EnumType][value]; ok {
+				operations[i].OptionalInputs[j].DefaultValue = enumConstant(name)
+			}
+		}
+	}
+}
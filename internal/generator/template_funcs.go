@@ -1,6 +1,7 @@
 package generator
 
 import (
+	"strings"
 	"text/template"
 
 	"github.com/cshum/vipsgen/internal/introspection"
@@ -21,10 +22,13 @@ func GetTemplateFuncMap() template.FuncMap {
 		"generateCFunctionDeclaration":       generateCFunctionDeclaration,
 		"generateCFunctionImplementation":    generateCFunctionImplementation,
 		"generateOptionalInputsStruct":       generateOptionalInputsStruct,
+		"generateCommonSaveOptionsStruct":    generateCommonSaveOptionsStruct,
 		"generateUtilFunctionCallArgs":       generateUtilFunctionCallArgs,
 		"generateUtilityFunctionReturnTypes": generateUtilityFunctionReturnTypes,
 		"getSupportedOptionalOutputs":        getSupportedOptionalOutputs,
 		"hasWithOptionsVariant":              hasWithOptionsVariant,
+		"enumStringValues":                   enumStringValues,
+		"enumNicknames":                      enumNicknames,
 	}
 }
 
@@ -43,3 +47,27 @@ func getSupportedOptionalOutputs(op introspection.Operation) []introspection.Arg
 func hasWithOptionsVariant(op introspection.Operation) bool {
 	return len(op.OptionalInputs) > 0 || len(getSupportedOptionalOutputs(op)) > 0
 }
+
+// enumStringValues returns the enum values named by String, keeping the first
+// value with a nickname for each number so that no switch case is repeated
+func enumStringValues(values []introspection.EnumValueInfo) []introspection.EnumValueInfo {
+	var unique []introspection.EnumValueInfo
+	seen := make(map[int]bool, len(values))
+	for _, v := range values {
+		if v.Description == "" || seen[v.Value] {
+			continue
+		}
+		seen[v.Value] = true
+		unique = append(unique, v)
+	}
+	return unique
+}
+
+// enumNicknames lists the nicknames of the enum values named by String, comma separated
+func enumNicknames(values []introspection.EnumValueInfo) string {
+	var nicknames []string
+	for _, v := range enumStringValues(values) {
+		nicknames = append(nicknames, v.Description)
+	}
+	return strings.Join(nicknames, ", ")
+}
@@ -27,6 +27,33 @@ type Operation struct {
 	HasBufferOutput    bool
 	HasArrayImageInput bool
 	ImageTypeString    string
+	MinVersion         string
+	// DocNote is a usage note appended to the generated doc comment
+	DocNote string
+}
+
+// operationMinVersions lists the libvips version that introduced operations
+// whose C entry points are not present in older headers, as given by the libvips ChangeLog.
+// Operations missing here compile only against the libvips they were generated from, so
+// TestOperationVersionGuardsMatchGeneratedPackages checks this list against the bundled
+// packages generated from libvips 8.16, 8.17 and 8.18, and fails when a release adds one.
+var operationMinVersions = map[string]string{
+	"clamp":             "8.16",
+	"maxpair":           "8.16",
+	"minpair":           "8.16",
+	"sdf":               "8.16",
+	"matrixmultiply":    "8.17",
+	"ppmload_buffer":    "8.17",
+	"remosaic":          "8.17",
+	"dcrawload":         "8.18",
+	"dcrawload_buffer":  "8.18",
+	"dcrawload_source":  "8.18",
+	"magickload_source": "8.18",
+	"Oklab2Oklch":       "8.18",
+	"Oklab2XYZ":         "8.18",
+	"Oklch2Oklab":       "8.18",
+	"XYZ2Oklab":         "8.18",
+	"uhdr2scRGB":        "8.18",
 }
 
 // Argument represents an argument to a libvips operation
@@ -98,6 +125,7 @@ func (v *Introspection) DiscoverOperations() []Operation {
 			HasBufferOutput:    int(details.has_buffer_output) != 0,
 			HasArrayImageInput: int(details.has_array_image_input) != 0,
 			ImageTypeString:    v.determineImageTypeStringFromOperation(name),
+			MinVersion:         operationMinVersions[name],
 		}
 
 		v.discoverEnumsFromOperation(name)
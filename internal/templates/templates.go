@@ -2,5 +2,5 @@ package templates
 
 import "embed"
 
-//go:embed *.tmpl
+//go:embed *.tmpl operation_docs.json
 var Templates embed.FS
@@ -34,12 +34,18 @@ func goSourceRead(
 		Cap:  int(size),
 	}
 	buf := *(*[]byte)(unsafe.Pointer(sh))
-	n, err := source.reader.Read(buf)
-	if err == io.EOF {
-		return C.longlong(n)
-	} else if err != nil {
+	var n int
+	var err error
+	if source.readerAt != nil {
+		n, err = source.readAt(buf)
+	} else {
+		n, err = source.reader.Read(buf)
+	}
+	if err != nil && err != io.EOF {
+		source.readErr = err
 		return -1
 	}
+	source.bytesRead.Add(int64(n))
 	return C.longlong(n)
 }
 
@@ -53,6 +59,9 @@ func goSourceSeek(
 	}
 	source.lock.Lock()
 	defer source.lock.Unlock()
+	if source.readerAt != nil {
+		return C.longlong(source.seekReaderAt(int64(offset), int(whence)))
+	}
 	if source.seeker == nil {
 		return -1
 	}
@@ -89,6 +98,7 @@ func goTargetWrite(
 	if err != nil {
 		return -1
 	}
+	target.bytesWritten.Add(int64(n))
 	return C.longlong(n)
 }
 
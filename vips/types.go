@@ -5,6 +5,8 @@ package vips
 // #include <vips/vips.h>
 import "C"
 import (
+	"fmt"
+	"strconv"
 	"strings"
 	"unsafe"
 )
@@ -54,6 +56,32 @@ const (
 	AccessSequentialUnbuffered Access = C.VIPS_ACCESS_SEQUENTIAL_UNBUFFERED
 )
 
+// String returns the libvips nickname of the Access, or Access(N) for an unknown value
+func (e Access) String() string {
+	switch e {
+	case AccessRandom:
+		return "random"
+	case AccessSequential:
+		return "sequential"
+	case AccessSequentialUnbuffered:
+		return "sequential-unbuffered"
+	}
+	return "Access(" + strconv.Itoa(int(e)) + ")"
+}
+
+// ParseAccess returns the Access with the given libvips nickname, ignoring case
+func ParseAccess(s string) (Access, error) {
+	switch strings.ToLower(s) {
+	case "random":
+		return AccessRandom, nil
+	case "sequential":
+		return AccessSequential, nil
+	case "sequential-unbuffered":
+		return AccessSequentialUnbuffered, nil
+	}
+	return 0, fmt.Errorf("unknown Access %q, valid values are: random, sequential, sequential-unbuffered", s)
+}
+
 // Align represents VipsAlign type
 type Align int
 
@@ -64,6 +92,32 @@ const (
 	AlignHigh Align = C.VIPS_ALIGN_HIGH
 )
 
+// String returns the libvips nickname of the Align, or Align(N) for an unknown value
+func (e Align) String() string {
+	switch e {
+	case AlignLow:
+		return "low"
+	case AlignCentre:
+		return "centre"
+	case AlignHigh:
+		return "high"
+	}
+	return "Align(" + strconv.Itoa(int(e)) + ")"
+}
+
+// ParseAlign returns the Align with the given libvips nickname, ignoring case
+func ParseAlign(s string) (Align, error) {
+	switch strings.ToLower(s) {
+	case "low":
+		return AlignLow, nil
+	case "centre":
+		return AlignCentre, nil
+	case "high":
+		return AlignHigh, nil
+	}
+	return 0, fmt.Errorf("unknown Align %q, valid values are: low, centre, high", s)
+}
+
 // Angle represents VipsAngle type
 type Angle int
 
@@ -75,6 +129,36 @@ const (
 	AngleD270 Angle = C.VIPS_ANGLE_D270
 )
 
+// String returns the libvips nickname of the Angle, or Angle(N) for an unknown value
+func (e Angle) String() string {
+	switch e {
+	case AngleD0:
+		return "d0"
+	case AngleD90:
+		return "d90"
+	case AngleD180:
+		return "d180"
+	case AngleD270:
+		return "d270"
+	}
+	return "Angle(" + strconv.Itoa(int(e)) + ")"
+}
+
+// ParseAngle returns the Angle with the given libvips nickname, ignoring case
+func ParseAngle(s string) (Angle, error) {
+	switch strings.ToLower(s) {
+	case "d0":
+		return AngleD0, nil
+	case "d90":
+		return AngleD90, nil
+	case "d180":
+		return AngleD180, nil
+	case "d270":
+		return AngleD270, nil
+	}
+	return 0, fmt.Errorf("unknown Angle %q, valid values are: d0, d90, d180, d270", s)
+}
+
 // Angle45 represents VipsAngle45 type
 type Angle45 int
 
@@ -90,6 +174,52 @@ const (
 	Angle45D315 Angle45 = C.VIPS_ANGLE45_D315
 )
 
+// String returns the libvips nickname of the Angle45, or Angle45(N) for an unknown value
+func (e Angle45) String() string {
+	switch e {
+	case Angle45D0:
+		return "d0"
+	case Angle45D45:
+		return "d45"
+	case Angle45D90:
+		return "d90"
+	case Angle45D135:
+		return "d135"
+	case Angle45D180:
+		return "d180"
+	case Angle45D225:
+		return "d225"
+	case Angle45D270:
+		return "d270"
+	case Angle45D315:
+		return "d315"
+	}
+	return "Angle45(" + strconv.Itoa(int(e)) + ")"
+}
+
+// ParseAngle45 returns the Angle45 with the given libvips nickname, ignoring case
+func ParseAngle45(s string) (Angle45, error) {
+	switch strings.ToLower(s) {
+	case "d0":
+		return Angle45D0, nil
+	case "d45":
+		return Angle45D45, nil
+	case "d90":
+		return Angle45D90, nil
+	case "d135":
+		return Angle45D135, nil
+	case "d180":
+		return Angle45D180, nil
+	case "d225":
+		return Angle45D225, nil
+	case "d270":
+		return Angle45D270, nil
+	case "d315":
+		return Angle45D315, nil
+	}
+	return 0, fmt.Errorf("unknown Angle45 %q, valid values are: d0, d45, d90, d135, d180, d225, d270, d315", s)
+}
+
 // BandFormat represents VipsBandFormat type
 type BandFormat int
 
@@ -108,6 +238,64 @@ const (
 	BandFormatDpcomplex BandFormat = C.VIPS_FORMAT_DPCOMPLEX
 )
 
+// String returns the libvips nickname of the BandFormat, or BandFormat(N) for an unknown value
+func (e BandFormat) String() string {
+	switch e {
+	case BandFormatNotset:
+		return "notset"
+	case BandFormatUchar:
+		return "uchar"
+	case BandFormatChar:
+		return "char"
+	case BandFormatUshort:
+		return "ushort"
+	case BandFormatShort:
+		return "short"
+	case BandFormatUint:
+		return "uint"
+	case BandFormatInt:
+		return "int"
+	case BandFormatFloat:
+		return "float"
+	case BandFormatComplex:
+		return "complex"
+	case BandFormatDouble:
+		return "double"
+	case BandFormatDpcomplex:
+		return "dpcomplex"
+	}
+	return "BandFormat(" + strconv.Itoa(int(e)) + ")"
+}
+
+// ParseBandFormat returns the BandFormat with the given libvips nickname, ignoring case
+func ParseBandFormat(s string) (BandFormat, error) {
+	switch strings.ToLower(s) {
+	case "notset":
+		return BandFormatNotset, nil
+	case "uchar":
+		return BandFormatUchar, nil
+	case "char":
+		return BandFormatChar, nil
+	case "ushort":
+		return BandFormatUshort, nil
+	case "short":
+		return BandFormatShort, nil
+	case "uint":
+		return BandFormatUint, nil
+	case "int":
+		return BandFormatInt, nil
+	case "float":
+		return BandFormatFloat, nil
+	case "complex":
+		return BandFormatComplex, nil
+	case "double":
+		return BandFormatDouble, nil
+	case "dpcomplex":
+		return BandFormatDpcomplex, nil
+	}
+	return 0, fmt.Errorf("unknown BandFormat %q, valid values are: notset, uchar, char, ushort, short, uint, int, float, complex, double, dpcomplex", s)
+}
+
 // BlendMode represents VipsBlendMode type
 type BlendMode int
 
@@ -140,6 +328,120 @@ const (
 	BlendModeExclusion BlendMode = C.VIPS_BLEND_MODE_EXCLUSION
 )
 
+// String returns the libvips nickname of the BlendMode, or BlendMode(N) for an unknown value
+func (e BlendMode) String() string {
+	switch e {
+	case BlendModeClear:
+		return "clear"
+	case BlendModeSource:
+		return "source"
+	case BlendModeOver:
+		return "over"
+	case BlendModeIn:
+		return "in"
+	case BlendModeOut:
+		return "out"
+	case BlendModeAtop:
+		return "atop"
+	case BlendModeDest:
+		return "dest"
+	case BlendModeDestOver:
+		return "dest-over"
+	case BlendModeDestIn:
+		return "dest-in"
+	case BlendModeDestOut:
+		return "dest-out"
+	case BlendModeDestAtop:
+		return "dest-atop"
+	case BlendModeXor:
+		return "xor"
+	case BlendModeAdd:
+		return "add"
+	case BlendModeSaturate:
+		return "saturate"
+	case BlendModeMultiply:
+		return "multiply"
+	case BlendModeScreen:
+		return "screen"
+	case BlendModeOverlay:
+		return "overlay"
+	case BlendModeDarken:
+		return "darken"
+	case BlendModeLighten:
+		return "lighten"
+	case BlendModeColourDodge:
+		return "colour-dodge"
+	case BlendModeColourBurn:
+		return "colour-burn"
+	case BlendModeHardLight:
+		return "hard-light"
+	case BlendModeSoftLight:
+		return "soft-light"
+	case BlendModeDifference:
+		return "difference"
+	case BlendModeExclusion:
+		return "exclusion"
+	}
+	return "BlendMode(" + strconv.Itoa(int(e)) + ")"
+}
+
+// ParseBlendMode returns the BlendMode with the given libvips nickname, ignoring case
+func ParseBlendMode(s string) (BlendMode, error) {
+	switch strings.ToLower(s) {
+	case "clear":
+		return BlendModeClear, nil
+	case "source":
+		return BlendModeSource, nil
+	case "over":
+		return BlendModeOver, nil
+	case "in":
+		return BlendModeIn, nil
+	case "out":
+		return BlendModeOut, nil
+	case "atop":
+		return BlendModeAtop, nil
+	case "dest":
+		return BlendModeDest, nil
+	case "dest-over":
+		return BlendModeDestOver, nil
+	case "dest-in":
+		return BlendModeDestIn, nil
+	case "dest-out":
+		return BlendModeDestOut, nil
+	case "dest-atop":
+		return BlendModeDestAtop, nil
+	case "xor":
+		return BlendModeXor, nil
+	case "add":
+		return BlendModeAdd, nil
+	case "saturate":
+		return BlendModeSaturate, nil
+	case "multiply":
+		return BlendModeMultiply, nil
+	case "screen":
+		return BlendModeScreen, nil
+	case "overlay":
+		return BlendModeOverlay, nil
+	case "darken":
+		return BlendModeDarken, nil
+	case "lighten":
+		return BlendModeLighten, nil
+	case "colour-dodge":
+		return BlendModeColourDodge, nil
+	case "colour-burn":
+		return BlendModeColourBurn, nil
+	case "hard-light":
+		return BlendModeHardLight, nil
+	case "soft-light":
+		return BlendModeSoftLight, nil
+	case "difference":
+		return BlendModeDifference, nil
+	case "exclusion":
+		return BlendModeExclusion, nil
+	}
+	return 0, fmt.Errorf("unknown BlendMode %q, valid values are: clear, source, over, in, out, atop, dest, dest-over, dest-in, dest-out, dest-atop, xor, add, saturate, multiply, screen, overlay, darken, lighten, colour-dodge, colour-burn, hard-light, soft-light, difference, exclusion", s)
+}
+
 // Coding represents VipsCoding type
 type Coding int
 
@@ -151,6 +453,36 @@ const (
 	CodingRad Coding = C.VIPS_CODING_RAD
 )
 
+// String returns the libvips nickname of the Coding, or Coding(N) for an unknown value
+func (e Coding) String() string {
+	switch e {
+	case CodingError:
+		return "error"
+	case CodingNone:
+		return "none"
+	case CodingLabq:
+		return "labq"
+	case CodingRad:
+		return "rad"
+	}
+	return "Coding(" + strconv.Itoa(int(e)) + ")"
+}
+
+// ParseCoding returns the Coding with the given libvips nickname, ignoring case
+func ParseCoding(s string) (Coding, error) {
+	switch strings.ToLower(s) {
+	case "error":
+		return CodingError, nil
+	case "none":
+		return CodingNone, nil
+	case "labq":
+		return CodingLabq, nil
+	case "rad":
+		return CodingRad, nil
+	}
+	return 0, fmt.Errorf("unknown Coding %q, valid values are: error, none, labq, rad", s)
+}
+
 // Combine represents VipsCombine type
 type Combine int
 
@@ -161,6 +493,32 @@ const (
 	CombineMin Combine = C.VIPS_COMBINE_MIN
 )
 
+// String returns the libvips nickname of the Combine, or Combine(N) for an unknown value
+func (e Combine) String() string {
+	switch e {
+	case CombineMax:
+		return "max"
+	case CombineSum:
+		return "sum"
+	case CombineMin:
+		return "min"
+	}
+	return "Combine(" + strconv.Itoa(int(e)) + ")"
+}
+
+// ParseCombine returns the Combine with the given libvips nickname, ignoring case
+func ParseCombine(s string) (Combine, error) {
+	switch strings.ToLower(s) {
+	case "max":
+		return CombineMax, nil
+	case "sum":
+		return CombineSum, nil
+	case "min":
+		return CombineMin, nil
+	}
+	return 0, fmt.Errorf("unknown Combine %q, valid values are: max, sum, min", s)
+}
+
 // CombineMode represents VipsCombineMode type
 type CombineMode int
 
@@ -170,6 +528,28 @@ const (
 	CombineModeAdd CombineMode = C.VIPS_COMBINE_MODE_ADD
 )
 
+// String returns the libvips nickname of the CombineMode, or CombineMode(N) for an unknown value
+func (e CombineMode) String() string {
+	switch e {
+	case CombineModeSet:
+		return "set"
+	case CombineModeAdd:
+		return "add"
+	}
+	return "CombineMode(" + strconv.Itoa(int(e)) + ")"
+}
+
+// ParseCombineMode returns the CombineMode with the given libvips nickname, ignoring case
+func ParseCombineMode(s string) (CombineMode, error) {
+	switch strings.ToLower(s) {
+	case "set":
+		return CombineModeSet, nil
+	case "add":
+		return CombineModeAdd, nil
+	}
+	return 0, fmt.Errorf("unknown CombineMode %q, valid values are: set, add", s)
+}
+
 // CompassDirection represents VipsCompassDirection type
 type CompassDirection int
 
@@ -186,6 +566,56 @@ const (
 	CompassDirectionNorthWest CompassDirection = C.VIPS_COMPASS_DIRECTION_NORTH_WEST
 )
 
+// String returns the libvips nickname of the CompassDirection, or CompassDirection(N) for an unknown value
+func (e CompassDirection) String() string {
+	switch e {
+	case CompassDirectionCentre:
+		return "centre"
+	case CompassDirectionNorth:
+		return "north"
+	case CompassDirectionEast:
+		return "east"
+	case CompassDirectionSouth:
+		return "south"
+	case CompassDirectionWest:
+		return "west"
+	case CompassDirectionNorthEast:
+		return "north-east"
+	case CompassDirectionSouthEast:
+		return "south-east"
+	case CompassDirectionSouthWest:
+		return "south-west"
+	case CompassDirectionNorthWest:
+		return "north-west"
+	}
+	return "CompassDirection(" + strconv.Itoa(int(e)) + ")"
+}
+
+// ParseCompassDirection returns the CompassDirection with the given libvips nickname, ignoring case
+func ParseCompassDirection(s string) (CompassDirection, error) {
+	switch strings.ToLower(s) {
+	case "centre":
+		return CompassDirectionCentre, nil
+	case "north":
+		return CompassDirectionNorth, nil
+	case "east":
+		return CompassDirectionEast, nil
+	case "south":
+		return CompassDirectionSouth, nil
+	case "west":
+		return CompassDirectionWest, nil
+	case "north-east":
+		return CompassDirectionNorthEast, nil
+	case "south-east":
+		return CompassDirectionSouthEast, nil
+	case "south-west":
+		return CompassDirectionSouthWest, nil
+	case "north-west":
+		return CompassDirectionNorthWest, nil
+	}
+	return 0, fmt.Errorf("unknown CompassDirection %q, valid values are: centre, north, east, south, west, north-east, south-east, south-west, north-west", s)
+}
+
 // Direction represents VipsDirection type
 type Direction int
 
@@ -195,6 +625,28 @@ const (
 	DirectionVertical Direction = C.VIPS_DIRECTION_VERTICAL
 )
 
+// String returns the libvips nickname of the Direction, or Direction(N) for an unknown value
+func (e Direction) String() string {
+	switch e {
+	case DirectionHorizontal:
+		return "horizontal"
+	case DirectionVertical:
+		return "vertical"
+	}
+	return "Direction(" + strconv.Itoa(int(e)) + ")"
+}
+
+// ParseDirection returns the Direction with the given libvips nickname, ignoring case
+func ParseDirection(s string) (Direction, error) {
+	switch strings.ToLower(s) {
+	case "horizontal":
+		return DirectionHorizontal, nil
+	case "vertical":
+		return DirectionVertical, nil
+	}
+	return 0, fmt.Errorf("unknown Direction %q, valid values are: horizontal, vertical", s)
+}
+
 // Extend represents VipsExtend type
 type Extend int
 
@@ -208,6 +660,44 @@ const (
 	ExtendBackground Extend = C.VIPS_EXTEND_BACKGROUND
 )
 
+// String returns the libvips nickname of the Extend, or Extend(N) for an unknown value
+func (e Extend) String() string {
+	switch e {
+	case ExtendBlack:
+		return "black"
+	case ExtendCopy:
+		return "copy"
+	case ExtendRepeat:
+		return "repeat"
+	case ExtendMirror:
+		return "mirror"
+	case ExtendWhite:
+		return "white"
+	case ExtendBackground:
+		return "background"
+	}
+	return "Extend(" + strconv.Itoa(int(e)) + ")"
+}
+
+// ParseExtend returns the Extend with the given libvips nickname, ignoring case
+func ParseExtend(s string) (Extend, error) {
+	switch strings.ToLower(s) {
+	case "black":
+		return ExtendBlack, nil
+	case "copy":
+		return ExtendCopy, nil
+	case "repeat":
+		return ExtendRepeat, nil
+	case "mirror":
+		return ExtendMirror, nil
+	case "white":
+		return ExtendWhite, nil
+	case "background":
+		return ExtendBackground, nil
+	}
+	return 0, fmt.Errorf("unknown Extend %q, valid values are: black, copy, repeat, mirror, white, background", s)
+}
+
 // FailOn represents VipsFailOn type
 type FailOn int
 
@@ -219,6 +709,36 @@ const (
 	FailOnWarning FailOn = C.VIPS_FAIL_ON_WARNING
 )
 
+// String returns the libvips nickname of the FailOn, or FailOn(N) for an unknown value
+func (e FailOn) String() string {
+	switch e {
+	case FailOnNone:
+		return "none"
+	case FailOnTruncated:
+		return "truncated"
+	case FailOnError:
+		return "error"
+	case FailOnWarning:
+		return "warning"
+	}
+	return "FailOn(" + strconv.Itoa(int(e)) + ")"
+}
+
+// ParseFailOn returns the FailOn with the given libvips nickname, ignoring case
+func ParseFailOn(s string) (FailOn, error) {
+	switch strings.ToLower(s) {
+	case "none":
+		return FailOnNone, nil
+	case "truncated":
+		return FailOnTruncated, nil
+	case "error":
+		return FailOnError, nil
+	case "warning":
+		return FailOnWarning, nil
+	}
+	return 0, fmt.Errorf("unknown FailOn %q, valid values are: none, truncated, error, warning", s)
+}
+
 // DzContainer represents VipsForeignDzContainer type
 type DzContainer int
 
@@ -229,6 +749,32 @@ const (
 	DzContainerSzi DzContainer = C.VIPS_FOREIGN_DZ_CONTAINER_SZI
 )
 
+// String returns the libvips nickname of the DzContainer, or DzContainer(N) for an unknown value
+func (e DzContainer) String() string {
+	switch e {
+	case DzContainerFs:
+		return "fs"
+	case DzContainerZip:
+		return "zip"
+	case DzContainerSzi:
+		return "szi"
+	}
+	return "DzContainer(" + strconv.Itoa(int(e)) + ")"
+}
+
+// ParseDzContainer returns the DzContainer with the given libvips nickname, ignoring case
+func ParseDzContainer(s string) (DzContainer, error) {
+	switch strings.ToLower(s) {
+	case "fs":
+		return DzContainerFs, nil
+	case "zip":
+		return DzContainerZip, nil
+	case "szi":
+		return DzContainerSzi, nil
+	}
+	return 0, fmt.Errorf("unknown DzContainer %q, valid values are: fs, zip, szi", s)
+}
+
 // DzDepth represents VipsForeignDzDepth type
 type DzDepth int
 
@@ -239,6 +785,32 @@ const (
 	DzDepthOne DzDepth = C.VIPS_FOREIGN_DZ_DEPTH_ONE
 )
 
+// String returns the libvips nickname of the DzDepth, or DzDepth(N) for an unknown value
+func (e DzDepth) String() string {
+	switch e {
+	case DzDepthOnepixel:
+		return "onepixel"
+	case DzDepthOnetile:
+		return "onetile"
+	case DzDepthOne:
+		return "one"
+	}
+	return "DzDepth(" + strconv.Itoa(int(e)) + ")"
+}
+
+// ParseDzDepth returns the DzDepth with the given libvips nickname, ignoring case
+func ParseDzDepth(s string) (DzDepth, error) {
+	switch strings.ToLower(s) {
+	case "onepixel":
+		return DzDepthOnepixel, nil
+	case "onetile":
+		return DzDepthOnetile, nil
+	case "one":
+		return DzDepthOne, nil
+	}
+	return 0, fmt.Errorf("unknown DzDepth %q, valid values are: onepixel, onetile, one", s)
+}
+
 // DzLayout represents VipsForeignDzLayout type
 type DzLayout int
 
@@ -251,6 +823,40 @@ const (
 	DzLayoutIiif3 DzLayout = C.VIPS_FOREIGN_DZ_LAYOUT_IIIF3
 )
 
+// String returns the libvips nickname of the DzLayout, or DzLayout(N) for an unknown value
+func (e DzLayout) String() string {
+	switch e {
+	case DzLayoutDz:
+		return "dz"
+	case DzLayoutZoomify:
+		return "zoomify"
+	case DzLayoutGoogle:
+		return "google"
+	case DzLayoutIiif:
+		return "iiif"
+	case DzLayoutIiif3:
+		return "iiif3"
+	}
+	return "DzLayout(" + strconv.Itoa(int(e)) + ")"
+}
+
+// ParseDzLayout returns the DzLayout with the given libvips nickname, ignoring case
+func ParseDzLayout(s string) (DzLayout, error) {
+	switch strings.ToLower(s) {
+	case "dz":
+		return DzLayoutDz, nil
+	case "zoomify":
+		return DzLayoutZoomify, nil
+	case "google":
+		return DzLayoutGoogle, nil
+	case "iiif":
+		return DzLayoutIiif, nil
+	case "iiif3":
+		return DzLayoutIiif3, nil
+	}
+	return 0, fmt.Errorf("unknown DzLayout %q, valid values are: dz, zoomify, google, iiif, iiif3", s)
+}
+
 // Flags represents VipsForeignFlags type
 type Flags int
 
@@ -263,6 +869,40 @@ const (
 	FlagsAll Flags = C.VIPS_FOREIGN_ALL
 )
 
+// String returns the libvips nickname of the Flags, or Flags(N) for an unknown value
+func (e Flags) String() string {
+	switch e {
+	case FlagsNone:
+		return "none"
+	case FlagsPartial:
+		return "partial"
+	case FlagsBigendian:
+		return "bigendian"
+	case FlagsSequential:
+		return "sequential"
+	case FlagsAll:
+		return "all"
+	}
+	return "Flags(" + strconv.Itoa(int(e)) + ")"
+}
+
+// ParseFlags returns the Flags with the given libvips nickname, ignoring case
+func ParseFlags(s string) (Flags, error) {
+	switch strings.ToLower(s) {
+	case "none":
+		return FlagsNone, nil
+	case "partial":
+		return FlagsPartial, nil
+	case "bigendian":
+		return FlagsBigendian, nil
+	case "sequential":
+		return FlagsSequential, nil
+	case "all":
+		return FlagsAll, nil
+	}
+	return 0, fmt.Errorf("unknown Flags %q, valid values are: none, partial, bigendian, sequential, all", s)
+}
+
 // HeifCompression represents VipsForeignHeifCompression type
 type HeifCompression int
 
@@ -274,6 +914,36 @@ const (
 	HeifCompressionAv1 HeifCompression = C.VIPS_FOREIGN_HEIF_COMPRESSION_AV1
 )
 
+// String returns the libvips nickname of the HeifCompression, or HeifCompression(N) for an unknown value
+func (e HeifCompression) String() string {
+	switch e {
+	case HeifCompressionHevc:
+		return "hevc"
+	case HeifCompressionAvc:
+		return "avc"
+	case HeifCompressionJpeg:
+		return "jpeg"
+	case HeifCompressionAv1:
+		return "av1"
+	}
+	return "HeifCompression(" + strconv.Itoa(int(e)) + ")"
+}
+
+// ParseHeifCompression returns the HeifCompression with the given libvips nickname, ignoring case
+func ParseHeifCompression(s string) (HeifCompression, error) {
+	switch strings.ToLower(s) {
+	case "hevc":
+		return HeifCompressionHevc, nil
+	case "avc":
+		return HeifCompressionAvc, nil
+	case "jpeg":
+		return HeifCompressionJpeg, nil
+	case "av1":
+		return HeifCompressionAv1, nil
+	}
+	return 0, fmt.Errorf("unknown HeifCompression %q, valid values are: hevc, avc, jpeg, av1", s)
+}
+
 // HeifEncoder represents VipsForeignHeifEncoder type
 type HeifEncoder int
 
@@ -286,6 +956,40 @@ const (
 	HeifEncoderX265 HeifEncoder = C.VIPS_FOREIGN_HEIF_ENCODER_X265
 )
 
+// String returns the libvips nickname of the HeifEncoder, or HeifEncoder(N) for an unknown value
+func (e HeifEncoder) String() string {
+	switch e {
+	case HeifEncoderAuto:
+		return "auto"
+	case HeifEncoderAom:
+		return "aom"
+	case HeifEncoderRav1e:
+		return "rav1e"
+	case HeifEncoderSvt:
+		return "svt"
+	case HeifEncoderX265:
+		return "x265"
+	}
+	return "HeifEncoder(" + strconv.Itoa(int(e)) + ")"
+}
+
+// ParseHeifEncoder returns the HeifEncoder with the given libvips nickname, ignoring case
+func ParseHeifEncoder(s string) (HeifEncoder, error) {
+	switch strings.ToLower(s) {
+	case "auto":
+		return HeifEncoderAuto, nil
+	case "aom":
+		return HeifEncoderAom, nil
+	case "rav1e":
+		return HeifEncoderRav1e, nil
+	case "svt":
+		return HeifEncoderSvt, nil
+	case "x265":
+		return HeifEncoderX265, nil
+	}
+	return 0, fmt.Errorf("unknown HeifEncoder %q, valid values are: auto, aom, rav1e, svt, x265", s)
+}
+
 // Keep represents VipsForeignKeep type
 type Keep int
 
@@ -301,6 +1005,52 @@ const (
 	KeepAll Keep = C.VIPS_FOREIGN_KEEP_ALL
 )
 
+// String returns the libvips nickname of the Keep, or Keep(N) for an unknown value
+func (e Keep) String() string {
+	switch e {
+	case KeepNone:
+		return "none"
+	case KeepExif:
+		return "exif"
+	case KeepXmp:
+		return "xmp"
+	case KeepIptc:
+		return "iptc"
+	case KeepIcc:
+		return "icc"
+	case KeepOther:
+		return "other"
+	case KeepGainmap:
+		return "gainmap"
+	case KeepAll:
+		return "all"
+	}
+	return "Keep(" + strconv.Itoa(int(e)) + ")"
+}
+
+// ParseKeep returns the Keep with the given libvips nickname, ignoring case
+func ParseKeep(s string) (Keep, error) {
+	switch strings.ToLower(s) {
+	case "none":
+		return KeepNone, nil
+	case "exif":
+		return KeepExif, nil
+	case "xmp":
+		return KeepXmp, nil
+	case "iptc":
+		return KeepIptc, nil
+	case "icc":
+		return KeepIcc, nil
+	case "other":
+		return KeepOther, nil
+	case "gainmap":
+		return KeepGainmap, nil
+	case "all":
+		return KeepAll, nil
+	}
+	return 0, fmt.Errorf("unknown Keep %q, valid values are: none, exif, xmp, iptc, icc, other, gainmap, all", s)
+}
+
 // PdfPageBox represents VipsForeignPdfPageBox type
 type PdfPageBox int
 
@@ -313,6 +1063,40 @@ const (
 	PdfPageBoxArt PdfPageBox = C.VIPS_FOREIGN_PDF_PAGE_BOX_ART
 )
 
+// String returns the libvips nickname of the PdfPageBox, or PdfPageBox(N) for an unknown value
+func (e PdfPageBox) String() string {
+	switch e {
+	case PdfPageBoxMedia:
+		return "media"
+	case PdfPageBoxCrop:
+		return "crop"
+	case PdfPageBoxTrim:
+		return "trim"
+	case PdfPageBoxBleed:
+		return "bleed"
+	case PdfPageBoxArt:
+		return "art"
+	}
+	return "PdfPageBox(" + strconv.Itoa(int(e)) + ")"
+}
+
+// ParsePdfPageBox returns the PdfPageBox with the given libvips nickname, ignoring case
+func ParsePdfPageBox(s string) (PdfPageBox, error) {
+	switch strings.ToLower(s) {
+	case "media":
+		return PdfPageBoxMedia, nil
+	case "crop":
+		return PdfPageBoxCrop, nil
+	case "trim":
+		return PdfPageBoxTrim, nil
+	case "bleed":
+		return PdfPageBoxBleed, nil
+	case "art":
+		return PdfPageBoxArt, nil
+	}
+	return 0, fmt.Errorf("unknown PdfPageBox %q, valid values are: media, crop, trim, bleed, art", s)
+}
+
 // PngFilter represents VipsForeignPngFilter type
 type PngFilter int
 
@@ -326,6 +1110,44 @@ const (
 	PngFilterAll PngFilter = C.VIPS_FOREIGN_PNG_FILTER_ALL
 )
 
+// String returns the libvips nickname of the PngFilter, or PngFilter(N) for an unknown value
+func (e PngFilter) String() string {
+	switch e {
+	case PngFilterNone:
+		return "none"
+	case PngFilterSub:
+		return "sub"
+	case PngFilterUp:
+		return "up"
+	case PngFilterAvg:
+		return "avg"
+	case PngFilterPaeth:
+		return "paeth"
+	case PngFilterAll:
+		return "all"
+	}
+	return "PngFilter(" + strconv.Itoa(int(e)) + ")"
+}
+
+// ParsePngFilter returns the PngFilter with the given libvips nickname, ignoring case
+func ParsePngFilter(s string) (PngFilter, error) {
+	switch strings.ToLower(s) {
+	case "none":
+		return PngFilterNone, nil
+	case "sub":
+		return PngFilterSub, nil
+	case "up":
+		return PngFilterUp, nil
+	case "avg":
+		return PngFilterAvg, nil
+	case "paeth":
+		return PngFilterPaeth, nil
+	case "all":
+		return PngFilterAll, nil
+	}
+	return 0, fmt.Errorf("unknown PngFilter %q, valid values are: none, sub, up, avg, paeth, all", s)
+}
+
 // PpmFormat represents VipsForeignPpmFormat type
 type PpmFormat int
 
@@ -338,6 +1160,40 @@ const (
 	PpmFormatPnm PpmFormat = C.VIPS_FOREIGN_PPM_FORMAT_PNM
 )
 
+// String returns the libvips nickname of the PpmFormat, or PpmFormat(N) for an unknown value
+func (e PpmFormat) String() string {
+	switch e {
+	case PpmFormatPbm:
+		return "pbm"
+	case PpmFormatPgm:
+		return "pgm"
+	case PpmFormatPpm:
+		return "ppm"
+	case PpmFormatPfm:
+		return "pfm"
+	case PpmFormatPnm:
+		return "pnm"
+	}
+	return "PpmFormat(" + strconv.Itoa(int(e)) + ")"
+}
+
+// ParsePpmFormat returns the PpmFormat with the given libvips nickname, ignoring case
+func ParsePpmFormat(s string) (PpmFormat, error) {
+	switch strings.ToLower(s) {
+	case "pbm":
+		return PpmFormatPbm, nil
+	case "pgm":
+		return PpmFormatPgm, nil
+	case "ppm":
+		return PpmFormatPpm, nil
+	case "pfm":
+		return PpmFormatPfm, nil
+	case "pnm":
+		return PpmFormatPnm, nil
+	}
+	return 0, fmt.Errorf("unknown PpmFormat %q, valid values are: pbm, pgm, ppm, pfm, pnm", s)
+}
+
 // Subsample represents VipsForeignSubsample type
 type Subsample int
 
@@ -348,6 +1204,32 @@ const (
 	SubsampleOff Subsample = C.VIPS_FOREIGN_SUBSAMPLE_OFF
 )
 
+// String returns the libvips nickname of the Subsample, or Subsample(N) for an unknown value
+func (e Subsample) String() string {
+	switch e {
+	case SubsampleAuto:
+		return "auto"
+	case SubsampleOn:
+		return "on"
+	case SubsampleOff:
+		return "off"
+	}
+	return "Subsample(" + strconv.Itoa(int(e)) + ")"
+}
+
+// ParseSubsample returns the Subsample with the given libvips nickname, ignoring case
+func ParseSubsample(s string) (Subsample, error) {
+	switch strings.ToLower(s) {
+	case "auto":
+		return SubsampleAuto, nil
+	case "on":
+		return SubsampleOn, nil
+	case "off":
+		return SubsampleOff, nil
+	}
+	return 0, fmt.Errorf("unknown Subsample %q, valid values are: auto, on, off", s)
+}
+
 // TiffCompression represents VipsForeignTiffCompression type
 type TiffCompression int
 
@@ -364,6 +1246,56 @@ const (
 	TiffCompressionJp2k TiffCompression = C.VIPS_FOREIGN_TIFF_COMPRESSION_JP2K
 )
 
+// String returns the libvips nickname of the TiffCompression, or TiffCompression(N) for an unknown value
+func (e TiffCompression) String() string {
+	switch e {
+	case TiffCompressionNone:
+		return "none"
+	case TiffCompressionJpeg:
+		return "jpeg"
+	case TiffCompressionDeflate:
+		return "deflate"
+	case TiffCompressionPackbits:
+		return "packbits"
+	case TiffCompressionCcittfax4:
+		return "ccittfax4"
+	case TiffCompressionLzw:
+		return "lzw"
+	case TiffCompressionWebp:
+		return "webp"
+	case TiffCompressionZstd:
+		return "zstd"
+	case TiffCompressionJp2k:
+		return "jp2k"
+	}
+	return "TiffCompression(" + strconv.Itoa(int(e)) + ")"
+}
+
+// ParseTiffCompression returns the TiffCompression with the given libvips nickname, ignoring case
+func ParseTiffCompression(s string) (TiffCompression, error) {
+	switch strings.ToLower(s) {
+	case "none":
+		return TiffCompressionNone, nil
+	case "jpeg":
+		return TiffCompressionJpeg, nil
+	case "deflate":
+		return TiffCompressionDeflate, nil
+	case "packbits":
+		return TiffCompressionPackbits, nil
+	case "ccittfax4":
+		return TiffCompressionCcittfax4, nil
+	case "lzw":
+		return TiffCompressionLzw, nil
+	case "webp":
+		return TiffCompressionWebp, nil
+	case "zstd":
+		return TiffCompressionZstd, nil
+	case "jp2k":
+		return TiffCompressionJp2k, nil
+	}
+	return 0, fmt.Errorf("unknown TiffCompression %q, valid values are: none, jpeg, deflate, packbits, ccittfax4, lzw, webp, zstd, jp2k", s)
+}
+
 // TiffPredictor represents VipsForeignTiffPredictor type
 type TiffPredictor int
 
@@ -374,6 +1306,32 @@ const (
 	TiffPredictorFloat TiffPredictor = C.VIPS_FOREIGN_TIFF_PREDICTOR_FLOAT
 )
 
+// String returns the libvips nickname of the TiffPredictor, or TiffPredictor(N) for an unknown value
+func (e TiffPredictor) String() string {
+	switch e {
+	case TiffPredictorNone:
+		return "none"
+	case TiffPredictorHorizontal:
+		return "horizontal"
+	case TiffPredictorFloat:
+		return "float"
+	}
+	return "TiffPredictor(" + strconv.Itoa(int(e)) + ")"
+}
+
+// ParseTiffPredictor returns the TiffPredictor with the given libvips nickname, ignoring case
+func ParseTiffPredictor(s string) (TiffPredictor, error) {
+	switch strings.ToLower(s) {
+	case "none":
+		return TiffPredictorNone, nil
+	case "horizontal":
+		return TiffPredictorHorizontal, nil
+	case "float":
+		return TiffPredictorFloat, nil
+	}
+	return 0, fmt.Errorf("unknown TiffPredictor %q, valid values are: none, horizontal, float", s)
+}
+
 // TiffResunit represents VipsForeignTiffResunit type
 type TiffResunit int
 
@@ -383,6 +1341,28 @@ const (
 	TiffResunitInch TiffResunit = C.VIPS_FOREIGN_TIFF_RESUNIT_INCH
 )
 
+// String returns the libvips nickname of the TiffResunit, or TiffResunit(N) for an unknown value
+func (e TiffResunit) String() string {
+	switch e {
+	case TiffResunitCm:
+		return "cm"
+	case TiffResunitInch:
+		return "inch"
+	}
+	return "TiffResunit(" + strconv.Itoa(int(e)) + ")"
+}
+
+// ParseTiffResunit returns the TiffResunit with the given libvips nickname, ignoring case
+func ParseTiffResunit(s string) (TiffResunit, error) {
+	switch strings.ToLower(s) {
+	case "cm":
+		return TiffResunitCm, nil
+	case "inch":
+		return TiffResunitInch, nil
+	}
+	return 0, fmt.Errorf("unknown TiffResunit %q, valid values are: cm, inch", s)
+}
+
 // WebpPreset represents VipsForeignWebpPreset type
 type WebpPreset int
 
@@ -396,6 +1376,44 @@ const (
 	WebpPresetText WebpPreset = C.VIPS_FOREIGN_WEBP_PRESET_TEXT
 )
 
+// String returns the libvips nickname of the WebpPreset, or WebpPreset(N) for an unknown value
+func (e WebpPreset) String() string {
+	switch e {
+	case WebpPresetDefault:
+		return "default"
+	case WebpPresetPicture:
+		return "picture"
+	case WebpPresetPhoto:
+		return "photo"
+	case WebpPresetDrawing:
+		return "drawing"
+	case WebpPresetIcon:
+		return "icon"
+	case WebpPresetText:
+		return "text"
+	}
+	return "WebpPreset(" + strconv.Itoa(int(e)) + ")"
+}
+
+// ParseWebpPreset returns the WebpPreset with the given libvips nickname, ignoring case
+func ParseWebpPreset(s string) (WebpPreset, error) {
+	switch strings.ToLower(s) {
+	case "default":
+		return WebpPresetDefault, nil
+	case "picture":
+		return WebpPresetPicture, nil
+	case "photo":
+		return WebpPresetPhoto, nil
+	case "drawing":
+		return WebpPresetDrawing, nil
+	case "icon":
+		return WebpPresetIcon, nil
+	case "text":
+		return WebpPresetText, nil
+	}
+	return 0, fmt.Errorf("unknown WebpPreset %q, valid values are: default, picture, photo, drawing, icon, text", s)
+}
+
 // Intent represents VipsIntent type
 type Intent int
 
@@ -408,6 +1426,40 @@ const (
 	IntentAuto Intent = C.VIPS_INTENT_AUTO
 )
 
+// String returns the libvips nickname of the Intent, or Intent(N) for an unknown value
+func (e Intent) String() string {
+	switch e {
+	case IntentPerceptual:
+		return "perceptual"
+	case IntentRelative:
+		return "relative"
+	case IntentSaturation:
+		return "saturation"
+	case IntentAbsolute:
+		return "absolute"
+	case IntentAuto:
+		return "auto"
+	}
+	return "Intent(" + strconv.Itoa(int(e)) + ")"
+}
+
+// ParseIntent returns the Intent with the given libvips nickname, ignoring case
+func ParseIntent(s string) (Intent, error) {
+	switch strings.ToLower(s) {
+	case "perceptual":
+		return IntentPerceptual, nil
+	case "relative":
+		return IntentRelative, nil
+	case "saturation":
+		return IntentSaturation, nil
+	case "absolute":
+		return IntentAbsolute, nil
+	case "auto":
+		return IntentAuto, nil
+	}
+	return 0, fmt.Errorf("unknown Intent %q, valid values are: perceptual, relative, saturation, absolute, auto", s)
+}
+
 // Interesting represents VipsInteresting type
 type Interesting int
 
@@ -422,6 +1474,48 @@ const (
 	InterestingAll Interesting = C.VIPS_INTERESTING_ALL
 )
 
+// String returns the libvips nickname of the Interesting, or Interesting(N) for an unknown value
+func (e Interesting) String() string {
+	switch e {
+	case InterestingNone:
+		return "none"
+	case InterestingCentre:
+		return "centre"
+	case InterestingEntropy:
+		return "entropy"
+	case InterestingAttention:
+		return "attention"
+	case InterestingLow:
+		return "low"
+	case InterestingHigh:
+		return "high"
+	case InterestingAll:
+		return "all"
+	}
+	return "Interesting(" + strconv.Itoa(int(e)) + ")"
+}
+
+// ParseInteresting returns the Interesting with the given libvips nickname, ignoring case
+func ParseInteresting(s string) (Interesting, error) {
+	switch strings.ToLower(s) {
+	case "none":
+		return InterestingNone, nil
+	case "centre":
+		return InterestingCentre, nil
+	case "entropy":
+		return InterestingEntropy, nil
+	case "attention":
+		return InterestingAttention, nil
+	case "low":
+		return InterestingLow, nil
+	case "high":
+		return InterestingHigh, nil
+	case "all":
+		return InterestingAll, nil
+	}
+	return 0, fmt.Errorf("unknown Interesting %q, valid values are: none, centre, entropy, attention, low, high, all", s)
+}
+
 // Interpretation represents VipsInterpretation type
 type Interpretation int
 
@@ -451,6 +1545,108 @@ const (
 	InterpretationOklch Interpretation = C.VIPS_INTERPRETATION_OKLCH
 )
 
+// String returns the libvips nickname of the Interpretation, or Interpretation(N) for an unknown value
+func (e Interpretation) String() string {
+	switch e {
+	case InterpretationError:
+		return "error"
+	case InterpretationMultiband:
+		return "multiband"
+	case InterpretationBW:
+		return "b-w"
+	case InterpretationHistogram:
+		return "histogram"
+	case InterpretationXyz:
+		return "xyz"
+	case InterpretationLab:
+		return "lab"
+	case InterpretationCmyk:
+		return "cmyk"
+	case InterpretationLabq:
+		return "labq"
+	case InterpretationRgb:
+		return "rgb"
+	case InterpretationCmc:
+		return "cmc"
+	case InterpretationLch:
+		return "lch"
+	case InterpretationLabs:
+		return "labs"
+	case InterpretationSrgb:
+		return "srgb"
+	case InterpretationYxy:
+		return "yxy"
+	case InterpretationFourier:
+		return "fourier"
+	case InterpretationRgb16:
+		return "rgb16"
+	case InterpretationGrey16:
+		return "grey16"
+	case InterpretationMatrix:
+		return "matrix"
+	case InterpretationScrgb:
+		return "scrgb"
+	case InterpretationHsv:
+		return "hsv"
+	case InterpretationOklab:
+		return "oklab"
+	case InterpretationOklch:
+		return "oklch"
+	}
+	return "Interpretation(" + strconv.Itoa(int(e)) + ")"
+}
+
+// ParseInterpretation returns the Interpretation with the given libvips nickname, ignoring case
+func ParseInterpretation(s string) (Interpretation, error) {
+	switch strings.ToLower(s) {
+	case "error":
+		return InterpretationError, nil
+	case "multiband":
+		return InterpretationMultiband, nil
+	case "b-w":
+		return InterpretationBW, nil
+	case "histogram":
+		return InterpretationHistogram, nil
+	case "xyz":
+		return InterpretationXyz, nil
+	case "lab":
+		return InterpretationLab, nil
+	case "cmyk":
+		return InterpretationCmyk, nil
+	case "labq":
+		return InterpretationLabq, nil
+	case "rgb":
+		return InterpretationRgb, nil
+	case "cmc":
+		return InterpretationCmc, nil
+	case "lch":
+		return InterpretationLch, nil
+	case "labs":
+		return InterpretationLabs, nil
+	case "srgb":
+		return InterpretationSrgb, nil
+	case "yxy":
+		return InterpretationYxy, nil
+	case "fourier":
+		return InterpretationFourier, nil
+	case "rgb16":
+		return InterpretationRgb16, nil
+	case "grey16":
+		return InterpretationGrey16, nil
+	case "matrix":
+		return InterpretationMatrix, nil
+	case "scrgb":
+		return InterpretationScrgb, nil
+	case "hsv":
+		return InterpretationHsv, nil
+	case "oklab":
+		return InterpretationOklab, nil
+	case "oklch":
+		return InterpretationOklch, nil
+	}
+	return 0, fmt.Errorf("unknown Interpretation %q, valid values are: error, multiband, b-w, histogram, xyz, lab, cmyk, labq, rgb, cmc, lch, labs, srgb, yxy, fourier, rgb16, grey16, matrix, scrgb, hsv, oklab, oklch", s)
+}
+
 // Kernel represents VipsKernel type
 type Kernel int
 
@@ -466,6 +1662,52 @@ const (
 	KernelMks2021 Kernel = C.VIPS_KERNEL_MKS2021
 )
 
+// String returns the libvips nickname of the Kernel, or Kernel(N) for an unknown value
+func (e Kernel) String() string {
+	switch e {
+	case KernelNearest:
+		return "nearest"
+	case KernelLinear:
+		return "linear"
+	case KernelCubic:
+		return "cubic"
+	case KernelMitchell:
+		return "mitchell"
+	case KernelLanczos2:
+		return "lanczos2"
+	case KernelLanczos3:
+		return "lanczos3"
+	case KernelMks2013:
+		return "mks2013"
+	case KernelMks2021:
+		return "mks2021"
+	}
+	return "Kernel(" + strconv.Itoa(int(e)) + ")"
+}
+
+// ParseKernel returns the Kernel with the given libvips nickname, ignoring case
+func ParseKernel(s string) (Kernel, error) {
+	switch strings.ToLower(s) {
+	case "nearest":
+		return KernelNearest, nil
+	case "linear":
+		return KernelLinear, nil
+	case "cubic":
+		return KernelCubic, nil
+	case "mitchell":
+		return KernelMitchell, nil
+	case "lanczos2":
+		return KernelLanczos2, nil
+	case "lanczos3":
+		return KernelLanczos3, nil
+	case "mks2013":
+		return KernelMks2013, nil
+	case "mks2021":
+		return KernelMks2021, nil
+	}
+	return 0, fmt.Errorf("unknown Kernel %q, valid values are: nearest, linear, cubic, mitchell, lanczos2, lanczos3, mks2013, mks2021", s)
+}
+
 // OperationBoolean represents VipsOperationBoolean type
 type OperationBoolean int
 
@@ -478,6 +1720,40 @@ const (
 	OperationBooleanRshift OperationBoolean = C.VIPS_OPERATION_BOOLEAN_RSHIFT
 )
 
+// String returns the libvips nickname of the OperationBoolean, or OperationBoolean(N) for an unknown value
+func (e OperationBoolean) String() string {
+	switch e {
+	case OperationBooleanAnd:
+		return "and"
+	case OperationBooleanOr:
+		return "or"
+	case OperationBooleanEor:
+		return "eor"
+	case OperationBooleanLshift:
+		return "lshift"
+	case OperationBooleanRshift:
+		return "rshift"
+	}
+	return "OperationBoolean(" + strconv.Itoa(int(e)) + ")"
+}
+
+// ParseOperationBoolean returns the OperationBoolean with the given libvips nickname, ignoring case
+func ParseOperationBoolean(s string) (OperationBoolean, error) {
+	switch strings.ToLower(s) {
+	case "and":
+		return OperationBooleanAnd, nil
+	case "or":
+		return OperationBooleanOr, nil
+	case "eor":
+		return OperationBooleanEor, nil
+	case "lshift":
+		return OperationBooleanLshift, nil
+	case "rshift":
+		return OperationBooleanRshift, nil
+	}
+	return 0, fmt.Errorf("unknown OperationBoolean %q, valid values are: and, or, eor, lshift, rshift", s)
+}
+
 // OperationComplex represents VipsOperationComplex type
 type OperationComplex int
 
@@ -488,6 +1764,32 @@ const (
 	OperationComplexConj OperationComplex = C.VIPS_OPERATION_COMPLEX_CONJ
 )
 
+// String returns the libvips nickname of the OperationComplex, or OperationComplex(N) for an unknown value
+func (e OperationComplex) String() string {
+	switch e {
+	case OperationComplexPolar:
+		return "polar"
+	case OperationComplexRect:
+		return "rect"
+	case OperationComplexConj:
+		return "conj"
+	}
+	return "OperationComplex(" + strconv.Itoa(int(e)) + ")"
+}
+
+// ParseOperationComplex returns the OperationComplex with the given libvips nickname, ignoring case
+func ParseOperationComplex(s string) (OperationComplex, error) {
+	switch strings.ToLower(s) {
+	case "polar":
+		return OperationComplexPolar, nil
+	case "rect":
+		return OperationComplexRect, nil
+	case "conj":
+		return OperationComplexConj, nil
+	}
+	return 0, fmt.Errorf("unknown OperationComplex %q, valid values are: polar, rect, conj", s)
+}
+
 // OperationComplex2 represents VipsOperationComplex2 type
 type OperationComplex2 int
 
@@ -496,6 +1798,24 @@ const (
 	OperationComplex2CrossPhase OperationComplex2 = C.VIPS_OPERATION_COMPLEX2_CROSS_PHASE
 )
 
+// String returns the libvips nickname of the OperationComplex2, or OperationComplex2(N) for an unknown value
+func (e OperationComplex2) String() string {
+	switch e {
+	case OperationComplex2CrossPhase:
+		return "cross-phase"
+	}
+	return "OperationComplex2(" + strconv.Itoa(int(e)) + ")"
+}
+
+// ParseOperationComplex2 returns the OperationComplex2 with the given libvips nickname, ignoring case
+func ParseOperationComplex2(s string) (OperationComplex2, error) {
+	switch strings.ToLower(s) {
+	case "cross-phase":
+		return OperationComplex2CrossPhase, nil
+	}
+	return 0, fmt.Errorf("unknown OperationComplex2 %q, valid values are: cross-phase", s)
+}
+
 // OperationComplexget represents VipsOperationComplexget type
 type OperationComplexget int
 
@@ -505,6 +1825,28 @@ const (
 	OperationComplexgetImag OperationComplexget = C.VIPS_OPERATION_COMPLEXGET_IMAG
 )
 
+// String returns the libvips nickname of the OperationComplexget, or OperationComplexget(N) for an unknown value
+func (e OperationComplexget) String() string {
+	switch e {
+	case OperationComplexgetReal:
+		return "real"
+	case OperationComplexgetImag:
+		return "imag"
+	}
+	return "OperationComplexget(" + strconv.Itoa(int(e)) + ")"
+}
+
+// ParseOperationComplexget returns the OperationComplexget with the given libvips nickname, ignoring case
+func ParseOperationComplexget(s string) (OperationComplexget, error) {
+	switch strings.ToLower(s) {
+	case "real":
+		return OperationComplexgetReal, nil
+	case "imag":
+		return OperationComplexgetImag, nil
+	}
+	return 0, fmt.Errorf("unknown OperationComplexget %q, valid values are: real, imag", s)
+}
+
 // OperationMath represents VipsOperationMath type
 type OperationMath int
 
@@ -528,6 +1870,84 @@ const (
 	OperationMathAtanh OperationMath = C.VIPS_OPERATION_MATH_ATANH
 )
 
+// String returns the libvips nickname of the OperationMath, or OperationMath(N) for an unknown value
+func (e OperationMath) String() string {
+	switch e {
+	case OperationMathSin:
+		return "sin"
+	case OperationMathCos:
+		return "cos"
+	case OperationMathTan:
+		return "tan"
+	case OperationMathAsin:
+		return "asin"
+	case OperationMathAcos:
+		return "acos"
+	case OperationMathAtan:
+		return "atan"
+	case OperationMathLog:
+		return "log"
+	case OperationMathLog10:
+		return "log10"
+	case OperationMathExp:
+		return "exp"
+	case OperationMathExp10:
+		return "exp10"
+	case OperationMathSinh:
+		return "sinh"
+	case OperationMathCosh:
+		return "cosh"
+	case OperationMathTanh:
+		return "tanh"
+	case OperationMathAsinh:
+		return "asinh"
+	case OperationMathAcosh:
+		return "acosh"
+	case OperationMathAtanh:
+		return "atanh"
+	}
+	return "OperationMath(" + strconv.Itoa(int(e)) + ")"
+}
+
+// ParseOperationMath returns the OperationMath with the given libvips nickname, ignoring case
+func ParseOperationMath(s string) (OperationMath, error) {
+	switch strings.ToLower(s) {
+	case "sin":
+		return OperationMathSin, nil
+	case "cos":
+		return OperationMathCos, nil
+	case "tan":
+		return OperationMathTan, nil
+	case "asin":
+		return OperationMathAsin, nil
+	case "acos":
+		return OperationMathAcos, nil
+	case "atan":
+		return OperationMathAtan, nil
+	case "log":
+		return OperationMathLog, nil
+	case "log10":
+		return OperationMathLog10, nil
+	case "exp":
+		return OperationMathExp, nil
+	case "exp10":
+		return OperationMathExp10, nil
+	case "sinh":
+		return OperationMathSinh, nil
+	case "cosh":
+		return OperationMathCosh, nil
+	case "tanh":
+		return OperationMathTanh, nil
+	case "asinh":
+		return OperationMathAsinh, nil
+	case "acosh":
+		return OperationMathAcosh, nil
+	case "atanh":
+		return OperationMathAtanh, nil
+	}
+	return 0, fmt.Errorf("unknown OperationMath %q, valid values are: sin, cos, tan, asin, acos, atan, log, log10, exp, exp10, sinh, cosh, tanh, asinh, acosh, atanh", s)
+}
+
 // OperationMath2 represents VipsOperationMath2 type
 type OperationMath2 int
 
@@ -538,6 +1958,32 @@ const (
 	OperationMath2Atan2 OperationMath2 = C.VIPS_OPERATION_MATH2_ATAN2
 )
 
+// String returns the libvips nickname of the OperationMath2, or OperationMath2(N) for an unknown value
+func (e OperationMath2) String() string {
+	switch e {
+	case OperationMath2Pow:
+		return "pow"
+	case OperationMath2Wop:
+		return "wop"
+	case OperationMath2Atan2:
+		return "atan2"
+	}
+	return "OperationMath2(" + strconv.Itoa(int(e)) + ")"
+}
+
+// ParseOperationMath2 returns the OperationMath2 with the given libvips nickname, ignoring case
+func ParseOperationMath2(s string) (OperationMath2, error) {
+	switch strings.ToLower(s) {
+	case "pow":
+		return OperationMath2Pow, nil
+	case "wop":
+		return OperationMath2Wop, nil
+	case "atan2":
+		return OperationMath2Atan2, nil
+	}
+	return 0, fmt.Errorf("unknown OperationMath2 %q, valid values are: pow, wop, atan2", s)
+}
+
 // OperationMorphology represents VipsOperationMorphology type
 type OperationMorphology int
 
@@ -547,6 +1993,28 @@ const (
 	OperationMorphologyDilate OperationMorphology = C.VIPS_OPERATION_MORPHOLOGY_DILATE
 )
 
+// String returns the libvips nickname of the OperationMorphology, or OperationMorphology(N) for an unknown value
+func (e OperationMorphology) String() string {
+	switch e {
+	case OperationMorphologyErode:
+		return "erode"
+	case OperationMorphologyDilate:
+		return "dilate"
+	}
+	return "OperationMorphology(" + strconv.Itoa(int(e)) + ")"
+}
+
+// ParseOperationMorphology returns the OperationMorphology with the given libvips nickname, ignoring case
+func ParseOperationMorphology(s string) (OperationMorphology, error) {
+	switch strings.ToLower(s) {
+	case "erode":
+		return OperationMorphologyErode, nil
+	case "dilate":
+		return OperationMorphologyDilate, nil
+	}
+	return 0, fmt.Errorf("unknown OperationMorphology %q, valid values are: erode, dilate", s)
+}
+
 // OperationRelational represents VipsOperationRelational type
 type OperationRelational int
 
@@ -560,6 +2028,44 @@ const (
 	OperationRelationalMoreeq OperationRelational = C.VIPS_OPERATION_RELATIONAL_MOREEQ
 )
 
+// String returns the libvips nickname of the OperationRelational, or OperationRelational(N) for an unknown value
+func (e OperationRelational) String() string {
+	switch e {
+	case OperationRelationalEqual:
+		return "equal"
+	case OperationRelationalNoteq:
+		return "noteq"
+	case OperationRelationalLess:
+		return "less"
+	case OperationRelationalLesseq:
+		return "lesseq"
+	case OperationRelationalMore:
+		return "more"
+	case OperationRelationalMoreeq:
+		return "moreeq"
+	}
+	return "OperationRelational(" + strconv.Itoa(int(e)) + ")"
+}
+
+// ParseOperationRelational returns the OperationRelational with the given libvips nickname, ignoring case
+func ParseOperationRelational(s string) (OperationRelational, error) {
+	switch strings.ToLower(s) {
+	case "equal":
+		return OperationRelationalEqual, nil
+	case "noteq":
+		return OperationRelationalNoteq, nil
+	case "less":
+		return OperationRelationalLess, nil
+	case "lesseq":
+		return OperationRelationalLesseq, nil
+	case "more":
+		return OperationRelationalMore, nil
+	case "moreeq":
+		return OperationRelationalMoreeq, nil
+	}
+	return 0, fmt.Errorf("unknown OperationRelational %q, valid values are: equal, noteq, less, lesseq, more, moreeq", s)
+}
+
 // OperationRound represents VipsOperationRound type
 type OperationRound int
 
@@ -570,6 +2076,32 @@ const (
 	OperationRoundFloor OperationRound = C.VIPS_OPERATION_ROUND_FLOOR
 )
 
+// String returns the libvips nickname of the OperationRound, or OperationRound(N) for an unknown value
+func (e OperationRound) String() string {
+	switch e {
+	case OperationRoundRint:
+		return "rint"
+	case OperationRoundCeil:
+		return "ceil"
+	case OperationRoundFloor:
+		return "floor"
+	}
+	return "OperationRound(" + strconv.Itoa(int(e)) + ")"
+}
+
+// ParseOperationRound returns the OperationRound with the given libvips nickname, ignoring case
+func ParseOperationRound(s string) (OperationRound, error) {
+	switch strings.ToLower(s) {
+	case "rint":
+		return OperationRoundRint, nil
+	case "ceil":
+		return OperationRoundCeil, nil
+	case "floor":
+		return OperationRoundFloor, nil
+	}
+	return 0, fmt.Errorf("unknown OperationRound %q, valid values are: rint, ceil, floor", s)
+}
+
 // PCS represents VipsPCS type
 type PCS int
 
@@ -579,6 +2111,28 @@ const (
 	PcsXyz PCS = C.VIPS_PCS_XYZ
 )
 
+// String returns the libvips nickname of the PCS, or PCS(N) for an unknown value
+func (e PCS) String() string {
+	switch e {
+	case PcsLab:
+		return "lab"
+	case PcsXyz:
+		return "xyz"
+	}
+	return "PCS(" + strconv.Itoa(int(e)) + ")"
+}
+
+// ParsePCS returns the PCS with the given libvips nickname, ignoring case
+func ParsePCS(s string) (PCS, error) {
+	switch strings.ToLower(s) {
+	case "lab":
+		return PcsLab, nil
+	case "xyz":
+		return PcsXyz, nil
+	}
+	return 0, fmt.Errorf("unknown PCS %q, valid values are: lab, xyz", s)
+}
+
 // Precision represents VipsPrecision type
 type Precision int
 
@@ -589,6 +2143,32 @@ const (
 	PrecisionApproximate Precision = C.VIPS_PRECISION_APPROXIMATE
 )
 
+// String returns the libvips nickname of the Precision, or Precision(N) for an unknown value
+func (e Precision) String() string {
+	switch e {
+	case PrecisionInteger:
+		return "integer"
+	case PrecisionFloat:
+		return "float"
+	case PrecisionApproximate:
+		return "approximate"
+	}
+	return "Precision(" + strconv.Itoa(int(e)) + ")"
+}
+
+// ParsePrecision returns the Precision with the given libvips nickname, ignoring case
+func ParsePrecision(s string) (Precision, error) {
+	switch strings.ToLower(s) {
+	case "integer":
+		return PrecisionInteger, nil
+	case "float":
+		return PrecisionFloat, nil
+	case "approximate":
+		return PrecisionApproximate, nil
+	}
+	return 0, fmt.Errorf("unknown Precision %q, valid values are: integer, float, approximate", s)
+}
+
 // RegionShrink represents VipsRegionShrink type
 type RegionShrink int
 
@@ -602,6 +2182,44 @@ const (
 	RegionShrinkNearest RegionShrink = C.VIPS_REGION_SHRINK_NEAREST
 )
 
+// String returns the libvips nickname of the RegionShrink, or RegionShrink(N) for an unknown value
+func (e RegionShrink) String() string {
+	switch e {
+	case RegionShrinkMean:
+		return "mean"
+	case RegionShrinkMedian:
+		return "median"
+	case RegionShrinkMode:
+		return "mode"
+	case RegionShrinkMax:
+		return "max"
+	case RegionShrinkMin:
+		return "min"
+	case RegionShrinkNearest:
+		return "nearest"
+	}
+	return "RegionShrink(" + strconv.Itoa(int(e)) + ")"
+}
+
+// ParseRegionShrink returns the RegionShrink with the given libvips nickname, ignoring case
+func ParseRegionShrink(s string) (RegionShrink, error) {
+	switch strings.ToLower(s) {
+	case "mean":
+		return RegionShrinkMean, nil
+	case "median":
+		return RegionShrinkMedian, nil
+	case "mode":
+		return RegionShrinkMode, nil
+	case "max":
+		return RegionShrinkMax, nil
+	case "min":
+		return RegionShrinkMin, nil
+	case "nearest":
+		return RegionShrinkNearest, nil
+	}
+	return 0, fmt.Errorf("unknown RegionShrink %q, valid values are: mean, median, mode, max, min, nearest", s)
+}
+
 // SdfShape represents VipsSdfShape type
 type SdfShape int
 
@@ -613,6 +2231,36 @@ const (
 	SdfShapeLine SdfShape = C.VIPS_SDF_SHAPE_LINE
 )
 
+// String returns the libvips nickname of the SdfShape, or SdfShape(N) for an unknown value
+func (e SdfShape) String() string {
+	switch e {
+	case SdfShapeCircle:
+		return "circle"
+	case SdfShapeBox:
+		return "box"
+	case SdfShapeRoundedBox:
+		return "rounded-box"
+	case SdfShapeLine:
+		return "line"
+	}
+	return "SdfShape(" + strconv.Itoa(int(e)) + ")"
+}
+
+// ParseSdfShape returns the SdfShape with the given libvips nickname, ignoring case
+func ParseSdfShape(s string) (SdfShape, error) {
+	switch strings.ToLower(s) {
+	case "circle":
+		return SdfShapeCircle, nil
+	case "box":
+		return SdfShapeBox, nil
+	case "rounded-box":
+		return SdfShapeRoundedBox, nil
+	case "line":
+		return SdfShapeLine, nil
+	}
+	return 0, fmt.Errorf("unknown SdfShape %q, valid values are: circle, box, rounded-box, line", s)
+}
+
 // Size represents VipsSize type
 type Size int
 
@@ -624,6 +2272,36 @@ const (
 	SizeForce Size = C.VIPS_SIZE_FORCE
 )
 
+// String returns the libvips nickname of the Size, or Size(N) for an unknown value
+func (e Size) String() string {
+	switch e {
+	case SizeBoth:
+		return "both"
+	case SizeUp:
+		return "up"
+	case SizeDown:
+		return "down"
+	case SizeForce:
+		return "force"
+	}
+	return "Size(" + strconv.Itoa(int(e)) + ")"
+}
+
+// ParseSize returns the Size with the given libvips nickname, ignoring case
+func ParseSize(s string) (Size, error) {
+	switch strings.ToLower(s) {
+	case "both":
+		return SizeBoth, nil
+	case "up":
+		return SizeUp, nil
+	case "down":
+		return SizeDown, nil
+	case "force":
+		return SizeForce, nil
+	}
+	return 0, fmt.Errorf("unknown Size %q, valid values are: both, up, down, force", s)
+}
+
 // TextWrap represents VipsTextWrap type
 type TextWrap int
 
@@ -635,6 +2313,36 @@ const (
 	TextWrapNone TextWrap = C.VIPS_TEXT_WRAP_NONE
 )
 
+// String returns the libvips nickname of the TextWrap, or TextWrap(N) for an unknown value
+func (e TextWrap) String() string {
+	switch e {
+	case TextWrapWord:
+		return "word"
+	case TextWrapChar:
+		return "char"
+	case TextWrapWordChar:
+		return "word-char"
+	case TextWrapNone:
+		return "none"
+	}
+	return "TextWrap(" + strconv.Itoa(int(e)) + ")"
+}
+
+// ParseTextWrap returns the TextWrap with the given libvips nickname, ignoring case
+func ParseTextWrap(s string) (TextWrap, error) {
+	switch strings.ToLower(s) {
+	case "word":
+		return TextWrapWord, nil
+	case "char":
+		return TextWrapChar, nil
+	case "word-char":
+		return TextWrapWordChar, nil
+	case "none":
+		return TextWrapNone, nil
+	}
+	return 0, fmt.Errorf("unknown TextWrap %q, valid values are: word, char, word-char, none", s)
+}
+
 
 // imageMimeTypes map the various image types to its mime type representation
 var imageMimeTypes = map[ImageType]string{
@@ -674,81 +2382,87 @@ func (imageType ImageType) MimeType() (mime string, ok bool) {
 func vipsDetermineImageType(in *C.VipsImage) ImageType {
 	if in != nil {
 		if vipsLoader, ok := vipsImageGetMetaLoader(in); ok {
-			if strings.HasPrefix(vipsLoader, "jpeg") {
-				return ImageTypeJpeg
-			}
-			if strings.HasPrefix(vipsLoader, "gif") {
-				return ImageTypeGif
-			}
-			if strings.HasPrefix(vipsLoader, "png") {
-				return ImageTypePng
-			}
-			if strings.HasPrefix(vipsLoader, "webp") {
-				return ImageTypeWebp
-			}
-			if strings.HasPrefix(vipsLoader, "heif") {
-				return ImageTypeHeif
-			}
-			if strings.HasPrefix(vipsLoader, "svg") {
-				return ImageTypeSvg
-			}
-			if strings.HasPrefix(vipsLoader, "tiff") {
-				return ImageTypeTiff
-			}
-			if strings.HasPrefix(vipsLoader, "jp2k") {
-				return ImageTypeJp2k
-			}
-			if strings.HasPrefix(vipsLoader, "pdf") {
-				return ImageTypePdf
-			}
-			if strings.HasPrefix(vipsLoader, "analyze") {
-				return ImageTypeAnalyze
-			}
-			if strings.HasPrefix(vipsLoader, "csv") {
-				return ImageTypeCsv
-			}
-			if strings.HasPrefix(vipsLoader, "dcraw") {
-				return ImageTypeDcraw
-			}
-			if strings.HasPrefix(vipsLoader, "fits") {
-				return ImageTypeFits
-			}
-			if strings.HasPrefix(vipsLoader, "jxl") {
-				return ImageTypeJxl
-			}
-			if strings.HasPrefix(vipsLoader, "mat") {
-				return ImageTypeMat
-			}
-			if strings.HasPrefix(vipsLoader, "matrix") {
-				return ImageTypeMatrix
-			}
-			if strings.HasPrefix(vipsLoader, "openexr") {
-				return ImageTypeOpenexr
-			}
-			if strings.HasPrefix(vipsLoader, "openslide") {
-				return ImageTypeOpenslide
-			}
-			if strings.HasPrefix(vipsLoader, "ppm") {
-				return ImageTypePpm
-			}
-			if strings.HasPrefix(vipsLoader, "rad") {
-				return ImageTypeRad
-			}
-			if strings.HasPrefix(vipsLoader, "raw") {
-				return ImageTypeRaw
-			}
-			if strings.HasPrefix(vipsLoader, "vips") {
-				return ImageTypeVips
-			}
-			if strings.HasPrefix(vipsLoader, "magick") {
-				return ImageTypeMagick
-			}
-			
+			return vipsImageTypeFromLoader(vipsLoader)
 		}
 	}
 	return ImageTypeUnknown
 }
 
+// vipsImageTypeFromLoader maps a loader name such as pngload_buffer to its image type
+func vipsImageTypeFromLoader(vipsLoader string) ImageType {
+	if strings.HasPrefix(vipsLoader, "jpeg") {
+		return ImageTypeJpeg
+	}
+	if strings.HasPrefix(vipsLoader, "gif") {
+		return ImageTypeGif
+	}
+	if strings.HasPrefix(vipsLoader, "png") {
+		return ImageTypePng
+	}
+	if strings.HasPrefix(vipsLoader, "webp") {
+		return ImageTypeWebp
+	}
+	if strings.HasPrefix(vipsLoader, "heif") {
+		return ImageTypeHeif
+	}
+	if strings.HasPrefix(vipsLoader, "svg") {
+		return ImageTypeSvg
+	}
+	if strings.HasPrefix(vipsLoader, "tiff") {
+		return ImageTypeTiff
+	}
+	if strings.HasPrefix(vipsLoader, "jp2k") {
+		return ImageTypeJp2k
+	}
+	if strings.HasPrefix(vipsLoader, "pdf") {
+		return ImageTypePdf
+	}
+	if strings.HasPrefix(vipsLoader, "analyze") {
+		return ImageTypeAnalyze
+	}
+	if strings.HasPrefix(vipsLoader, "csv") {
+		return ImageTypeCsv
+	}
+	if strings.HasPrefix(vipsLoader, "dcraw") {
+		return ImageTypeDcraw
+	}
+	if strings.HasPrefix(vipsLoader, "fits") {
+		return ImageTypeFits
+	}
+	if strings.HasPrefix(vipsLoader, "jxl") {
+		return ImageTypeJxl
+	}
+	if strings.HasPrefix(vipsLoader, "mat") {
+		return ImageTypeMat
+	}
+	if strings.HasPrefix(vipsLoader, "matrix") {
+		return ImageTypeMatrix
+	}
+	if strings.HasPrefix(vipsLoader, "openexr") {
+		return ImageTypeOpenexr
+	}
+	if strings.HasPrefix(vipsLoader, "openslide") {
+		return ImageTypeOpenslide
+	}
+	if strings.HasPrefix(vipsLoader, "ppm") {
+		return ImageTypePpm
+	}
+	if strings.HasPrefix(vipsLoader, "rad") {
+		return ImageTypeRad
+	}
+	if strings.HasPrefix(vipsLoader, "raw") {
+		return ImageTypeRaw
+	}
+	if strings.HasPrefix(vipsLoader, "vips") {
+		return ImageTypeVips
+	}
+	if strings.HasPrefix(vipsLoader, "magick") {
+		return ImageTypeMagick
+	}
+	
+	return ImageTypeUnknown
+}
+
 // Interpolate represents VipsInterpolate type
 type Interpolate struct {
 	interp *C.VipsInterpolate
@@ -792,3 +2506,41 @@ func (i *Interpolate) Close() {
 		i.interp = nil
 	}
 }
+
+// MetaType represents the type of an image metadata field
+type MetaType int
+
+// MetaType enum
+const (
+	MetaTypeNone MetaType = iota
+	MetaTypeInt
+	MetaTypeDouble
+	MetaTypeString
+	MetaTypeBlob
+	MetaTypeArrayInt
+	MetaTypeArrayDouble
+	MetaTypeImage
+	MetaTypeArrayImage
+	MetaTypeUnknown
+)
+
+var metaTypeNames = [...]string{
+	MetaTypeNone:        "none",
+	MetaTypeInt:         "int",
+	MetaTypeDouble:      "double",
+	MetaTypeString:      "string",
+	MetaTypeBlob:        "blob",
+	MetaTypeArrayInt:    "array-int",
+	MetaTypeArrayDouble: "array-double",
+	MetaTypeImage:       "image",
+	MetaTypeArrayImage:  "array-image",
+	MetaTypeUnknown:     "unknown",
+}
+
+// String returns the name of the MetaType, or MetaType(N) for an unknown value
+func (m MetaType) String() string {
+	if m >= 0 && int(m) < len(metaTypeNames) {
+		return metaTypeNames[m]
+	}
+	return "MetaType(" + strconv.Itoa(int(m)) + ")"
+}
@@ -5,6 +5,7 @@ package vips
 import "C"
 import (
 	"runtime"
+	"time"
 	"unsafe"
 )
 
@@ -13,7 +14,7 @@ import (
 func vipsgenCMC2LCh(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_CMC2LCh(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("CMC2LCh", out)
 	}
 	return out, nil
 }
@@ -22,7 +23,7 @@ func vipsgenCMC2LCh(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenCMYK2XYZ(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_CMYK2XYZ(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("CMYK2XYZ", out)
 	}
 	return out, nil
 }
@@ -31,7 +32,7 @@ func vipsgenCMYK2XYZ(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenHSV2sRGB(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_HSV2sRGB(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("HSV2sRGB", out)
 	}
 	return out, nil
 }
@@ -40,7 +41,7 @@ func vipsgenHSV2sRGB(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenLCh2CMC(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_LCh2CMC(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("LCh2CMC", out)
 	}
 	return out, nil
 }
@@ -49,7 +50,7 @@ func vipsgenLCh2CMC(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenLCh2Lab(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_LCh2Lab(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("LCh2Lab", out)
 	}
 	return out, nil
 }
@@ -58,7 +59,7 @@ func vipsgenLCh2Lab(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenLab2LCh(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_Lab2LCh(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("Lab2LCh", out)
 	}
 	return out, nil
 }
@@ -67,7 +68,7 @@ func vipsgenLab2LCh(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenLab2LabQ(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_Lab2LabQ(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("Lab2LabQ", out)
 	}
 	return out, nil
 }
@@ -76,7 +77,7 @@ func vipsgenLab2LabQ(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenLab2LabS(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_Lab2LabS(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("Lab2LabS", out)
 	}
 	return out, nil
 }
@@ -85,7 +86,7 @@ func vipsgenLab2LabS(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenLab2XYZ(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_Lab2XYZ(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("Lab2XYZ", out)
 	}
 	return out, nil
 }
@@ -101,7 +102,7 @@ func vipsgenLab2XYZWithOptions(in *C.VipsImage, temp []float64) (*C.VipsImage, e
 		defer freeDoubleArray(ctemp)
 	}
 	if err := C.vipsgen_Lab2XYZ_with_options(in, &out, ctemp, ctempLength); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("Lab2XYZ", out)
 	}
 	return out, nil
 }
@@ -110,7 +111,7 @@ func vipsgenLab2XYZWithOptions(in *C.VipsImage, temp []float64) (*C.VipsImage, e
 func vipsgenLabQ2Lab(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_LabQ2Lab(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("LabQ2Lab", out)
 	}
 	return out, nil
 }
@@ -119,7 +120,7 @@ func vipsgenLabQ2Lab(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenLabQ2LabS(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_LabQ2LabS(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("LabQ2LabS", out)
 	}
 	return out, nil
 }
@@ -128,7 +129,7 @@ func vipsgenLabQ2LabS(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenLabQ2sRGB(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_LabQ2sRGB(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("LabQ2sRGB", out)
 	}
 	return out, nil
 }
@@ -137,7 +138,7 @@ func vipsgenLabQ2sRGB(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenLabS2Lab(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_LabS2Lab(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("LabS2Lab", out)
 	}
 	return out, nil
 }
@@ -146,7 +147,7 @@ func vipsgenLabS2Lab(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenLabS2LabQ(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_LabS2LabQ(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("LabS2LabQ", out)
 	}
 	return out, nil
 }
@@ -155,7 +156,7 @@ func vipsgenLabS2LabQ(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenOklab2Oklch(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_Oklab2Oklch(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("Oklab2Oklch", out)
 	}
 	return out, nil
 }
@@ -164,7 +165,7 @@ func vipsgenOklab2Oklch(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenOklab2XYZ(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_Oklab2XYZ(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("Oklab2XYZ", out)
 	}
 	return out, nil
 }
@@ -173,7 +174,7 @@ func vipsgenOklab2XYZ(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenOklch2Oklab(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_Oklch2Oklab(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("Oklch2Oklab", out)
 	}
 	return out, nil
 }
@@ -182,7 +183,7 @@ func vipsgenOklch2Oklab(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenXYZ2CMYK(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_XYZ2CMYK(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("XYZ2CMYK", out)
 	}
 	return out, nil
 }
@@ -191,7 +192,7 @@ func vipsgenXYZ2CMYK(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenXYZ2Lab(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_XYZ2Lab(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("XYZ2Lab", out)
 	}
 	return out, nil
 }
@@ -207,7 +208,7 @@ func vipsgenXYZ2LabWithOptions(in *C.VipsImage, temp []float64) (*C.VipsImage, e
 		defer freeDoubleArray(ctemp)
 	}
 	if err := C.vipsgen_XYZ2Lab_with_options(in, &out, ctemp, ctempLength); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("XYZ2Lab", out)
 	}
 	return out, nil
 }
@@ -216,7 +217,7 @@ func vipsgenXYZ2LabWithOptions(in *C.VipsImage, temp []float64) (*C.VipsImage, e
 func vipsgenXYZ2Oklab(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_XYZ2Oklab(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("XYZ2Oklab", out)
 	}
 	return out, nil
 }
@@ -225,7 +226,7 @@ func vipsgenXYZ2Oklab(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenXYZ2Yxy(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_XYZ2Yxy(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("XYZ2Yxy", out)
 	}
 	return out, nil
 }
@@ -234,7 +235,7 @@ func vipsgenXYZ2Yxy(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenXYZ2scRGB(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_XYZ2scRGB(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("XYZ2scRGB", out)
 	}
 	return out, nil
 }
@@ -243,7 +244,7 @@ func vipsgenXYZ2scRGB(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenYxy2XYZ(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_Yxy2XYZ(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("Yxy2XYZ", out)
 	}
 	return out, nil
 }
@@ -252,7 +253,7 @@ func vipsgenYxy2XYZ(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenAbs(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_abs(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("abs", out)
 	}
 	return out, nil
 }
@@ -261,7 +262,7 @@ func vipsgenAbs(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenAdd(left *C.VipsImage, right *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_add(left, right, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("add", out)
 	}
 	return out, nil
 }
@@ -270,7 +271,7 @@ func vipsgenAdd(left *C.VipsImage, right *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenAddalpha(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_addalpha(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("addalpha", out)
 	}
 	return out, nil
 }
@@ -279,7 +280,7 @@ func vipsgenAddalpha(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenAffine(in *C.VipsImage, a float64, b float64, c float64, d float64) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_affine(in, &out, C.double(a), C.double(b), C.double(c), C.double(d)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("affine", out)
 	}
 	return out, nil
 }
@@ -302,7 +303,7 @@ func vipsgenAffineWithOptions(in *C.VipsImage, a float64, b float64, c float64,
 		defer freeDoubleArray(cbackground)
 	}
 	if err := C.vipsgen_affine_with_options(in, &out, C.double(a), C.double(b), C.double(c), C.double(d), vipsInterpolateToC(interpolate), coarea, coareaLength, C.double(odx), C.double(ody), C.double(idx), C.double(idy), cbackground, cbackgroundLength, C.int(boolToInt(premultiplied)), C.VipsExtend(extend)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("affine", out)
 	}
 	return out, nil
 }
@@ -313,7 +314,7 @@ func vipsgenAnalyzeload(filename string) (*C.VipsImage, error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_analyzeload(cfilename, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("analyzeload", out)
 	}
 	return out, nil
 }
@@ -324,7 +325,7 @@ func vipsgenAnalyzeloadWithOptions(filename string, memory bool, access Access,
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_analyzeload_with_options(cfilename, &out, C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("analyzeload", out)
 	}
 	return out, nil
 }
@@ -343,7 +344,7 @@ func vipsgenArrayjoin(in []*C.VipsImage) (*C.VipsImage, error) {
 		defer freeImageArray(cin)
 	}
 	if err := C.vipsgen_arrayjoin((**C.VipsImage)(cin), &out, C.int(len(in))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("arrayjoin", out)
 	}
 	return out, nil
 }
@@ -369,7 +370,7 @@ func vipsgenArrayjoinWithOptions(in []*C.VipsImage, across int, shim int, backgr
 		defer freeDoubleArray(cbackground)
 	}
 	if err := C.vipsgen_arrayjoin_with_options(cin, &out, C.int(len(in)), C.gint(across), C.gint(shim), cbackground, cbackgroundLength, C.VipsAlign(halign), C.VipsAlign(valign), C.gint(hspacing), C.gint(vspacing)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("arrayjoin", out)
 	}
 	return out, nil
 }
@@ -378,7 +379,7 @@ func vipsgenArrayjoinWithOptions(in []*C.VipsImage, across int, shim int, backgr
 func vipsgenAutorot(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_autorot(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("autorot", out)
 	}
 	return out, nil
 }
@@ -392,7 +393,7 @@ func vipsgenAutorotWithOptions(in *C.VipsImage, flip *bool) (*C.VipsImage, error
 		cflip = &cflipValue
 	}
 	if err := C.vipsgen_autorot_with_options(in, &out, cflip); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("autorot", out)
 	}
 	if flip != nil {
 		*flip = cflipValue != 0
@@ -405,7 +406,7 @@ func vipsgenAvg(in *C.VipsImage) (float64, error) {
 	var out float64
 	cout := new(C.double)
 	if err := C.vipsgen_avg(in, cout); err != 0 {
-		return 0, handleVipsError()
+		return 0, handleVipsError("avg")
 	}
 	out = float64(*cout)
 	return out, nil
@@ -415,7 +416,7 @@ func vipsgenAvg(in *C.VipsImage) (float64, error) {
 func vipsgenBandbool(in *C.VipsImage, boolean OperationBoolean) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_bandbool(in, &out, C.VipsOperationBoolean(boolean)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("bandbool", out)
 	}
 	return out, nil
 }
@@ -424,7 +425,7 @@ func vipsgenBandbool(in *C.VipsImage, boolean OperationBoolean) (*C.VipsImage, e
 func vipsgenBandfold(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_bandfold(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("bandfold", out)
 	}
 	return out, nil
 }
@@ -433,7 +434,7 @@ func vipsgenBandfold(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenBandfoldWithOptions(in *C.VipsImage, factor int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_bandfold_with_options(in, &out, C.gint(factor)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("bandfold", out)
 	}
 	return out, nil
 }
@@ -452,7 +453,7 @@ func vipsgenBandjoin(in []*C.VipsImage) (*C.VipsImage, error) {
 		defer freeImageArray(cin)
 	}
 	if err := C.vipsgen_bandjoin((**C.VipsImage)(cin), &out, C.int(len(in))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("bandjoin", out)
 	}
 	return out, nil
 }
@@ -471,7 +472,7 @@ func vipsgenBandjoinConst(in *C.VipsImage, c []float64) (*C.VipsImage, error) {
 		defer freeDoubleArray(cc)
 	}
 	if err := C.vipsgen_bandjoin_const(in, &out, cc, C.int(len(c))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("bandjoin_const", out)
 	}
 	return out, nil
 }
@@ -480,7 +481,7 @@ func vipsgenBandjoinConst(in *C.VipsImage, c []float64) (*C.VipsImage, error) {
 func vipsgenBandmean(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_bandmean(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("bandmean", out)
 	}
 	return out, nil
 }
@@ -499,7 +500,7 @@ func vipsgenBandrank(in []*C.VipsImage) (*C.VipsImage, error) {
 		defer freeImageArray(cin)
 	}
 	if err := C.vipsgen_bandrank((**C.VipsImage)(cin), &out, C.int(len(in))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("bandrank", out)
 	}
 	return out, nil
 }
@@ -518,7 +519,7 @@ func vipsgenBandrankWithOptions(in []*C.VipsImage, index int) (*C.VipsImage, err
 		defer freeImageArray(cin)
 	}
 	if err := C.vipsgen_bandrank_with_options(cin, &out, C.int(len(in)), C.gint(index)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("bandrank", out)
 	}
 	return out, nil
 }
@@ -527,7 +528,7 @@ func vipsgenBandrankWithOptions(in []*C.VipsImage, index int) (*C.VipsImage, err
 func vipsgenBandunfold(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_bandunfold(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("bandunfold", out)
 	}
 	return out, nil
 }
@@ -536,7 +537,7 @@ func vipsgenBandunfold(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenBandunfoldWithOptions(in *C.VipsImage, factor int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_bandunfold_with_options(in, &out, C.gint(factor)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("bandunfold", out)
 	}
 	return out, nil
 }
@@ -545,7 +546,7 @@ func vipsgenBandunfoldWithOptions(in *C.VipsImage, factor int) (*C.VipsImage, er
 func vipsgenBlack(width int, height int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_black(&out, C.gint(width), C.gint(height)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("black", out)
 	}
 	return out, nil
 }
@@ -554,7 +555,7 @@ func vipsgenBlack(width int, height int) (*C.VipsImage, error) {
 func vipsgenBlackWithOptions(width int, height int, bands int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_black_with_options(&out, C.gint(width), C.gint(height), C.gint(bands)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("black", out)
 	}
 	return out, nil
 }
@@ -563,7 +564,7 @@ func vipsgenBlackWithOptions(width int, height int, bands int) (*C.VipsImage, er
 func vipsgenBoolean(left *C.VipsImage, right *C.VipsImage, boolean OperationBoolean) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_boolean(left, right, &out, C.VipsOperationBoolean(boolean)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("boolean", out)
 	}
 	return out, nil
 }
@@ -582,7 +583,7 @@ func vipsgenBooleanConst(in *C.VipsImage, boolean OperationBoolean, c []float64)
 		defer freeDoubleArray(cc)
 	}
 	if err := C.vipsgen_boolean_const(in, &out, C.VipsOperationBoolean(boolean), cc, C.int(len(c))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("boolean_const", out)
 	}
 	return out, nil
 }
@@ -591,7 +592,7 @@ func vipsgenBooleanConst(in *C.VipsImage, boolean OperationBoolean, c []float64)
 func vipsgenBuildlut(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_buildlut(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("buildlut", out)
 	}
 	return out, nil
 }
@@ -600,7 +601,7 @@ func vipsgenBuildlut(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenByteswap(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_byteswap(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("byteswap", out)
 	}
 	return out, nil
 }
@@ -609,7 +610,7 @@ func vipsgenByteswap(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenCanny(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_canny(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("canny", out)
 	}
 	return out, nil
 }
@@ -618,7 +619,7 @@ func vipsgenCanny(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenCannyWithOptions(in *C.VipsImage, sigma float64, precision Precision) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_canny_with_options(in, &out, C.double(sigma), C.VipsPrecision(precision)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("canny", out)
 	}
 	return out, nil
 }
@@ -637,7 +638,7 @@ func vipsgenCase(index *C.VipsImage, cases []*C.VipsImage) (*C.VipsImage, error)
 		defer freeImageArray(ccases)
 	}
 	if err := C.vipsgen_case(index, (**C.VipsImage)(ccases), &out, C.int(len(cases))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("case", out)
 	}
 	return out, nil
 }
@@ -646,7 +647,7 @@ func vipsgenCase(index *C.VipsImage, cases []*C.VipsImage) (*C.VipsImage, error)
 func vipsgenCast(in *C.VipsImage, format BandFormat) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_cast(in, &out, C.VipsBandFormat(format)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("cast", out)
 	}
 	return out, nil
 }
@@ -655,7 +656,7 @@ func vipsgenCast(in *C.VipsImage, format BandFormat) (*C.VipsImage, error) {
 func vipsgenCastWithOptions(in *C.VipsImage, format BandFormat, shift bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_cast_with_options(in, &out, C.VipsBandFormat(format), C.int(boolToInt(shift))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("cast", out)
 	}
 	return out, nil
 }
@@ -664,7 +665,7 @@ func vipsgenCastWithOptions(in *C.VipsImage, format BandFormat, shift bool) (*C.
 func vipsgenClamp(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_clamp(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("clamp", out)
 	}
 	return out, nil
 }
@@ -673,7 +674,7 @@ func vipsgenClamp(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenClampWithOptions(in *C.VipsImage, min float64, max float64) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_clamp_with_options(in, &out, C.double(min), C.double(max)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("clamp", out)
 	}
 	return out, nil
 }
@@ -682,7 +683,7 @@ func vipsgenClampWithOptions(in *C.VipsImage, min float64, max float64) (*C.Vips
 func vipsgenColourspace(in *C.VipsImage, space Interpretation) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_colourspace(in, &out, C.VipsInterpretation(space)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("colourspace", out)
 	}
 	return out, nil
 }
@@ -691,7 +692,7 @@ func vipsgenColourspace(in *C.VipsImage, space Interpretation) (*C.VipsImage, er
 func vipsgenColourspaceWithOptions(in *C.VipsImage, space Interpretation, sourceSpace Interpretation) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_colourspace_with_options(in, &out, C.VipsInterpretation(space), C.VipsInterpretation(sourceSpace)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("colourspace", out)
 	}
 	return out, nil
 }
@@ -700,7 +701,7 @@ func vipsgenColourspaceWithOptions(in *C.VipsImage, space Interpretation, source
 func vipsgenCompass(in *C.VipsImage, mask *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_compass(in, &out, mask); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("compass", out)
 	}
 	return out, nil
 }
@@ -709,7 +710,7 @@ func vipsgenCompass(in *C.VipsImage, mask *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenCompassWithOptions(in *C.VipsImage, mask *C.VipsImage, times int, angle Angle45, combine Combine, precision Precision, layers int, cluster int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_compass_with_options(in, &out, mask, C.gint(times), C.VipsAngle45(angle), C.VipsCombine(combine), C.VipsPrecision(precision), C.gint(layers), C.gint(cluster)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("compass", out)
 	}
 	return out, nil
 }
@@ -718,7 +719,7 @@ func vipsgenCompassWithOptions(in *C.VipsImage, mask *C.VipsImage, times int, an
 func vipsgenComplex(in *C.VipsImage, cmplx OperationComplex) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_complex(in, &out, C.VipsOperationComplex(cmplx)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("complex", out)
 	}
 	return out, nil
 }
@@ -727,7 +728,7 @@ func vipsgenComplex(in *C.VipsImage, cmplx OperationComplex) (*C.VipsImage, erro
 func vipsgenComplex2(left *C.VipsImage, right *C.VipsImage, cmplx OperationComplex2) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_complex2(left, right, &out, C.VipsOperationComplex2(cmplx)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("complex2", out)
 	}
 	return out, nil
 }
@@ -736,7 +737,7 @@ func vipsgenComplex2(left *C.VipsImage, right *C.VipsImage, cmplx OperationCompl
 func vipsgenComplexform(left *C.VipsImage, right *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_complexform(left, right, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("complexform", out)
 	}
 	return out, nil
 }
@@ -745,7 +746,7 @@ func vipsgenComplexform(left *C.VipsImage, right *C.VipsImage) (*C.VipsImage, er
 func vipsgenComplexget(in *C.VipsImage, get OperationComplexget) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_complexget(in, &out, C.VipsOperationComplexget(get)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("complexget", out)
 	}
 	return out, nil
 }
@@ -774,7 +775,7 @@ func vipsgenComposite(in []*C.VipsImage, mode []BlendMode) (*C.VipsImage, error)
 		defer freeIntArray(cmode)
 	}
 	if err := C.vipsgen_composite((**C.VipsImage)(cin), &out, C.int(len(in)), cmode); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("composite", out)
 	}
 	return out, nil
 }
@@ -817,7 +818,7 @@ func vipsgenCompositeWithOptions(in []*C.VipsImage, mode []BlendMode, x []int, y
 		defer freeIntArray(cy)
 	}
 	if err := C.vipsgen_composite_with_options(cin, &out, C.int(len(in)), cmode, cx, cxLength, cy, cyLength, C.VipsInterpretation(compositingSpace), C.int(boolToInt(premultiplied))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("composite", out)
 	}
 	return out, nil
 }
@@ -826,7 +827,7 @@ func vipsgenCompositeWithOptions(in []*C.VipsImage, mode []BlendMode, x []int, y
 func vipsgenComposite2(base *C.VipsImage, overlay *C.VipsImage, mode BlendMode) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_composite2(base, overlay, &out, C.VipsBlendMode(mode)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("composite2", out)
 	}
 	return out, nil
 }
@@ -835,7 +836,7 @@ func vipsgenComposite2(base *C.VipsImage, overlay *C.VipsImage, mode BlendMode)
 func vipsgenComposite2WithOptions(base *C.VipsImage, overlay *C.VipsImage, mode BlendMode, x int, y int, compositingSpace Interpretation, premultiplied bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_composite2_with_options(base, overlay, &out, C.VipsBlendMode(mode), C.gint(x), C.gint(y), C.VipsInterpretation(compositingSpace), C.int(boolToInt(premultiplied))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("composite2", out)
 	}
 	return out, nil
 }
@@ -844,7 +845,7 @@ func vipsgenComposite2WithOptions(base *C.VipsImage, overlay *C.VipsImage, mode
 func vipsgenConv(in *C.VipsImage, mask *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_conv(in, &out, mask); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("conv", out)
 	}
 	return out, nil
 }
@@ -853,7 +854,7 @@ func vipsgenConv(in *C.VipsImage, mask *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenConvWithOptions(in *C.VipsImage, mask *C.VipsImage, precision Precision, layers int, cluster int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_conv_with_options(in, &out, mask, C.VipsPrecision(precision), C.gint(layers), C.gint(cluster)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("conv", out)
 	}
 	return out, nil
 }
@@ -862,7 +863,7 @@ func vipsgenConvWithOptions(in *C.VipsImage, mask *C.VipsImage, precision Precis
 func vipsgenConva(in *C.VipsImage, mask *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_conva(in, &out, mask); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("conva", out)
 	}
 	return out, nil
 }
@@ -871,7 +872,7 @@ func vipsgenConva(in *C.VipsImage, mask *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenConvaWithOptions(in *C.VipsImage, mask *C.VipsImage, layers int, cluster int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_conva_with_options(in, &out, mask, C.gint(layers), C.gint(cluster)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("conva", out)
 	}
 	return out, nil
 }
@@ -880,7 +881,7 @@ func vipsgenConvaWithOptions(in *C.VipsImage, mask *C.VipsImage, layers int, clu
 func vipsgenConvasep(in *C.VipsImage, mask *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_convasep(in, &out, mask); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("convasep", out)
 	}
 	return out, nil
 }
@@ -889,7 +890,7 @@ func vipsgenConvasep(in *C.VipsImage, mask *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenConvasepWithOptions(in *C.VipsImage, mask *C.VipsImage, layers int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_convasep_with_options(in, &out, mask, C.gint(layers)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("convasep", out)
 	}
 	return out, nil
 }
@@ -898,7 +899,7 @@ func vipsgenConvasepWithOptions(in *C.VipsImage, mask *C.VipsImage, layers int)
 func vipsgenConvf(in *C.VipsImage, mask *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_convf(in, &out, mask); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("convf", out)
 	}
 	return out, nil
 }
@@ -907,7 +908,7 @@ func vipsgenConvf(in *C.VipsImage, mask *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenConvi(in *C.VipsImage, mask *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_convi(in, &out, mask); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("convi", out)
 	}
 	return out, nil
 }
@@ -916,7 +917,7 @@ func vipsgenConvi(in *C.VipsImage, mask *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenConvsep(in *C.VipsImage, mask *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_convsep(in, &out, mask); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("convsep", out)
 	}
 	return out, nil
 }
@@ -925,7 +926,7 @@ func vipsgenConvsep(in *C.VipsImage, mask *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenConvsepWithOptions(in *C.VipsImage, mask *C.VipsImage, precision Precision, layers int, cluster int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_convsep_with_options(in, &out, mask, C.VipsPrecision(precision), C.gint(layers), C.gint(cluster)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("convsep", out)
 	}
 	return out, nil
 }
@@ -934,7 +935,7 @@ func vipsgenConvsepWithOptions(in *C.VipsImage, mask *C.VipsImage, precision Pre
 func vipsgenCopy(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_copy(in, &out); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("copy")
 	}
 	return out, nil
 }
@@ -943,7 +944,7 @@ func vipsgenCopy(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenCopyWithOptions(in *C.VipsImage, width int, height int, bands int, format BandFormat, coding Coding, interpretation Interpretation, xres float64, yres float64, xoffset int, yoffset int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_copy_with_options(in, &out, C.gint(width), C.gint(height), C.gint(bands), C.VipsBandFormat(format), C.VipsCoding(coding), C.VipsInterpretation(interpretation), C.double(xres), C.double(yres), C.gint(xoffset), C.gint(yoffset)); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("copy")
 	}
 	return out, nil
 }
@@ -953,7 +954,7 @@ func vipsgenCountlines(in *C.VipsImage, direction Direction) (float64, error) {
 	var nolines float64
 	cnolines := new(C.double)
 	if err := C.vipsgen_countlines(in, cnolines, C.VipsDirection(direction)); err != 0 {
-		return 0, handleVipsError()
+		return 0, handleVipsError("countlines")
 	}
 	nolines = float64(*cnolines)
 	return nolines, nil
@@ -965,7 +966,7 @@ func vipsgenCsvload(filename string) (*C.VipsImage, error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_csvload(cfilename, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("csvload", out)
 	}
 	return out, nil
 }
@@ -980,7 +981,7 @@ func vipsgenCsvloadWithOptions(filename string, skip int, lines int, whitespace
 	cseparator := C.CString(separator)
 	defer freeCString(cseparator)
 	if err := C.vipsgen_csvload_with_options(cfilename, &out, C.gint(skip), C.gint(lines), cwhitespace, cseparator, C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("csvload", out)
 	}
 	return out, nil
 }
@@ -989,7 +990,7 @@ func vipsgenCsvloadWithOptions(filename string, skip int, lines int, whitespace
 func vipsgenCsvloadSource(source *C.VipsSourceCustom) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_csvload_source(source, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("csvload_source", out)
 	}
 	return out, nil
 }
@@ -1002,7 +1003,7 @@ func vipsgenCsvloadSourceWithOptions(source *C.VipsSourceCustom, skip int, lines
 	cseparator := C.CString(separator)
 	defer freeCString(cseparator)
 	if err := C.vipsgen_csvload_source_with_options(source, &out, C.gint(skip), C.gint(lines), cwhitespace, cseparator, C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("csvload_source", out)
 	}
 	return out, nil
 }
@@ -1012,7 +1013,7 @@ func vipsgenCsvsave(in *C.VipsImage, filename string) (error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_csvsave(in, cfilename); err != 0 {
-		return handleVipsError()
+		return handleVipsError("csvsave")
 	}
 	return nil
 }
@@ -1033,7 +1034,7 @@ func vipsgenCsvsaveWithOptions(in *C.VipsImage, filename string, separator strin
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_csvsave_with_options(in, cfilename, cseparator, C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return handleVipsError()
+		return handleVipsError("csvsave")
 	}
 	return nil
 }
@@ -1042,7 +1043,7 @@ func vipsgenCsvsaveWithOptions(in *C.VipsImage, filename string, separator strin
 func vipsgenCsvsaveTarget(in *C.VipsImage, target *C.VipsTargetCustom) (error) {
 	
 	if err := C.vipsgen_csvsave_target(in, target); err != 0 {
-		return handleVipsError()
+		return handleVipsError("csvsave_target")
 	}
 	return nil
 }
@@ -1061,7 +1062,7 @@ func vipsgenCsvsaveTargetWithOptions(in *C.VipsImage, target *C.VipsTargetCustom
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_csvsave_target_with_options(in, target, cseparator, C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return handleVipsError()
+		return handleVipsError("csvsave_target")
 	}
 	return nil
 }
@@ -1070,7 +1071,7 @@ func vipsgenCsvsaveTargetWithOptions(in *C.VipsImage, target *C.VipsTargetCustom
 func vipsgenDE00(left *C.VipsImage, right *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_dE00(left, right, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("dE00", out)
 	}
 	return out, nil
 }
@@ -1079,7 +1080,7 @@ func vipsgenDE00(left *C.VipsImage, right *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenDE76(left *C.VipsImage, right *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_dE76(left, right, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("dE76", out)
 	}
 	return out, nil
 }
@@ -1088,7 +1089,7 @@ func vipsgenDE76(left *C.VipsImage, right *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenDECMC(left *C.VipsImage, right *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_dECMC(left, right, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("dECMC", out)
 	}
 	return out, nil
 }
@@ -1099,7 +1100,7 @@ func vipsgenDcrawload(filename string) (*C.VipsImage, error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_dcrawload(cfilename, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("dcrawload", out)
 	}
 	return out, nil
 }
@@ -1110,7 +1111,7 @@ func vipsgenDcrawloadWithOptions(filename string, bitdepth int, memory bool, acc
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_dcrawload_with_options(cfilename, &out, C.gint(bitdepth), C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("dcrawload", out)
 	}
 	return out, nil
 }
@@ -1122,7 +1123,7 @@ func vipsgenDcrawloadBuffer(buf []byte) (*C.VipsImage, error) {
 	defer runtime.KeepAlive(src)
 	var out *C.VipsImage
 	if err := C.vipsgen_dcrawload_buffer(unsafe.Pointer(&src[0]), C.size_t(len(src)), &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("dcrawload_buffer", out)
 	}
 	return out, nil
 }
@@ -1134,7 +1135,7 @@ func vipsgenDcrawloadBufferWithOptions(buf []byte, bitdepth int, memory bool, ac
 	defer runtime.KeepAlive(src)
 	var out *C.VipsImage
 	if err := C.vipsgen_dcrawload_buffer_with_options(unsafe.Pointer(&src[0]), C.size_t(len(src)), &out, C.gint(bitdepth), C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("dcrawload_buffer", out)
 	}
 	return out, nil
 }
@@ -1143,7 +1144,7 @@ func vipsgenDcrawloadBufferWithOptions(buf []byte, bitdepth int, memory bool, ac
 func vipsgenDcrawloadSource(source *C.VipsSourceCustom) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_dcrawload_source(source, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("dcrawload_source", out)
 	}
 	return out, nil
 }
@@ -1152,7 +1153,7 @@ func vipsgenDcrawloadSource(source *C.VipsSourceCustom) (*C.VipsImage, error) {
 func vipsgenDcrawloadSourceWithOptions(source *C.VipsSourceCustom, bitdepth int, memory bool, access Access, failOn FailOn, revalidate bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_dcrawload_source_with_options(source, &out, C.gint(bitdepth), C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("dcrawload_source", out)
 	}
 	return out, nil
 }
@@ -1162,7 +1163,7 @@ func vipsgenDeviate(in *C.VipsImage) (float64, error) {
 	var out float64
 	cout := new(C.double)
 	if err := C.vipsgen_deviate(in, cout); err != 0 {
-		return 0, handleVipsError()
+		return 0, handleVipsError("deviate")
 	}
 	out = float64(*cout)
 	return out, nil
@@ -1172,7 +1173,7 @@ func vipsgenDeviate(in *C.VipsImage) (float64, error) {
 func vipsgenDivide(left *C.VipsImage, right *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_divide(left, right, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("divide", out)
 	}
 	return out, nil
 }
@@ -1190,7 +1191,7 @@ func vipsgenDrawCircle(image *C.VipsImage, ink []float64, cx int, cy int, radius
 		defer freeDoubleArray(cink)
 	}
 	if err := C.vipsgen_draw_circle(image, cink, C.int(len(ink)), C.gint(cx), C.gint(cy), C.gint(radius)); err != 0 {
-		return handleVipsError()
+		return handleVipsError("draw_circle")
 	}
 	return nil
 }
@@ -1208,7 +1209,7 @@ func vipsgenDrawCircleWithOptions(image *C.VipsImage, ink []float64, cx int, cy
 		defer freeDoubleArray(cink)
 	}
 	if err := C.vipsgen_draw_circle_with_options(image, cink, C.int(len(ink)), C.gint(cx), C.gint(cy), C.gint(radius), C.int(boolToInt(fill))); err != 0 {
-		return handleVipsError()
+		return handleVipsError("draw_circle")
 	}
 	return nil
 }
@@ -1226,7 +1227,7 @@ func vipsgenDrawFlood(image *C.VipsImage, ink []float64, x int, y int) (error) {
 		defer freeDoubleArray(cink)
 	}
 	if err := C.vipsgen_draw_flood(image, cink, C.int(len(ink)), C.gint(x), C.gint(y)); err != 0 {
-		return handleVipsError()
+		return handleVipsError("draw_flood")
 	}
 	return nil
 }
@@ -1264,7 +1265,7 @@ func vipsgenDrawFloodWithOptions(image *C.VipsImage, ink []float64, x int, y int
 		cheight = &cheightValue
 	}
 	if err := C.vipsgen_draw_flood_with_options(image, cink, C.int(len(ink)), C.gint(x), C.gint(y), test, C.int(boolToInt(equal)), cleft, ctop, cwidth, cheight); err != 0 {
-		return handleVipsError()
+		return handleVipsError("draw_flood")
 	}
 	if left != nil {
 		*left = int(cleftValue)
@@ -1285,7 +1286,7 @@ func vipsgenDrawFloodWithOptions(image *C.VipsImage, ink []float64, x int, y int
 func vipsgenDrawImage(image *C.VipsImage, sub *C.VipsImage, x int, y int) (error) {
 	
 	if err := C.vipsgen_draw_image(image, sub, C.gint(x), C.gint(y)); err != 0 {
-		return handleVipsError()
+		return handleVipsError("draw_image")
 	}
 	return nil
 }
@@ -1294,7 +1295,7 @@ func vipsgenDrawImage(image *C.VipsImage, sub *C.VipsImage, x int, y int) (error
 func vipsgenDrawImageWithOptions(image *C.VipsImage, sub *C.VipsImage, x int, y int, mode CombineMode) (error) {
 	
 	if err := C.vipsgen_draw_image_with_options(image, sub, C.gint(x), C.gint(y), C.VipsCombineMode(mode)); err != 0 {
-		return handleVipsError()
+		return handleVipsError("draw_image")
 	}
 	return nil
 }
@@ -1312,7 +1313,7 @@ func vipsgenDrawLine(image *C.VipsImage, ink []float64, x1 int, y1 int, x2 int,
 		defer freeDoubleArray(cink)
 	}
 	if err := C.vipsgen_draw_line(image, cink, C.int(len(ink)), C.gint(x1), C.gint(y1), C.gint(x2), C.gint(y2)); err != 0 {
-		return handleVipsError()
+		return handleVipsError("draw_line")
 	}
 	return nil
 }
@@ -1330,7 +1331,7 @@ func vipsgenDrawMask(image *C.VipsImage, ink []float64, mask *C.VipsImage, x int
 		defer freeDoubleArray(cink)
 	}
 	if err := C.vipsgen_draw_mask(image, cink, C.int(len(ink)), mask, C.gint(x), C.gint(y)); err != 0 {
-		return handleVipsError()
+		return handleVipsError("draw_mask")
 	}
 	return nil
 }
@@ -1348,7 +1349,7 @@ func vipsgenDrawRect(image *C.VipsImage, ink []float64, left int, top int, width
 		defer freeDoubleArray(cink)
 	}
 	if err := C.vipsgen_draw_rect(image, cink, C.int(len(ink)), C.gint(left), C.gint(top), C.gint(width), C.gint(height)); err != 0 {
-		return handleVipsError()
+		return handleVipsError("draw_rect")
 	}
 	return nil
 }
@@ -1366,7 +1367,7 @@ func vipsgenDrawRectWithOptions(image *C.VipsImage, ink []float64, left int, top
 		defer freeDoubleArray(cink)
 	}
 	if err := C.vipsgen_draw_rect_with_options(image, cink, C.int(len(ink)), C.gint(left), C.gint(top), C.gint(width), C.gint(height), C.int(boolToInt(fill))); err != 0 {
-		return handleVipsError()
+		return handleVipsError("draw_rect")
 	}
 	return nil
 }
@@ -1375,7 +1376,7 @@ func vipsgenDrawRectWithOptions(image *C.VipsImage, ink []float64, left int, top
 func vipsgenDrawSmudge(image *C.VipsImage, left int, top int, width int, height int) (error) {
 	
 	if err := C.vipsgen_draw_smudge(image, C.gint(left), C.gint(top), C.gint(width), C.gint(height)); err != 0 {
-		return handleVipsError()
+		return handleVipsError("draw_smudge")
 	}
 	return nil
 }
@@ -1385,7 +1386,7 @@ func vipsgenDzsave(in *C.VipsImage, filename string) (error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_dzsave(in, cfilename); err != 0 {
-		return handleVipsError()
+		return handleVipsError("dzsave")
 	}
 	return nil
 }
@@ -1410,7 +1411,7 @@ func vipsgenDzsaveWithOptions(in *C.VipsImage, filename string, imagename string
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_dzsave_with_options(in, cfilename, cimagename, C.VipsForeignDzLayout(layout), csuffix, C.gint(overlap), C.gint(tileSize), C.int(boolToInt(centre)), C.VipsForeignDzDepth(depth), C.VipsAngle(angle), C.VipsForeignDzContainer(container), C.gint(compression), C.VipsRegionShrink(regionShrink), C.gint(skipBlanks), cid, C.gint(q), C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return handleVipsError()
+		return handleVipsError("dzsave")
 	}
 	return nil
 }
@@ -1420,7 +1421,7 @@ func vipsgenDzsaveBuffer(in *C.VipsImage) ([]byte, error) {
 	var buf unsafe.Pointer
 	var length C.size_t
 	if err := C.vipsgen_dzsave_buffer(in, &buf, &length); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("dzsave_buffer")
 	}
 	return bufferToBytes(buf, length), nil
 }
@@ -1445,7 +1446,7 @@ func vipsgenDzsaveBufferWithOptions(in *C.VipsImage, imagename string, layout Dz
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_dzsave_buffer_with_options(in, &buf, &length, cimagename, C.VipsForeignDzLayout(layout), csuffix, C.gint(overlap), C.gint(tileSize), C.int(boolToInt(centre)), C.VipsForeignDzDepth(depth), C.VipsAngle(angle), C.VipsForeignDzContainer(container), C.gint(compression), C.VipsRegionShrink(regionShrink), C.gint(skipBlanks), cid, C.gint(q), C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("dzsave_buffer")
 	}
 	return bufferToBytes(buf, length), nil
 }
@@ -1454,7 +1455,7 @@ func vipsgenDzsaveBufferWithOptions(in *C.VipsImage, imagename string, layout Dz
 func vipsgenDzsaveTarget(in *C.VipsImage, target *C.VipsTargetCustom) (error) {
 	
 	if err := C.vipsgen_dzsave_target(in, target); err != 0 {
-		return handleVipsError()
+		return handleVipsError("dzsave_target")
 	}
 	return nil
 }
@@ -1477,7 +1478,7 @@ func vipsgenDzsaveTargetWithOptions(in *C.VipsImage, target *C.VipsTargetCustom,
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_dzsave_target_with_options(in, target, cimagename, C.VipsForeignDzLayout(layout), csuffix, C.gint(overlap), C.gint(tileSize), C.int(boolToInt(centre)), C.VipsForeignDzDepth(depth), C.VipsAngle(angle), C.VipsForeignDzContainer(container), C.gint(compression), C.VipsRegionShrink(regionShrink), C.gint(skipBlanks), cid, C.gint(q), C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return handleVipsError()
+		return handleVipsError("dzsave_target")
 	}
 	return nil
 }
@@ -1486,7 +1487,7 @@ func vipsgenDzsaveTargetWithOptions(in *C.VipsImage, target *C.VipsTargetCustom,
 func vipsgenEmbed(in *C.VipsImage, x int, y int, width int, height int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_embed(in, &out, C.gint(x), C.gint(y), C.gint(width), C.gint(height)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("embed", out)
 	}
 	return out, nil
 }
@@ -1502,7 +1503,7 @@ func vipsgenEmbedWithOptions(in *C.VipsImage, x int, y int, width int, height in
 		defer freeDoubleArray(cbackground)
 	}
 	if err := C.vipsgen_embed_with_options(in, &out, C.gint(x), C.gint(y), C.gint(width), C.gint(height), C.VipsExtend(extend), cbackground, cbackgroundLength); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("embed", out)
 	}
 	return out, nil
 }
@@ -1511,7 +1512,7 @@ func vipsgenEmbedWithOptions(in *C.VipsImage, x int, y int, width int, height in
 func vipsgenExtractArea(input *C.VipsImage, left int, top int, width int, height int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_extract_area(input, &out, C.gint(left), C.gint(top), C.gint(width), C.gint(height)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("extract_area", out)
 	}
 	return out, nil
 }
@@ -1520,7 +1521,7 @@ func vipsgenExtractArea(input *C.VipsImage, left int, top int, width int, height
 func vipsgenExtractBand(in *C.VipsImage, band int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_extract_band(in, &out, C.gint(band)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("extract_band", out)
 	}
 	return out, nil
 }
@@ -1529,7 +1530,7 @@ func vipsgenExtractBand(in *C.VipsImage, band int) (*C.VipsImage, error) {
 func vipsgenExtractBandWithOptions(in *C.VipsImage, band int, n int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_extract_band_with_options(in, &out, C.gint(band), C.gint(n)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("extract_band", out)
 	}
 	return out, nil
 }
@@ -1538,7 +1539,7 @@ func vipsgenExtractBandWithOptions(in *C.VipsImage, band int, n int) (*C.VipsIma
 func vipsgenEye(width int, height int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_eye(&out, C.gint(width), C.gint(height)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("eye", out)
 	}
 	return out, nil
 }
@@ -1547,7 +1548,7 @@ func vipsgenEye(width int, height int) (*C.VipsImage, error) {
 func vipsgenEyeWithOptions(width int, height int, uchar bool, factor float64) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_eye_with_options(&out, C.gint(width), C.gint(height), C.int(boolToInt(uchar)), C.double(factor)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("eye", out)
 	}
 	return out, nil
 }
@@ -1556,7 +1557,7 @@ func vipsgenEyeWithOptions(width int, height int, uchar bool, factor float64) (*
 func vipsgenFalsecolour(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_falsecolour(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("falsecolour", out)
 	}
 	return out, nil
 }
@@ -1565,7 +1566,7 @@ func vipsgenFalsecolour(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenFastcor(in *C.VipsImage, ref *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_fastcor(in, ref, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("fastcor", out)
 	}
 	return out, nil
 }
@@ -1574,7 +1575,7 @@ func vipsgenFastcor(in *C.VipsImage, ref *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenFillNearest(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_fill_nearest(in, &out); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("fill_nearest")
 	}
 	return out, nil
 }
@@ -1590,7 +1591,7 @@ func vipsgenFindTrim(in *C.VipsImage) (int, int, int, int, error) {
 	var height int
 	cheight := new(C.gint)
 	if err := C.vipsgen_find_trim(in, cleft, ctop, cwidth, cheight); err != 0 {
-		return 0, 0, 0, 0, handleVipsError()
+		return 0, 0, 0, 0, handleVipsError("find_trim")
 	}
 	left = int(*cleft)
 	top = int(*ctop)
@@ -1617,7 +1618,7 @@ func vipsgenFindTrimWithOptions(in *C.VipsImage, threshold float64, background [
 		defer freeDoubleArray(cbackground)
 	}
 	if err := C.vipsgen_find_trim_with_options(in, cleft, ctop, cwidth, cheight, C.double(threshold), cbackground, cbackgroundLength, C.int(boolToInt(lineArt))); err != 0 {
-		return 0, 0, 0, 0, handleVipsError()
+		return 0, 0, 0, 0, handleVipsError("find_trim")
 	}
 	left = int(*cleft)
 	top = int(*ctop)
@@ -1632,7 +1633,7 @@ func vipsgenFitsload(filename string) (*C.VipsImage, error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_fitsload(cfilename, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("fitsload", out)
 	}
 	return out, nil
 }
@@ -1643,7 +1644,7 @@ func vipsgenFitsloadWithOptions(filename string, memory bool, access Access, fai
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_fitsload_with_options(cfilename, &out, C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("fitsload", out)
 	}
 	return out, nil
 }
@@ -1653,7 +1654,7 @@ func vipsgenFitssave(in *C.VipsImage, filename string) (error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_fitssave(in, cfilename); err != 0 {
-		return handleVipsError()
+		return handleVipsError("fitssave")
 	}
 	return nil
 }
@@ -1672,7 +1673,7 @@ func vipsgenFitssaveWithOptions(in *C.VipsImage, filename string, keep Keep, bac
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_fitssave_with_options(in, cfilename, C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return handleVipsError()
+		return handleVipsError("fitssave")
 	}
 	return nil
 }
@@ -1681,7 +1682,7 @@ func vipsgenFitssaveWithOptions(in *C.VipsImage, filename string, keep Keep, bac
 func vipsgenFlatten(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_flatten(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("flatten", out)
 	}
 	return out, nil
 }
@@ -1697,7 +1698,7 @@ func vipsgenFlattenWithOptions(in *C.VipsImage, background []float64, maxAlpha f
 		defer freeDoubleArray(cbackground)
 	}
 	if err := C.vipsgen_flatten_with_options(in, &out, cbackground, cbackgroundLength, C.double(maxAlpha)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("flatten", out)
 	}
 	return out, nil
 }
@@ -1706,7 +1707,7 @@ func vipsgenFlattenWithOptions(in *C.VipsImage, background []float64, maxAlpha f
 func vipsgenFlip(in *C.VipsImage, direction Direction) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_flip(in, &out, C.VipsDirection(direction)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("flip", out)
 	}
 	return out, nil
 }
@@ -1715,7 +1716,7 @@ func vipsgenFlip(in *C.VipsImage, direction Direction) (*C.VipsImage, error) {
 func vipsgenFloat2rad(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_float2rad(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("float2rad", out)
 	}
 	return out, nil
 }
@@ -1724,7 +1725,7 @@ func vipsgenFloat2rad(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenFractsurf(width int, height int, fractalDimension float64) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_fractsurf(&out, C.gint(width), C.gint(height), C.double(fractalDimension)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("fractsurf", out)
 	}
 	return out, nil
 }
@@ -1733,7 +1734,7 @@ func vipsgenFractsurf(width int, height int, fractalDimension float64) (*C.VipsI
 func vipsgenFreqmult(in *C.VipsImage, mask *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_freqmult(in, mask, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("freqmult", out)
 	}
 	return out, nil
 }
@@ -1742,7 +1743,7 @@ func vipsgenFreqmult(in *C.VipsImage, mask *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenFwfft(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_fwfft(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("fwfft", out)
 	}
 	return out, nil
 }
@@ -1751,7 +1752,7 @@ func vipsgenFwfft(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenGamma(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_gamma(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("gamma", out)
 	}
 	return out, nil
 }
@@ -1760,7 +1761,7 @@ func vipsgenGamma(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenGammaWithOptions(in *C.VipsImage, exponent float64) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_gamma_with_options(in, &out, C.double(exponent)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("gamma", out)
 	}
 	return out, nil
 }
@@ -1769,7 +1770,7 @@ func vipsgenGammaWithOptions(in *C.VipsImage, exponent float64) (*C.VipsImage, e
 func vipsgenGaussblur(in *C.VipsImage, sigma float64) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_gaussblur(in, &out, C.double(sigma)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("gaussblur", out)
 	}
 	return out, nil
 }
@@ -1778,7 +1779,7 @@ func vipsgenGaussblur(in *C.VipsImage, sigma float64) (*C.VipsImage, error) {
 func vipsgenGaussblurWithOptions(in *C.VipsImage, sigma float64, minAmpl float64, precision Precision) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_gaussblur_with_options(in, &out, C.double(sigma), C.double(minAmpl), C.VipsPrecision(precision)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("gaussblur", out)
 	}
 	return out, nil
 }
@@ -1787,7 +1788,7 @@ func vipsgenGaussblurWithOptions(in *C.VipsImage, sigma float64, minAmpl float64
 func vipsgenGaussmat(sigma float64, minAmpl float64) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_gaussmat(&out, C.double(sigma), C.double(minAmpl)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("gaussmat", out)
 	}
 	return out, nil
 }
@@ -1796,7 +1797,7 @@ func vipsgenGaussmat(sigma float64, minAmpl float64) (*C.VipsImage, error) {
 func vipsgenGaussmatWithOptions(sigma float64, minAmpl float64, separable bool, precision Precision) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_gaussmat_with_options(&out, C.double(sigma), C.double(minAmpl), C.int(boolToInt(separable)), C.VipsPrecision(precision)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("gaussmat", out)
 	}
 	return out, nil
 }
@@ -1805,7 +1806,7 @@ func vipsgenGaussmatWithOptions(sigma float64, minAmpl float64, separable bool,
 func vipsgenGaussnoise(width int, height int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_gaussnoise(&out, C.gint(width), C.gint(height)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("gaussnoise", out)
 	}
 	return out, nil
 }
@@ -1814,7 +1815,7 @@ func vipsgenGaussnoise(width int, height int) (*C.VipsImage, error) {
 func vipsgenGaussnoiseWithOptions(width int, height int, sigma float64, mean float64, seed int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_gaussnoise_with_options(&out, C.gint(width), C.gint(height), C.double(sigma), C.double(mean), C.gint(seed)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("gaussnoise", out)
 	}
 	return out, nil
 }
@@ -1825,7 +1826,7 @@ func vipsgenGetpoint(in *C.VipsImage, x int, y int) ([]float64, error) {
 	var n int
 	cn := new(C.int)
 	if err := C.vipsgen_getpoint(in, &out, cn, C.gint(x), C.gint(y)); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("getpoint")
 	}
 	n = int(*cn)
 	result := make([]float64, n)
@@ -1840,7 +1841,7 @@ func vipsgenGetpointWithOptions(in *C.VipsImage, x int, y int, unpackComplex boo
 	var n int
 	cn := new(C.int)
 	if err := C.vipsgen_getpoint_with_options(in, &out, cn, C.gint(x), C.gint(y), C.int(boolToInt(unpackComplex))); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("getpoint")
 	}
 	n = int(*cn)
 	result := make([]float64, n)
@@ -1855,7 +1856,7 @@ func vipsgenGifload(filename string) (*C.VipsImage, error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_gifload(cfilename, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("gifload", out)
 	}
 	return out, nil
 }
@@ -1866,7 +1867,7 @@ func vipsgenGifloadWithOptions(filename string, n int, page int, memory bool, ac
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_gifload_with_options(cfilename, &out, C.gint(n), C.gint(page), C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("gifload", out)
 	}
 	return out, nil
 }
@@ -1878,7 +1879,7 @@ func vipsgenGifloadBuffer(buf []byte) (*C.VipsImage, error) {
 	defer runtime.KeepAlive(src)
 	var out *C.VipsImage
 	if err := C.vipsgen_gifload_buffer(unsafe.Pointer(&src[0]), C.size_t(len(src)), &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("gifload_buffer", out)
 	}
 	return out, nil
 }
@@ -1890,7 +1891,7 @@ func vipsgenGifloadBufferWithOptions(buf []byte, n int, page int, memory bool, a
 	defer runtime.KeepAlive(src)
 	var out *C.VipsImage
 	if err := C.vipsgen_gifload_buffer_with_options(unsafe.Pointer(&src[0]), C.size_t(len(src)), &out, C.gint(n), C.gint(page), C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("gifload_buffer", out)
 	}
 	return out, nil
 }
@@ -1899,7 +1900,7 @@ func vipsgenGifloadBufferWithOptions(buf []byte, n int, page int, memory bool, a
 func vipsgenGifloadSource(source *C.VipsSourceCustom) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_gifload_source(source, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("gifload_source", out)
 	}
 	return out, nil
 }
@@ -1908,7 +1909,7 @@ func vipsgenGifloadSource(source *C.VipsSourceCustom) (*C.VipsImage, error) {
 func vipsgenGifloadSourceWithOptions(source *C.VipsSourceCustom, n int, page int, memory bool, access Access, failOn FailOn, revalidate bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_gifload_source_with_options(source, &out, C.gint(n), C.gint(page), C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("gifload_source", out)
 	}
 	return out, nil
 }
@@ -1918,13 +1919,16 @@ func vipsgenGifsave(in *C.VipsImage, filename string) (error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_gifsave(in, cfilename); err != 0 {
-		return handleVipsError()
+		return handleVipsError("gifsave")
 	}
 	return nil
 }
 
 // vipsgenGifsaveWithOptions vips_gifsave save as gif with optional arguments
 func vipsgenGifsaveWithOptions(in *C.VipsImage, filename string, dither float64, effort int, bitdepth int, interframeMaxerror float64, reuse bool, interpaletteMaxerror float64, interlace bool, keepDuplicateFrames bool, keep Keep, background []float64, pageHeight int, profile string) (error) {
+	if err := checkOptionRange("gifsave", "bitdepth", bitdepth, 1, 8); err != nil {
+		return err
+	}
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	cbackground, cbackgroundLength, err := convertToDoubleArray(background)
@@ -1937,7 +1941,7 @@ func vipsgenGifsaveWithOptions(in *C.VipsImage, filename string, dither float64,
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_gifsave_with_options(in, cfilename, C.double(dither), C.gint(effort), C.gint(bitdepth), C.double(interframeMaxerror), C.int(boolToInt(reuse)), C.double(interpaletteMaxerror), C.int(boolToInt(interlace)), C.int(boolToInt(keepDuplicateFrames)), C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return handleVipsError()
+		return handleVipsError("gifsave")
 	}
 	return nil
 }
@@ -1947,13 +1951,16 @@ func vipsgenGifsaveBuffer(in *C.VipsImage) ([]byte, error) {
 	var buf unsafe.Pointer
 	var length C.size_t
 	if err := C.vipsgen_gifsave_buffer(in, &buf, &length); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("gifsave_buffer")
 	}
 	return bufferToBytes(buf, length), nil
 }
 
 // vipsgenGifsaveBufferWithOptions vips_gifsave_buffer save as gif with optional arguments
 func vipsgenGifsaveBufferWithOptions(in *C.VipsImage, dither float64, effort int, bitdepth int, interframeMaxerror float64, reuse bool, interpaletteMaxerror float64, interlace bool, keepDuplicateFrames bool, keep Keep, background []float64, pageHeight int, profile string) ([]byte, error) {
+	if err := checkOptionRange("gifsave_buffer", "bitdepth", bitdepth, 1, 8); err != nil {
+		return nil, err
+	}
 	var buf unsafe.Pointer
 	var length C.size_t
 	cbackground, cbackgroundLength, err := convertToDoubleArray(background)
@@ -1966,7 +1973,7 @@ func vipsgenGifsaveBufferWithOptions(in *C.VipsImage, dither float64, effort int
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_gifsave_buffer_with_options(in, &buf, &length, C.double(dither), C.gint(effort), C.gint(bitdepth), C.double(interframeMaxerror), C.int(boolToInt(reuse)), C.double(interpaletteMaxerror), C.int(boolToInt(interlace)), C.int(boolToInt(keepDuplicateFrames)), C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("gifsave_buffer")
 	}
 	return bufferToBytes(buf, length), nil
 }
@@ -1975,13 +1982,16 @@ func vipsgenGifsaveBufferWithOptions(in *C.VipsImage, dither float64, effort int
 func vipsgenGifsaveTarget(in *C.VipsImage, target *C.VipsTargetCustom) (error) {
 	
 	if err := C.vipsgen_gifsave_target(in, target); err != 0 {
-		return handleVipsError()
+		return handleVipsError("gifsave_target")
 	}
 	return nil
 }
 
 // vipsgenGifsaveTargetWithOptions vips_gifsave_target save as gif with optional arguments
 func vipsgenGifsaveTargetWithOptions(in *C.VipsImage, target *C.VipsTargetCustom, dither float64, effort int, bitdepth int, interframeMaxerror float64, reuse bool, interpaletteMaxerror float64, interlace bool, keepDuplicateFrames bool, keep Keep, background []float64, pageHeight int, profile string) (error) {
+	if err := checkOptionRange("gifsave_target", "bitdepth", bitdepth, 1, 8); err != nil {
+		return err
+	}
 	cbackground, cbackgroundLength, err := convertToDoubleArray(background)
 	if err != nil {
 		return err
@@ -1992,7 +2002,7 @@ func vipsgenGifsaveTargetWithOptions(in *C.VipsImage, target *C.VipsTargetCustom
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_gifsave_target_with_options(in, target, C.double(dither), C.gint(effort), C.gint(bitdepth), C.double(interframeMaxerror), C.int(boolToInt(reuse)), C.double(interpaletteMaxerror), C.int(boolToInt(interlace)), C.int(boolToInt(keepDuplicateFrames)), C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return handleVipsError()
+		return handleVipsError("gifsave_target")
 	}
 	return nil
 }
@@ -2001,7 +2011,7 @@ func vipsgenGifsaveTargetWithOptions(in *C.VipsImage, target *C.VipsTargetCustom
 func vipsgenGlobalbalance(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_globalbalance(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("globalbalance", out)
 	}
 	return out, nil
 }
@@ -2010,7 +2020,7 @@ func vipsgenGlobalbalance(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenGlobalbalanceWithOptions(in *C.VipsImage, gamma float64, intOutput bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_globalbalance_with_options(in, &out, C.double(gamma), C.int(boolToInt(intOutput))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("globalbalance", out)
 	}
 	return out, nil
 }
@@ -2019,7 +2029,7 @@ func vipsgenGlobalbalanceWithOptions(in *C.VipsImage, gamma float64, intOutput b
 func vipsgenGravity(in *C.VipsImage, direction CompassDirection, width int, height int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_gravity(in, &out, C.VipsCompassDirection(direction), C.gint(width), C.gint(height)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("gravity", out)
 	}
 	return out, nil
 }
@@ -2035,7 +2045,7 @@ func vipsgenGravityWithOptions(in *C.VipsImage, direction CompassDirection, widt
 		defer freeDoubleArray(cbackground)
 	}
 	if err := C.vipsgen_gravity_with_options(in, &out, C.VipsCompassDirection(direction), C.gint(width), C.gint(height), C.VipsExtend(extend), cbackground, cbackgroundLength); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("gravity", out)
 	}
 	return out, nil
 }
@@ -2044,7 +2054,7 @@ func vipsgenGravityWithOptions(in *C.VipsImage, direction CompassDirection, widt
 func vipsgenGrey(width int, height int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_grey(&out, C.gint(width), C.gint(height)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("grey", out)
 	}
 	return out, nil
 }
@@ -2053,7 +2063,7 @@ func vipsgenGrey(width int, height int) (*C.VipsImage, error) {
 func vipsgenGreyWithOptions(width int, height int, uchar bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_grey_with_options(&out, C.gint(width), C.gint(height), C.int(boolToInt(uchar))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("grey", out)
 	}
 	return out, nil
 }
@@ -2062,7 +2072,7 @@ func vipsgenGreyWithOptions(width int, height int, uchar bool) (*C.VipsImage, er
 func vipsgenGrid(in *C.VipsImage, tileHeight int, across int, down int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_grid(in, &out, C.gint(tileHeight), C.gint(across), C.gint(down)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("grid", out)
 	}
 	return out, nil
 }
@@ -2073,7 +2083,7 @@ func vipsgenHeifload(filename string) (*C.VipsImage, error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_heifload(cfilename, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("heifload", out)
 	}
 	return out, nil
 }
@@ -2084,7 +2094,7 @@ func vipsgenHeifloadWithOptions(filename string, page int, n int, thumbnail bool
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_heifload_with_options(cfilename, &out, C.gint(page), C.gint(n), C.int(boolToInt(thumbnail)), C.int(boolToInt(unlimited)), C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("heifload", out)
 	}
 	return out, nil
 }
@@ -2096,7 +2106,7 @@ func vipsgenHeifloadBuffer(buf []byte) (*C.VipsImage, error) {
 	defer runtime.KeepAlive(src)
 	var out *C.VipsImage
 	if err := C.vipsgen_heifload_buffer(unsafe.Pointer(&src[0]), C.size_t(len(src)), &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("heifload_buffer", out)
 	}
 	return out, nil
 }
@@ -2108,7 +2118,7 @@ func vipsgenHeifloadBufferWithOptions(buf []byte, page int, n int, thumbnail boo
 	defer runtime.KeepAlive(src)
 	var out *C.VipsImage
 	if err := C.vipsgen_heifload_buffer_with_options(unsafe.Pointer(&src[0]), C.size_t(len(src)), &out, C.gint(page), C.gint(n), C.int(boolToInt(thumbnail)), C.int(boolToInt(unlimited)), C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("heifload_buffer", out)
 	}
 	return out, nil
 }
@@ -2117,7 +2127,7 @@ func vipsgenHeifloadBufferWithOptions(buf []byte, page int, n int, thumbnail boo
 func vipsgenHeifloadSource(source *C.VipsSourceCustom) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_heifload_source(source, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("heifload_source", out)
 	}
 	return out, nil
 }
@@ -2126,23 +2136,29 @@ func vipsgenHeifloadSource(source *C.VipsSourceCustom) (*C.VipsImage, error) {
 func vipsgenHeifloadSourceWithOptions(source *C.VipsSourceCustom, page int, n int, thumbnail bool, unlimited bool, memory bool, access Access, failOn FailOn, revalidate bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_heifload_source_with_options(source, &out, C.gint(page), C.gint(n), C.int(boolToInt(thumbnail)), C.int(boolToInt(unlimited)), C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("heifload_source", out)
 	}
 	return out, nil
 }
 
 // vipsgenHeifsave vips_heifsave save image in HEIF format
 func vipsgenHeifsave(in *C.VipsImage, filename string) (error) {
+	if err := requireOptionalOperation("heifsave"); err != nil {
+		return err
+	}
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_heifsave(in, cfilename); err != 0 {
-		return handleVipsError()
+		return handleVipsError("heifsave")
 	}
 	return nil
 }
 
 // vipsgenHeifsaveWithOptions vips_heifsave save image in HEIF format with optional arguments
 func vipsgenHeifsaveWithOptions(in *C.VipsImage, filename string, q int, bitdepth int, lossless bool, compression HeifCompression, effort int, subsampleMode Subsample, encoder HeifEncoder, tune string, keep Keep, background []float64, pageHeight int, profile string) (error) {
+	if err := requireOptionalOperation("heifsave"); err != nil {
+		return err
+	}
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	cbackground, cbackgroundLength, err := convertToDoubleArray(background)
@@ -2157,23 +2173,29 @@ func vipsgenHeifsaveWithOptions(in *C.VipsImage, filename string, q int, bitdept
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_heifsave_with_options(in, cfilename, C.gint(q), C.gint(bitdepth), C.int(boolToInt(lossless)), C.VipsForeignHeifCompression(compression), C.gint(effort), C.VipsForeignSubsample(subsampleMode), C.VipsForeignHeifEncoder(encoder), ctune, C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return handleVipsError()
+		return handleVipsError("heifsave")
 	}
 	return nil
 }
 
 // vipsgenHeifsaveBuffer vips_heifsave_buffer save image in HEIF format
 func vipsgenHeifsaveBuffer(in *C.VipsImage) ([]byte, error) {
+	if err := requireOptionalOperation("heifsave_buffer"); err != nil {
+		return nil, err
+	}
 	var buf unsafe.Pointer
 	var length C.size_t
 	if err := C.vipsgen_heifsave_buffer(in, &buf, &length); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("heifsave_buffer")
 	}
 	return bufferToBytes(buf, length), nil
 }
 
 // vipsgenHeifsaveBufferWithOptions vips_heifsave_buffer save image in HEIF format with optional arguments
 func vipsgenHeifsaveBufferWithOptions(in *C.VipsImage, q int, bitdepth int, lossless bool, compression HeifCompression, effort int, subsampleMode Subsample, encoder HeifEncoder, tune string, keep Keep, background []float64, pageHeight int, profile string) ([]byte, error) {
+	if err := requireOptionalOperation("heifsave_buffer"); err != nil {
+		return nil, err
+	}
 	var buf unsafe.Pointer
 	var length C.size_t
 	cbackground, cbackgroundLength, err := convertToDoubleArray(background)
@@ -2188,22 +2210,28 @@ func vipsgenHeifsaveBufferWithOptions(in *C.VipsImage, q int, bitdepth int, loss
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_heifsave_buffer_with_options(in, &buf, &length, C.gint(q), C.gint(bitdepth), C.int(boolToInt(lossless)), C.VipsForeignHeifCompression(compression), C.gint(effort), C.VipsForeignSubsample(subsampleMode), C.VipsForeignHeifEncoder(encoder), ctune, C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("heifsave_buffer")
 	}
 	return bufferToBytes(buf, length), nil
 }
 
 // vipsgenHeifsaveTarget vips_heifsave_target save image in HEIF format
 func vipsgenHeifsaveTarget(in *C.VipsImage, target *C.VipsTargetCustom) (error) {
+	if err := requireOptionalOperation("heifsave_target"); err != nil {
+		return err
+	}
 	
 	if err := C.vipsgen_heifsave_target(in, target); err != 0 {
-		return handleVipsError()
+		return handleVipsError("heifsave_target")
 	}
 	return nil
 }
 
 // vipsgenHeifsaveTargetWithOptions vips_heifsave_target save image in HEIF format with optional arguments
 func vipsgenHeifsaveTargetWithOptions(in *C.VipsImage, target *C.VipsTargetCustom, q int, bitdepth int, lossless bool, compression HeifCompression, effort int, subsampleMode Subsample, encoder HeifEncoder, tune string, keep Keep, background []float64, pageHeight int, profile string) (error) {
+	if err := requireOptionalOperation("heifsave_target"); err != nil {
+		return err
+	}
 	cbackground, cbackgroundLength, err := convertToDoubleArray(background)
 	if err != nil {
 		return err
@@ -2216,7 +2244,7 @@ func vipsgenHeifsaveTargetWithOptions(in *C.VipsImage, target *C.VipsTargetCusto
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_heifsave_target_with_options(in, target, C.gint(q), C.gint(bitdepth), C.int(boolToInt(lossless)), C.VipsForeignHeifCompression(compression), C.gint(effort), C.VipsForeignSubsample(subsampleMode), C.VipsForeignHeifEncoder(encoder), ctune, C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return handleVipsError()
+		return handleVipsError("heifsave_target")
 	}
 	return nil
 }
@@ -2225,7 +2253,7 @@ func vipsgenHeifsaveTargetWithOptions(in *C.VipsImage, target *C.VipsTargetCusto
 func vipsgenHistCum(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_hist_cum(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("hist_cum", out)
 	}
 	return out, nil
 }
@@ -2235,7 +2263,7 @@ func vipsgenHistEntropy(in *C.VipsImage) (float64, error) {
 	var out float64
 	cout := new(C.double)
 	if err := C.vipsgen_hist_entropy(in, cout); err != 0 {
-		return 0, handleVipsError()
+		return 0, handleVipsError("hist_entropy")
 	}
 	out = float64(*cout)
 	return out, nil
@@ -2245,7 +2273,7 @@ func vipsgenHistEntropy(in *C.VipsImage) (float64, error) {
 func vipsgenHistEqual(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_hist_equal(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("hist_equal", out)
 	}
 	return out, nil
 }
@@ -2254,7 +2282,7 @@ func vipsgenHistEqual(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenHistEqualWithOptions(in *C.VipsImage, band int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_hist_equal_with_options(in, &out, C.gint(band)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("hist_equal", out)
 	}
 	return out, nil
 }
@@ -2263,7 +2291,7 @@ func vipsgenHistEqualWithOptions(in *C.VipsImage, band int) (*C.VipsImage, error
 func vipsgenHistFind(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_hist_find(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("hist_find", out)
 	}
 	return out, nil
 }
@@ -2272,7 +2300,7 @@ func vipsgenHistFind(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenHistFindWithOptions(in *C.VipsImage, band int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_hist_find_with_options(in, &out, C.gint(band)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("hist_find", out)
 	}
 	return out, nil
 }
@@ -2281,7 +2309,7 @@ func vipsgenHistFindWithOptions(in *C.VipsImage, band int) (*C.VipsImage, error)
 func vipsgenHistFindIndexed(in *C.VipsImage, index *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_hist_find_indexed(in, index, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("hist_find_indexed", out)
 	}
 	return out, nil
 }
@@ -2290,7 +2318,7 @@ func vipsgenHistFindIndexed(in *C.VipsImage, index *C.VipsImage) (*C.VipsImage,
 func vipsgenHistFindIndexedWithOptions(in *C.VipsImage, index *C.VipsImage, combine Combine) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_hist_find_indexed_with_options(in, index, &out, C.VipsCombine(combine)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("hist_find_indexed", out)
 	}
 	return out, nil
 }
@@ -2299,7 +2327,7 @@ func vipsgenHistFindIndexedWithOptions(in *C.VipsImage, index *C.VipsImage, comb
 func vipsgenHistFindNdim(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_hist_find_ndim(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("hist_find_ndim", out)
 	}
 	return out, nil
 }
@@ -2308,7 +2336,7 @@ func vipsgenHistFindNdim(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenHistFindNdimWithOptions(in *C.VipsImage, bins int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_hist_find_ndim_with_options(in, &out, C.gint(bins)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("hist_find_ndim", out)
 	}
 	return out, nil
 }
@@ -2318,7 +2346,7 @@ func vipsgenHistIsmonotonic(in *C.VipsImage) (bool, error) {
 	var monotonic bool
 	cmonotonic := new(C.gboolean)
 	if err := C.vipsgen_hist_ismonotonic(in, cmonotonic); err != 0 {
-		return false, handleVipsError()
+		return false, handleVipsError("hist_ismonotonic")
 	}
 	monotonic = *cmonotonic != 0
 	return monotonic, nil
@@ -2328,7 +2356,7 @@ func vipsgenHistIsmonotonic(in *C.VipsImage) (bool, error) {
 func vipsgenHistLocal(in *C.VipsImage, width int, height int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_hist_local(in, &out, C.gint(width), C.gint(height)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("hist_local", out)
 	}
 	return out, nil
 }
@@ -2337,7 +2365,7 @@ func vipsgenHistLocal(in *C.VipsImage, width int, height int) (*C.VipsImage, err
 func vipsgenHistLocalWithOptions(in *C.VipsImage, width int, height int, maxSlope int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_hist_local_with_options(in, &out, C.gint(width), C.gint(height), C.gint(maxSlope)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("hist_local", out)
 	}
 	return out, nil
 }
@@ -2346,7 +2374,7 @@ func vipsgenHistLocalWithOptions(in *C.VipsImage, width int, height int, maxSlop
 func vipsgenHistMatch(in *C.VipsImage, ref *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_hist_match(in, ref, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("hist_match", out)
 	}
 	return out, nil
 }
@@ -2355,7 +2383,7 @@ func vipsgenHistMatch(in *C.VipsImage, ref *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenHistNorm(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_hist_norm(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("hist_norm", out)
 	}
 	return out, nil
 }
@@ -2364,7 +2392,7 @@ func vipsgenHistNorm(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenHistPlot(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_hist_plot(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("hist_plot", out)
 	}
 	return out, nil
 }
@@ -2373,7 +2401,7 @@ func vipsgenHistPlot(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenHoughCircle(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_hough_circle(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("hough_circle", out)
 	}
 	return out, nil
 }
@@ -2382,7 +2410,7 @@ func vipsgenHoughCircle(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenHoughCircleWithOptions(in *C.VipsImage, scale int, minRadius int, maxRadius int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_hough_circle_with_options(in, &out, C.gint(scale), C.gint(minRadius), C.gint(maxRadius)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("hough_circle", out)
 	}
 	return out, nil
 }
@@ -2391,7 +2419,7 @@ func vipsgenHoughCircleWithOptions(in *C.VipsImage, scale int, minRadius int, ma
 func vipsgenHoughLine(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_hough_line(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("hough_line", out)
 	}
 	return out, nil
 }
@@ -2400,7 +2428,7 @@ func vipsgenHoughLine(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenHoughLineWithOptions(in *C.VipsImage, width int, height int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_hough_line_with_options(in, &out, C.gint(width), C.gint(height)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("hough_line", out)
 	}
 	return out, nil
 }
@@ -2409,7 +2437,7 @@ func vipsgenHoughLineWithOptions(in *C.VipsImage, width int, height int) (*C.Vip
 func vipsgenIccExport(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_icc_export(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("icc_export", out)
 	}
 	return out, nil
 }
@@ -2420,7 +2448,7 @@ func vipsgenIccExportWithOptions(in *C.VipsImage, pcs PCS, intent Intent, blackP
 	coutputProfile := C.CString(outputProfile)
 	defer freeCString(coutputProfile)
 	if err := C.vipsgen_icc_export_with_options(in, &out, C.VipsPCS(pcs), C.VipsIntent(intent), C.int(boolToInt(blackPointCompensation)), coutputProfile, C.gint(depth)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("icc_export", out)
 	}
 	return out, nil
 }
@@ -2429,7 +2457,7 @@ func vipsgenIccExportWithOptions(in *C.VipsImage, pcs PCS, intent Intent, blackP
 func vipsgenIccImport(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_icc_import(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("icc_import", out)
 	}
 	return out, nil
 }
@@ -2440,7 +2468,7 @@ func vipsgenIccImportWithOptions(in *C.VipsImage, pcs PCS, intent Intent, blackP
 	cinputProfile := C.CString(inputProfile)
 	defer freeCString(cinputProfile)
 	if err := C.vipsgen_icc_import_with_options(in, &out, C.VipsPCS(pcs), C.VipsIntent(intent), C.int(boolToInt(blackPointCompensation)), C.int(boolToInt(embedded)), cinputProfile); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("icc_import", out)
 	}
 	return out, nil
 }
@@ -2451,7 +2479,7 @@ func vipsgenIccTransform(in *C.VipsImage, outputProfile string) (*C.VipsImage, e
 	coutputProfile := C.CString(outputProfile)
 	defer freeCString(coutputProfile)
 	if err := C.vipsgen_icc_transform(in, &out, coutputProfile); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("icc_transform", out)
 	}
 	return out, nil
 }
@@ -2464,7 +2492,7 @@ func vipsgenIccTransformWithOptions(in *C.VipsImage, outputProfile string, pcs P
 	cinputProfile := C.CString(inputProfile)
 	defer freeCString(cinputProfile)
 	if err := C.vipsgen_icc_transform_with_options(in, &out, coutputProfile, C.VipsPCS(pcs), C.VipsIntent(intent), C.int(boolToInt(blackPointCompensation)), C.int(boolToInt(embedded)), cinputProfile, C.gint(depth)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("icc_transform", out)
 	}
 	return out, nil
 }
@@ -2473,7 +2501,7 @@ func vipsgenIccTransformWithOptions(in *C.VipsImage, outputProfile string, pcs P
 func vipsgenIdentity() (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_identity(&out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("identity", out)
 	}
 	return out, nil
 }
@@ -2482,7 +2510,7 @@ func vipsgenIdentity() (*C.VipsImage, error) {
 func vipsgenIdentityWithOptions(bands int, ushort bool, size int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_identity_with_options(&out, C.gint(bands), C.int(boolToInt(ushort)), C.gint(size)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("identity", out)
 	}
 	return out, nil
 }
@@ -2491,7 +2519,7 @@ func vipsgenIdentityWithOptions(bands int, ushort bool, size int) (*C.VipsImage,
 func vipsgenIfthenelse(cond *C.VipsImage, in1 *C.VipsImage, in2 *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_ifthenelse(cond, in1, in2, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("ifthenelse", out)
 	}
 	return out, nil
 }
@@ -2500,7 +2528,7 @@ func vipsgenIfthenelse(cond *C.VipsImage, in1 *C.VipsImage, in2 *C.VipsImage) (*
 func vipsgenIfthenelseWithOptions(cond *C.VipsImage, in1 *C.VipsImage, in2 *C.VipsImage, blend bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_ifthenelse_with_options(cond, in1, in2, &out, C.int(boolToInt(blend))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("ifthenelse", out)
 	}
 	return out, nil
 }
@@ -2509,7 +2537,7 @@ func vipsgenIfthenelseWithOptions(cond *C.VipsImage, in1 *C.VipsImage, in2 *C.Vi
 func vipsgenInsert(main *C.VipsImage, sub *C.VipsImage, x int, y int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_insert(main, sub, &out, C.gint(x), C.gint(y)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("insert", out)
 	}
 	return out, nil
 }
@@ -2525,7 +2553,7 @@ func vipsgenInsertWithOptions(main *C.VipsImage, sub *C.VipsImage, x int, y int,
 		defer freeDoubleArray(cbackground)
 	}
 	if err := C.vipsgen_insert_with_options(main, sub, &out, C.gint(x), C.gint(y), C.int(boolToInt(expand)), cbackground, cbackgroundLength); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("insert", out)
 	}
 	return out, nil
 }
@@ -2534,7 +2562,7 @@ func vipsgenInsertWithOptions(main *C.VipsImage, sub *C.VipsImage, x int, y int,
 func vipsgenInvert(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_invert(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("invert", out)
 	}
 	return out, nil
 }
@@ -2543,7 +2571,7 @@ func vipsgenInvert(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenInvertlut(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_invertlut(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("invertlut", out)
 	}
 	return out, nil
 }
@@ -2552,7 +2580,7 @@ func vipsgenInvertlut(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenInvertlutWithOptions(in *C.VipsImage, size int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_invertlut_with_options(in, &out, C.gint(size)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("invertlut", out)
 	}
 	return out, nil
 }
@@ -2561,7 +2589,7 @@ func vipsgenInvertlutWithOptions(in *C.VipsImage, size int) (*C.VipsImage, error
 func vipsgenInvfft(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_invfft(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("invfft", out)
 	}
 	return out, nil
 }
@@ -2570,7 +2598,7 @@ func vipsgenInvfft(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenInvfftWithOptions(in *C.VipsImage, real bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_invfft_with_options(in, &out, C.int(boolToInt(real))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("invfft", out)
 	}
 	return out, nil
 }
@@ -2579,7 +2607,7 @@ func vipsgenInvfftWithOptions(in *C.VipsImage, real bool) (*C.VipsImage, error)
 func vipsgenJoin(in1 *C.VipsImage, in2 *C.VipsImage, direction Direction) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_join(in1, in2, &out, C.VipsDirection(direction)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("join", out)
 	}
 	return out, nil
 }
@@ -2595,7 +2623,7 @@ func vipsgenJoinWithOptions(in1 *C.VipsImage, in2 *C.VipsImage, direction Direct
 		defer freeDoubleArray(cbackground)
 	}
 	if err := C.vipsgen_join_with_options(in1, in2, &out, C.VipsDirection(direction), C.int(boolToInt(expand)), C.gint(shim), cbackground, cbackgroundLength, C.VipsAlign(align)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("join", out)
 	}
 	return out, nil
 }
@@ -2606,7 +2634,7 @@ func vipsgenJp2kload(filename string) (*C.VipsImage, error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_jp2kload(cfilename, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("jp2kload", out)
 	}
 	return out, nil
 }
@@ -2617,7 +2645,7 @@ func vipsgenJp2kloadWithOptions(filename string, page int, oneshot bool, memory
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_jp2kload_with_options(cfilename, &out, C.gint(page), C.int(boolToInt(oneshot)), C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("jp2kload", out)
 	}
 	return out, nil
 }
@@ -2629,7 +2657,7 @@ func vipsgenJp2kloadBuffer(buf []byte) (*C.VipsImage, error) {
 	defer runtime.KeepAlive(src)
 	var out *C.VipsImage
 	if err := C.vipsgen_jp2kload_buffer(unsafe.Pointer(&src[0]), C.size_t(len(src)), &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("jp2kload_buffer", out)
 	}
 	return out, nil
 }
@@ -2641,7 +2669,7 @@ func vipsgenJp2kloadBufferWithOptions(buf []byte, page int, oneshot bool, memory
 	defer runtime.KeepAlive(src)
 	var out *C.VipsImage
 	if err := C.vipsgen_jp2kload_buffer_with_options(unsafe.Pointer(&src[0]), C.size_t(len(src)), &out, C.gint(page), C.int(boolToInt(oneshot)), C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("jp2kload_buffer", out)
 	}
 	return out, nil
 }
@@ -2650,7 +2678,7 @@ func vipsgenJp2kloadBufferWithOptions(buf []byte, page int, oneshot bool, memory
 func vipsgenJp2kloadSource(source *C.VipsSourceCustom) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_jp2kload_source(source, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("jp2kload_source", out)
 	}
 	return out, nil
 }
@@ -2659,7 +2687,7 @@ func vipsgenJp2kloadSource(source *C.VipsSourceCustom) (*C.VipsImage, error) {
 func vipsgenJp2kloadSourceWithOptions(source *C.VipsSourceCustom, page int, oneshot bool, memory bool, access Access, failOn FailOn, revalidate bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_jp2kload_source_with_options(source, &out, C.gint(page), C.int(boolToInt(oneshot)), C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("jp2kload_source", out)
 	}
 	return out, nil
 }
@@ -2669,7 +2697,7 @@ func vipsgenJp2ksave(in *C.VipsImage, filename string) (error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_jp2ksave(in, cfilename); err != 0 {
-		return handleVipsError()
+		return handleVipsError("jp2ksave")
 	}
 	return nil
 }
@@ -2688,7 +2716,7 @@ func vipsgenJp2ksaveWithOptions(in *C.VipsImage, filename string, tileWidth int,
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_jp2ksave_with_options(in, cfilename, C.gint(tileWidth), C.gint(tileHeight), C.int(boolToInt(lossless)), C.gint(q), C.VipsForeignSubsample(subsampleMode), C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return handleVipsError()
+		return handleVipsError("jp2ksave")
 	}
 	return nil
 }
@@ -2698,7 +2726,7 @@ func vipsgenJp2ksaveBuffer(in *C.VipsImage) ([]byte, error) {
 	var buf unsafe.Pointer
 	var length C.size_t
 	if err := C.vipsgen_jp2ksave_buffer(in, &buf, &length); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("jp2ksave_buffer")
 	}
 	return bufferToBytes(buf, length), nil
 }
@@ -2717,7 +2745,7 @@ func vipsgenJp2ksaveBufferWithOptions(in *C.VipsImage, tileWidth int, tileHeight
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_jp2ksave_buffer_with_options(in, &buf, &length, C.gint(tileWidth), C.gint(tileHeight), C.int(boolToInt(lossless)), C.gint(q), C.VipsForeignSubsample(subsampleMode), C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("jp2ksave_buffer")
 	}
 	return bufferToBytes(buf, length), nil
 }
@@ -2726,7 +2754,7 @@ func vipsgenJp2ksaveBufferWithOptions(in *C.VipsImage, tileWidth int, tileHeight
 func vipsgenJp2ksaveTarget(in *C.VipsImage, target *C.VipsTargetCustom) (error) {
 	
 	if err := C.vipsgen_jp2ksave_target(in, target); err != 0 {
-		return handleVipsError()
+		return handleVipsError("jp2ksave_target")
 	}
 	return nil
 }
@@ -2743,7 +2771,7 @@ func vipsgenJp2ksaveTargetWithOptions(in *C.VipsImage, target *C.VipsTargetCusto
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_jp2ksave_target_with_options(in, target, C.gint(tileWidth), C.gint(tileHeight), C.int(boolToInt(lossless)), C.gint(q), C.VipsForeignSubsample(subsampleMode), C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return handleVipsError()
+		return handleVipsError("jp2ksave_target")
 	}
 	return nil
 }
@@ -2754,7 +2782,7 @@ func vipsgenJpegload(filename string) (*C.VipsImage, error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_jpegload(cfilename, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("jpegload", out)
 	}
 	return out, nil
 }
@@ -2765,7 +2793,7 @@ func vipsgenJpegloadWithOptions(filename string, shrink int, autorotate bool, un
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_jpegload_with_options(cfilename, &out, C.gint(shrink), C.int(boolToInt(autorotate)), C.int(boolToInt(unlimited)), C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("jpegload", out)
 	}
 	return out, nil
 }
@@ -2777,7 +2805,7 @@ func vipsgenJpegloadBuffer(buf []byte) (*C.VipsImage, error) {
 	defer runtime.KeepAlive(src)
 	var out *C.VipsImage
 	if err := C.vipsgen_jpegload_buffer(unsafe.Pointer(&src[0]), C.size_t(len(src)), &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("jpegload_buffer", out)
 	}
 	return out, nil
 }
@@ -2789,7 +2817,7 @@ func vipsgenJpegloadBufferWithOptions(buf []byte, shrink int, autorotate bool, u
 	defer runtime.KeepAlive(src)
 	var out *C.VipsImage
 	if err := C.vipsgen_jpegload_buffer_with_options(unsafe.Pointer(&src[0]), C.size_t(len(src)), &out, C.gint(shrink), C.int(boolToInt(autorotate)), C.int(boolToInt(unlimited)), C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("jpegload_buffer", out)
 	}
 	return out, nil
 }
@@ -2798,7 +2826,7 @@ func vipsgenJpegloadBufferWithOptions(buf []byte, shrink int, autorotate bool, u
 func vipsgenJpegloadSource(source *C.VipsSourceCustom) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_jpegload_source(source, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("jpegload_source", out)
 	}
 	return out, nil
 }
@@ -2807,7 +2835,7 @@ func vipsgenJpegloadSource(source *C.VipsSourceCustom) (*C.VipsImage, error) {
 func vipsgenJpegloadSourceWithOptions(source *C.VipsSourceCustom, shrink int, autorotate bool, unlimited bool, memory bool, access Access, failOn FailOn, revalidate bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_jpegload_source_with_options(source, &out, C.gint(shrink), C.int(boolToInt(autorotate)), C.int(boolToInt(unlimited)), C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("jpegload_source", out)
 	}
 	return out, nil
 }
@@ -2817,7 +2845,7 @@ func vipsgenJpegsave(in *C.VipsImage, filename string) (error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_jpegsave(in, cfilename); err != 0 {
-		return handleVipsError()
+		return handleVipsError("jpegsave")
 	}
 	return nil
 }
@@ -2836,7 +2864,7 @@ func vipsgenJpegsaveWithOptions(in *C.VipsImage, filename string, q int, optimiz
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_jpegsave_with_options(in, cfilename, C.gint(q), C.int(boolToInt(optimizeCoding)), C.int(boolToInt(interlace)), C.int(boolToInt(trellisQuant)), C.int(boolToInt(overshootDeringing)), C.int(boolToInt(optimizeScans)), C.gint(quantTable), C.VipsForeignSubsample(subsampleMode), C.gint(restartInterval), C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return handleVipsError()
+		return handleVipsError("jpegsave")
 	}
 	return nil
 }
@@ -2846,7 +2874,7 @@ func vipsgenJpegsaveBuffer(in *C.VipsImage) ([]byte, error) {
 	var buf unsafe.Pointer
 	var length C.size_t
 	if err := C.vipsgen_jpegsave_buffer(in, &buf, &length); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("jpegsave_buffer")
 	}
 	return bufferToBytes(buf, length), nil
 }
@@ -2865,7 +2893,7 @@ func vipsgenJpegsaveBufferWithOptions(in *C.VipsImage, q int, optimizeCoding boo
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_jpegsave_buffer_with_options(in, &buf, &length, C.gint(q), C.int(boolToInt(optimizeCoding)), C.int(boolToInt(interlace)), C.int(boolToInt(trellisQuant)), C.int(boolToInt(overshootDeringing)), C.int(boolToInt(optimizeScans)), C.gint(quantTable), C.VipsForeignSubsample(subsampleMode), C.gint(restartInterval), C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("jpegsave_buffer")
 	}
 	return bufferToBytes(buf, length), nil
 }
@@ -2874,7 +2902,7 @@ func vipsgenJpegsaveBufferWithOptions(in *C.VipsImage, q int, optimizeCoding boo
 func vipsgenJpegsaveTarget(in *C.VipsImage, target *C.VipsTargetCustom) (error) {
 	
 	if err := C.vipsgen_jpegsave_target(in, target); err != 0 {
-		return handleVipsError()
+		return handleVipsError("jpegsave_target")
 	}
 	return nil
 }
@@ -2891,7 +2919,7 @@ func vipsgenJpegsaveTargetWithOptions(in *C.VipsImage, target *C.VipsTargetCusto
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_jpegsave_target_with_options(in, target, C.gint(q), C.int(boolToInt(optimizeCoding)), C.int(boolToInt(interlace)), C.int(boolToInt(trellisQuant)), C.int(boolToInt(overshootDeringing)), C.int(boolToInt(optimizeScans)), C.gint(quantTable), C.VipsForeignSubsample(subsampleMode), C.gint(restartInterval), C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return handleVipsError()
+		return handleVipsError("jpegsave_target")
 	}
 	return nil
 }
@@ -2902,7 +2930,7 @@ func vipsgenJxlload(filename string) (*C.VipsImage, error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_jxlload(cfilename, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("jxlload", out)
 	}
 	return out, nil
 }
@@ -2913,7 +2941,7 @@ func vipsgenJxlloadWithOptions(filename string, page int, n int, memory bool, ac
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_jxlload_with_options(cfilename, &out, C.gint(page), C.gint(n), C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("jxlload", out)
 	}
 	return out, nil
 }
@@ -2925,7 +2953,7 @@ func vipsgenJxlloadBuffer(buf []byte) (*C.VipsImage, error) {
 	defer runtime.KeepAlive(src)
 	var out *C.VipsImage
 	if err := C.vipsgen_jxlload_buffer(unsafe.Pointer(&src[0]), C.size_t(len(src)), &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("jxlload_buffer", out)
 	}
 	return out, nil
 }
@@ -2937,7 +2965,7 @@ func vipsgenJxlloadBufferWithOptions(buf []byte, page int, n int, memory bool, a
 	defer runtime.KeepAlive(src)
 	var out *C.VipsImage
 	if err := C.vipsgen_jxlload_buffer_with_options(unsafe.Pointer(&src[0]), C.size_t(len(src)), &out, C.gint(page), C.gint(n), C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("jxlload_buffer", out)
 	}
 	return out, nil
 }
@@ -2946,7 +2974,7 @@ func vipsgenJxlloadBufferWithOptions(buf []byte, page int, n int, memory bool, a
 func vipsgenJxlloadSource(source *C.VipsSourceCustom) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_jxlload_source(source, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("jxlload_source", out)
 	}
 	return out, nil
 }
@@ -2955,23 +2983,29 @@ func vipsgenJxlloadSource(source *C.VipsSourceCustom) (*C.VipsImage, error) {
 func vipsgenJxlloadSourceWithOptions(source *C.VipsSourceCustom, page int, n int, memory bool, access Access, failOn FailOn, revalidate bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_jxlload_source_with_options(source, &out, C.gint(page), C.gint(n), C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("jxlload_source", out)
 	}
 	return out, nil
 }
 
 // vipsgenJxlsave vips_jxlsave save image in JPEG-XL format
 func vipsgenJxlsave(in *C.VipsImage, filename string) (error) {
+	if err := requireOptionalOperation("jxlsave"); err != nil {
+		return err
+	}
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_jxlsave(in, cfilename); err != 0 {
-		return handleVipsError()
+		return handleVipsError("jxlsave")
 	}
 	return nil
 }
 
 // vipsgenJxlsaveWithOptions vips_jxlsave save image in JPEG-XL format with optional arguments
 func vipsgenJxlsaveWithOptions(in *C.VipsImage, filename string, tier int, distance float64, effort int, lossless bool, q int, bitdepth int, keep Keep, background []float64, pageHeight int, profile string) (error) {
+	if err := requireOptionalOperation("jxlsave"); err != nil {
+		return err
+	}
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	cbackground, cbackgroundLength, err := convertToDoubleArray(background)
@@ -2984,23 +3018,29 @@ func vipsgenJxlsaveWithOptions(in *C.VipsImage, filename string, tier int, dista
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_jxlsave_with_options(in, cfilename, C.gint(tier), C.double(distance), C.gint(effort), C.int(boolToInt(lossless)), C.gint(q), C.gint(bitdepth), C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return handleVipsError()
+		return handleVipsError("jxlsave")
 	}
 	return nil
 }
 
 // vipsgenJxlsaveBuffer vips_jxlsave_buffer save image in JPEG-XL format
 func vipsgenJxlsaveBuffer(in *C.VipsImage) ([]byte, error) {
+	if err := requireOptionalOperation("jxlsave_buffer"); err != nil {
+		return nil, err
+	}
 	var buf unsafe.Pointer
 	var length C.size_t
 	if err := C.vipsgen_jxlsave_buffer(in, &buf, &length); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("jxlsave_buffer")
 	}
 	return bufferToBytes(buf, length), nil
 }
 
 // vipsgenJxlsaveBufferWithOptions vips_jxlsave_buffer save image in JPEG-XL format with optional arguments
 func vipsgenJxlsaveBufferWithOptions(in *C.VipsImage, tier int, distance float64, effort int, lossless bool, q int, bitdepth int, keep Keep, background []float64, pageHeight int, profile string) ([]byte, error) {
+	if err := requireOptionalOperation("jxlsave_buffer"); err != nil {
+		return nil, err
+	}
 	var buf unsafe.Pointer
 	var length C.size_t
 	cbackground, cbackgroundLength, err := convertToDoubleArray(background)
@@ -3013,22 +3053,28 @@ func vipsgenJxlsaveBufferWithOptions(in *C.VipsImage, tier int, distance float64
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_jxlsave_buffer_with_options(in, &buf, &length, C.gint(tier), C.double(distance), C.gint(effort), C.int(boolToInt(lossless)), C.gint(q), C.gint(bitdepth), C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("jxlsave_buffer")
 	}
 	return bufferToBytes(buf, length), nil
 }
 
 // vipsgenJxlsaveTarget vips_jxlsave_target save image in JPEG-XL format
 func vipsgenJxlsaveTarget(in *C.VipsImage, target *C.VipsTargetCustom) (error) {
+	if err := requireOptionalOperation("jxlsave_target"); err != nil {
+		return err
+	}
 	
 	if err := C.vipsgen_jxlsave_target(in, target); err != 0 {
-		return handleVipsError()
+		return handleVipsError("jxlsave_target")
 	}
 	return nil
 }
 
 // vipsgenJxlsaveTargetWithOptions vips_jxlsave_target save image in JPEG-XL format with optional arguments
 func vipsgenJxlsaveTargetWithOptions(in *C.VipsImage, target *C.VipsTargetCustom, tier int, distance float64, effort int, lossless bool, q int, bitdepth int, keep Keep, background []float64, pageHeight int, profile string) (error) {
+	if err := requireOptionalOperation("jxlsave_target"); err != nil {
+		return err
+	}
 	cbackground, cbackgroundLength, err := convertToDoubleArray(background)
 	if err != nil {
 		return err
@@ -3039,7 +3085,7 @@ func vipsgenJxlsaveTargetWithOptions(in *C.VipsImage, target *C.VipsTargetCustom
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_jxlsave_target_with_options(in, target, C.gint(tier), C.double(distance), C.gint(effort), C.int(boolToInt(lossless)), C.gint(q), C.gint(bitdepth), C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return handleVipsError()
+		return handleVipsError("jxlsave_target")
 	}
 	return nil
 }
@@ -3048,7 +3094,7 @@ func vipsgenJxlsaveTargetWithOptions(in *C.VipsImage, target *C.VipsTargetCustom
 func vipsgenLabelregions(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_labelregions(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("labelregions", out)
 	}
 	return out, nil
 }
@@ -3062,7 +3108,7 @@ func vipsgenLabelregionsWithOptions(in *C.VipsImage, segments *int) (*C.VipsImag
 		csegments = &csegmentsValue
 	}
 	if err := C.vipsgen_labelregions_with_options(in, &out, csegments); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("labelregions", out)
 	}
 	if segments != nil {
 		*segments = int(csegmentsValue)
@@ -3094,7 +3140,7 @@ func vipsgenLinear(in *C.VipsImage, a []float64, b []float64) (*C.VipsImage, err
 		defer freeDoubleArray(cb)
 	}
 	if err := C.vipsgen_linear(in, &out, ca, cb, C.int(len(a))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("linear", out)
 	}
 	return out, nil
 }
@@ -3123,7 +3169,7 @@ func vipsgenLinearWithOptions(in *C.VipsImage, a []float64, b []float64, uchar b
 		defer freeDoubleArray(cb)
 	}
 	if err := C.vipsgen_linear_with_options(in, &out, ca, cb, C.int(len(a)), C.int(boolToInt(uchar))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("linear", out)
 	}
 	return out, nil
 }
@@ -3132,7 +3178,7 @@ func vipsgenLinearWithOptions(in *C.VipsImage, a []float64, b []float64, uchar b
 func vipsgenLinecache(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_linecache(in, &out); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("linecache")
 	}
 	return out, nil
 }
@@ -3141,7 +3187,7 @@ func vipsgenLinecache(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenLinecacheWithOptions(in *C.VipsImage, tileHeight int, access Access, threaded bool, persistent bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_linecache_with_options(in, &out, C.gint(tileHeight), C.VipsAccess(access), C.int(boolToInt(threaded)), C.int(boolToInt(persistent))); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("linecache")
 	}
 	return out, nil
 }
@@ -3150,7 +3196,7 @@ func vipsgenLinecacheWithOptions(in *C.VipsImage, tileHeight int, access Access,
 func vipsgenLogmat(sigma float64, minAmpl float64) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_logmat(&out, C.double(sigma), C.double(minAmpl)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("logmat", out)
 	}
 	return out, nil
 }
@@ -3159,7 +3205,7 @@ func vipsgenLogmat(sigma float64, minAmpl float64) (*C.VipsImage, error) {
 func vipsgenLogmatWithOptions(sigma float64, minAmpl float64, separable bool, precision Precision) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_logmat_with_options(&out, C.double(sigma), C.double(minAmpl), C.int(boolToInt(separable)), C.VipsPrecision(precision)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("logmat", out)
 	}
 	return out, nil
 }
@@ -3170,7 +3216,7 @@ func vipsgenMagickload(filename string) (*C.VipsImage, error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_magickload(cfilename, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("magickload", out)
 	}
 	return out, nil
 }
@@ -3183,7 +3229,7 @@ func vipsgenMagickloadWithOptions(filename string, density string, page int, n i
 	cdensity := C.CString(density)
 	defer freeCString(cdensity)
 	if err := C.vipsgen_magickload_with_options(cfilename, &out, cdensity, C.gint(page), C.gint(n), C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("magickload", out)
 	}
 	return out, nil
 }
@@ -3195,7 +3241,7 @@ func vipsgenMagickloadBuffer(buf []byte) (*C.VipsImage, error) {
 	defer runtime.KeepAlive(src)
 	var out *C.VipsImage
 	if err := C.vipsgen_magickload_buffer(unsafe.Pointer(&src[0]), C.size_t(len(src)), &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("magickload_buffer", out)
 	}
 	return out, nil
 }
@@ -3209,7 +3255,7 @@ func vipsgenMagickloadBufferWithOptions(buf []byte, density string, page int, n
 	cdensity := C.CString(density)
 	defer freeCString(cdensity)
 	if err := C.vipsgen_magickload_buffer_with_options(unsafe.Pointer(&src[0]), C.size_t(len(src)), &out, cdensity, C.gint(page), C.gint(n), C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("magickload_buffer", out)
 	}
 	return out, nil
 }
@@ -3218,7 +3264,7 @@ func vipsgenMagickloadBufferWithOptions(buf []byte, density string, page int, n
 func vipsgenMagickloadSource(source *C.VipsSourceCustom) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_magickload_source(source, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("magickload_source", out)
 	}
 	return out, nil
 }
@@ -3229,7 +3275,7 @@ func vipsgenMagickloadSourceWithOptions(source *C.VipsSourceCustom, density stri
 	cdensity := C.CString(density)
 	defer freeCString(cdensity)
 	if err := C.vipsgen_magickload_source_with_options(source, &out, cdensity, C.gint(page), C.gint(n), C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("magickload_source", out)
 	}
 	return out, nil
 }
@@ -3239,7 +3285,7 @@ func vipsgenMagicksave(in *C.VipsImage, filename string) (error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_magicksave(in, cfilename); err != 0 {
-		return handleVipsError()
+		return handleVipsError("magicksave")
 	}
 	return nil
 }
@@ -3260,7 +3306,7 @@ func vipsgenMagicksaveWithOptions(in *C.VipsImage, filename string, format strin
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_magicksave_with_options(in, cfilename, cformat, C.gint(quality), C.int(boolToInt(optimizeGifFrames)), C.int(boolToInt(optimizeGifTransparency)), C.gint(bitdepth), C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return handleVipsError()
+		return handleVipsError("magicksave")
 	}
 	return nil
 }
@@ -3270,7 +3316,7 @@ func vipsgenMagicksaveBuffer(in *C.VipsImage) ([]byte, error) {
 	var buf unsafe.Pointer
 	var length C.size_t
 	if err := C.vipsgen_magicksave_buffer(in, &buf, &length); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("magicksave_buffer")
 	}
 	return bufferToBytes(buf, length), nil
 }
@@ -3291,7 +3337,7 @@ func vipsgenMagicksaveBufferWithOptions(in *C.VipsImage, format string, quality
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_magicksave_buffer_with_options(in, &buf, &length, cformat, C.gint(quality), C.int(boolToInt(optimizeGifFrames)), C.int(boolToInt(optimizeGifTransparency)), C.gint(bitdepth), C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("magicksave_buffer")
 	}
 	return bufferToBytes(buf, length), nil
 }
@@ -3300,7 +3346,7 @@ func vipsgenMagicksaveBufferWithOptions(in *C.VipsImage, format string, quality
 func vipsgenMapim(in *C.VipsImage, index *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_mapim(in, &out, index); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("mapim", out)
 	}
 	return out, nil
 }
@@ -3316,7 +3362,7 @@ func vipsgenMapimWithOptions(in *C.VipsImage, index *C.VipsImage, interpolate *I
 		defer freeDoubleArray(cbackground)
 	}
 	if err := C.vipsgen_mapim_with_options(in, &out, index, vipsInterpolateToC(interpolate), cbackground, cbackgroundLength, C.int(boolToInt(premultiplied)), C.VipsExtend(extend)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("mapim", out)
 	}
 	return out, nil
 }
@@ -3325,7 +3371,7 @@ func vipsgenMapimWithOptions(in *C.VipsImage, index *C.VipsImage, interpolate *I
 func vipsgenMaplut(in *C.VipsImage, lut *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_maplut(in, &out, lut); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("maplut", out)
 	}
 	return out, nil
 }
@@ -3334,7 +3380,7 @@ func vipsgenMaplut(in *C.VipsImage, lut *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenMaplutWithOptions(in *C.VipsImage, lut *C.VipsImage, band int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_maplut_with_options(in, &out, lut, C.gint(band)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("maplut", out)
 	}
 	return out, nil
 }
@@ -3343,7 +3389,7 @@ func vipsgenMaplutWithOptions(in *C.VipsImage, lut *C.VipsImage, band int) (*C.V
 func vipsgenMaskButterworth(width int, height int, order float64, frequencyCutoff float64, amplitudeCutoff float64) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_mask_butterworth(&out, C.gint(width), C.gint(height), C.double(order), C.double(frequencyCutoff), C.double(amplitudeCutoff)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("mask_butterworth", out)
 	}
 	return out, nil
 }
@@ -3352,7 +3398,7 @@ func vipsgenMaskButterworth(width int, height int, order float64, frequencyCutof
 func vipsgenMaskButterworthWithOptions(width int, height int, order float64, frequencyCutoff float64, amplitudeCutoff float64, uchar bool, nodc bool, reject bool, optical bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_mask_butterworth_with_options(&out, C.gint(width), C.gint(height), C.double(order), C.double(frequencyCutoff), C.double(amplitudeCutoff), C.int(boolToInt(uchar)), C.int(boolToInt(nodc)), C.int(boolToInt(reject)), C.int(boolToInt(optical))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("mask_butterworth", out)
 	}
 	return out, nil
 }
@@ -3361,7 +3407,7 @@ func vipsgenMaskButterworthWithOptions(width int, height int, order float64, fre
 func vipsgenMaskButterworthBand(width int, height int, order float64, frequencyCutoffX float64, frequencyCutoffY float64, radius float64, amplitudeCutoff float64) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_mask_butterworth_band(&out, C.gint(width), C.gint(height), C.double(order), C.double(frequencyCutoffX), C.double(frequencyCutoffY), C.double(radius), C.double(amplitudeCutoff)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("mask_butterworth_band", out)
 	}
 	return out, nil
 }
@@ -3370,7 +3416,7 @@ func vipsgenMaskButterworthBand(width int, height int, order float64, frequencyC
 func vipsgenMaskButterworthBandWithOptions(width int, height int, order float64, frequencyCutoffX float64, frequencyCutoffY float64, radius float64, amplitudeCutoff float64, uchar bool, nodc bool, reject bool, optical bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_mask_butterworth_band_with_options(&out, C.gint(width), C.gint(height), C.double(order), C.double(frequencyCutoffX), C.double(frequencyCutoffY), C.double(radius), C.double(amplitudeCutoff), C.int(boolToInt(uchar)), C.int(boolToInt(nodc)), C.int(boolToInt(reject)), C.int(boolToInt(optical))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("mask_butterworth_band", out)
 	}
 	return out, nil
 }
@@ -3379,7 +3425,7 @@ func vipsgenMaskButterworthBandWithOptions(width int, height int, order float64,
 func vipsgenMaskButterworthRing(width int, height int, order float64, frequencyCutoff float64, amplitudeCutoff float64, ringwidth float64) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_mask_butterworth_ring(&out, C.gint(width), C.gint(height), C.double(order), C.double(frequencyCutoff), C.double(amplitudeCutoff), C.double(ringwidth)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("mask_butterworth_ring", out)
 	}
 	return out, nil
 }
@@ -3388,7 +3434,7 @@ func vipsgenMaskButterworthRing(width int, height int, order float64, frequencyC
 func vipsgenMaskButterworthRingWithOptions(width int, height int, order float64, frequencyCutoff float64, amplitudeCutoff float64, ringwidth float64, uchar bool, nodc bool, reject bool, optical bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_mask_butterworth_ring_with_options(&out, C.gint(width), C.gint(height), C.double(order), C.double(frequencyCutoff), C.double(amplitudeCutoff), C.double(ringwidth), C.int(boolToInt(uchar)), C.int(boolToInt(nodc)), C.int(boolToInt(reject)), C.int(boolToInt(optical))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("mask_butterworth_ring", out)
 	}
 	return out, nil
 }
@@ -3397,7 +3443,7 @@ func vipsgenMaskButterworthRingWithOptions(width int, height int, order float64,
 func vipsgenMaskFractal(width int, height int, fractalDimension float64) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_mask_fractal(&out, C.gint(width), C.gint(height), C.double(fractalDimension)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("mask_fractal", out)
 	}
 	return out, nil
 }
@@ -3406,7 +3452,7 @@ func vipsgenMaskFractal(width int, height int, fractalDimension float64) (*C.Vip
 func vipsgenMaskFractalWithOptions(width int, height int, fractalDimension float64, uchar bool, nodc bool, reject bool, optical bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_mask_fractal_with_options(&out, C.gint(width), C.gint(height), C.double(fractalDimension), C.int(boolToInt(uchar)), C.int(boolToInt(nodc)), C.int(boolToInt(reject)), C.int(boolToInt(optical))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("mask_fractal", out)
 	}
 	return out, nil
 }
@@ -3415,7 +3461,7 @@ func vipsgenMaskFractalWithOptions(width int, height int, fractalDimension float
 func vipsgenMaskGaussian(width int, height int, frequencyCutoff float64, amplitudeCutoff float64) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_mask_gaussian(&out, C.gint(width), C.gint(height), C.double(frequencyCutoff), C.double(amplitudeCutoff)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("mask_gaussian", out)
 	}
 	return out, nil
 }
@@ -3424,7 +3470,7 @@ func vipsgenMaskGaussian(width int, height int, frequencyCutoff float64, amplitu
 func vipsgenMaskGaussianWithOptions(width int, height int, frequencyCutoff float64, amplitudeCutoff float64, uchar bool, nodc bool, reject bool, optical bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_mask_gaussian_with_options(&out, C.gint(width), C.gint(height), C.double(frequencyCutoff), C.double(amplitudeCutoff), C.int(boolToInt(uchar)), C.int(boolToInt(nodc)), C.int(boolToInt(reject)), C.int(boolToInt(optical))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("mask_gaussian", out)
 	}
 	return out, nil
 }
@@ -3433,7 +3479,7 @@ func vipsgenMaskGaussianWithOptions(width int, height int, frequencyCutoff float
 func vipsgenMaskGaussianBand(width int, height int, frequencyCutoffX float64, frequencyCutoffY float64, radius float64, amplitudeCutoff float64) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_mask_gaussian_band(&out, C.gint(width), C.gint(height), C.double(frequencyCutoffX), C.double(frequencyCutoffY), C.double(radius), C.double(amplitudeCutoff)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("mask_gaussian_band", out)
 	}
 	return out, nil
 }
@@ -3442,7 +3488,7 @@ func vipsgenMaskGaussianBand(width int, height int, frequencyCutoffX float64, fr
 func vipsgenMaskGaussianBandWithOptions(width int, height int, frequencyCutoffX float64, frequencyCutoffY float64, radius float64, amplitudeCutoff float64, uchar bool, nodc bool, reject bool, optical bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_mask_gaussian_band_with_options(&out, C.gint(width), C.gint(height), C.double(frequencyCutoffX), C.double(frequencyCutoffY), C.double(radius), C.double(amplitudeCutoff), C.int(boolToInt(uchar)), C.int(boolToInt(nodc)), C.int(boolToInt(reject)), C.int(boolToInt(optical))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("mask_gaussian_band", out)
 	}
 	return out, nil
 }
@@ -3451,7 +3497,7 @@ func vipsgenMaskGaussianBandWithOptions(width int, height int, frequencyCutoffX
 func vipsgenMaskGaussianRing(width int, height int, frequencyCutoff float64, amplitudeCutoff float64, ringwidth float64) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_mask_gaussian_ring(&out, C.gint(width), C.gint(height), C.double(frequencyCutoff), C.double(amplitudeCutoff), C.double(ringwidth)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("mask_gaussian_ring", out)
 	}
 	return out, nil
 }
@@ -3460,7 +3506,7 @@ func vipsgenMaskGaussianRing(width int, height int, frequencyCutoff float64, amp
 func vipsgenMaskGaussianRingWithOptions(width int, height int, frequencyCutoff float64, amplitudeCutoff float64, ringwidth float64, uchar bool, nodc bool, reject bool, optical bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_mask_gaussian_ring_with_options(&out, C.gint(width), C.gint(height), C.double(frequencyCutoff), C.double(amplitudeCutoff), C.double(ringwidth), C.int(boolToInt(uchar)), C.int(boolToInt(nodc)), C.int(boolToInt(reject)), C.int(boolToInt(optical))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("mask_gaussian_ring", out)
 	}
 	return out, nil
 }
@@ -3469,7 +3515,7 @@ func vipsgenMaskGaussianRingWithOptions(width int, height int, frequencyCutoff f
 func vipsgenMaskIdeal(width int, height int, frequencyCutoff float64) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_mask_ideal(&out, C.gint(width), C.gint(height), C.double(frequencyCutoff)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("mask_ideal", out)
 	}
 	return out, nil
 }
@@ -3478,7 +3524,7 @@ func vipsgenMaskIdeal(width int, height int, frequencyCutoff float64) (*C.VipsIm
 func vipsgenMaskIdealWithOptions(width int, height int, frequencyCutoff float64, uchar bool, nodc bool, reject bool, optical bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_mask_ideal_with_options(&out, C.gint(width), C.gint(height), C.double(frequencyCutoff), C.int(boolToInt(uchar)), C.int(boolToInt(nodc)), C.int(boolToInt(reject)), C.int(boolToInt(optical))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("mask_ideal", out)
 	}
 	return out, nil
 }
@@ -3487,7 +3533,7 @@ func vipsgenMaskIdealWithOptions(width int, height int, frequencyCutoff float64,
 func vipsgenMaskIdealBand(width int, height int, frequencyCutoffX float64, frequencyCutoffY float64, radius float64) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_mask_ideal_band(&out, C.gint(width), C.gint(height), C.double(frequencyCutoffX), C.double(frequencyCutoffY), C.double(radius)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("mask_ideal_band", out)
 	}
 	return out, nil
 }
@@ -3496,7 +3542,7 @@ func vipsgenMaskIdealBand(width int, height int, frequencyCutoffX float64, frequ
 func vipsgenMaskIdealBandWithOptions(width int, height int, frequencyCutoffX float64, frequencyCutoffY float64, radius float64, uchar bool, nodc bool, reject bool, optical bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_mask_ideal_band_with_options(&out, C.gint(width), C.gint(height), C.double(frequencyCutoffX), C.double(frequencyCutoffY), C.double(radius), C.int(boolToInt(uchar)), C.int(boolToInt(nodc)), C.int(boolToInt(reject)), C.int(boolToInt(optical))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("mask_ideal_band", out)
 	}
 	return out, nil
 }
@@ -3505,7 +3551,7 @@ func vipsgenMaskIdealBandWithOptions(width int, height int, frequencyCutoffX flo
 func vipsgenMaskIdealRing(width int, height int, frequencyCutoff float64, ringwidth float64) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_mask_ideal_ring(&out, C.gint(width), C.gint(height), C.double(frequencyCutoff), C.double(ringwidth)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("mask_ideal_ring", out)
 	}
 	return out, nil
 }
@@ -3514,7 +3560,7 @@ func vipsgenMaskIdealRing(width int, height int, frequencyCutoff float64, ringwi
 func vipsgenMaskIdealRingWithOptions(width int, height int, frequencyCutoff float64, ringwidth float64, uchar bool, nodc bool, reject bool, optical bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_mask_ideal_ring_with_options(&out, C.gint(width), C.gint(height), C.double(frequencyCutoff), C.double(ringwidth), C.int(boolToInt(uchar)), C.int(boolToInt(nodc)), C.int(boolToInt(reject)), C.int(boolToInt(optical))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("mask_ideal_ring", out)
 	}
 	return out, nil
 }
@@ -3523,7 +3569,7 @@ func vipsgenMaskIdealRingWithOptions(width int, height int, frequencyCutoff floa
 func vipsgenMatch(ref *C.VipsImage, sec *C.VipsImage, xr1 int, yr1 int, xs1 int, ys1 int, xr2 int, yr2 int, xs2 int, ys2 int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_match(ref, sec, &out, C.gint(xr1), C.gint(yr1), C.gint(xs1), C.gint(ys1), C.gint(xr2), C.gint(yr2), C.gint(xs2), C.gint(ys2)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("match", out)
 	}
 	return out, nil
 }
@@ -3532,7 +3578,7 @@ func vipsgenMatch(ref *C.VipsImage, sec *C.VipsImage, xr1 int, yr1 int, xs1 int,
 func vipsgenMatchWithOptions(ref *C.VipsImage, sec *C.VipsImage, xr1 int, yr1 int, xs1 int, ys1 int, xr2 int, yr2 int, xs2 int, ys2 int, hwindow int, harea int, search bool, interpolate *Interpolate) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_match_with_options(ref, sec, &out, C.gint(xr1), C.gint(yr1), C.gint(xs1), C.gint(ys1), C.gint(xr2), C.gint(yr2), C.gint(xs2), C.gint(ys2), C.gint(hwindow), C.gint(harea), C.int(boolToInt(search)), vipsInterpolateToC(interpolate)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("match", out)
 	}
 	return out, nil
 }
@@ -3541,7 +3587,7 @@ func vipsgenMatchWithOptions(ref *C.VipsImage, sec *C.VipsImage, xr1 int, yr1 in
 func vipsgenMath(in *C.VipsImage, math OperationMath) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_math(in, &out, C.VipsOperationMath(math)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("math", out)
 	}
 	return out, nil
 }
@@ -3550,7 +3596,7 @@ func vipsgenMath(in *C.VipsImage, math OperationMath) (*C.VipsImage, error) {
 func vipsgenMath2(left *C.VipsImage, right *C.VipsImage, math2 OperationMath2) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_math2(left, right, &out, C.VipsOperationMath2(math2)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("math2", out)
 	}
 	return out, nil
 }
@@ -3569,7 +3615,7 @@ func vipsgenMath2Const(in *C.VipsImage, math2 OperationMath2, c []float64) (*C.V
 		defer freeDoubleArray(cc)
 	}
 	if err := C.vipsgen_math2_const(in, &out, C.VipsOperationMath2(math2), cc, C.int(len(c))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("math2_const", out)
 	}
 	return out, nil
 }
@@ -3580,7 +3626,7 @@ func vipsgenMatload(filename string) (*C.VipsImage, error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_matload(cfilename, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("matload", out)
 	}
 	return out, nil
 }
@@ -3591,7 +3637,7 @@ func vipsgenMatloadWithOptions(filename string, memory bool, access Access, fail
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_matload_with_options(cfilename, &out, C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("matload", out)
 	}
 	return out, nil
 }
@@ -3600,7 +3646,7 @@ func vipsgenMatloadWithOptions(filename string, memory bool, access Access, fail
 func vipsgenMatrixinvert(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_matrixinvert(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("matrixinvert", out)
 	}
 	return out, nil
 }
@@ -3611,7 +3657,7 @@ func vipsgenMatrixload(filename string) (*C.VipsImage, error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_matrixload(cfilename, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("matrixload", out)
 	}
 	return out, nil
 }
@@ -3622,7 +3668,7 @@ func vipsgenMatrixloadWithOptions(filename string, memory bool, access Access, f
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_matrixload_with_options(cfilename, &out, C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("matrixload", out)
 	}
 	return out, nil
 }
@@ -3631,7 +3677,7 @@ func vipsgenMatrixloadWithOptions(filename string, memory bool, access Access, f
 func vipsgenMatrixloadSource(source *C.VipsSourceCustom) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_matrixload_source(source, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("matrixload_source", out)
 	}
 	return out, nil
 }
@@ -3640,7 +3686,7 @@ func vipsgenMatrixloadSource(source *C.VipsSourceCustom) (*C.VipsImage, error) {
 func vipsgenMatrixloadSourceWithOptions(source *C.VipsSourceCustom, memory bool, access Access, failOn FailOn, revalidate bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_matrixload_source_with_options(source, &out, C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("matrixload_source", out)
 	}
 	return out, nil
 }
@@ -3649,7 +3695,7 @@ func vipsgenMatrixloadSourceWithOptions(source *C.VipsSourceCustom, memory bool,
 func vipsgenMatrixmultiply(left *C.VipsImage, right *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_matrixmultiply(left, right, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("matrixmultiply", out)
 	}
 	return out, nil
 }
@@ -3658,7 +3704,7 @@ func vipsgenMatrixmultiply(left *C.VipsImage, right *C.VipsImage) (*C.VipsImage,
 func vipsgenMatrixprint(in *C.VipsImage) (error) {
 	
 	if err := C.vipsgen_matrixprint(in); err != 0 {
-		return handleVipsError()
+		return handleVipsError("matrixprint")
 	}
 	return nil
 }
@@ -3675,7 +3721,7 @@ func vipsgenMatrixprintWithOptions(in *C.VipsImage, keep Keep, background []floa
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_matrixprint_with_options(in, C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return handleVipsError()
+		return handleVipsError("matrixprint")
 	}
 	return nil
 }
@@ -3685,7 +3731,7 @@ func vipsgenMatrixsave(in *C.VipsImage, filename string) (error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_matrixsave(in, cfilename); err != 0 {
-		return handleVipsError()
+		return handleVipsError("matrixsave")
 	}
 	return nil
 }
@@ -3704,7 +3750,7 @@ func vipsgenMatrixsaveWithOptions(in *C.VipsImage, filename string, keep Keep, b
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_matrixsave_with_options(in, cfilename, C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return handleVipsError()
+		return handleVipsError("matrixsave")
 	}
 	return nil
 }
@@ -3713,7 +3759,7 @@ func vipsgenMatrixsaveWithOptions(in *C.VipsImage, filename string, keep Keep, b
 func vipsgenMatrixsaveTarget(in *C.VipsImage, target *C.VipsTargetCustom) (error) {
 	
 	if err := C.vipsgen_matrixsave_target(in, target); err != 0 {
-		return handleVipsError()
+		return handleVipsError("matrixsave_target")
 	}
 	return nil
 }
@@ -3730,7 +3776,7 @@ func vipsgenMatrixsaveTargetWithOptions(in *C.VipsImage, target *C.VipsTargetCus
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_matrixsave_target_with_options(in, target, C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return handleVipsError()
+		return handleVipsError("matrixsave_target")
 	}
 	return nil
 }
@@ -3740,7 +3786,7 @@ func vipsgenMax(in *C.VipsImage) (float64, error) {
 	var out float64
 	cout := new(C.double)
 	if err := C.vipsgen_max(in, cout); err != 0 {
-		return 0, handleVipsError()
+		return 0, handleVipsError("max")
 	}
 	out = float64(*cout)
 	return out, nil
@@ -3761,7 +3807,7 @@ func vipsgenMaxWithOptions(in *C.VipsImage, size int, x *int, y *int) (float64,
 		cy = &cyValue
 	}
 	if err := C.vipsgen_max_with_options(in, cout, C.gint(size), cx, cy); err != 0 {
-		return 0, handleVipsError()
+		return 0, handleVipsError("max")
 	}
 	out = float64(*cout)
 	if x != nil {
@@ -3777,7 +3823,7 @@ func vipsgenMaxWithOptions(in *C.VipsImage, size int, x *int, y *int) (float64,
 func vipsgenMaxpair(left *C.VipsImage, right *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_maxpair(left, right, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("maxpair", out)
 	}
 	return out, nil
 }
@@ -3786,7 +3832,7 @@ func vipsgenMaxpair(left *C.VipsImage, right *C.VipsImage) (*C.VipsImage, error)
 func vipsgenMeasure(in *C.VipsImage, h int, v int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_measure(in, &out, C.gint(h), C.gint(v)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("measure", out)
 	}
 	return out, nil
 }
@@ -3795,7 +3841,7 @@ func vipsgenMeasure(in *C.VipsImage, h int, v int) (*C.VipsImage, error) {
 func vipsgenMeasureWithOptions(in *C.VipsImage, h int, v int, left int, top int, width int, height int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_measure_with_options(in, &out, C.gint(h), C.gint(v), C.gint(left), C.gint(top), C.gint(width), C.gint(height)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("measure", out)
 	}
 	return out, nil
 }
@@ -3804,7 +3850,7 @@ func vipsgenMeasureWithOptions(in *C.VipsImage, h int, v int, left int, top int,
 func vipsgenMerge(ref *C.VipsImage, sec *C.VipsImage, direction Direction, dx int, dy int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_merge(ref, sec, &out, C.VipsDirection(direction), C.gint(dx), C.gint(dy)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("merge", out)
 	}
 	return out, nil
 }
@@ -3813,7 +3859,7 @@ func vipsgenMerge(ref *C.VipsImage, sec *C.VipsImage, direction Direction, dx in
 func vipsgenMergeWithOptions(ref *C.VipsImage, sec *C.VipsImage, direction Direction, dx int, dy int, mblend int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_merge_with_options(ref, sec, &out, C.VipsDirection(direction), C.gint(dx), C.gint(dy), C.gint(mblend)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("merge", out)
 	}
 	return out, nil
 }
@@ -3823,7 +3869,7 @@ func vipsgenMin(in *C.VipsImage) (float64, error) {
 	var out float64
 	cout := new(C.double)
 	if err := C.vipsgen_min(in, cout); err != 0 {
-		return 0, handleVipsError()
+		return 0, handleVipsError("min")
 	}
 	out = float64(*cout)
 	return out, nil
@@ -3844,7 +3890,7 @@ func vipsgenMinWithOptions(in *C.VipsImage, size int, x *int, y *int) (float64,
 		cy = &cyValue
 	}
 	if err := C.vipsgen_min_with_options(in, cout, C.gint(size), cx, cy); err != 0 {
-		return 0, handleVipsError()
+		return 0, handleVipsError("min")
 	}
 	out = float64(*cout)
 	if x != nil {
@@ -3860,7 +3906,7 @@ func vipsgenMinWithOptions(in *C.VipsImage, size int, x *int, y *int) (float64,
 func vipsgenMinpair(left *C.VipsImage, right *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_minpair(left, right, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("minpair", out)
 	}
 	return out, nil
 }
@@ -3869,7 +3915,7 @@ func vipsgenMinpair(left *C.VipsImage, right *C.VipsImage) (*C.VipsImage, error)
 func vipsgenMorph(in *C.VipsImage, mask *C.VipsImage, morph OperationMorphology) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_morph(in, &out, mask, C.VipsOperationMorphology(morph)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("morph", out)
 	}
 	return out, nil
 }
@@ -3878,7 +3924,7 @@ func vipsgenMorph(in *C.VipsImage, mask *C.VipsImage, morph OperationMorphology)
 func vipsgenMosaic(ref *C.VipsImage, sec *C.VipsImage, direction Direction, xref int, yref int, xsec int, ysec int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_mosaic(ref, sec, &out, C.VipsDirection(direction), C.gint(xref), C.gint(yref), C.gint(xsec), C.gint(ysec)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("mosaic", out)
 	}
 	return out, nil
 }
@@ -3917,7 +3963,7 @@ func vipsgenMosaicWithOptions(ref *C.VipsImage, sec *C.VipsImage, direction Dire
 		cdx1 = &cdx1Value
 	}
 	if err := C.vipsgen_mosaic_with_options(ref, sec, &out, C.VipsDirection(direction), C.gint(xref), C.gint(yref), C.gint(xsec), C.gint(ysec), C.gint(hwindow), C.gint(harea), C.gint(mblend), C.gint(bandno), cdx0, cdy0, cscale1, cangle1, cdy1, cdx1); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("mosaic", out)
 	}
 	if dx0 != nil {
 		*dx0 = int(cdx0Value)
@@ -3944,7 +3990,7 @@ func vipsgenMosaicWithOptions(ref *C.VipsImage, sec *C.VipsImage, direction Dire
 func vipsgenMosaic1(ref *C.VipsImage, sec *C.VipsImage, direction Direction, xr1 int, yr1 int, xs1 int, ys1 int, xr2 int, yr2 int, xs2 int, ys2 int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_mosaic1(ref, sec, &out, C.VipsDirection(direction), C.gint(xr1), C.gint(yr1), C.gint(xs1), C.gint(ys1), C.gint(xr2), C.gint(yr2), C.gint(xs2), C.gint(ys2)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("mosaic1", out)
 	}
 	return out, nil
 }
@@ -3953,7 +3999,7 @@ func vipsgenMosaic1(ref *C.VipsImage, sec *C.VipsImage, direction Direction, xr1
 func vipsgenMosaic1WithOptions(ref *C.VipsImage, sec *C.VipsImage, direction Direction, xr1 int, yr1 int, xs1 int, ys1 int, xr2 int, yr2 int, xs2 int, ys2 int, hwindow int, harea int, search bool, interpolate *Interpolate, mblend int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_mosaic1_with_options(ref, sec, &out, C.VipsDirection(direction), C.gint(xr1), C.gint(yr1), C.gint(xs1), C.gint(ys1), C.gint(xr2), C.gint(yr2), C.gint(xs2), C.gint(ys2), C.gint(hwindow), C.gint(harea), C.int(boolToInt(search)), vipsInterpolateToC(interpolate), C.gint(mblend)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("mosaic1", out)
 	}
 	return out, nil
 }
@@ -3962,7 +4008,7 @@ func vipsgenMosaic1WithOptions(ref *C.VipsImage, sec *C.VipsImage, direction Dir
 func vipsgenMsb(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_msb(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("msb", out)
 	}
 	return out, nil
 }
@@ -3971,7 +4017,7 @@ func vipsgenMsb(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenMsbWithOptions(in *C.VipsImage, band int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_msb_with_options(in, &out, C.gint(band)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("msb", out)
 	}
 	return out, nil
 }
@@ -3980,7 +4026,7 @@ func vipsgenMsbWithOptions(in *C.VipsImage, band int) (*C.VipsImage, error) {
 func vipsgenMultiply(left *C.VipsImage, right *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_multiply(left, right, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("multiply", out)
 	}
 	return out, nil
 }
@@ -3991,7 +4037,7 @@ func vipsgenNiftiload(filename string) (*C.VipsImage, error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_niftiload(cfilename, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("niftiload", out)
 	}
 	return out, nil
 }
@@ -4002,7 +4048,7 @@ func vipsgenNiftiloadWithOptions(filename string, memory bool, access Access, fa
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_niftiload_with_options(cfilename, &out, C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("niftiload", out)
 	}
 	return out, nil
 }
@@ -4011,7 +4057,7 @@ func vipsgenNiftiloadWithOptions(filename string, memory bool, access Access, fa
 func vipsgenNiftiloadSource(source *C.VipsSourceCustom) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_niftiload_source(source, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("niftiload_source", out)
 	}
 	return out, nil
 }
@@ -4020,7 +4066,7 @@ func vipsgenNiftiloadSource(source *C.VipsSourceCustom) (*C.VipsImage, error) {
 func vipsgenNiftiloadSourceWithOptions(source *C.VipsSourceCustom, memory bool, access Access, failOn FailOn, revalidate bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_niftiload_source_with_options(source, &out, C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("niftiload_source", out)
 	}
 	return out, nil
 }
@@ -4030,7 +4076,7 @@ func vipsgenNiftisave(in *C.VipsImage, filename string) (error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_niftisave(in, cfilename); err != 0 {
-		return handleVipsError()
+		return handleVipsError("niftisave")
 	}
 	return nil
 }
@@ -4049,7 +4095,7 @@ func vipsgenNiftisaveWithOptions(in *C.VipsImage, filename string, keep Keep, ba
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_niftisave_with_options(in, cfilename, C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return handleVipsError()
+		return handleVipsError("niftisave")
 	}
 	return nil
 }
@@ -4060,7 +4106,7 @@ func vipsgenOpenexrload(filename string) (*C.VipsImage, error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_openexrload(cfilename, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("openexrload", out)
 	}
 	return out, nil
 }
@@ -4071,7 +4117,7 @@ func vipsgenOpenexrloadWithOptions(filename string, memory bool, access Access,
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_openexrload_with_options(cfilename, &out, C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("openexrload", out)
 	}
 	return out, nil
 }
@@ -4082,7 +4128,7 @@ func vipsgenOpenslideload(filename string) (*C.VipsImage, error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_openslideload(cfilename, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("openslideload", out)
 	}
 	return out, nil
 }
@@ -4095,7 +4141,7 @@ func vipsgenOpenslideloadWithOptions(filename string, level int, autocrop bool,
 	cassociated := C.CString(associated)
 	defer freeCString(cassociated)
 	if err := C.vipsgen_openslideload_with_options(cfilename, &out, C.gint(level), C.int(boolToInt(autocrop)), cassociated, C.int(boolToInt(attachAssociated)), C.int(boolToInt(rgb)), C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("openslideload", out)
 	}
 	return out, nil
 }
@@ -4104,7 +4150,7 @@ func vipsgenOpenslideloadWithOptions(filename string, level int, autocrop bool,
 func vipsgenOpenslideloadSource(source *C.VipsSourceCustom) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_openslideload_source(source, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("openslideload_source", out)
 	}
 	return out, nil
 }
@@ -4115,7 +4161,7 @@ func vipsgenOpenslideloadSourceWithOptions(source *C.VipsSourceCustom, level int
 	cassociated := C.CString(associated)
 	defer freeCString(cassociated)
 	if err := C.vipsgen_openslideload_source_with_options(source, &out, C.gint(level), C.int(boolToInt(autocrop)), cassociated, C.int(boolToInt(attachAssociated)), C.int(boolToInt(rgb)), C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("openslideload_source", out)
 	}
 	return out, nil
 }
@@ -4126,7 +4172,7 @@ func vipsgenPdfload(filename string) (*C.VipsImage, error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_pdfload(cfilename, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("pdfload", out)
 	}
 	return out, nil
 }
@@ -4146,7 +4192,7 @@ func vipsgenPdfloadWithOptions(filename string, page int, n int, dpi float64, sc
 	cpassword := C.CString(password)
 	defer freeCString(cpassword)
 	if err := C.vipsgen_pdfload_with_options(cfilename, &out, C.gint(page), C.gint(n), C.double(dpi), C.double(scale), cbackground, cbackgroundLength, cpassword, C.VipsForeignPdfPageBox(pageBox), C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("pdfload", out)
 	}
 	return out, nil
 }
@@ -4158,7 +4204,7 @@ func vipsgenPdfloadBuffer(buf []byte) (*C.VipsImage, error) {
 	defer runtime.KeepAlive(src)
 	var out *C.VipsImage
 	if err := C.vipsgen_pdfload_buffer(unsafe.Pointer(&src[0]), C.size_t(len(src)), &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("pdfload_buffer", out)
 	}
 	return out, nil
 }
@@ -4179,7 +4225,7 @@ func vipsgenPdfloadBufferWithOptions(buf []byte, page int, n int, dpi float64, s
 	cpassword := C.CString(password)
 	defer freeCString(cpassword)
 	if err := C.vipsgen_pdfload_buffer_with_options(unsafe.Pointer(&src[0]), C.size_t(len(src)), &out, C.gint(page), C.gint(n), C.double(dpi), C.double(scale), cbackground, cbackgroundLength, cpassword, C.VipsForeignPdfPageBox(pageBox), C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("pdfload_buffer", out)
 	}
 	return out, nil
 }
@@ -4188,7 +4234,7 @@ func vipsgenPdfloadBufferWithOptions(buf []byte, page int, n int, dpi float64, s
 func vipsgenPdfloadSource(source *C.VipsSourceCustom) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_pdfload_source(source, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("pdfload_source", out)
 	}
 	return out, nil
 }
@@ -4206,7 +4252,7 @@ func vipsgenPdfloadSourceWithOptions(source *C.VipsSourceCustom, page int, n int
 	cpassword := C.CString(password)
 	defer freeCString(cpassword)
 	if err := C.vipsgen_pdfload_source_with_options(source, &out, C.gint(page), C.gint(n), C.double(dpi), C.double(scale), cbackground, cbackgroundLength, cpassword, C.VipsForeignPdfPageBox(pageBox), C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("pdfload_source", out)
 	}
 	return out, nil
 }
@@ -4216,7 +4262,7 @@ func vipsgenPercent(in *C.VipsImage, percent float64) (int, error) {
 	var threshold int
 	cthreshold := new(C.gint)
 	if err := C.vipsgen_percent(in, C.double(percent), cthreshold); err != 0 {
-		return 0, handleVipsError()
+		return 0, handleVipsError("percent")
 	}
 	threshold = int(*cthreshold)
 	return threshold, nil
@@ -4226,7 +4272,7 @@ func vipsgenPercent(in *C.VipsImage, percent float64) (int, error) {
 func vipsgenPerlin(width int, height int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_perlin(&out, C.gint(width), C.gint(height)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("perlin", out)
 	}
 	return out, nil
 }
@@ -4235,7 +4281,7 @@ func vipsgenPerlin(width int, height int) (*C.VipsImage, error) {
 func vipsgenPerlinWithOptions(width int, height int, cellSize int, uchar bool, seed int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_perlin_with_options(&out, C.gint(width), C.gint(height), C.gint(cellSize), C.int(boolToInt(uchar)), C.gint(seed)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("perlin", out)
 	}
 	return out, nil
 }
@@ -4244,7 +4290,7 @@ func vipsgenPerlinWithOptions(width int, height int, cellSize int, uchar bool, s
 func vipsgenPhasecor(in *C.VipsImage, in2 *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_phasecor(in, in2, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("phasecor", out)
 	}
 	return out, nil
 }
@@ -4255,7 +4301,7 @@ func vipsgenPngload(filename string) (*C.VipsImage, error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_pngload(cfilename, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("pngload", out)
 	}
 	return out, nil
 }
@@ -4266,7 +4312,7 @@ func vipsgenPngloadWithOptions(filename string, unlimited bool, memory bool, acc
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_pngload_with_options(cfilename, &out, C.int(boolToInt(unlimited)), C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("pngload", out)
 	}
 	return out, nil
 }
@@ -4278,7 +4324,7 @@ func vipsgenPngloadBuffer(buf []byte) (*C.VipsImage, error) {
 	defer runtime.KeepAlive(src)
 	var out *C.VipsImage
 	if err := C.vipsgen_pngload_buffer(unsafe.Pointer(&src[0]), C.size_t(len(src)), &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("pngload_buffer", out)
 	}
 	return out, nil
 }
@@ -4290,7 +4336,7 @@ func vipsgenPngloadBufferWithOptions(buf []byte, unlimited bool, memory bool, ac
 	defer runtime.KeepAlive(src)
 	var out *C.VipsImage
 	if err := C.vipsgen_pngload_buffer_with_options(unsafe.Pointer(&src[0]), C.size_t(len(src)), &out, C.int(boolToInt(unlimited)), C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("pngload_buffer", out)
 	}
 	return out, nil
 }
@@ -4299,7 +4345,7 @@ func vipsgenPngloadBufferWithOptions(buf []byte, unlimited bool, memory bool, ac
 func vipsgenPngloadSource(source *C.VipsSourceCustom) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_pngload_source(source, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("pngload_source", out)
 	}
 	return out, nil
 }
@@ -4308,7 +4354,7 @@ func vipsgenPngloadSource(source *C.VipsSourceCustom) (*C.VipsImage, error) {
 func vipsgenPngloadSourceWithOptions(source *C.VipsSourceCustom, unlimited bool, memory bool, access Access, failOn FailOn, revalidate bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_pngload_source_with_options(source, &out, C.int(boolToInt(unlimited)), C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("pngload_source", out)
 	}
 	return out, nil
 }
@@ -4318,7 +4364,7 @@ func vipsgenPngsave(in *C.VipsImage, filename string) (error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_pngsave(in, cfilename); err != 0 {
-		return handleVipsError()
+		return handleVipsError("pngsave")
 	}
 	return nil
 }
@@ -4337,7 +4383,7 @@ func vipsgenPngsaveWithOptions(in *C.VipsImage, filename string, compression int
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_pngsave_with_options(in, cfilename, C.gint(compression), C.int(boolToInt(interlace)), C.VipsForeignPngFilter(filter), C.int(boolToInt(palette)), C.gint(q), C.double(dither), C.gint(bitdepth), C.gint(effort), C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return handleVipsError()
+		return handleVipsError("pngsave")
 	}
 	return nil
 }
@@ -4347,7 +4393,7 @@ func vipsgenPngsaveBuffer(in *C.VipsImage) ([]byte, error) {
 	var buf unsafe.Pointer
 	var length C.size_t
 	if err := C.vipsgen_pngsave_buffer(in, &buf, &length); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("pngsave_buffer")
 	}
 	return bufferToBytes(buf, length), nil
 }
@@ -4366,7 +4412,7 @@ func vipsgenPngsaveBufferWithOptions(in *C.VipsImage, compression int, interlace
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_pngsave_buffer_with_options(in, &buf, &length, C.gint(compression), C.int(boolToInt(interlace)), C.VipsForeignPngFilter(filter), C.int(boolToInt(palette)), C.gint(q), C.double(dither), C.gint(bitdepth), C.gint(effort), C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("pngsave_buffer")
 	}
 	return bufferToBytes(buf, length), nil
 }
@@ -4375,7 +4421,7 @@ func vipsgenPngsaveBufferWithOptions(in *C.VipsImage, compression int, interlace
 func vipsgenPngsaveTarget(in *C.VipsImage, target *C.VipsTargetCustom) (error) {
 	
 	if err := C.vipsgen_pngsave_target(in, target); err != 0 {
-		return handleVipsError()
+		return handleVipsError("pngsave_target")
 	}
 	return nil
 }
@@ -4392,7 +4438,7 @@ func vipsgenPngsaveTargetWithOptions(in *C.VipsImage, target *C.VipsTargetCustom
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_pngsave_target_with_options(in, target, C.gint(compression), C.int(boolToInt(interlace)), C.VipsForeignPngFilter(filter), C.int(boolToInt(palette)), C.gint(q), C.double(dither), C.gint(bitdepth), C.gint(effort), C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return handleVipsError()
+		return handleVipsError("pngsave_target")
 	}
 	return nil
 }
@@ -4403,7 +4449,7 @@ func vipsgenPpmload(filename string) (*C.VipsImage, error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_ppmload(cfilename, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("ppmload", out)
 	}
 	return out, nil
 }
@@ -4414,7 +4460,7 @@ func vipsgenPpmloadWithOptions(filename string, memory bool, access Access, fail
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_ppmload_with_options(cfilename, &out, C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("ppmload", out)
 	}
 	return out, nil
 }
@@ -4426,7 +4472,7 @@ func vipsgenPpmloadBuffer(buf []byte) (*C.VipsImage, error) {
 	defer runtime.KeepAlive(src)
 	var out *C.VipsImage
 	if err := C.vipsgen_ppmload_buffer(unsafe.Pointer(&src[0]), C.size_t(len(src)), &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("ppmload_buffer", out)
 	}
 	return out, nil
 }
@@ -4438,7 +4484,7 @@ func vipsgenPpmloadBufferWithOptions(buf []byte, memory bool, access Access, fai
 	defer runtime.KeepAlive(src)
 	var out *C.VipsImage
 	if err := C.vipsgen_ppmload_buffer_with_options(unsafe.Pointer(&src[0]), C.size_t(len(src)), &out, C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("ppmload_buffer", out)
 	}
 	return out, nil
 }
@@ -4447,7 +4493,7 @@ func vipsgenPpmloadBufferWithOptions(buf []byte, memory bool, access Access, fai
 func vipsgenPpmloadSource(source *C.VipsSourceCustom) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_ppmload_source(source, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("ppmload_source", out)
 	}
 	return out, nil
 }
@@ -4456,7 +4502,7 @@ func vipsgenPpmloadSource(source *C.VipsSourceCustom) (*C.VipsImage, error) {
 func vipsgenPpmloadSourceWithOptions(source *C.VipsSourceCustom, memory bool, access Access, failOn FailOn, revalidate bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_ppmload_source_with_options(source, &out, C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("ppmload_source", out)
 	}
 	return out, nil
 }
@@ -4466,7 +4512,7 @@ func vipsgenPpmsave(in *C.VipsImage, filename string) (error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_ppmsave(in, cfilename); err != 0 {
-		return handleVipsError()
+		return handleVipsError("ppmsave")
 	}
 	return nil
 }
@@ -4485,7 +4531,7 @@ func vipsgenPpmsaveWithOptions(in *C.VipsImage, filename string, format PpmForma
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_ppmsave_with_options(in, cfilename, C.VipsForeignPpmFormat(format), C.int(boolToInt(ascii)), C.gint(bitdepth), C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return handleVipsError()
+		return handleVipsError("ppmsave")
 	}
 	return nil
 }
@@ -4494,7 +4540,7 @@ func vipsgenPpmsaveWithOptions(in *C.VipsImage, filename string, format PpmForma
 func vipsgenPpmsaveTarget(in *C.VipsImage, target *C.VipsTargetCustom) (error) {
 	
 	if err := C.vipsgen_ppmsave_target(in, target); err != 0 {
-		return handleVipsError()
+		return handleVipsError("ppmsave_target")
 	}
 	return nil
 }
@@ -4511,7 +4557,7 @@ func vipsgenPpmsaveTargetWithOptions(in *C.VipsImage, target *C.VipsTargetCustom
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_ppmsave_target_with_options(in, target, C.VipsForeignPpmFormat(format), C.int(boolToInt(ascii)), C.gint(bitdepth), C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return handleVipsError()
+		return handleVipsError("ppmsave_target")
 	}
 	return nil
 }
@@ -4520,7 +4566,7 @@ func vipsgenPpmsaveTargetWithOptions(in *C.VipsImage, target *C.VipsTargetCustom
 func vipsgenPremultiply(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_premultiply(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("premultiply", out)
 	}
 	return out, nil
 }
@@ -4529,7 +4575,7 @@ func vipsgenPremultiply(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenPremultiplyWithOptions(in *C.VipsImage, maxAlpha float64) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_premultiply_with_options(in, &out, C.double(maxAlpha)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("premultiply", out)
 	}
 	return out, nil
 }
@@ -4538,7 +4584,7 @@ func vipsgenPremultiplyWithOptions(in *C.VipsImage, maxAlpha float64) (*C.VipsIm
 func vipsgenPrewitt(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_prewitt(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("prewitt", out)
 	}
 	return out, nil
 }
@@ -4548,7 +4594,7 @@ func vipsgenProfile(in *C.VipsImage) (*C.VipsImage, *C.VipsImage, error) {
 	var columns *C.VipsImage
 	var rows *C.VipsImage
 	if err := C.vipsgen_profile(in, &columns, &rows); err != 0 {
-		return nil, nil, handleVipsError()
+		return nil, nil, handleVipsError("profile")
 	}
 	return columns, rows, nil
 }
@@ -4559,7 +4605,7 @@ func vipsgenProfileLoad(name string) ([]byte, error) {
 	cname := C.CString(name)
 	defer freeCString(cname)
 	if err := C.vipsgen_profile_load(cname, &profile); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("profile_load")
 	}
 	return vipsBlobToBytes(profile), nil
 }
@@ -4569,7 +4615,7 @@ func vipsgenProject(in *C.VipsImage) (*C.VipsImage, *C.VipsImage, error) {
 	var columns *C.VipsImage
 	var rows *C.VipsImage
 	if err := C.vipsgen_project(in, &columns, &rows); err != 0 {
-		return nil, nil, handleVipsError()
+		return nil, nil, handleVipsError("project")
 	}
 	return columns, rows, nil
 }
@@ -4578,7 +4624,7 @@ func vipsgenProject(in *C.VipsImage) (*C.VipsImage, *C.VipsImage, error) {
 func vipsgenQuadratic(in *C.VipsImage, coeff *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_quadratic(in, &out, coeff); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("quadratic", out)
 	}
 	return out, nil
 }
@@ -4587,7 +4633,7 @@ func vipsgenQuadratic(in *C.VipsImage, coeff *C.VipsImage) (*C.VipsImage, error)
 func vipsgenQuadraticWithOptions(in *C.VipsImage, coeff *C.VipsImage, interpolate *Interpolate) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_quadratic_with_options(in, &out, coeff, vipsInterpolateToC(interpolate)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("quadratic", out)
 	}
 	return out, nil
 }
@@ -4596,7 +4642,7 @@ func vipsgenQuadraticWithOptions(in *C.VipsImage, coeff *C.VipsImage, interpolat
 func vipsgenRad2float(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_rad2float(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("rad2float", out)
 	}
 	return out, nil
 }
@@ -4607,7 +4653,7 @@ func vipsgenRadload(filename string) (*C.VipsImage, error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_radload(cfilename, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("radload", out)
 	}
 	return out, nil
 }
@@ -4618,7 +4664,7 @@ func vipsgenRadloadWithOptions(filename string, memory bool, access Access, fail
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_radload_with_options(cfilename, &out, C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("radload", out)
 	}
 	return out, nil
 }
@@ -4630,7 +4676,7 @@ func vipsgenRadloadBuffer(buf []byte) (*C.VipsImage, error) {
 	defer runtime.KeepAlive(src)
 	var out *C.VipsImage
 	if err := C.vipsgen_radload_buffer(unsafe.Pointer(&src[0]), C.size_t(len(src)), &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("radload_buffer", out)
 	}
 	return out, nil
 }
@@ -4642,7 +4688,7 @@ func vipsgenRadloadBufferWithOptions(buf []byte, memory bool, access Access, fai
 	defer runtime.KeepAlive(src)
 	var out *C.VipsImage
 	if err := C.vipsgen_radload_buffer_with_options(unsafe.Pointer(&src[0]), C.size_t(len(src)), &out, C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("radload_buffer", out)
 	}
 	return out, nil
 }
@@ -4651,7 +4697,7 @@ func vipsgenRadloadBufferWithOptions(buf []byte, memory bool, access Access, fai
 func vipsgenRadloadSource(source *C.VipsSourceCustom) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_radload_source(source, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("radload_source", out)
 	}
 	return out, nil
 }
@@ -4660,7 +4706,7 @@ func vipsgenRadloadSource(source *C.VipsSourceCustom) (*C.VipsImage, error) {
 func vipsgenRadloadSourceWithOptions(source *C.VipsSourceCustom, memory bool, access Access, failOn FailOn, revalidate bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_radload_source_with_options(source, &out, C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("radload_source", out)
 	}
 	return out, nil
 }
@@ -4670,7 +4716,7 @@ func vipsgenRadsave(in *C.VipsImage, filename string) (error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_radsave(in, cfilename); err != 0 {
-		return handleVipsError()
+		return handleVipsError("radsave")
 	}
 	return nil
 }
@@ -4689,7 +4735,7 @@ func vipsgenRadsaveWithOptions(in *C.VipsImage, filename string, keep Keep, back
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_radsave_with_options(in, cfilename, C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return handleVipsError()
+		return handleVipsError("radsave")
 	}
 	return nil
 }
@@ -4699,7 +4745,7 @@ func vipsgenRadsaveBuffer(in *C.VipsImage) ([]byte, error) {
 	var buf unsafe.Pointer
 	var length C.size_t
 	if err := C.vipsgen_radsave_buffer(in, &buf, &length); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("radsave_buffer")
 	}
 	return bufferToBytes(buf, length), nil
 }
@@ -4718,7 +4764,7 @@ func vipsgenRadsaveBufferWithOptions(in *C.VipsImage, keep Keep, background []fl
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_radsave_buffer_with_options(in, &buf, &length, C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("radsave_buffer")
 	}
 	return bufferToBytes(buf, length), nil
 }
@@ -4727,7 +4773,7 @@ func vipsgenRadsaveBufferWithOptions(in *C.VipsImage, keep Keep, background []fl
 func vipsgenRadsaveTarget(in *C.VipsImage, target *C.VipsTargetCustom) (error) {
 	
 	if err := C.vipsgen_radsave_target(in, target); err != 0 {
-		return handleVipsError()
+		return handleVipsError("radsave_target")
 	}
 	return nil
 }
@@ -4744,7 +4790,7 @@ func vipsgenRadsaveTargetWithOptions(in *C.VipsImage, target *C.VipsTargetCustom
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_radsave_target_with_options(in, target, C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return handleVipsError()
+		return handleVipsError("radsave_target")
 	}
 	return nil
 }
@@ -4753,7 +4799,7 @@ func vipsgenRadsaveTargetWithOptions(in *C.VipsImage, target *C.VipsTargetCustom
 func vipsgenRank(in *C.VipsImage, width int, height int, index int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_rank(in, &out, C.gint(width), C.gint(height), C.gint(index)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("rank", out)
 	}
 	return out, nil
 }
@@ -4764,7 +4810,7 @@ func vipsgenRawload(filename string, width int, height int, bands int) (*C.VipsI
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_rawload(cfilename, &out, C.gint(width), C.gint(height), C.gint(bands)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("rawload", out)
 	}
 	return out, nil
 }
@@ -4775,7 +4821,7 @@ func vipsgenRawloadWithOptions(filename string, width int, height int, bands int
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_rawload_with_options(cfilename, &out, C.gint(width), C.gint(height), C.gint(bands), C.guint64(offset), C.VipsBandFormat(format), C.VipsInterpretation(interpretation), C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("rawload", out)
 	}
 	return out, nil
 }
@@ -4785,7 +4831,7 @@ func vipsgenRawsave(in *C.VipsImage, filename string) (error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_rawsave(in, cfilename); err != 0 {
-		return handleVipsError()
+		return handleVipsError("rawsave")
 	}
 	return nil
 }
@@ -4804,7 +4850,7 @@ func vipsgenRawsaveWithOptions(in *C.VipsImage, filename string, keep Keep, back
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_rawsave_with_options(in, cfilename, C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return handleVipsError()
+		return handleVipsError("rawsave")
 	}
 	return nil
 }
@@ -4814,7 +4860,7 @@ func vipsgenRawsaveBuffer(in *C.VipsImage) ([]byte, error) {
 	var buf unsafe.Pointer
 	var length C.size_t
 	if err := C.vipsgen_rawsave_buffer(in, &buf, &length); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("rawsave_buffer")
 	}
 	return bufferToBytes(buf, length), nil
 }
@@ -4833,7 +4879,7 @@ func vipsgenRawsaveBufferWithOptions(in *C.VipsImage, keep Keep, background []fl
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_rawsave_buffer_with_options(in, &buf, &length, C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("rawsave_buffer")
 	}
 	return bufferToBytes(buf, length), nil
 }
@@ -4842,7 +4888,7 @@ func vipsgenRawsaveBufferWithOptions(in *C.VipsImage, keep Keep, background []fl
 func vipsgenRawsaveTarget(in *C.VipsImage, target *C.VipsTargetCustom) (error) {
 	
 	if err := C.vipsgen_rawsave_target(in, target); err != 0 {
-		return handleVipsError()
+		return handleVipsError("rawsave_target")
 	}
 	return nil
 }
@@ -4859,7 +4905,7 @@ func vipsgenRawsaveTargetWithOptions(in *C.VipsImage, target *C.VipsTargetCustom
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_rawsave_target_with_options(in, target, C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return handleVipsError()
+		return handleVipsError("rawsave_target")
 	}
 	return nil
 }
@@ -4868,7 +4914,7 @@ func vipsgenRawsaveTargetWithOptions(in *C.VipsImage, target *C.VipsTargetCustom
 func vipsgenRecomb(in *C.VipsImage, m *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_recomb(in, &out, m); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("recomb", out)
 	}
 	return out, nil
 }
@@ -4877,7 +4923,7 @@ func vipsgenRecomb(in *C.VipsImage, m *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenReduce(in *C.VipsImage, hshrink float64, vshrink float64) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_reduce(in, &out, C.double(hshrink), C.double(vshrink)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("reduce", out)
 	}
 	return out, nil
 }
@@ -4886,7 +4932,7 @@ func vipsgenReduce(in *C.VipsImage, hshrink float64, vshrink float64) (*C.VipsIm
 func vipsgenReduceWithOptions(in *C.VipsImage, hshrink float64, vshrink float64, kernel Kernel, gap float64) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_reduce_with_options(in, &out, C.double(hshrink), C.double(vshrink), C.VipsKernel(kernel), C.double(gap)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("reduce", out)
 	}
 	return out, nil
 }
@@ -4895,7 +4941,7 @@ func vipsgenReduceWithOptions(in *C.VipsImage, hshrink float64, vshrink float64,
 func vipsgenReduceh(in *C.VipsImage, hshrink float64) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_reduceh(in, &out, C.double(hshrink)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("reduceh", out)
 	}
 	return out, nil
 }
@@ -4904,7 +4950,7 @@ func vipsgenReduceh(in *C.VipsImage, hshrink float64) (*C.VipsImage, error) {
 func vipsgenReducehWithOptions(in *C.VipsImage, hshrink float64, kernel Kernel, gap float64) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_reduceh_with_options(in, &out, C.double(hshrink), C.VipsKernel(kernel), C.double(gap)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("reduceh", out)
 	}
 	return out, nil
 }
@@ -4913,7 +4959,7 @@ func vipsgenReducehWithOptions(in *C.VipsImage, hshrink float64, kernel Kernel,
 func vipsgenReducev(in *C.VipsImage, vshrink float64) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_reducev(in, &out, C.double(vshrink)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("reducev", out)
 	}
 	return out, nil
 }
@@ -4922,7 +4968,7 @@ func vipsgenReducev(in *C.VipsImage, vshrink float64) (*C.VipsImage, error) {
 func vipsgenReducevWithOptions(in *C.VipsImage, vshrink float64, kernel Kernel, gap float64) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_reducev_with_options(in, &out, C.double(vshrink), C.VipsKernel(kernel), C.double(gap)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("reducev", out)
 	}
 	return out, nil
 }
@@ -4931,7 +4977,7 @@ func vipsgenReducevWithOptions(in *C.VipsImage, vshrink float64, kernel Kernel,
 func vipsgenRelational(left *C.VipsImage, right *C.VipsImage, relational OperationRelational) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_relational(left, right, &out, C.VipsOperationRelational(relational)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("relational", out)
 	}
 	return out, nil
 }
@@ -4950,7 +4996,7 @@ func vipsgenRelationalConst(in *C.VipsImage, relational OperationRelational, c [
 		defer freeDoubleArray(cc)
 	}
 	if err := C.vipsgen_relational_const(in, &out, C.VipsOperationRelational(relational), cc, C.int(len(c))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("relational_const", out)
 	}
 	return out, nil
 }
@@ -4959,7 +5005,7 @@ func vipsgenRelationalConst(in *C.VipsImage, relational OperationRelational, c [
 func vipsgenRemainder(left *C.VipsImage, right *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_remainder(left, right, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("remainder", out)
 	}
 	return out, nil
 }
@@ -4978,7 +5024,7 @@ func vipsgenRemainderConst(in *C.VipsImage, c []float64) (*C.VipsImage, error) {
 		defer freeDoubleArray(cc)
 	}
 	if err := C.vipsgen_remainder_const(in, &out, cc, C.int(len(c))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("remainder_const", out)
 	}
 	return out, nil
 }
@@ -4991,7 +5037,7 @@ func vipsgenRemosaic(in *C.VipsImage, oldStr string, newStr string) (*C.VipsImag
 	cnewStr := C.CString(newStr)
 	defer freeCString(cnewStr)
 	if err := C.vipsgen_remosaic(in, &out, coldStr, cnewStr); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("remosaic", out)
 	}
 	return out, nil
 }
@@ -5000,7 +5046,7 @@ func vipsgenRemosaic(in *C.VipsImage, oldStr string, newStr string) (*C.VipsImag
 func vipsgenReplicate(in *C.VipsImage, across int, down int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_replicate(in, &out, C.gint(across), C.gint(down)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("replicate", out)
 	}
 	return out, nil
 }
@@ -5009,7 +5055,7 @@ func vipsgenReplicate(in *C.VipsImage, across int, down int) (*C.VipsImage, erro
 func vipsgenResize(in *C.VipsImage, scale float64) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_resize(in, &out, C.double(scale)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("resize", out)
 	}
 	return out, nil
 }
@@ -5018,7 +5064,7 @@ func vipsgenResize(in *C.VipsImage, scale float64) (*C.VipsImage, error) {
 func vipsgenResizeWithOptions(in *C.VipsImage, scale float64, kernel Kernel, gap float64, vscale float64) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_resize_with_options(in, &out, C.double(scale), C.VipsKernel(kernel), C.double(gap), C.double(vscale)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("resize", out)
 	}
 	return out, nil
 }
@@ -5027,7 +5073,7 @@ func vipsgenResizeWithOptions(in *C.VipsImage, scale float64, kernel Kernel, gap
 func vipsgenRot(in *C.VipsImage, angle Angle) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_rot(in, &out, C.VipsAngle(angle)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("rot", out)
 	}
 	return out, nil
 }
@@ -5036,7 +5082,7 @@ func vipsgenRot(in *C.VipsImage, angle Angle) (*C.VipsImage, error) {
 func vipsgenRot45(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_rot45(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("rot45", out)
 	}
 	return out, nil
 }
@@ -5045,7 +5091,7 @@ func vipsgenRot45(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenRot45WithOptions(in *C.VipsImage, angle Angle45) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_rot45_with_options(in, &out, C.VipsAngle45(angle)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("rot45", out)
 	}
 	return out, nil
 }
@@ -5054,7 +5100,7 @@ func vipsgenRot45WithOptions(in *C.VipsImage, angle Angle45) (*C.VipsImage, erro
 func vipsgenRotate(in *C.VipsImage, angle float64) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_rotate(in, &out, C.double(angle)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("rotate", out)
 	}
 	return out, nil
 }
@@ -5070,7 +5116,7 @@ func vipsgenRotateWithOptions(in *C.VipsImage, angle float64, interpolate *Inter
 		defer freeDoubleArray(cbackground)
 	}
 	if err := C.vipsgen_rotate_with_options(in, &out, C.double(angle), vipsInterpolateToC(interpolate), cbackground, cbackgroundLength, C.double(odx), C.double(ody), C.double(idx), C.double(idy)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("rotate", out)
 	}
 	return out, nil
 }
@@ -5079,7 +5125,7 @@ func vipsgenRotateWithOptions(in *C.VipsImage, angle float64, interpolate *Inter
 func vipsgenRound(in *C.VipsImage, round OperationRound) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_round(in, &out, C.VipsOperationRound(round)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("round", out)
 	}
 	return out, nil
 }
@@ -5088,7 +5134,7 @@ func vipsgenRound(in *C.VipsImage, round OperationRound) (*C.VipsImage, error) {
 func vipsgenSRGB2HSV(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_sRGB2HSV(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("sRGB2HSV", out)
 	}
 	return out, nil
 }
@@ -5097,7 +5143,7 @@ func vipsgenSRGB2HSV(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenSRGB2scRGB(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_sRGB2scRGB(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("sRGB2scRGB", out)
 	}
 	return out, nil
 }
@@ -5106,7 +5152,7 @@ func vipsgenSRGB2scRGB(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenScRGB2BW(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_scRGB2BW(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("scRGB2BW", out)
 	}
 	return out, nil
 }
@@ -5115,7 +5161,7 @@ func vipsgenScRGB2BW(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenScRGB2BWWithOptions(in *C.VipsImage, depth int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_scRGB2BW_with_options(in, &out, C.gint(depth)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("scRGB2BW", out)
 	}
 	return out, nil
 }
@@ -5124,7 +5170,7 @@ func vipsgenScRGB2BWWithOptions(in *C.VipsImage, depth int) (*C.VipsImage, error
 func vipsgenScRGB2XYZ(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_scRGB2XYZ(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("scRGB2XYZ", out)
 	}
 	return out, nil
 }
@@ -5133,7 +5179,7 @@ func vipsgenScRGB2XYZ(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenScRGB2sRGB(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_scRGB2sRGB(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("scRGB2sRGB", out)
 	}
 	return out, nil
 }
@@ -5142,7 +5188,7 @@ func vipsgenScRGB2sRGB(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenScRGB2sRGBWithOptions(in *C.VipsImage, depth int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_scRGB2sRGB_with_options(in, &out, C.gint(depth)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("scRGB2sRGB", out)
 	}
 	return out, nil
 }
@@ -5151,7 +5197,7 @@ func vipsgenScRGB2sRGBWithOptions(in *C.VipsImage, depth int) (*C.VipsImage, err
 func vipsgenScale(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_scale(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("scale", out)
 	}
 	return out, nil
 }
@@ -5160,7 +5206,7 @@ func vipsgenScale(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenScaleWithOptions(in *C.VipsImage, exp float64, log bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_scale_with_options(in, &out, C.double(exp), C.int(boolToInt(log))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("scale", out)
 	}
 	return out, nil
 }
@@ -5169,7 +5215,7 @@ func vipsgenScaleWithOptions(in *C.VipsImage, exp float64, log bool) (*C.VipsIma
 func vipsgenScharr(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_scharr(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("scharr", out)
 	}
 	return out, nil
 }
@@ -5178,7 +5224,7 @@ func vipsgenScharr(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenSdf(width int, height int, shape SdfShape) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_sdf(&out, C.gint(width), C.gint(height), C.VipsSdfShape(shape)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("sdf", out)
 	}
 	return out, nil
 }
@@ -5208,7 +5254,7 @@ func vipsgenSdfWithOptions(width int, height int, shape SdfShape, r float64, a [
 		defer freeDoubleArray(ccorners)
 	}
 	if err := C.vipsgen_sdf_with_options(&out, C.gint(width), C.gint(height), C.VipsSdfShape(shape), C.double(r), ca, caLength, cb, cbLength, ccorners, ccornersLength); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("sdf", out)
 	}
 	return out, nil
 }
@@ -5217,7 +5263,7 @@ func vipsgenSdfWithOptions(width int, height int, shape SdfShape, r float64, a [
 func vipsgenSequential(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_sequential(in, &out); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("sequential")
 	}
 	return out, nil
 }
@@ -5226,7 +5272,7 @@ func vipsgenSequential(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenSequentialWithOptions(in *C.VipsImage, tileHeight int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_sequential_with_options(in, &out, C.gint(tileHeight)); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("sequential")
 	}
 	return out, nil
 }
@@ -5235,7 +5281,7 @@ func vipsgenSequentialWithOptions(in *C.VipsImage, tileHeight int) (*C.VipsImage
 func vipsgenSharpen(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_sharpen(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("sharpen", out)
 	}
 	return out, nil
 }
@@ -5244,7 +5290,7 @@ func vipsgenSharpen(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenSharpenWithOptions(in *C.VipsImage, sigma float64, x1 float64, y2 float64, y3 float64, m1 float64, m2 float64) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_sharpen_with_options(in, &out, C.double(sigma), C.double(x1), C.double(y2), C.double(y3), C.double(m1), C.double(m2)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("sharpen", out)
 	}
 	return out, nil
 }
@@ -5253,7 +5299,7 @@ func vipsgenSharpenWithOptions(in *C.VipsImage, sigma float64, x1 float64, y2 fl
 func vipsgenShrink(in *C.VipsImage, hshrink float64, vshrink float64) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_shrink(in, &out, C.double(hshrink), C.double(vshrink)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("shrink", out)
 	}
 	return out, nil
 }
@@ -5262,7 +5308,7 @@ func vipsgenShrink(in *C.VipsImage, hshrink float64, vshrink float64) (*C.VipsIm
 func vipsgenShrinkWithOptions(in *C.VipsImage, hshrink float64, vshrink float64, ceil bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_shrink_with_options(in, &out, C.double(hshrink), C.double(vshrink), C.int(boolToInt(ceil))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("shrink", out)
 	}
 	return out, nil
 }
@@ -5271,7 +5317,7 @@ func vipsgenShrinkWithOptions(in *C.VipsImage, hshrink float64, vshrink float64,
 func vipsgenShrinkh(in *C.VipsImage, hshrink int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_shrinkh(in, &out, C.gint(hshrink)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("shrinkh", out)
 	}
 	return out, nil
 }
@@ -5280,7 +5326,7 @@ func vipsgenShrinkh(in *C.VipsImage, hshrink int) (*C.VipsImage, error) {
 func vipsgenShrinkhWithOptions(in *C.VipsImage, hshrink int, ceil bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_shrinkh_with_options(in, &out, C.gint(hshrink), C.int(boolToInt(ceil))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("shrinkh", out)
 	}
 	return out, nil
 }
@@ -5289,7 +5335,7 @@ func vipsgenShrinkhWithOptions(in *C.VipsImage, hshrink int, ceil bool) (*C.Vips
 func vipsgenShrinkv(in *C.VipsImage, vshrink int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_shrinkv(in, &out, C.gint(vshrink)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("shrinkv", out)
 	}
 	return out, nil
 }
@@ -5298,7 +5344,7 @@ func vipsgenShrinkv(in *C.VipsImage, vshrink int) (*C.VipsImage, error) {
 func vipsgenShrinkvWithOptions(in *C.VipsImage, vshrink int, ceil bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_shrinkv_with_options(in, &out, C.gint(vshrink), C.int(boolToInt(ceil))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("shrinkv", out)
 	}
 	return out, nil
 }
@@ -5307,7 +5353,7 @@ func vipsgenShrinkvWithOptions(in *C.VipsImage, vshrink int, ceil bool) (*C.Vips
 func vipsgenSign(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_sign(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("sign", out)
 	}
 	return out, nil
 }
@@ -5316,7 +5362,7 @@ func vipsgenSign(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenSimilarity(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_similarity(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("similarity", out)
 	}
 	return out, nil
 }
@@ -5332,7 +5378,7 @@ func vipsgenSimilarityWithOptions(in *C.VipsImage, scale float64, angle float64,
 		defer freeDoubleArray(cbackground)
 	}
 	if err := C.vipsgen_similarity_with_options(in, &out, C.double(scale), C.double(angle), vipsInterpolateToC(interpolate), cbackground, cbackgroundLength, C.double(odx), C.double(ody), C.double(idx), C.double(idy)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("similarity", out)
 	}
 	return out, nil
 }
@@ -5341,7 +5387,7 @@ func vipsgenSimilarityWithOptions(in *C.VipsImage, scale float64, angle float64,
 func vipsgenSines(width int, height int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_sines(&out, C.gint(width), C.gint(height)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("sines", out)
 	}
 	return out, nil
 }
@@ -5350,7 +5396,7 @@ func vipsgenSines(width int, height int) (*C.VipsImage, error) {
 func vipsgenSinesWithOptions(width int, height int, uchar bool, hfreq float64, vfreq float64) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_sines_with_options(&out, C.gint(width), C.gint(height), C.int(boolToInt(uchar)), C.double(hfreq), C.double(vfreq)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("sines", out)
 	}
 	return out, nil
 }
@@ -5359,7 +5405,7 @@ func vipsgenSinesWithOptions(width int, height int, uchar bool, hfreq float64, v
 func vipsgenSmartcrop(input *C.VipsImage, width int, height int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_smartcrop(input, &out, C.gint(width), C.gint(height)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("smartcrop", out)
 	}
 	return out, nil
 }
@@ -5378,7 +5424,7 @@ func vipsgenSmartcropWithOptions(input *C.VipsImage, width int, height int, inte
 		cattentionY = &cattentionYValue
 	}
 	if err := C.vipsgen_smartcrop_with_options(input, &out, C.gint(width), C.gint(height), C.VipsInteresting(interesting), C.int(boolToInt(premultiplied)), cattentionX, cattentionY); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("smartcrop", out)
 	}
 	if attentionX != nil {
 		*attentionX = int(cattentionXValue)
@@ -5393,7 +5439,7 @@ func vipsgenSmartcropWithOptions(input *C.VipsImage, width int, height int, inte
 func vipsgenSobel(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_sobel(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("sobel", out)
 	}
 	return out, nil
 }
@@ -5402,7 +5448,7 @@ func vipsgenSobel(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenSpcor(in *C.VipsImage, ref *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_spcor(in, ref, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("spcor", out)
 	}
 	return out, nil
 }
@@ -5411,7 +5457,7 @@ func vipsgenSpcor(in *C.VipsImage, ref *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenSpectrum(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_spectrum(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("spectrum", out)
 	}
 	return out, nil
 }
@@ -5420,7 +5466,7 @@ func vipsgenSpectrum(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenStats(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_stats(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("stats", out)
 	}
 	return out, nil
 }
@@ -5429,7 +5475,7 @@ func vipsgenStats(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenStdif(in *C.VipsImage, width int, height int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_stdif(in, &out, C.gint(width), C.gint(height)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("stdif", out)
 	}
 	return out, nil
 }
@@ -5438,7 +5484,7 @@ func vipsgenStdif(in *C.VipsImage, width int, height int) (*C.VipsImage, error)
 func vipsgenStdifWithOptions(in *C.VipsImage, width int, height int, s0 float64, b float64, m0 float64, a float64) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_stdif_with_options(in, &out, C.gint(width), C.gint(height), C.double(s0), C.double(b), C.double(m0), C.double(a)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("stdif", out)
 	}
 	return out, nil
 }
@@ -5447,7 +5493,7 @@ func vipsgenStdifWithOptions(in *C.VipsImage, width int, height int, s0 float64,
 func vipsgenSubsample(input *C.VipsImage, xfac int, yfac int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_subsample(input, &out, C.gint(xfac), C.gint(yfac)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("subsample", out)
 	}
 	return out, nil
 }
@@ -5456,7 +5502,7 @@ func vipsgenSubsample(input *C.VipsImage, xfac int, yfac int) (*C.VipsImage, err
 func vipsgenSubsampleWithOptions(input *C.VipsImage, xfac int, yfac int, point bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_subsample_with_options(input, &out, C.gint(xfac), C.gint(yfac), C.int(boolToInt(point))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("subsample", out)
 	}
 	return out, nil
 }
@@ -5465,7 +5511,7 @@ func vipsgenSubsampleWithOptions(input *C.VipsImage, xfac int, yfac int, point b
 func vipsgenSubtract(left *C.VipsImage, right *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_subtract(left, right, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("subtract", out)
 	}
 	return out, nil
 }
@@ -5484,7 +5530,7 @@ func vipsgenSum(in []*C.VipsImage) (*C.VipsImage, error) {
 		defer freeImageArray(cin)
 	}
 	if err := C.vipsgen_sum((**C.VipsImage)(cin), &out, C.int(len(in))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("sum", out)
 	}
 	return out, nil
 }
@@ -5495,7 +5541,7 @@ func vipsgenSvgload(filename string) (*C.VipsImage, error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_svgload(cfilename, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("svgload", out)
 	}
 	return out, nil
 }
@@ -5508,7 +5554,7 @@ func vipsgenSvgloadWithOptions(filename string, dpi float64, scale float64, unli
 	cstylesheet := C.CString(stylesheet)
 	defer freeCString(cstylesheet)
 	if err := C.vipsgen_svgload_with_options(cfilename, &out, C.double(dpi), C.double(scale), C.int(boolToInt(unlimited)), cstylesheet, C.int(boolToInt(highBitdepth)), C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("svgload", out)
 	}
 	return out, nil
 }
@@ -5520,7 +5566,7 @@ func vipsgenSvgloadBuffer(buf []byte) (*C.VipsImage, error) {
 	defer runtime.KeepAlive(src)
 	var out *C.VipsImage
 	if err := C.vipsgen_svgload_buffer(unsafe.Pointer(&src[0]), C.size_t(len(src)), &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("svgload_buffer", out)
 	}
 	return out, nil
 }
@@ -5534,7 +5580,7 @@ func vipsgenSvgloadBufferWithOptions(buf []byte, dpi float64, scale float64, unl
 	cstylesheet := C.CString(stylesheet)
 	defer freeCString(cstylesheet)
 	if err := C.vipsgen_svgload_buffer_with_options(unsafe.Pointer(&src[0]), C.size_t(len(src)), &out, C.double(dpi), C.double(scale), C.int(boolToInt(unlimited)), cstylesheet, C.int(boolToInt(highBitdepth)), C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("svgload_buffer", out)
 	}
 	return out, nil
 }
@@ -5543,7 +5589,7 @@ func vipsgenSvgloadBufferWithOptions(buf []byte, dpi float64, scale float64, unl
 func vipsgenSvgloadSource(source *C.VipsSourceCustom) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_svgload_source(source, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("svgload_source", out)
 	}
 	return out, nil
 }
@@ -5554,7 +5600,7 @@ func vipsgenSvgloadSourceWithOptions(source *C.VipsSourceCustom, dpi float64, sc
 	cstylesheet := C.CString(stylesheet)
 	defer freeCString(cstylesheet)
 	if err := C.vipsgen_svgload_source_with_options(source, &out, C.double(dpi), C.double(scale), C.int(boolToInt(unlimited)), cstylesheet, C.int(boolToInt(highBitdepth)), C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("svgload_source", out)
 	}
 	return out, nil
 }
@@ -5573,7 +5619,7 @@ func vipsgenSwitch(tests []*C.VipsImage) (*C.VipsImage, error) {
 		defer freeImageArray(ctests)
 	}
 	if err := C.vipsgen_switch((**C.VipsImage)(ctests), &out, C.int(len(tests))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("switch", out)
 	}
 	return out, nil
 }
@@ -5584,7 +5630,7 @@ func vipsgenSystem(cmdFormat string) (*C.VipsImage, error) {
 	ccmdFormat := C.CString(cmdFormat)
 	defer freeCString(ccmdFormat)
 	if err := C.vipsgen_system(ccmdFormat); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("system", out)
 	}
 	return out, nil
 }
@@ -5606,7 +5652,7 @@ func vipsgenSystemWithOptions(cmdFormat string, in []*C.VipsImage, inFormat stri
 	coutFormat := C.CString(outFormat)
 	defer freeCString(coutFormat)
 	if err := C.vipsgen_system_with_options(ccmdFormat, cin, cinLength, cinFormat, coutFormat, C.int(boolToInt(cache))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("system", out)
 	}
 	return out, nil
 }
@@ -5617,7 +5663,7 @@ func vipsgenText(text string) (*C.VipsImage, error) {
 	ctext := C.CString(text)
 	defer freeCString(ctext)
 	if err := C.vipsgen_text(&out, ctext); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("text", out)
 	}
 	return out, nil
 }
@@ -5637,7 +5683,7 @@ func vipsgenTextWithOptions(text string, font string, width int, height int, ali
 		cautofitDpi = &cautofitDpiValue
 	}
 	if err := C.vipsgen_text_with_options(&out, ctext, cfont, C.gint(width), C.gint(height), C.VipsAlign(align), C.int(boolToInt(justify)), C.gint(dpi), C.gint(spacing), cfontfile, C.int(boolToInt(rgba)), C.VipsTextWrap(wrap), cautofitDpi); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("text", out)
 	}
 	if autofitDpi != nil {
 		*autofitDpi = int(cautofitDpiValue)
@@ -5651,7 +5697,7 @@ func vipsgenThumbnail(filename string, width int) (*C.VipsImage, error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_thumbnail(cfilename, &out, C.gint(width)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("thumbnail", out)
 	}
 	return out, nil
 }
@@ -5666,7 +5712,7 @@ func vipsgenThumbnailWithOptions(filename string, width int, height int, size Si
 	coutputProfile := C.CString(outputProfile)
 	defer freeCString(coutputProfile)
 	if err := C.vipsgen_thumbnail_with_options(cfilename, &out, C.gint(width), C.gint(height), C.VipsSize(size), C.int(boolToInt(noRotate)), C.VipsInteresting(crop), C.int(boolToInt(linear)), cinputProfile, coutputProfile, C.VipsIntent(intent), C.VipsFailOn(failOn)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("thumbnail", out)
 	}
 	return out, nil
 }
@@ -5678,7 +5724,7 @@ func vipsgenThumbnailBuffer(buf []byte, width int) (*C.VipsImage, error) {
 	defer runtime.KeepAlive(src)
 	var out *C.VipsImage
 	if err := C.vipsgen_thumbnail_buffer(unsafe.Pointer(&src[0]), C.size_t(len(src)), &out, C.gint(width)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("thumbnail_buffer", out)
 	}
 	return out, nil
 }
@@ -5696,7 +5742,7 @@ func vipsgenThumbnailBufferWithOptions(buf []byte, width int, optionString strin
 	coutputProfile := C.CString(outputProfile)
 	defer freeCString(coutputProfile)
 	if err := C.vipsgen_thumbnail_buffer_with_options(unsafe.Pointer(&src[0]), C.size_t(len(src)), &out, C.gint(width), coptionString, C.gint(height), C.VipsSize(size), C.int(boolToInt(noRotate)), C.VipsInteresting(crop), C.int(boolToInt(linear)), cinputProfile, coutputProfile, C.VipsIntent(intent), C.VipsFailOn(failOn)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("thumbnail_buffer", out)
 	}
 	return out, nil
 }
@@ -5705,7 +5751,7 @@ func vipsgenThumbnailBufferWithOptions(buf []byte, width int, optionString strin
 func vipsgenThumbnailImage(in *C.VipsImage, width int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_thumbnail_image(in, &out, C.gint(width)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("thumbnail_image", out)
 	}
 	return out, nil
 }
@@ -5718,7 +5764,7 @@ func vipsgenThumbnailImageWithOptions(in *C.VipsImage, width int, height int, si
 	coutputProfile := C.CString(outputProfile)
 	defer freeCString(coutputProfile)
 	if err := C.vipsgen_thumbnail_image_with_options(in, &out, C.gint(width), C.gint(height), C.VipsSize(size), C.int(boolToInt(noRotate)), C.VipsInteresting(crop), C.int(boolToInt(linear)), cinputProfile, coutputProfile, C.VipsIntent(intent), C.VipsFailOn(failOn)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("thumbnail_image", out)
 	}
 	return out, nil
 }
@@ -5727,7 +5773,7 @@ func vipsgenThumbnailImageWithOptions(in *C.VipsImage, width int, height int, si
 func vipsgenThumbnailSource(source *C.VipsSourceCustom, width int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_thumbnail_source(source, &out, C.gint(width)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("thumbnail_source", out)
 	}
 	return out, nil
 }
@@ -5742,7 +5788,7 @@ func vipsgenThumbnailSourceWithOptions(source *C.VipsSourceCustom, width int, op
 	coutputProfile := C.CString(outputProfile)
 	defer freeCString(coutputProfile)
 	if err := C.vipsgen_thumbnail_source_with_options(source, &out, C.gint(width), coptionString, C.gint(height), C.VipsSize(size), C.int(boolToInt(noRotate)), C.VipsInteresting(crop), C.int(boolToInt(linear)), cinputProfile, coutputProfile, C.VipsIntent(intent), C.VipsFailOn(failOn)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("thumbnail_source", out)
 	}
 	return out, nil
 }
@@ -5753,7 +5799,7 @@ func vipsgenTiffload(filename string) (*C.VipsImage, error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_tiffload(cfilename, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("tiffload", out)
 	}
 	return out, nil
 }
@@ -5764,7 +5810,7 @@ func vipsgenTiffloadWithOptions(filename string, page int, n int, autorotate boo
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_tiffload_with_options(cfilename, &out, C.gint(page), C.gint(n), C.int(boolToInt(autorotate)), C.gint(subifd), C.int(boolToInt(unlimited)), C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("tiffload", out)
 	}
 	return out, nil
 }
@@ -5776,7 +5822,7 @@ func vipsgenTiffloadBuffer(buf []byte) (*C.VipsImage, error) {
 	defer runtime.KeepAlive(src)
 	var out *C.VipsImage
 	if err := C.vipsgen_tiffload_buffer(unsafe.Pointer(&src[0]), C.size_t(len(src)), &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("tiffload_buffer", out)
 	}
 	return out, nil
 }
@@ -5788,7 +5834,7 @@ func vipsgenTiffloadBufferWithOptions(buf []byte, page int, n int, autorotate bo
 	defer runtime.KeepAlive(src)
 	var out *C.VipsImage
 	if err := C.vipsgen_tiffload_buffer_with_options(unsafe.Pointer(&src[0]), C.size_t(len(src)), &out, C.gint(page), C.gint(n), C.int(boolToInt(autorotate)), C.gint(subifd), C.int(boolToInt(unlimited)), C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("tiffload_buffer", out)
 	}
 	return out, nil
 }
@@ -5797,7 +5843,7 @@ func vipsgenTiffloadBufferWithOptions(buf []byte, page int, n int, autorotate bo
 func vipsgenTiffloadSource(source *C.VipsSourceCustom) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_tiffload_source(source, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("tiffload_source", out)
 	}
 	return out, nil
 }
@@ -5806,7 +5852,7 @@ func vipsgenTiffloadSource(source *C.VipsSourceCustom) (*C.VipsImage, error) {
 func vipsgenTiffloadSourceWithOptions(source *C.VipsSourceCustom, page int, n int, autorotate bool, subifd int, unlimited bool, memory bool, access Access, failOn FailOn, revalidate bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_tiffload_source_with_options(source, &out, C.gint(page), C.gint(n), C.int(boolToInt(autorotate)), C.gint(subifd), C.int(boolToInt(unlimited)), C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("tiffload_source", out)
 	}
 	return out, nil
 }
@@ -5816,7 +5862,7 @@ func vipsgenTiffsave(in *C.VipsImage, filename string) (error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_tiffsave(in, cfilename); err != 0 {
-		return handleVipsError()
+		return handleVipsError("tiffsave")
 	}
 	return nil
 }
@@ -5835,7 +5881,7 @@ func vipsgenTiffsaveWithOptions(in *C.VipsImage, filename string, compression Ti
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_tiffsave_with_options(in, cfilename, C.VipsForeignTiffCompression(compression), C.gint(q), C.VipsForeignTiffPredictor(predictor), C.int(boolToInt(tile)), C.gint(tileWidth), C.gint(tileHeight), C.int(boolToInt(pyramid)), C.int(boolToInt(miniswhite)), C.gint(bitdepth), C.VipsForeignTiffResunit(resunit), C.double(xres), C.double(yres), C.int(boolToInt(bigtiff)), C.int(boolToInt(properties)), C.VipsRegionShrink(regionShrink), C.gint(level), C.int(boolToInt(lossless)), C.VipsForeignDzDepth(depth), C.int(boolToInt(subifd)), C.int(boolToInt(premultiply)), C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return handleVipsError()
+		return handleVipsError("tiffsave")
 	}
 	return nil
 }
@@ -5845,7 +5891,7 @@ func vipsgenTiffsaveBuffer(in *C.VipsImage) ([]byte, error) {
 	var buf unsafe.Pointer
 	var length C.size_t
 	if err := C.vipsgen_tiffsave_buffer(in, &buf, &length); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("tiffsave_buffer")
 	}
 	return bufferToBytes(buf, length), nil
 }
@@ -5864,7 +5910,7 @@ func vipsgenTiffsaveBufferWithOptions(in *C.VipsImage, compression TiffCompressi
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_tiffsave_buffer_with_options(in, &buf, &length, C.VipsForeignTiffCompression(compression), C.gint(q), C.VipsForeignTiffPredictor(predictor), C.int(boolToInt(tile)), C.gint(tileWidth), C.gint(tileHeight), C.int(boolToInt(pyramid)), C.int(boolToInt(miniswhite)), C.gint(bitdepth), C.VipsForeignTiffResunit(resunit), C.double(xres), C.double(yres), C.int(boolToInt(bigtiff)), C.int(boolToInt(properties)), C.VipsRegionShrink(regionShrink), C.gint(level), C.int(boolToInt(lossless)), C.VipsForeignDzDepth(depth), C.int(boolToInt(subifd)), C.int(boolToInt(premultiply)), C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("tiffsave_buffer")
 	}
 	return bufferToBytes(buf, length), nil
 }
@@ -5873,7 +5919,7 @@ func vipsgenTiffsaveBufferWithOptions(in *C.VipsImage, compression TiffCompressi
 func vipsgenTiffsaveTarget(in *C.VipsImage, target *C.VipsTargetCustom) (error) {
 	
 	if err := C.vipsgen_tiffsave_target(in, target); err != 0 {
-		return handleVipsError()
+		return handleVipsError("tiffsave_target")
 	}
 	return nil
 }
@@ -5890,7 +5936,7 @@ func vipsgenTiffsaveTargetWithOptions(in *C.VipsImage, target *C.VipsTargetCusto
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_tiffsave_target_with_options(in, target, C.VipsForeignTiffCompression(compression), C.gint(q), C.VipsForeignTiffPredictor(predictor), C.int(boolToInt(tile)), C.gint(tileWidth), C.gint(tileHeight), C.int(boolToInt(pyramid)), C.int(boolToInt(miniswhite)), C.gint(bitdepth), C.VipsForeignTiffResunit(resunit), C.double(xres), C.double(yres), C.int(boolToInt(bigtiff)), C.int(boolToInt(properties)), C.VipsRegionShrink(regionShrink), C.gint(level), C.int(boolToInt(lossless)), C.VipsForeignDzDepth(depth), C.int(boolToInt(subifd)), C.int(boolToInt(premultiply)), C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return handleVipsError()
+		return handleVipsError("tiffsave_target")
 	}
 	return nil
 }
@@ -5899,7 +5945,7 @@ func vipsgenTiffsaveTargetWithOptions(in *C.VipsImage, target *C.VipsTargetCusto
 func vipsgenTilecache(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_tilecache(in, &out); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("tilecache")
 	}
 	return out, nil
 }
@@ -5908,7 +5954,7 @@ func vipsgenTilecache(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenTilecacheWithOptions(in *C.VipsImage, tileWidth int, tileHeight int, maxTiles int, access Access, threaded bool, persistent bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_tilecache_with_options(in, &out, C.gint(tileWidth), C.gint(tileHeight), C.gint(maxTiles), C.VipsAccess(access), C.int(boolToInt(threaded)), C.int(boolToInt(persistent))); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("tilecache")
 	}
 	return out, nil
 }
@@ -5917,7 +5963,7 @@ func vipsgenTilecacheWithOptions(in *C.VipsImage, tileWidth int, tileHeight int,
 func vipsgenTonelut() (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_tonelut(&out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("tonelut", out)
 	}
 	return out, nil
 }
@@ -5926,7 +5972,7 @@ func vipsgenTonelut() (*C.VipsImage, error) {
 func vipsgenTonelutWithOptions(inMax int, outMax int, lb float64, lw float64, ps float64, pm float64, ph float64, s float64, m float64, h float64) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_tonelut_with_options(&out, C.gint(inMax), C.gint(outMax), C.double(lb), C.double(lw), C.double(ps), C.double(pm), C.double(ph), C.double(s), C.double(m), C.double(h)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("tonelut", out)
 	}
 	return out, nil
 }
@@ -5935,7 +5981,7 @@ func vipsgenTonelutWithOptions(inMax int, outMax int, lb float64, lw float64, ps
 func vipsgenTranspose3d(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_transpose3d(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("transpose3d", out)
 	}
 	return out, nil
 }
@@ -5944,7 +5990,7 @@ func vipsgenTranspose3d(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenTranspose3dWithOptions(in *C.VipsImage, pageHeight int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_transpose3d_with_options(in, &out, C.gint(pageHeight)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("transpose3d", out)
 	}
 	return out, nil
 }
@@ -5953,7 +5999,7 @@ func vipsgenTranspose3dWithOptions(in *C.VipsImage, pageHeight int) (*C.VipsImag
 func vipsgenUhdr2scRGB(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_uhdr2scRGB(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("uhdr2scRGB", out)
 	}
 	return out, nil
 }
@@ -5962,7 +6008,7 @@ func vipsgenUhdr2scRGB(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenUnpremultiply(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_unpremultiply(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("unpremultiply", out)
 	}
 	return out, nil
 }
@@ -5971,7 +6017,7 @@ func vipsgenUnpremultiply(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenUnpremultiplyWithOptions(in *C.VipsImage, maxAlpha float64, alphaBand int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_unpremultiply_with_options(in, &out, C.double(maxAlpha), C.gint(alphaBand)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("unpremultiply", out)
 	}
 	return out, nil
 }
@@ -5982,7 +6028,7 @@ func vipsgenVipsload(filename string) (*C.VipsImage, error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_vipsload(cfilename, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("vipsload", out)
 	}
 	return out, nil
 }
@@ -5993,7 +6039,7 @@ func vipsgenVipsloadWithOptions(filename string, memory bool, access Access, fai
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_vipsload_with_options(cfilename, &out, C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("vipsload", out)
 	}
 	return out, nil
 }
@@ -6002,7 +6048,7 @@ func vipsgenVipsloadWithOptions(filename string, memory bool, access Access, fai
 func vipsgenVipsloadSource(source *C.VipsSourceCustom) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_vipsload_source(source, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("vipsload_source", out)
 	}
 	return out, nil
 }
@@ -6011,7 +6057,7 @@ func vipsgenVipsloadSource(source *C.VipsSourceCustom) (*C.VipsImage, error) {
 func vipsgenVipsloadSourceWithOptions(source *C.VipsSourceCustom, memory bool, access Access, failOn FailOn, revalidate bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_vipsload_source_with_options(source, &out, C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("vipsload_source", out)
 	}
 	return out, nil
 }
@@ -6021,7 +6067,7 @@ func vipsgenVipssave(in *C.VipsImage, filename string) (error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_vipssave(in, cfilename); err != 0 {
-		return handleVipsError()
+		return handleVipsError("vipssave")
 	}
 	return nil
 }
@@ -6040,7 +6086,7 @@ func vipsgenVipssaveWithOptions(in *C.VipsImage, filename string, keep Keep, bac
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_vipssave_with_options(in, cfilename, C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return handleVipsError()
+		return handleVipsError("vipssave")
 	}
 	return nil
 }
@@ -6049,7 +6095,7 @@ func vipsgenVipssaveWithOptions(in *C.VipsImage, filename string, keep Keep, bac
 func vipsgenVipssaveTarget(in *C.VipsImage, target *C.VipsTargetCustom) (error) {
 	
 	if err := C.vipsgen_vipssave_target(in, target); err != 0 {
-		return handleVipsError()
+		return handleVipsError("vipssave_target")
 	}
 	return nil
 }
@@ -6066,7 +6112,7 @@ func vipsgenVipssaveTargetWithOptions(in *C.VipsImage, target *C.VipsTargetCusto
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_vipssave_target_with_options(in, target, C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return handleVipsError()
+		return handleVipsError("vipssave_target")
 	}
 	return nil
 }
@@ -6077,7 +6123,7 @@ func vipsgenWebpload(filename string) (*C.VipsImage, error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_webpload(cfilename, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("webpload", out)
 	}
 	return out, nil
 }
@@ -6088,7 +6134,7 @@ func vipsgenWebploadWithOptions(filename string, page int, n int, scale float64,
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_webpload_with_options(cfilename, &out, C.gint(page), C.gint(n), C.double(scale), C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("webpload", out)
 	}
 	return out, nil
 }
@@ -6100,7 +6146,7 @@ func vipsgenWebploadBuffer(buf []byte) (*C.VipsImage, error) {
 	defer runtime.KeepAlive(src)
 	var out *C.VipsImage
 	if err := C.vipsgen_webpload_buffer(unsafe.Pointer(&src[0]), C.size_t(len(src)), &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("webpload_buffer", out)
 	}
 	return out, nil
 }
@@ -6112,7 +6158,7 @@ func vipsgenWebploadBufferWithOptions(buf []byte, page int, n int, scale float64
 	defer runtime.KeepAlive(src)
 	var out *C.VipsImage
 	if err := C.vipsgen_webpload_buffer_with_options(unsafe.Pointer(&src[0]), C.size_t(len(src)), &out, C.gint(page), C.gint(n), C.double(scale), C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("webpload_buffer", out)
 	}
 	return out, nil
 }
@@ -6121,7 +6167,7 @@ func vipsgenWebploadBufferWithOptions(buf []byte, page int, n int, scale float64
 func vipsgenWebploadSource(source *C.VipsSourceCustom) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_webpload_source(source, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("webpload_source", out)
 	}
 	return out, nil
 }
@@ -6130,7 +6176,7 @@ func vipsgenWebploadSource(source *C.VipsSourceCustom) (*C.VipsImage, error) {
 func vipsgenWebploadSourceWithOptions(source *C.VipsSourceCustom, page int, n int, scale float64, memory bool, access Access, failOn FailOn, revalidate bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_webpload_source_with_options(source, &out, C.gint(page), C.gint(n), C.double(scale), C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("webpload_source", out)
 	}
 	return out, nil
 }
@@ -6140,7 +6186,7 @@ func vipsgenWebpsave(in *C.VipsImage, filename string) (error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_webpsave(in, cfilename); err != 0 {
-		return handleVipsError()
+		return handleVipsError("webpsave")
 	}
 	return nil
 }
@@ -6159,7 +6205,7 @@ func vipsgenWebpsaveWithOptions(in *C.VipsImage, filename string, q int, lossles
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_webpsave_with_options(in, cfilename, C.gint(q), C.int(boolToInt(lossless)), C.int(boolToInt(exact)), C.VipsForeignWebpPreset(preset), C.int(boolToInt(smartSubsample)), C.int(boolToInt(nearLossless)), C.gint(alphaQ), C.int(boolToInt(minSize)), C.gint(kmin), C.gint(kmax), C.gint(effort), C.gint(targetSize), C.int(boolToInt(mixed)), C.int(boolToInt(smartDeblock)), C.gint(passes), C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return handleVipsError()
+		return handleVipsError("webpsave")
 	}
 	return nil
 }
@@ -6169,7 +6215,7 @@ func vipsgenWebpsaveBuffer(in *C.VipsImage) ([]byte, error) {
 	var buf unsafe.Pointer
 	var length C.size_t
 	if err := C.vipsgen_webpsave_buffer(in, &buf, &length); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("webpsave_buffer")
 	}
 	return bufferToBytes(buf, length), nil
 }
@@ -6188,7 +6234,7 @@ func vipsgenWebpsaveBufferWithOptions(in *C.VipsImage, q int, lossless bool, exa
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_webpsave_buffer_with_options(in, &buf, &length, C.gint(q), C.int(boolToInt(lossless)), C.int(boolToInt(exact)), C.VipsForeignWebpPreset(preset), C.int(boolToInt(smartSubsample)), C.int(boolToInt(nearLossless)), C.gint(alphaQ), C.int(boolToInt(minSize)), C.gint(kmin), C.gint(kmax), C.gint(effort), C.gint(targetSize), C.int(boolToInt(mixed)), C.int(boolToInt(smartDeblock)), C.gint(passes), C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("webpsave_buffer")
 	}
 	return bufferToBytes(buf, length), nil
 }
@@ -6197,7 +6243,7 @@ func vipsgenWebpsaveBufferWithOptions(in *C.VipsImage, q int, lossless bool, exa
 func vipsgenWebpsaveTarget(in *C.VipsImage, target *C.VipsTargetCustom) (error) {
 	
 	if err := C.vipsgen_webpsave_target(in, target); err != 0 {
-		return handleVipsError()
+		return handleVipsError("webpsave_target")
 	}
 	return nil
 }
@@ -6214,7 +6260,7 @@ func vipsgenWebpsaveTargetWithOptions(in *C.VipsImage, target *C.VipsTargetCusto
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_webpsave_target_with_options(in, target, C.gint(q), C.int(boolToInt(lossless)), C.int(boolToInt(exact)), C.VipsForeignWebpPreset(preset), C.int(boolToInt(smartSubsample)), C.int(boolToInt(nearLossless)), C.gint(alphaQ), C.int(boolToInt(minSize)), C.gint(kmin), C.gint(kmax), C.gint(effort), C.gint(targetSize), C.int(boolToInt(mixed)), C.int(boolToInt(smartDeblock)), C.gint(passes), C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return handleVipsError()
+		return handleVipsError("webpsave_target")
 	}
 	return nil
 }
@@ -6223,7 +6269,7 @@ func vipsgenWebpsaveTargetWithOptions(in *C.VipsImage, target *C.VipsTargetCusto
 func vipsgenWorley(width int, height int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_worley(&out, C.gint(width), C.gint(height)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("worley", out)
 	}
 	return out, nil
 }
@@ -6232,7 +6278,7 @@ func vipsgenWorley(width int, height int) (*C.VipsImage, error) {
 func vipsgenWorleyWithOptions(width int, height int, cellSize int, seed int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_worley_with_options(&out, C.gint(width), C.gint(height), C.gint(cellSize), C.gint(seed)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("worley", out)
 	}
 	return out, nil
 }
@@ -6241,7 +6287,7 @@ func vipsgenWorleyWithOptions(width int, height int, cellSize int, seed int) (*C
 func vipsgenWrap(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_wrap(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("wrap", out)
 	}
 	return out, nil
 }
@@ -6250,7 +6296,7 @@ func vipsgenWrap(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenWrapWithOptions(in *C.VipsImage, x int, y int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_wrap_with_options(in, &out, C.gint(x), C.gint(y)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("wrap", out)
 	}
 	return out, nil
 }
@@ -6259,7 +6305,7 @@ func vipsgenWrapWithOptions(in *C.VipsImage, x int, y int) (*C.VipsImage, error)
 func vipsgenXyz(width int, height int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_xyz(&out, C.gint(width), C.gint(height)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("xyz", out)
 	}
 	return out, nil
 }
@@ -6268,7 +6314,7 @@ func vipsgenXyz(width int, height int) (*C.VipsImage, error) {
 func vipsgenXyzWithOptions(width int, height int, csize int, dsize int, esize int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_xyz_with_options(&out, C.gint(width), C.gint(height), C.gint(csize), C.gint(dsize), C.gint(esize)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("xyz", out)
 	}
 	return out, nil
 }
@@ -6277,7 +6323,7 @@ func vipsgenXyzWithOptions(width int, height int, csize int, dsize int, esize in
 func vipsgenZone(width int, height int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_zone(&out, C.gint(width), C.gint(height)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("zone", out)
 	}
 	return out, nil
 }
@@ -6286,7 +6332,7 @@ func vipsgenZone(width int, height int) (*C.VipsImage, error) {
 func vipsgenZoneWithOptions(width int, height int, uchar bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_zone_with_options(&out, C.gint(width), C.gint(height), C.int(boolToInt(uchar))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("zone", out)
 	}
 	return out, nil
 }
@@ -6295,7 +6341,7 @@ func vipsgenZoneWithOptions(width int, height int, uchar bool) (*C.VipsImage, er
 func vipsgenZoom(input *C.VipsImage, xfac int, yfac int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_zoom(input, &out, C.gint(xfac), C.gint(yfac)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("zoom", out)
 	}
 	return out, nil
 }
@@ -6324,7 +6370,7 @@ func vipsgenImageFromSource(src *C.VipsSourceCustom, params *LoadOptions) (*C.Vi
 		code = C.vipsgen_image_new_from_source_with_option(src, &out, cOptionString)
 	}
 	if code != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("image_new_from_source", out)
 	}
 	return out, nil
 }
@@ -6350,7 +6396,7 @@ func vipsgenImageFromBuffer(buf []byte, params *LoadOptions) (*C.VipsImage, erro
 		code = C.vipsgen_image_new_from_buffer_with_option(unsafe.Pointer(&src[0]), C.size_t(len(src)), &out, cOptionString)
 	}
 	if code != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("image_new_from_buffer", out)
 	}
 	return out, nil
 }
@@ -6377,11 +6423,96 @@ func vipsgenImageFromFile(path string, params *LoadOptions) (*C.VipsImage, error
 	}
 
 	if code != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("image_new_from_file", out)
 	}
 	return out, nil
 }
 
+// vipsgenImageWriteToFile saves with the saver picked from the filename extension
+func vipsgenImageWriteToFile(in *C.VipsImage, path string, optionString string) error {
+	cPath := C.CString(path)
+	defer freeCString(cPath)
+	cOptionString := C.CString(optionString)
+	defer freeCString(cOptionString)
+	if C.vipsgen_image_write_to_file(in, cPath, cOptionString) != 0 {
+		return handleVipsError("image_write_to_file")
+	}
+	return nil
+}
+
+func vipsgenImageWriteToBuffer(in *C.VipsImage, suffix string) ([]byte, error) {
+	cSuffix := C.CString(suffix)
+	defer freeCString(cSuffix)
+	var buf unsafe.Pointer
+	var bufSize C.size_t
+	if C.vipsgen_image_write_to_buffer(in, cSuffix, &buf, &bufSize) != 0 {
+		return nil, handleVipsError("image_write_to_buffer")
+	}
+	defer C.g_free(C.gpointer(buf))
+	return C.GoBytes(buf, C.int(bufSize)), nil
+}
+
+func vipsgenGetpointMany(in *C.VipsImage, xy []C.int, bands int) ([]float64, error) {
+	n := len(xy) / 2
+	out := make([]float64, n*bands)
+	if n == 0 {
+		return out, nil
+	}
+	if C.vipsgen_getpoint_many(in, &xy[0], C.int(n), (*C.double)(unsafe.Pointer(&out[0]))) != 0 {
+		return nil, handleVipsError("getpoint_many")
+	}
+	return out, nil
+}
+
+// vipsForeignFindSave reports whether libvips has a saver for the filename extension
+func vipsForeignFindSave(path string) bool {
+	cPath := C.CString(path)
+	defer freeCString(cPath)
+	if C.vips_foreign_find_save(cPath) == nil {
+		clearVipsError()
+		return false
+	}
+	return true
+}
+
+// vipsForeignFindSaveBuffer reports whether libvips can save to memory in the format of the suffix,
+// through a target or a buffer saver as vips_image_write_to_buffer picks them
+func vipsForeignFindSaveBuffer(suffix string) bool {
+	cSuffix := C.CString(suffix)
+	defer freeCString(cSuffix)
+	if C.vips_foreign_find_save_target(cSuffix) != nil {
+		return true
+	}
+	found := C.vips_foreign_find_save_buffer(cSuffix) != nil
+	clearVipsError()
+	return found
+}
+
+// vipsForeignGetSuffixes returns the file suffixes of all libvips savers
+func vipsForeignGetSuffixes() (suffixes []string) {
+	const maxSuffixes = 1024
+	rawSuffixes := C.vips_foreign_get_suffixes()
+	defer C.g_strfreev(rawSuffixes)
+	cSuffixes := (*[maxSuffixes]*C.char)(unsafe.Pointer(rawSuffixes))[:maxSuffixes:maxSuffixes]
+	for _, suffix := range cSuffixes {
+		if suffix == nil {
+			break
+		}
+		suffixes = append(suffixes, C.GoString(suffix))
+	}
+	return
+}
+
+// vipsForeignFindLoadBuffer returns the name of the loader that recognises the buffer header
+func vipsForeignFindLoadBuffer(buf []byte) (string, error) {
+	defer runtime.KeepAlive(buf)
+	name := C.vips_foreign_find_load_buffer(unsafe.Pointer(&buf[0]), C.size_t(len(buf)))
+	if name == nil {
+		return "", handleVipsError("foreign_find_load_buffer")
+	}
+	return C.GoString(name), nil
+}
+
 // vipsgenImageFromMemory vips_image_new_memory
 func vipsgenImageFromMemory(buf []byte, width, height, bands int) (*C.VipsImage, error) {
 	src := buf
@@ -6392,7 +6523,41 @@ func vipsgenImageFromMemory(buf []byte, width, height, bands int) (*C.VipsImage,
 	var code C.int
 	code = C.vipsgen_image_new_from_memory(unsafe.Pointer(&src[0]), C.size_t(len(src)), C.int(width), C.int(height), C.int(bands), &out)
 	if code != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("image_new_from_memory", out)
+	}
+	return out, nil
+}
+
+// vipsgenImageFromMemoryLike copies buf into a new image with the header and metadata of like,
+// for pixels computed in Go from the output of vipsgenImageWriteToMemory
+func vipsgenImageFromMemoryLike(like *C.VipsImage, buf []byte) (*C.VipsImage, error) {
+	var out *C.VipsImage
+	if C.vipsgen_image_new_from_memory_like(like, unsafe.Pointer(&buf[0]), C.size_t(len(buf)), &out) != 0 {
+		return nil, handleImageError("image_new_from_memory_like", out)
+	}
+	for _, name := range vipsImageGetFields(like) {
+		if imageHeaderFields[name] {
+			continue
+		}
+		if err := vipsImageCopyField(like, out, name); err != nil {
+			clearImage(out)
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// vipsgenImageNewMatrixFromArray vips_image_new_matrix_from_array
+func vipsgenImageNewMatrixFromArray(width, height int, array []float64) (*C.VipsImage, error) {
+	cArray, size, err := convertToDoubleArray(array)
+	if err != nil {
+		return nil, err
+	}
+	defer freeDoubleArray(cArray)
+
+	var out *C.VipsImage
+	if code := C.vipsgen_image_new_matrix_from_array(C.int(width), C.int(height), cArray, size, &out); code != 0 {
+		return nil, handleImageError("image_new_matrix_from_array", out)
 	}
 	return out, nil
 }
@@ -6402,20 +6567,46 @@ func vipsgenImageWriteToMemory(in *C.VipsImage) ([]byte, error) {
 	var buf unsafe.Pointer
 	var bufSize C.size_t
 	if C.vipsgen_image_write_to_memory(in, &buf, &bufSize) != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("image_write_to_memory")
 	}
 	defer C.free(buf)
 	return C.GoBytes(buf, C.int(bufSize)), nil
 }
 
+func vipsgenImageCopyMemory(in *C.VipsImage) (*C.VipsImage, error) {
+	var out *C.VipsImage
+	if err := C.vipsgen_image_copy_memory(in, &out); err != 0 {
+		return nil, handleImageError("image_copy_memory", out)
+	}
+	return out, nil
+}
+
 func vipsHasAlpha(in *C.VipsImage) bool {
 	return int(C.vips_image_hasalpha(in)) > 0
 }
 
+func vipsFormatSizeof(format BandFormat) int {
+	return int(C.vips_format_sizeof(C.VipsBandFormat(format)))
+}
+
 func vipsIsColorSpaceSupported(in *C.VipsImage) bool {
 	return int(C.vips_colourspace_issupported(in)) != 0
 }
 
+func vipsBlendModes() []BlendMode {
+	var values [64]C.int
+	n := int(C.vipsgen_blend_modes(&values[0], C.int(len(values))))
+	modes := make([]BlendMode, 0, n)
+	for i := 0; i < n && i < len(values); i++ {
+		modes = append(modes, BlendMode(values[i]))
+	}
+	return modes
+}
+
+func vipsTrellisQuantSupported() bool {
+	return int(C.vipsgen_trellis_quant_supported()) != 0
+}
+
 func vipsImageGetFields(in *C.VipsImage) (fields []string) {
 	const maxFields = 1024
 	rawFields := C.vips_image_get_fields(in)
@@ -6436,12 +6627,27 @@ func vipsImageHasField(in *C.VipsImage, name string) bool {
 	return int(C.vips_image_get_typeof(in, cName)) != 0
 }
 
+func vipsImageGetMetaType(in *C.VipsImage, name string) MetaType {
+	cName := C.CString(name)
+	defer freeCString(cName)
+	return MetaType(C.vipsgen_image_get_meta_type(in, cName))
+}
+
 func vipsImageRemoveField(in *C.VipsImage, name string) {
 	cName := C.CString(name)
 	defer freeCString(cName)
 	C.vips_image_remove(in, cName)
 }
 
+func vipsImageCopyField(from, to *C.VipsImage, name string) error {
+	cName := C.CString(name)
+	defer freeCString(cName)
+	if C.vipsgen_image_copy_field(from, to, cName) != 0 {
+		return handleVipsError("image_copy_field")
+	}
+	return nil
+}
+
 func vipsImageSetArrayInt(in *C.VipsImage, name string, values []int) error {
 	cName := C.CString(name)
 	defer freeCString(cName)
@@ -6460,7 +6666,7 @@ func vipsImageGetArrayInt(in *C.VipsImage, name string) ([]int, error) {
 	cName := C.CString(name)
 	defer freeCString(cName)
 	if err := C.vips_image_get_array_int(in, cName, &out, &n); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("image_get_array_int")
 	}
 	result := fromCArrayInt(out, int(n))
 	// Do not free 'out' - it points to libvips-managed memory that will be
@@ -6486,7 +6692,7 @@ func vipsImageGetArrayDouble(in *C.VipsImage, name string) ([]float64, error) {
 	cName := C.CString(name)
 	defer freeCString(cName)
 	if err := C.vips_image_get_array_double(in, cName, &out, &n); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("image_get_array_double")
 	}
 	result := fromCArrayDouble(out, int(n))
 	// Do not free 'out' - it points to libvips-managed memory that will be
@@ -6494,6 +6700,36 @@ func vipsImageGetArrayDouble(in *C.VipsImage, name string) ([]float64, error) {
 	return result, nil
 }
 
+func vipsImageSetArrayImage(in *C.VipsImage, name string, images []*C.VipsImage) error {
+	cName := C.CString(name)
+	defer freeCString(cName)
+	cArray, length, err := convertToImageArray(images)
+	if err != nil {
+		return err
+	}
+	defer freeImageArray(cArray)
+	if err := C.vipsgen_image_set_array_image(in, cName, cArray, length); err != 0 {
+		return handleVipsError("image_set_array_image")
+	}
+	return nil
+}
+
+func vipsImageGetArrayImage(in *C.VipsImage, name string) ([]*C.VipsImage, error) {
+	var out **C.VipsImage
+	var n C.int
+	cName := C.CString(name)
+	defer freeCString(cName)
+	if err := C.vipsgen_image_get_array_image(in, cName, &out, &n); err != 0 {
+		return nil, handleVipsError("image_get_array_image")
+	}
+	defer gFreePointer(unsafe.Pointer(out))
+	images := make([]*C.VipsImage, int(n))
+	for i := range images {
+		images[i] = *(**C.VipsImage)(unsafe.Pointer(uintptr(unsafe.Pointer(out)) + uintptr(i)*unsafe.Sizeof((*C.VipsImage)(nil))))
+	}
+	return images, nil
+}
+
 func vipsImageSetBlob(in *C.VipsImage, name string, data []byte) {
 	cData := unsafe.Pointer(&data[0])
 	cDataLength := C.size_t(len(data))
@@ -6508,9 +6744,11 @@ func vipsImageGetBlob(in *C.VipsImage, name string) ([]byte, error) {
 	cField := C.CString(name)
 	defer freeCString(cField)
 	if int(C.vips_image_get_blob(in, cField, &bufPtr, &dataLength)) != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("image_get_blob")
 	}
-	return bufferToBytes(bufPtr, dataLength), nil
+	// Do not free bufPtr - it points to libvips-managed memory that will be
+	// automatically freed when the VipsImage is unreferenced
+	return C.GoBytes(bufPtr, C.int(dataLength)), nil
 }
 
 func vipsHasICCProfile(in *C.VipsImage) bool {
@@ -6611,7 +6849,7 @@ func vipsImageGetString(in *C.VipsImage, name string) (string, error) {
 	if int(C.vips_image_get_string(in, cField, &cFieldValue)) == 0 {
 		return C.GoString(cFieldValue), nil
 	}
-	return "", handleVipsError()
+	return "", handleVipsError("image_get_string")
 }
 
 func vipsImageGetAsString(in *C.VipsImage, name string) (string, error) {
@@ -6625,7 +6863,7 @@ func vipsImageGetAsString(in *C.VipsImage, name string) (string, error) {
 	if int(C.vips_image_get_as_string(in, cField, &cFieldValue)) == 0 {
 		return C.GoString(cFieldValue), nil
 	}
-	return "", handleVipsError()
+	return "", handleVipsError("image_get_as_string")
 }
 
 func vipsImageSetDouble(in *C.VipsImage, name string, f float64) {
@@ -6642,7 +6880,7 @@ func vipsImageGetDouble(in *C.VipsImage, name string) (float64, error) {
 	if int(C.vips_image_get_double(in, cField, &cDouble)) == 0 {
 		return float64(cDouble), nil
 	}
-	return 0, handleVipsError()
+	return 0, handleVipsError("image_get_double")
 }
 
 func vipsImageSetInt(in *C.VipsImage, name string, i int) {
@@ -6659,7 +6897,7 @@ func vipsImageGetInt(in *C.VipsImage, name string) (int, error) {
 	if int(C.vips_image_get_int(in, cField, &cInt)) == 0 {
 		return int(cInt), nil
 	}
-	return 0, handleVipsError()
+	return 0, handleVipsError("image_get_int")
 }
 
 func vipsImageGetMetaLoader(in *C.VipsImage) (string, bool) {
@@ -6677,7 +6915,7 @@ func vipsImageGetMetaLoader(in *C.VipsImage) (string, bool) {
 func vipsgenEmbedMultiPage(in *C.VipsImage, left, top, width, height int, extend Extend) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_embed_multi_page(in, &out, C.int(left), C.int(top), C.int(width), C.int(height), C.int(extend)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("embed", out)
 	}
 	return out, nil
 }
@@ -6688,7 +6926,7 @@ func vipsgenEmbedMultiPageBackground(in *C.VipsImage, left, top, width, height,
 	if err := C.vipsgen_embed_multi_page_background(in, &out, C.int(left), C.int(top), C.int(width),
 		C.int(height), C.double(backgroundColorR),
 		C.double(backgroundColorG), C.double(backgroundColorB), C.double(backgroundColorA)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("embed", out)
 	}
 	return out, nil
 }
@@ -6696,7 +6934,7 @@ func vipsgenEmbedMultiPageBackground(in *C.VipsImage, left, top, width, height,
 func vipsgenExtractAreaMultiPage(in *C.VipsImage, left, top, width, height int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_extract_area_multi_page(in, &out, C.int(left), C.int(top), C.int(width), C.int(height)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("extract_area", out)
 	}
 	return out, nil
 }
@@ -6704,15 +6942,40 @@ func vipsgenExtractAreaMultiPage(in *C.VipsImage, left, top, width, height int)
 func vipsgenRotMultiPage(in *C.VipsImage, angle Angle) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_rot_multi_page(in, &out, C.VipsAngle(angle)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("rot", out)
+	}
+	return out, nil
+}
+
+func vipsgenResizeMultiPage(in *C.VipsImage, scale, vscale float64, kernel Kernel, gap float64) (*C.VipsImage, error) {
+	var out *C.VipsImage
+	if err := C.vipsgen_resize_multi_page(in, &out, C.double(scale), C.double(vscale), C.VipsKernel(kernel), C.double(gap)); err != 0 {
+		return nil, handleImageError("resize", out)
 	}
 	return out, nil
 }
 
+func vipsSetEvalLimit(in *C.VipsImage, deadline time.Time, timeout time.Duration) {
+	var cDeadline C.gint64
+	if !deadline.IsZero() {
+		cDeadline = C.gint64(deadline.UnixMicro())
+	}
+	C.vipsgen_set_eval_limit(in, cDeadline, C.double(timeout.Seconds()))
+}
+
+// vipsSetCancel returns a function that aborts evaluation of images derived from in.
+// It must not be called once in has been freed.
+func vipsSetCancel(in *C.VipsImage) func() {
+	cancelled := C.vipsgen_set_cancel(in)
+	return func() {
+		C.vipsgen_cancel(cancelled)
+	}
+}
+
 func vipsgenRemoveExif(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_remove_exif(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("remove_exif", out)
 	}
 	return out, nil
 }
@@ -6,6 +6,10 @@
 
 // Prerequisites to build, get outputs and cleanup a vips operation
 
+#define VIPSGEN_VERSION_AT_LEAST(major, minor) \
+    (VIPS_MAJOR_VERSION > (major) || (VIPS_MAJOR_VERSION == (major) && VIPS_MINOR_VERSION >= (minor)))
+
+int vipsgen_operation_unsupported(const char *name);
 int vipsgen_operation_execute(VipsOperation *operation, ...);
 int vipsgen_operation_save_buffer(VipsOperation *operation, void** buf, size_t* len);
 int vipsgen_set_source(VipsOperation *operation, const char *name, VipsSource *value);
@@ -897,14 +901,30 @@ int vipsgen_image_new_from_source(VipsSourceCustom *source, VipsImage **out);
 int vipsgen_image_new_from_source_with_option(VipsSourceCustom *source, VipsImage **out, const char *option_string);
 int vipsgen_image_new_from_file(const char *name, VipsImage **out);
 int vipsgen_image_new_from_file_with_option(const char *name, VipsImage **out, const char *option_string);
+int vipsgen_image_write_to_file(VipsImage *in, const char *name, const char *option_string);
+int vipsgen_image_write_to_buffer(VipsImage *in, const char *suffix, void **buf, size_t *len);
+int vipsgen_getpoint_many(VipsImage *in, const int *xy, int n, double *out);
 int vipsgen_image_new_from_buffer(const void *buf, size_t len, VipsImage **out);
 int vipsgen_image_new_from_buffer_with_option(const void *buf, size_t len, VipsImage **out, const char *option_string);
 int vipsgen_image_new_from_memory(const void *buf, size_t len, int width, int height, int bands, VipsImage **out);
+int vipsgen_image_new_from_memory_like(VipsImage *like, const void *buf, size_t len, VipsImage **out);
+int vipsgen_image_new_matrix_from_array(int width, int height, const double *array, int size, VipsImage **out);
 void vipsgen_clear_image(VipsImage **image);
+void vipsgen_set_eval_limit(VipsImage *in, gint64 deadline, double timeout);
+gint *vipsgen_set_cancel(VipsImage *in);
+void vipsgen_cancel(gint *cancelled);
 
 int vipsgen_remove_exif(VipsImage *in, VipsImage **out);
 int vipsgen_embed_multi_page(VipsImage *in, VipsImage **out, int left, int top, int width, int height, int extend);
 int vipsgen_embed_multi_page_background(VipsImage *in, VipsImage **out, int left, int top, int width, int height, double r, double g, double b, double a);
 int vipsgen_extract_area_multi_page(VipsImage *in, VipsImage **out, int left, int top, int width, int height);
 int vipsgen_rot_multi_page(VipsImage *in, VipsImage **out, VipsAngle angle);
+int vipsgen_resize_multi_page(VipsImage *in, VipsImage **out, double scale, double vscale, VipsKernel kernel, double gap);
 int vipsgen_image_write_to_memory(VipsImage *in, void **buf, size_t *len);
+int vipsgen_image_copy_memory(VipsImage *in, VipsImage **out);
+int vipsgen_image_set_array_image(VipsImage *in, const char *name, VipsImage **images, int n);
+int vipsgen_image_get_array_image(VipsImage *in, const char *name, VipsImage ***out, int *n);
+int vipsgen_image_get_meta_type(VipsImage *in, const char *name);
+int vipsgen_image_copy_field(VipsImage *from, VipsImage *to, const char *name);
+int vipsgen_blend_modes(int *out, int max);
+int vipsgen_trellis_quant_supported(void);
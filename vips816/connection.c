@@ -27,15 +27,6 @@ static void go_handle_weak_notify(gpointer data, GObject *where_the_object_was)
   goHandleDelete((uintptr_t) data);
 }
 
-VipsSourceCustom * create_go_custom_source(uintptr_t handle)
-{
-  VipsSourceCustom * source_custom = vips_source_custom_new();
-  if (source_custom == NULL) return NULL;
-  g_object_weak_ref(G_OBJECT(source_custom), go_handle_weak_notify, (gpointer) handle);
-  g_signal_connect(source_custom, "read", G_CALLBACK(go_read), (gpointer) handle);
-  return source_custom;
-}
-
 VipsSourceCustom * create_go_custom_source_with_seek(uintptr_t handle)
 {
   VipsSourceCustom * source_custom = vips_source_custom_new();
@@ -16,7 +16,6 @@ static gint64 go_write(VipsTargetCustom *target_custom, void *buffer, gint64 len
 static gint64 go_target_seek(VipsTargetCustom *target_custom, gint64 offset, int whence, uintptr_t handle);
 static void go_handle_weak_notify(gpointer data, GObject *where_the_object_was);
 
-VipsSourceCustom * create_go_custom_source(uintptr_t handle);
 VipsSourceCustom * create_go_custom_source_with_seek(uintptr_t handle);
 VipsTargetCustom * create_go_custom_target(uintptr_t handle);
 VipsTargetCustom * create_go_custom_target_with_seek(uintptr_t handle);
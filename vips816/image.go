@@ -6,10 +6,20 @@ package vips
 import "C"
 
 import (
+	"context"
+	"encoding/binary"
+	"errors"
 	"fmt"
+	"io"
+	"io/fs"
+	"math"
+	"math/bits"
+	"runtime"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 )
 
 // Image contains a libvips image and manages its lifecycle.
@@ -22,7 +32,8 @@ type Image struct {
 	format ImageType
 	lock   sync.Mutex
 
-	pageHeight int // cached page height
+	pageHeight  int          // cached page height
+	loadOptions *LoadOptions // options buf was loaded with, reused to load single pages
 }
 
 
@@ -54,12 +65,20 @@ func NewAnalyzeload(filename string, options *AnalyzeloadOptions) (*Image, error
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeAnalyze, nil), nil
 	}
 	vipsImage, err := vipsgenAnalyzeload(filename)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeAnalyze, nil), nil
 }
 
@@ -100,12 +119,20 @@ func NewArrayjoin(in []*Image, options *ArrayjoinOptions) (*Image, error) {
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeUnknown, nil), nil
 	}
 	vipsImage, err := vipsgenArrayjoin(convertImagesToVipsImages(in))
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeUnknown, nil), nil
 }
 
@@ -119,6 +146,10 @@ func NewBandjoin(in []*Image) (*Image, error) {
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeUnknown, nil), nil
 }
 
@@ -145,12 +176,20 @@ func NewBandrank(in []*Image, options *BandrankOptions) (*Image, error) {
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeUnknown, nil), nil
 	}
 	vipsImage, err := vipsgenBandrank(convertImagesToVipsImages(in))
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeUnknown, nil), nil
 }
 
@@ -178,12 +217,20 @@ func NewBlack(width int, height int, options *BlackOptions) (*Image, error) {
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeUnknown, nil), nil
 	}
 	vipsImage, err := vipsgenBlack(width, height)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeUnknown, nil), nil
 }
 
@@ -202,7 +249,7 @@ type CompositeOptions struct {
 // DefaultCompositeOptions creates default value for vips_composite optional arguments
 func DefaultCompositeOptions() *CompositeOptions {
 	return &CompositeOptions{
-		CompositingSpace: Interpretation(22),
+		CompositingSpace: InterpretationSrgb,
 	}
 }
 
@@ -212,17 +259,28 @@ func DefaultCompositeOptions() *CompositeOptions {
 // The mode specifies array of VipsBlendMode to join with.
 func NewComposite(in []*Image, mode []BlendMode, options *CompositeOptions) (*Image, error) {
 	Startup(nil)
+	if err := checkBlendMode(mode...); err != nil {
+		return nil, err
+	}
 	if options != nil {
 		vipsImage, err := vipsgenCompositeWithOptions(convertImagesToVipsImages(in), mode, options.X, options.Y, options.CompositingSpace, options.Premultiplied)
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeUnknown, nil), nil
 	}
 	vipsImage, err := vipsgenComposite(convertImagesToVipsImages(in), mode)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeUnknown, nil), nil
 }
 
@@ -264,12 +322,20 @@ func NewCsvload(filename string, options *CsvloadOptions) (*Image, error) {
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeCsv, nil), nil
 	}
 	vipsImage, err := vipsgenCsvload(filename)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeCsv, nil), nil
 }
 
@@ -311,12 +377,20 @@ func NewCsvloadSource(source *Source, options *CsvloadSourceOptions) (*Image, er
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeCsv, nil), nil
 	}
 	vipsImage, err := vipsgenCsvloadSource(source.src)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeCsv, nil), nil
 }
 
@@ -346,12 +420,20 @@ func NewEye(width int, height int, options *EyeOptions) (*Image, error) {
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeUnknown, nil), nil
 	}
 	vipsImage, err := vipsgenEye(width, height)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeUnknown, nil), nil
 }
 
@@ -383,12 +465,20 @@ func NewFitsload(filename string, options *FitsloadOptions) (*Image, error) {
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeFits, nil), nil
 	}
 	vipsImage, err := vipsgenFitsload(filename)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeFits, nil), nil
 }
 
@@ -404,6 +494,10 @@ func NewFractsurf(width int, height int, fractalDimension float64) (*Image, erro
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeUnknown, nil), nil
 }
 
@@ -425,6 +519,9 @@ func DefaultGaussmatOptions() *GaussmatOptions {
 //
 // The sigma specifies sigma of Gaussian.
 // The minAmpl specifies minimum amplitude of Gaussian.
+//
+// The kernel is a matrix image with a scale that normalises it, ready to use as the mask of Conv.
+// With Separable set it is a single row for Convsep, which convolves rows then columns and is much faster.
 func NewGaussmat(sigma float64, minAmpl float64, options *GaussmatOptions) (*Image, error) {
 	Startup(nil)
 	if options != nil {
@@ -432,12 +529,20 @@ func NewGaussmat(sigma float64, minAmpl float64, options *GaussmatOptions) (*Ima
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeUnknown, nil), nil
 	}
 	vipsImage, err := vipsgenGaussmat(sigma, minAmpl)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeUnknown, nil), nil
 }
 
@@ -470,12 +575,20 @@ func NewGaussnoise(width int, height int, options *GaussnoiseOptions) (*Image, e
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeUnknown, nil), nil
 	}
 	vipsImage, err := vipsgenGaussnoise(width, height)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeUnknown, nil), nil
 }
 
@@ -512,12 +625,20 @@ func NewGifload(filename string, options *GifloadOptions) (*Image, error) {
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeGif, nil), nil
 	}
 	vipsImage, err := vipsgenGifload(filename)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeGif, nil), nil
 }
 
@@ -555,12 +676,20 @@ func NewGifloadBuffer(buf []byte, options *GifloadBufferOptions) (*Image, error)
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeGif, buf), nil
 	}
 	vipsImage, err := vipsgenGifloadBuffer(buf)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeGif, buf), nil
 }
 
@@ -597,12 +726,20 @@ func NewGifloadSource(source *Source, options *GifloadSourceOptions) (*Image, er
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeGif, nil), nil
 	}
 	vipsImage, err := vipsgenGifloadSource(source.src)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeGif, nil), nil
 }
 
@@ -629,12 +766,20 @@ func NewGrey(width int, height int, options *GreyOptions) (*Image, error) {
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeUnknown, nil), nil
 	}
 	vipsImage, err := vipsgenGrey(width, height)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeUnknown, nil), nil
 }
 
@@ -675,12 +820,20 @@ func NewHeifload(filename string, options *HeifloadOptions) (*Image, error) {
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeHeif, nil), nil
 	}
 	vipsImage, err := vipsgenHeifload(filename)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeHeif, nil), nil
 }
 
@@ -722,12 +875,20 @@ func NewHeifloadBuffer(buf []byte, options *HeifloadBufferOptions) (*Image, erro
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeHeif, buf), nil
 	}
 	vipsImage, err := vipsgenHeifloadBuffer(buf)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeHeif, buf), nil
 }
 
@@ -768,12 +929,20 @@ func NewHeifloadSource(source *Source, options *HeifloadSourceOptions) (*Image,
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeHeif, nil), nil
 	}
 	vipsImage, err := vipsgenHeifloadSource(source.src)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeHeif, nil), nil
 }
 
@@ -796,6 +965,10 @@ func DefaultIdentityOptions() *IdentityOptions {
 }
 
 // NewIdentity vips_identity make a 1D image where pixel values are indexes
+//
+// The LUT is a 256 x 1 uchar image, or Size x 1 ushort with Ushort set, where each pixel holds its x coordinate.
+// Bands defaults to 1; set it to the band count of the image to use it with Maplut, e.g. 3 for sRGB,
+// and transform its values first to build a tone curve.
 func NewIdentity(options *IdentityOptions) (*Image, error) {
 	Startup(nil)
 	if options != nil {
@@ -803,12 +976,20 @@ func NewIdentity(options *IdentityOptions) (*Image, error) {
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeUnknown, nil), nil
 	}
 	vipsImage, err := vipsgenIdentity()
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeUnknown, nil), nil
 }
 
@@ -842,12 +1023,20 @@ func NewJp2kload(filename string, options *Jp2kloadOptions) (*Image, error) {
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeJp2k, nil), nil
 	}
 	vipsImage, err := vipsgenJp2kload(filename)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeJp2k, nil), nil
 }
 
@@ -882,12 +1071,20 @@ func NewJp2kloadBuffer(buf []byte, options *Jp2kloadBufferOptions) (*Image, erro
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeJp2k, buf), nil
 	}
 	vipsImage, err := vipsgenJp2kloadBuffer(buf)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeJp2k, buf), nil
 }
 
@@ -921,12 +1118,20 @@ func NewJp2kloadSource(source *Source, options *Jp2kloadSourceOptions) (*Image,
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeJp2k, nil), nil
 	}
 	vipsImage, err := vipsgenJp2kloadSource(source.src)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeJp2k, nil), nil
 }
 
@@ -965,12 +1170,20 @@ func NewJpegload(filename string, options *JpegloadOptions) (*Image, error) {
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeJpeg, nil), nil
 	}
 	vipsImage, err := vipsgenJpegload(filename)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeJpeg, nil), nil
 }
 
@@ -1010,12 +1223,20 @@ func NewJpegloadBuffer(buf []byte, options *JpegloadBufferOptions) (*Image, erro
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeJpeg, buf), nil
 	}
 	vipsImage, err := vipsgenJpegloadBuffer(buf)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeJpeg, buf), nil
 }
 
@@ -1054,12 +1275,20 @@ func NewJpegloadSource(source *Source, options *JpegloadSourceOptions) (*Image,
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeJpeg, nil), nil
 	}
 	vipsImage, err := vipsgenJpegloadSource(source.src)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeJpeg, nil), nil
 }
 
@@ -1096,12 +1325,20 @@ func NewJxlload(filename string, options *JxlloadOptions) (*Image, error) {
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeJxl, nil), nil
 	}
 	vipsImage, err := vipsgenJxlload(filename)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeJxl, nil), nil
 }
 
@@ -1139,12 +1376,20 @@ func NewJxlloadBuffer(buf []byte, options *JxlloadBufferOptions) (*Image, error)
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeJxl, buf), nil
 	}
 	vipsImage, err := vipsgenJxlloadBuffer(buf)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeJxl, buf), nil
 }
 
@@ -1181,12 +1426,20 @@ func NewJxlloadSource(source *Source, options *JxlloadSourceOptions) (*Image, er
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeJxl, nil), nil
 	}
 	vipsImage, err := vipsgenJxlloadSource(source.src)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeJxl, nil), nil
 }
 
@@ -1208,6 +1461,9 @@ func DefaultLogmatOptions() *LogmatOptions {
 //
 // The sigma specifies radius of Gaussian.
 // The minAmpl specifies minimum amplitude of Gaussian.
+//
+// The kernel is a Laplacian of Gaussian matrix image with a scale, for edge detection with Conv.
+// With Separable set it is a single row for Convsep.
 func NewLogmat(sigma float64, minAmpl float64, options *LogmatOptions) (*Image, error) {
 	Startup(nil)
 	if options != nil {
@@ -1215,12 +1471,20 @@ func NewLogmat(sigma float64, minAmpl float64, options *LogmatOptions) (*Image,
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeUnknown, nil), nil
 	}
 	vipsImage, err := vipsgenLogmat(sigma, minAmpl)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeUnknown, nil), nil
 }
 
@@ -1259,12 +1523,20 @@ func NewMagickload(filename string, options *MagickloadOptions) (*Image, error)
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeMagick, nil), nil
 	}
 	vipsImage, err := vipsgenMagickload(filename)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeMagick, nil), nil
 }
 
@@ -1304,12 +1576,20 @@ func NewMagickloadBuffer(buf []byte, options *MagickloadBufferOptions) (*Image,
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeMagick, buf), nil
 	}
 	vipsImage, err := vipsgenMagickloadBuffer(buf)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeMagick, buf), nil
 }
 
@@ -1340,17 +1620,28 @@ func DefaultMaskButterworthOptions() *MaskButterworthOptions {
 // The amplitudeCutoff specifies amplitude cutoff.
 func NewMaskButterworth(width int, height int, order float64, frequencyCutoff float64, amplitudeCutoff float64, options *MaskButterworthOptions) (*Image, error) {
 	Startup(nil)
+	if frequencyCutoff < 0 || frequencyCutoff > 1 {
+		return nil, fmt.Errorf("mask_butterworth: frequencyCutoff %g is outside the range 0 to 1", frequencyCutoff)
+	}
 	if options != nil {
 		vipsImage, err := vipsgenMaskButterworthWithOptions(width, height, order, frequencyCutoff, amplitudeCutoff, options.Uchar, options.Nodc, options.Reject, options.Optical)
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeUnknown, nil), nil
 	}
 	vipsImage, err := vipsgenMaskButterworth(width, height, order, frequencyCutoff, amplitudeCutoff)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeUnknown, nil), nil
 }
 
@@ -1383,17 +1674,31 @@ func DefaultMaskButterworthBandOptions() *MaskButterworthBandOptions {
 // The amplitudeCutoff specifies amplitude cutoff.
 func NewMaskButterworthBand(width int, height int, order float64, frequencyCutoffX float64, frequencyCutoffY float64, radius float64, amplitudeCutoff float64, options *MaskButterworthBandOptions) (*Image, error) {
 	Startup(nil)
+	if frequencyCutoffX < 0 || frequencyCutoffX > 1 {
+		return nil, fmt.Errorf("mask_butterworth_band: frequencyCutoffX %g is outside the range 0 to 1", frequencyCutoffX)
+	}
+	if frequencyCutoffY < 0 || frequencyCutoffY > 1 {
+		return nil, fmt.Errorf("mask_butterworth_band: frequencyCutoffY %g is outside the range 0 to 1", frequencyCutoffY)
+	}
 	if options != nil {
 		vipsImage, err := vipsgenMaskButterworthBandWithOptions(width, height, order, frequencyCutoffX, frequencyCutoffY, radius, amplitudeCutoff, options.Uchar, options.Nodc, options.Reject, options.Optical)
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeUnknown, nil), nil
 	}
 	vipsImage, err := vipsgenMaskButterworthBand(width, height, order, frequencyCutoffX, frequencyCutoffY, radius, amplitudeCutoff)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeUnknown, nil), nil
 }
 
@@ -1425,17 +1730,28 @@ func DefaultMaskButterworthRingOptions() *MaskButterworthRingOptions {
 // The ringwidth specifies ringwidth.
 func NewMaskButterworthRing(width int, height int, order float64, frequencyCutoff float64, amplitudeCutoff float64, ringwidth float64, options *MaskButterworthRingOptions) (*Image, error) {
 	Startup(nil)
+	if frequencyCutoff < 0 || frequencyCutoff > 1 {
+		return nil, fmt.Errorf("mask_butterworth_ring: frequencyCutoff %g is outside the range 0 to 1", frequencyCutoff)
+	}
 	if options != nil {
 		vipsImage, err := vipsgenMaskButterworthRingWithOptions(width, height, order, frequencyCutoff, amplitudeCutoff, ringwidth, options.Uchar, options.Nodc, options.Reject, options.Optical)
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeUnknown, nil), nil
 	}
 	vipsImage, err := vipsgenMaskButterworthRing(width, height, order, frequencyCutoff, amplitudeCutoff, ringwidth)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeUnknown, nil), nil
 }
 
@@ -1469,12 +1785,20 @@ func NewMaskFractal(width int, height int, fractalDimension float64, options *Ma
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeUnknown, nil), nil
 	}
 	vipsImage, err := vipsgenMaskFractal(width, height, fractalDimension)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeUnknown, nil), nil
 }
 
@@ -1504,17 +1828,28 @@ func DefaultMaskGaussianOptions() *MaskGaussianOptions {
 // The amplitudeCutoff specifies amplitude cutoff.
 func NewMaskGaussian(width int, height int, frequencyCutoff float64, amplitudeCutoff float64, options *MaskGaussianOptions) (*Image, error) {
 	Startup(nil)
+	if frequencyCutoff < 0 || frequencyCutoff > 1 {
+		return nil, fmt.Errorf("mask_gaussian: frequencyCutoff %g is outside the range 0 to 1", frequencyCutoff)
+	}
 	if options != nil {
 		vipsImage, err := vipsgenMaskGaussianWithOptions(width, height, frequencyCutoff, amplitudeCutoff, options.Uchar, options.Nodc, options.Reject, options.Optical)
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeUnknown, nil), nil
 	}
 	vipsImage, err := vipsgenMaskGaussian(width, height, frequencyCutoff, amplitudeCutoff)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeUnknown, nil), nil
 }
 
@@ -1546,17 +1881,31 @@ func DefaultMaskGaussianBandOptions() *MaskGaussianBandOptions {
 // The amplitudeCutoff specifies amplitude cutoff.
 func NewMaskGaussianBand(width int, height int, frequencyCutoffX float64, frequencyCutoffY float64, radius float64, amplitudeCutoff float64, options *MaskGaussianBandOptions) (*Image, error) {
 	Startup(nil)
+	if frequencyCutoffX < 0 || frequencyCutoffX > 1 {
+		return nil, fmt.Errorf("mask_gaussian_band: frequencyCutoffX %g is outside the range 0 to 1", frequencyCutoffX)
+	}
+	if frequencyCutoffY < 0 || frequencyCutoffY > 1 {
+		return nil, fmt.Errorf("mask_gaussian_band: frequencyCutoffY %g is outside the range 0 to 1", frequencyCutoffY)
+	}
 	if options != nil {
 		vipsImage, err := vipsgenMaskGaussianBandWithOptions(width, height, frequencyCutoffX, frequencyCutoffY, radius, amplitudeCutoff, options.Uchar, options.Nodc, options.Reject, options.Optical)
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeUnknown, nil), nil
 	}
 	vipsImage, err := vipsgenMaskGaussianBand(width, height, frequencyCutoffX, frequencyCutoffY, radius, amplitudeCutoff)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeUnknown, nil), nil
 }
 
@@ -1587,17 +1936,28 @@ func DefaultMaskGaussianRingOptions() *MaskGaussianRingOptions {
 // The ringwidth specifies ringwidth.
 func NewMaskGaussianRing(width int, height int, frequencyCutoff float64, amplitudeCutoff float64, ringwidth float64, options *MaskGaussianRingOptions) (*Image, error) {
 	Startup(nil)
+	if frequencyCutoff < 0 || frequencyCutoff > 1 {
+		return nil, fmt.Errorf("mask_gaussian_ring: frequencyCutoff %g is outside the range 0 to 1", frequencyCutoff)
+	}
 	if options != nil {
 		vipsImage, err := vipsgenMaskGaussianRingWithOptions(width, height, frequencyCutoff, amplitudeCutoff, ringwidth, options.Uchar, options.Nodc, options.Reject, options.Optical)
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeUnknown, nil), nil
 	}
 	vipsImage, err := vipsgenMaskGaussianRing(width, height, frequencyCutoff, amplitudeCutoff, ringwidth)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeUnknown, nil), nil
 }
 
@@ -1626,17 +1986,28 @@ func DefaultMaskIdealOptions() *MaskIdealOptions {
 // The frequencyCutoff specifies frequency cutoff.
 func NewMaskIdeal(width int, height int, frequencyCutoff float64, options *MaskIdealOptions) (*Image, error) {
 	Startup(nil)
+	if frequencyCutoff < 0 || frequencyCutoff > 1 {
+		return nil, fmt.Errorf("mask_ideal: frequencyCutoff %g is outside the range 0 to 1", frequencyCutoff)
+	}
 	if options != nil {
 		vipsImage, err := vipsgenMaskIdealWithOptions(width, height, frequencyCutoff, options.Uchar, options.Nodc, options.Reject, options.Optical)
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeUnknown, nil), nil
 	}
 	vipsImage, err := vipsgenMaskIdeal(width, height, frequencyCutoff)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeUnknown, nil), nil
 }
 
@@ -1667,17 +2038,31 @@ func DefaultMaskIdealBandOptions() *MaskIdealBandOptions {
 // The radius specifies radius of circle.
 func NewMaskIdealBand(width int, height int, frequencyCutoffX float64, frequencyCutoffY float64, radius float64, options *MaskIdealBandOptions) (*Image, error) {
 	Startup(nil)
+	if frequencyCutoffX < 0 || frequencyCutoffX > 1 {
+		return nil, fmt.Errorf("mask_ideal_band: frequencyCutoffX %g is outside the range 0 to 1", frequencyCutoffX)
+	}
+	if frequencyCutoffY < 0 || frequencyCutoffY > 1 {
+		return nil, fmt.Errorf("mask_ideal_band: frequencyCutoffY %g is outside the range 0 to 1", frequencyCutoffY)
+	}
 	if options != nil {
 		vipsImage, err := vipsgenMaskIdealBandWithOptions(width, height, frequencyCutoffX, frequencyCutoffY, radius, options.Uchar, options.Nodc, options.Reject, options.Optical)
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeUnknown, nil), nil
 	}
 	vipsImage, err := vipsgenMaskIdealBand(width, height, frequencyCutoffX, frequencyCutoffY, radius)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeUnknown, nil), nil
 }
 
@@ -1707,17 +2092,28 @@ func DefaultMaskIdealRingOptions() *MaskIdealRingOptions {
 // The ringwidth specifies ringwidth.
 func NewMaskIdealRing(width int, height int, frequencyCutoff float64, ringwidth float64, options *MaskIdealRingOptions) (*Image, error) {
 	Startup(nil)
+	if frequencyCutoff < 0 || frequencyCutoff > 1 {
+		return nil, fmt.Errorf("mask_ideal_ring: frequencyCutoff %g is outside the range 0 to 1", frequencyCutoff)
+	}
 	if options != nil {
 		vipsImage, err := vipsgenMaskIdealRingWithOptions(width, height, frequencyCutoff, ringwidth, options.Uchar, options.Nodc, options.Reject, options.Optical)
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeUnknown, nil), nil
 	}
 	vipsImage, err := vipsgenMaskIdealRing(width, height, frequencyCutoff, ringwidth)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeUnknown, nil), nil
 }
 
@@ -1749,12 +2145,20 @@ func NewMatload(filename string, options *MatloadOptions) (*Image, error) {
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeMat, nil), nil
 	}
 	vipsImage, err := vipsgenMatload(filename)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeMat, nil), nil
 }
 
@@ -1786,12 +2190,20 @@ func NewMatrixload(filename string, options *MatrixloadOptions) (*Image, error)
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeMatrix, nil), nil
 	}
 	vipsImage, err := vipsgenMatrixload(filename)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeMatrix, nil), nil
 }
 
@@ -1823,12 +2235,20 @@ func NewMatrixloadSource(source *Source, options *MatrixloadSourceOptions) (*Ima
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeMatrix, nil), nil
 	}
 	vipsImage, err := vipsgenMatrixloadSource(source.src)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeMatrix, nil), nil
 }
 
@@ -1860,12 +2280,20 @@ func NewNiftiload(filename string, options *NiftiloadOptions) (*Image, error) {
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeUnknown, nil), nil
 	}
 	vipsImage, err := vipsgenNiftiload(filename)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeUnknown, nil), nil
 }
 
@@ -1897,12 +2325,20 @@ func NewNiftiloadSource(source *Source, options *NiftiloadSourceOptions) (*Image
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeUnknown, nil), nil
 	}
 	vipsImage, err := vipsgenNiftiloadSource(source.src)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeUnknown, nil), nil
 }
 
@@ -1934,12 +2370,20 @@ func NewOpenexrload(filename string, options *OpenexrloadOptions) (*Image, error
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeOpenexr, nil), nil
 	}
 	vipsImage, err := vipsgenOpenexrload(filename)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeOpenexr, nil), nil
 }
 
@@ -1981,12 +2425,20 @@ func NewOpenslideload(filename string, options *OpenslideloadOptions) (*Image, e
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeOpenslide, nil), nil
 	}
 	vipsImage, err := vipsgenOpenslideload(filename)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeOpenslide, nil), nil
 }
 
@@ -2028,12 +2480,20 @@ func NewOpenslideloadSource(source *Source, options *OpenslideloadSourceOptions)
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeOpenslide, nil), nil
 	}
 	vipsImage, err := vipsgenOpenslideloadSource(source.src)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeOpenslide, nil), nil
 }
 
@@ -2080,12 +2540,20 @@ func NewPdfload(filename string, options *PdfloadOptions) (*Image, error) {
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypePdf, nil), nil
 	}
 	vipsImage, err := vipsgenPdfload(filename)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypePdf, nil), nil
 }
 
@@ -2133,12 +2601,20 @@ func NewPdfloadBuffer(buf []byte, options *PdfloadBufferOptions) (*Image, error)
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypePdf, buf), nil
 	}
 	vipsImage, err := vipsgenPdfloadBuffer(buf)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypePdf, buf), nil
 }
 
@@ -2185,12 +2661,20 @@ func NewPdfloadSource(source *Source, options *PdfloadSourceOptions) (*Image, er
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypePdf, nil), nil
 	}
 	vipsImage, err := vipsgenPdfloadSource(source.src)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypePdf, nil), nil
 }
 
@@ -2222,12 +2706,20 @@ func NewPerlin(width int, height int, options *PerlinOptions) (*Image, error) {
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeUnknown, nil), nil
 	}
 	vipsImage, err := vipsgenPerlin(width, height)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeUnknown, nil), nil
 }
 
@@ -2261,12 +2753,20 @@ func NewPngload(filename string, options *PngloadOptions) (*Image, error) {
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypePng, nil), nil
 	}
 	vipsImage, err := vipsgenPngload(filename)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypePng, nil), nil
 }
 
@@ -2301,12 +2801,20 @@ func NewPngloadBuffer(buf []byte, options *PngloadBufferOptions) (*Image, error)
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypePng, buf), nil
 	}
 	vipsImage, err := vipsgenPngloadBuffer(buf)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypePng, buf), nil
 }
 
@@ -2340,12 +2848,20 @@ func NewPngloadSource(source *Source, options *PngloadSourceOptions) (*Image, er
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypePng, nil), nil
 	}
 	vipsImage, err := vipsgenPngloadSource(source.src)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypePng, nil), nil
 }
 
@@ -2377,12 +2893,20 @@ func NewPpmload(filename string, options *PpmloadOptions) (*Image, error) {
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypePpm, nil), nil
 	}
 	vipsImage, err := vipsgenPpmload(filename)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypePpm, nil), nil
 }
 
@@ -2414,12 +2938,20 @@ func NewPpmloadSource(source *Source, options *PpmloadSourceOptions) (*Image, er
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypePpm, nil), nil
 	}
 	vipsImage, err := vipsgenPpmloadSource(source.src)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypePpm, nil), nil
 }
 
@@ -2451,13 +2983,21 @@ func NewRadload(filename string, options *RadloadOptions) (*Image, error) {
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeRad, nil), nil
 	}
 	vipsImage, err := vipsgenRadload(filename)
 	if err != nil {
 		return nil, err
 	}
-	return newImageRef(vipsImage, ImageTypeRad, nil), nil
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
+	return newImageRef(vipsImage, ImageTypeRad, nil), nil
 }
 
 // RadloadBufferOptions optional arguments for vips_radload_buffer
@@ -2489,12 +3029,20 @@ func NewRadloadBuffer(buf []byte, options *RadloadBufferOptions) (*Image, error)
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeRad, buf), nil
 	}
 	vipsImage, err := vipsgenRadloadBuffer(buf)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeRad, buf), nil
 }
 
@@ -2526,12 +3074,20 @@ func NewRadloadSource(source *Source, options *RadloadSourceOptions) (*Image, er
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeRad, nil), nil
 	}
 	vipsImage, err := vipsgenRadloadSource(source.src)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeRad, nil), nil
 }
 
@@ -2572,12 +3128,20 @@ func NewRawload(filename string, width int, height int, bands int, options *Rawl
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeRaw, nil), nil
 	}
 	vipsImage, err := vipsgenRawload(filename, width, height, bands)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeRaw, nil), nil
 }
 
@@ -2612,12 +3176,20 @@ func NewSdf(width int, height int, shape SdfShape, options *SdfOptions) (*Image,
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeUnknown, nil), nil
 	}
 	vipsImage, err := vipsgenSdf(width, height, shape)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeUnknown, nil), nil
 }
 
@@ -2650,12 +3222,20 @@ func NewSines(width int, height int, options *SinesOptions) (*Image, error) {
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeUnknown, nil), nil
 	}
 	vipsImage, err := vipsgenSines(width, height)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeUnknown, nil), nil
 }
 
@@ -2669,6 +3249,10 @@ func NewSum(in []*Image) (*Image, error) {
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeUnknown, nil), nil
 }
 
@@ -2708,12 +3292,20 @@ func NewSvgload(filename string, options *SvgloadOptions) (*Image, error) {
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeSvg, nil), nil
 	}
 	vipsImage, err := vipsgenSvgload(filename)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeSvg, nil), nil
 }
 
@@ -2754,12 +3346,20 @@ func NewSvgloadBuffer(buf []byte, options *SvgloadBufferOptions) (*Image, error)
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeSvg, buf), nil
 	}
 	vipsImage, err := vipsgenSvgloadBuffer(buf)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeSvg, buf), nil
 }
 
@@ -2799,12 +3399,20 @@ func NewSvgloadSource(source *Source, options *SvgloadSourceOptions) (*Image, er
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeSvg, nil), nil
 	}
 	vipsImage, err := vipsgenSvgloadSource(source.src)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeSvg, nil), nil
 }
 
@@ -2818,6 +3426,10 @@ func NewSwitch(tests []*Image) (*Image, error) {
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeUnknown, nil), nil
 }
 
@@ -2847,12 +3459,20 @@ func NewSystem(cmdFormat string, options *SystemOptions) (*Image, error) {
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeUnknown, nil), nil
 	}
 	vipsImage, err := vipsgenSystem(cmdFormat)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeUnknown, nil), nil
 }
 
@@ -2899,12 +3519,20 @@ func NewText(text string, options *TextOptions) (*Image, error) {
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeUnknown, nil), nil
 	}
 	vipsImage, err := vipsgenText(text)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeUnknown, nil), nil
 }
 
@@ -2914,7 +3542,7 @@ type ThumbnailOptions struct {
 	Height int
 	// Size Only upsize, only downsize, or both
 	Size Size
-	// NoRotate Don't use orientation tags to rotate image upright
+	// NoRotate Don't use orientation tags to rotate image upright. Width, Height and Size apply to the upright image, or to the stored pixel layout when NoRotate is set
 	NoRotate bool
 	// Crop Reduce to fill target rectangle, then crop
 	Crop Interesting
@@ -2934,7 +3562,7 @@ type ThumbnailOptions struct {
 func DefaultThumbnailOptions() *ThumbnailOptions {
 	return &ThumbnailOptions{
 		Height: 1,
-		Intent: Intent(1),
+		Intent: IntentRelative,
 	}
 }
 
@@ -2949,12 +3577,20 @@ func NewThumbnail(filename string, width int, options *ThumbnailOptions) (*Image
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, vipsDetermineImageType(vipsImage), nil), nil
 	}
 	vipsImage, err := vipsgenThumbnail(filename, width)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, vipsDetermineImageType(vipsImage), nil), nil
 }
 
@@ -2966,7 +3602,7 @@ type ThumbnailBufferOptions struct {
 	Height int
 	// Size Only upsize, only downsize, or both
 	Size Size
-	// NoRotate Don't use orientation tags to rotate image upright
+	// NoRotate Don't use orientation tags to rotate image upright. Width, Height and Size apply to the upright image, or to the stored pixel layout when NoRotate is set
 	NoRotate bool
 	// Crop Reduce to fill target rectangle, then crop
 	Crop Interesting
@@ -2986,7 +3622,7 @@ type ThumbnailBufferOptions struct {
 func DefaultThumbnailBufferOptions() *ThumbnailBufferOptions {
 	return &ThumbnailBufferOptions{
 		Height: 1,
-		Intent: Intent(1),
+		Intent: IntentRelative,
 	}
 }
 
@@ -3003,12 +3639,20 @@ func NewThumbnailBuffer(buf []byte, width int, options *ThumbnailBufferOptions)
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, vipsDetermineImageType(vipsImage), buf), nil
 	}
 	vipsImage, err := vipsgenThumbnailBuffer(buf, width)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, vipsDetermineImageType(vipsImage), buf), nil
 }
 
@@ -3020,7 +3664,7 @@ type ThumbnailSourceOptions struct {
 	Height int
 	// Size Only upsize, only downsize, or both
 	Size Size
-	// NoRotate Don't use orientation tags to rotate image upright
+	// NoRotate Don't use orientation tags to rotate image upright. Width, Height and Size apply to the upright image, or to the stored pixel layout when NoRotate is set
 	NoRotate bool
 	// Crop Reduce to fill target rectangle, then crop
 	Crop Interesting
@@ -3040,7 +3684,7 @@ type ThumbnailSourceOptions struct {
 func DefaultThumbnailSourceOptions() *ThumbnailSourceOptions {
 	return &ThumbnailSourceOptions{
 		Height: 1,
-		Intent: Intent(1),
+		Intent: IntentRelative,
 	}
 }
 
@@ -3055,12 +3699,20 @@ func NewThumbnailSource(source *Source, width int, options *ThumbnailSourceOptio
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, vipsDetermineImageType(vipsImage), nil), nil
 	}
 	vipsImage, err := vipsgenThumbnailSource(source.src, width)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, vipsDetermineImageType(vipsImage), nil), nil
 }
 
@@ -3102,12 +3754,20 @@ func NewTiffload(filename string, options *TiffloadOptions) (*Image, error) {
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeTiff, nil), nil
 	}
 	vipsImage, err := vipsgenTiffload(filename)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeTiff, nil), nil
 }
 
@@ -3150,12 +3810,20 @@ func NewTiffloadBuffer(buf []byte, options *TiffloadBufferOptions) (*Image, erro
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeTiff, buf), nil
 	}
 	vipsImage, err := vipsgenTiffloadBuffer(buf)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeTiff, buf), nil
 }
 
@@ -3197,12 +3865,20 @@ func NewTiffloadSource(source *Source, options *TiffloadSourceOptions) (*Image,
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeTiff, nil), nil
 	}
 	vipsImage, err := vipsgenTiffloadSource(source.src)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeTiff, nil), nil
 }
 
@@ -3250,12 +3926,20 @@ func NewTonelut(options *TonelutOptions) (*Image, error) {
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeUnknown, nil), nil
 	}
 	vipsImage, err := vipsgenTonelut()
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeUnknown, nil), nil
 }
 
@@ -3287,12 +3971,20 @@ func NewVipsload(filename string, options *VipsloadOptions) (*Image, error) {
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeVips, nil), nil
 	}
 	vipsImage, err := vipsgenVipsload(filename)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeVips, nil), nil
 }
 
@@ -3324,12 +4016,20 @@ func NewVipsloadSource(source *Source, options *VipsloadSourceOptions) (*Image,
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeVips, nil), nil
 	}
 	vipsImage, err := vipsgenVipsloadSource(source.src)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeVips, nil), nil
 }
 
@@ -3369,12 +4069,20 @@ func NewWebpload(filename string, options *WebploadOptions) (*Image, error) {
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeWebp, nil), nil
 	}
 	vipsImage, err := vipsgenWebpload(filename)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeWebp, nil), nil
 }
 
@@ -3415,12 +4123,20 @@ func NewWebploadBuffer(buf []byte, options *WebploadBufferOptions) (*Image, erro
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeWebp, buf), nil
 	}
 	vipsImage, err := vipsgenWebploadBuffer(buf)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeWebp, buf), nil
 }
 
@@ -3460,12 +4176,20 @@ func NewWebploadSource(source *Source, options *WebploadSourceOptions) (*Image,
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeWebp, nil), nil
 	}
 	vipsImage, err := vipsgenWebploadSource(source.src)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeWebp, nil), nil
 }
 
@@ -3495,12 +4219,20 @@ func NewWorley(width int, height int, options *WorleyOptions) (*Image, error) {
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeUnknown, nil), nil
 	}
 	vipsImage, err := vipsgenWorley(width, height)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeUnknown, nil), nil
 }
 
@@ -3534,12 +4266,20 @@ func NewXyz(width int, height int, options *XyzOptions) (*Image, error) {
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeUnknown, nil), nil
 	}
 	vipsImage, err := vipsgenXyz(width, height)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeUnknown, nil), nil
 }
 
@@ -3566,16 +4306,46 @@ func NewZone(width int, height int, options *ZoneOptions) (*Image, error) {
 		if err != nil {
 			return nil, err
 		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
 		return newImageRef(vipsImage, ImageTypeUnknown, nil), nil
 	}
 	vipsImage, err := vipsgenZone(width, height)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeUnknown, nil), nil
 }
 
 
+// CommonSaveOptions optional arguments shared by all savers, embedded in the options of each saver
+type CommonSaveOptions struct {
+	// Keep Which metadata to retain
+	Keep Keep
+	// Background Background value
+	Background []float64
+	// PageHeight Set page height for multipage save
+	PageHeight int
+	// Profile Filename of ICC profile to embed
+	Profile string
+}
+
+// SaveOptions is implemented by the options of every saver through the embedded CommonSaveOptions
+type SaveOptions interface {
+	CommonOptions() *CommonSaveOptions
+}
+
+// CommonOptions returns the optional arguments shared by all savers, e.g. to set Keep on the options of any saver
+func (o *CommonSaveOptions) CommonOptions() *CommonSaveOptions {
+	return o
+}
+
 
 
 // CMC2LCh vips_CMC2LCh transform LCh to CMC
@@ -3861,7 +4631,7 @@ func (r *Image) Addalpha() (error) {
 
 // AffineOptions optional arguments for vips_affine
 type AffineOptions struct {
-	// Interpolate Interpolate pixels with this
+	// Interpolate Interpolate pixels with this, e.g. NewInterpolate(InterpolateBicubic). Nil uses bilinear interpolation
 	Interpolate *Interpolate
 	// Oarea Area of output to generate
 	Oarea []int
@@ -3884,7 +4654,7 @@ type AffineOptions struct {
 // DefaultAffineOptions creates default value for vips_affine optional arguments
 func DefaultAffineOptions() *AffineOptions {
 	return &AffineOptions{
-		Extend: Extend(5),
+		Extend: ExtendBackground,
 	}
 }
 
@@ -4110,7 +4880,7 @@ type CannyOptions struct {
 func DefaultCannyOptions() *CannyOptions {
 	return &CannyOptions{
 		Sigma: 1.4,
-		Precision: Precision(1),
+		Precision: PrecisionFloat,
 	}
 }
 
@@ -4218,7 +4988,7 @@ type ColourspaceOptions struct {
 // DefaultColourspaceOptions creates default value for vips_colourspace optional arguments
 func DefaultColourspaceOptions() *ColourspaceOptions {
 	return &ColourspaceOptions{
-		SourceSpace: Interpretation(22),
+		SourceSpace: InterpretationSrgb,
 	}
 }
 
@@ -4263,7 +5033,7 @@ func DefaultCompassOptions() *CompassOptions {
 	return &CompassOptions{
 		Times: 2,
 		Angle: Angle45(2),
-		Precision: Precision(1),
+		Precision: PrecisionFloat,
 		Layers: 5,
 		Cluster: 1,
 	}
@@ -4357,7 +5127,7 @@ type Composite2Options struct {
 // DefaultComposite2Options creates default value for vips_composite2 optional arguments
 func DefaultComposite2Options() *Composite2Options {
 	return &Composite2Options{
-		CompositingSpace: Interpretation(22),
+		CompositingSpace: InterpretationSrgb,
 	}
 }
 
@@ -4366,6 +5136,9 @@ func DefaultComposite2Options() *Composite2Options {
 // The overlay specifies overlay image.
 // The mode specifies vipsBlendMode to join with.
 func (r *Image) Composite2(overlay *Image, mode BlendMode, options *Composite2Options) (error) {
+	if err := checkBlendMode(mode); err != nil {
+		return err
+	}
 	if options != nil {
 		out, err := vipsgenComposite2WithOptions(r.image, overlay.image, mode, options.X, options.Y, options.CompositingSpace, options.Premultiplied)
 		if err != nil {
@@ -4395,7 +5168,7 @@ type ConvOptions struct {
 // DefaultConvOptions creates default value for vips_conv optional arguments
 func DefaultConvOptions() *ConvOptions {
 	return &ConvOptions{
-		Precision: Precision(1),
+		Precision: PrecisionFloat,
 		Layers: 5,
 		Cluster: 1,
 	}
@@ -4529,7 +5302,7 @@ type ConvsepOptions struct {
 // DefaultConvsepOptions creates default value for vips_convsep optional arguments
 func DefaultConvsepOptions() *ConvsepOptions {
 	return &ConvsepOptions{
-		Precision: Precision(1),
+		Precision: PrecisionFloat,
 		Layers: 5,
 		Cluster: 1,
 	}
@@ -4563,7 +5336,7 @@ type CopyOptions struct {
 	Height int
 	// Bands Number of bands in image
 	Bands int
-	// Format Pixel format in image
+	// Format Pixel format in image. Zero, which is BandFormatUchar, leaves the format unchanged
 	Format BandFormat
 	// Coding Pixel coding
 	Coding Coding
@@ -4586,6 +5359,9 @@ func DefaultCopyOptions() *CopyOptions {
 }
 
 // Copy vips_copy copy an image
+//
+// Options relabel the image header without touching the pixels, e.g. to fix a wrong Interpretation
+// or resolution. Bands and Format must keep the same bytes per pixel, otherwise libvips returns an error.
 func (r *Image) Copy(options *CopyOptions) (*Image, error) {
 	if options != nil {
 		out, err := vipsgenCopyWithOptions(r.image, options.Width, options.Height, options.Bands, options.Format, options.Coding, options.Interpretation, options.Xres, options.Yres, options.Xoffset, options.Yoffset)
@@ -4619,14 +5395,8 @@ func (r *Image) Countlines(direction Direction) (float64, error) {
 type CsvsaveOptions struct {
 	// Separator Separator characters
 	Separator string
-	// Keep Which metadata to retain
-	Keep Keep
-	// Background Background value
-	Background []float64
-	// PageHeight Set page height for multipage save
-	PageHeight int
-	// Profile Filename of ICC profile to embed
-	Profile string
+	// CommonSaveOptions optional arguments shared by all savers
+	CommonSaveOptions
 }
 
 // DefaultCsvsaveOptions creates default value for vips_csvsave optional arguments
@@ -4658,14 +5428,8 @@ func (r *Image) Csvsave(filename string, options *CsvsaveOptions) (error) {
 type CsvsaveTargetOptions struct {
 	// Separator Separator characters
 	Separator string
-	// Keep Which metadata to retain
-	Keep Keep
-	// Background Background value
-	Background []float64
-	// PageHeight Set page height for multipage save
-	PageHeight int
-	// Profile Filename of ICC profile to embed
-	Profile string
+	// CommonSaveOptions optional arguments shared by all savers
+	CommonSaveOptions
 }
 
 // DefaultCsvsaveTargetOptions creates default value for vips_csvsave_target optional arguments
@@ -4972,14 +5736,8 @@ type DzsaveOptions struct {
 	Id string
 	// Q Q factor
 	Q int
-	// Keep Which metadata to retain
-	Keep Keep
-	// Background Background value
-	Background []float64
-	// PageHeight Set page height for multipage save
-	PageHeight int
-	// Profile Filename of ICC profile to embed
-	Profile string
+	// CommonSaveOptions optional arguments shared by all savers
+	CommonSaveOptions
 }
 
 // DefaultDzsaveOptions creates default value for vips_dzsave optional arguments
@@ -5030,7 +5788,7 @@ type DzsaveBufferOptions struct {
 	Depth DzDepth
 	// Angle Rotate image during save
 	Angle Angle
-	// Container Pyramid container type
+	// Container Pyramid container type. Writing to a buffer or target needs an archive, so the default Fs container is saved as a zip
 	Container DzContainer
 	// Compression ZIP deflate compression level
 	Compression int
@@ -5042,14 +5800,8 @@ type DzsaveBufferOptions struct {
 	Id string
 	// Q Q factor
 	Q int
-	// Keep Which metadata to retain
-	Keep Keep
-	// Background Background value
-	Background []float64
-	// PageHeight Set page height for multipage save
-	PageHeight int
-	// Profile Filename of ICC profile to embed
-	Profile string
+	// CommonSaveOptions optional arguments shared by all savers
+	CommonSaveOptions
 }
 
 // DefaultDzsaveBufferOptions creates default value for vips_dzsave_buffer optional arguments
@@ -5098,7 +5850,7 @@ type DzsaveTargetOptions struct {
 	Depth DzDepth
 	// Angle Rotate image during save
 	Angle Angle
-	// Container Pyramid container type
+	// Container Pyramid container type. Writing to a buffer or target needs an archive, so the default Fs container is saved as a zip
 	Container DzContainer
 	// Compression ZIP deflate compression level
 	Compression int
@@ -5110,14 +5862,8 @@ type DzsaveTargetOptions struct {
 	Id string
 	// Q Q factor
 	Q int
-	// Keep Which metadata to retain
-	Keep Keep
-	// Background Background value
-	Background []float64
-	// PageHeight Set page height for multipage save
-	PageHeight int
-	// Profile Filename of ICC profile to embed
-	Profile string
+	// CommonSaveOptions optional arguments shared by all savers
+	CommonSaveOptions
 }
 
 // DefaultDzsaveTargetOptions creates default value for vips_dzsave_target optional arguments
@@ -5239,6 +5985,9 @@ func (r *Image) ExtractBand(band int, options *ExtractBandOptions) (error) {
 
 // Falsecolour vips_falsecolour false-color an image
 func (r *Image) Falsecolour() (error) {
+	if bands := r.Bands(); bands != 1 {
+		return fmt.Errorf("falsecolour: image has %d bands, expected a single band image, e.g. from ExtractBand or Colourspace(InterpretationBW)", bands)
+	}
 	out, err := vipsgenFalsecolour(r.image)
 	if err != nil {
 		return err
@@ -5306,14 +6055,8 @@ func (r *Image) FindTrim(options *FindTrimOptions) (int, int, int, int, error) {
 
 // FitssaveOptions optional arguments for vips_fitssave
 type FitssaveOptions struct {
-	// Keep Which metadata to retain
-	Keep Keep
-	// Background Background value
-	Background []float64
-	// PageHeight Set page height for multipage save
-	PageHeight int
-	// Profile Filename of ICC profile to embed
-	Profile string
+	// CommonSaveOptions optional arguments shared by all savers
+	CommonSaveOptions
 }
 
 // DefaultFitssaveOptions creates default value for vips_fitssave optional arguments
@@ -5412,6 +6155,10 @@ func (r *Image) Freqmult(mask *Image) (error) {
 
 
 // Fwfft vips_fwfft forward FFT
+//
+// The image is replaced by its Fourier transform in dpcomplex format, one complex band per input band,
+// with the zero frequency at the top left. Use Invfft to transform back or Spectrum to view the magnitude.
+// The Fourier operations are only available when libvips is built with FFTW, see HasOperation.
 func (r *Image) Fwfft() (error) {
 	out, err := vipsgenFwfft(r.image)
 	if err != nil {
@@ -5534,14 +6281,8 @@ type GifsaveOptions struct {
 	InterpaletteMaxerror float64
 	// Interlace Generate an interlaced (progressive) GIF
 	Interlace bool
-	// Keep Which metadata to retain
-	Keep Keep
-	// Background Background value
-	Background []float64
-	// PageHeight Set page height for multipage save
-	PageHeight int
-	// Profile Filename of ICC profile to embed
-	Profile string
+	// CommonSaveOptions optional arguments shared by all savers
+	CommonSaveOptions
 }
 
 // DefaultGifsaveOptions creates default value for vips_gifsave optional arguments
@@ -5588,14 +6329,8 @@ type GifsaveBufferOptions struct {
 	InterpaletteMaxerror float64
 	// Interlace Generate an interlaced (progressive) GIF
 	Interlace bool
-	// Keep Which metadata to retain
-	Keep Keep
-	// Background Background value
-	Background []float64
-	// PageHeight Set page height for multipage save
-	PageHeight int
-	// Profile Filename of ICC profile to embed
-	Profile string
+	// CommonSaveOptions optional arguments shared by all savers
+	CommonSaveOptions
 }
 
 // DefaultGifsaveBufferOptions creates default value for vips_gifsave_buffer optional arguments
@@ -5640,14 +6375,8 @@ type GifsaveTargetOptions struct {
 	InterpaletteMaxerror float64
 	// Interlace Generate an interlaced (progressive) GIF
 	Interlace bool
-	// Keep Which metadata to retain
-	Keep Keep
-	// Background Background value
-	Background []float64
-	// PageHeight Set page height for multipage save
-	PageHeight int
-	// Profile Filename of ICC profile to embed
-	Profile string
+	// CommonSaveOptions optional arguments shared by all savers
+	CommonSaveOptions
 }
 
 // DefaultGifsaveTargetOptions creates default value for vips_gifsave_target optional arguments
@@ -5778,14 +6507,8 @@ type HeifsaveOptions struct {
 	SubsampleMode Subsample
 	// Encoder Select encoder to use
 	Encoder HeifEncoder
-	// Keep Which metadata to retain
-	Keep Keep
-	// Background Background value
-	Background []float64
-	// PageHeight Set page height for multipage save
-	PageHeight int
-	// Profile Filename of ICC profile to embed
-	Profile string
+	// CommonSaveOptions optional arguments shared by all savers
+	CommonSaveOptions
 }
 
 // DefaultHeifsaveOptions creates default value for vips_heifsave optional arguments
@@ -5793,7 +6516,7 @@ func DefaultHeifsaveOptions() *HeifsaveOptions {
 	return &HeifsaveOptions{
 		Q: 50,
 		Bitdepth: 12,
-		Compression: HeifCompression(1),
+		Compression: HeifCompressionHevc,
 		Effort: 4,
 	}
 }
@@ -5832,14 +6555,8 @@ type HeifsaveBufferOptions struct {
 	SubsampleMode Subsample
 	// Encoder Select encoder to use
 	Encoder HeifEncoder
-	// Keep Which metadata to retain
-	Keep Keep
-	// Background Background value
-	Background []float64
-	// PageHeight Set page height for multipage save
-	PageHeight int
-	// Profile Filename of ICC profile to embed
-	Profile string
+	// CommonSaveOptions optional arguments shared by all savers
+	CommonSaveOptions
 }
 
 // DefaultHeifsaveBufferOptions creates default value for vips_heifsave_buffer optional arguments
@@ -5847,7 +6564,7 @@ func DefaultHeifsaveBufferOptions() *HeifsaveBufferOptions {
 	return &HeifsaveBufferOptions{
 		Q: 50,
 		Bitdepth: 12,
-		Compression: HeifCompression(1),
+		Compression: HeifCompressionHevc,
 		Effort: 4,
 	}
 }
@@ -5884,14 +6601,8 @@ type HeifsaveTargetOptions struct {
 	SubsampleMode Subsample
 	// Encoder Select encoder to use
 	Encoder HeifEncoder
-	// Keep Which metadata to retain
-	Keep Keep
-	// Background Background value
-	Background []float64
-	// PageHeight Set page height for multipage save
-	PageHeight int
-	// Profile Filename of ICC profile to embed
-	Profile string
+	// CommonSaveOptions optional arguments shared by all savers
+	CommonSaveOptions
 }
 
 // DefaultHeifsaveTargetOptions creates default value for vips_heifsave_target optional arguments
@@ -5899,7 +6610,7 @@ func DefaultHeifsaveTargetOptions() *HeifsaveTargetOptions {
 	return &HeifsaveTargetOptions{
 		Q: 50,
 		Bitdepth: 12,
-		Compression: HeifCompression(1),
+		Compression: HeifCompressionHevc,
 		Effort: 4,
 	}
 }
@@ -6014,7 +6725,7 @@ type HistFindIndexedOptions struct {
 // DefaultHistFindIndexedOptions creates default value for vips_hist_find_indexed optional arguments
 func DefaultHistFindIndexedOptions() *HistFindIndexedOptions {
 	return &HistFindIndexedOptions{
-		Combine: Combine(1),
+		Combine: CombineSum,
 	}
 }
 
@@ -6167,6 +6878,10 @@ func DefaultHoughCircleOptions() *HoughCircleOptions {
 }
 
 // HoughCircle vips_hough_circle find hough circle transform
+//
+// The input must be a one-band image such as a Threshold mask, where non-zero pixels vote.
+// The image is replaced by the accumulator, scaled down by Scale, with one band per radius
+// from MinRadius to MaxRadius, and peaks are the most likely circle centres.
 func (r *Image) HoughCircle(options *HoughCircleOptions) (error) {
 	if options != nil {
 		out, err := vipsgenHoughCircleWithOptions(r.image, options.Scale, options.MinRadius, options.MaxRadius)
@@ -6201,6 +6916,10 @@ func DefaultHoughLineOptions() *HoughLineOptions {
 }
 
 // HoughLine vips_hough_line find hough line transform
+//
+// The input must be a one-band image such as a Threshold mask, where non-zero pixels vote.
+// The image is replaced by the accumulator: x is the line angle from 0 to 180 degrees across Width,
+// y the distance from the origin across Height, and peaks are the most likely lines.
 func (r *Image) HoughLine(options *HoughLineOptions) (error) {
 	if options != nil {
 		out, err := vipsgenHoughLineWithOptions(r.image, options.Width, options.Height)
@@ -6235,7 +6954,7 @@ type IccExportOptions struct {
 // DefaultIccExportOptions creates default value for vips_icc_export optional arguments
 func DefaultIccExportOptions() *IccExportOptions {
 	return &IccExportOptions{
-		Intent: Intent(1),
+		Intent: IntentRelative,
 		Depth: 8,
 	}
 }
@@ -6275,7 +6994,7 @@ type IccImportOptions struct {
 // DefaultIccImportOptions creates default value for vips_icc_import optional arguments
 func DefaultIccImportOptions() *IccImportOptions {
 	return &IccImportOptions{
-		Intent: Intent(1),
+		Intent: IntentRelative,
 	}
 }
 
@@ -6316,7 +7035,7 @@ type IccTransformOptions struct {
 // DefaultIccTransformOptions creates default value for vips_icc_transform optional arguments
 func DefaultIccTransformOptions() *IccTransformOptions {
 	return &IccTransformOptions{
-		Intent: Intent(1),
+		Intent: IntentRelative,
 		Depth: 8,
 	}
 }
@@ -6465,6 +7184,9 @@ func DefaultInvfftOptions() *InvfftOptions {
 }
 
 // Invfft vips_invfft inverse FFT
+//
+// The input is a complex frequency domain image such as the output of Fwfft. The result is dpcomplex,
+// or with Real set the real part in double format, which can be Cast back to Uchar for display.
 func (r *Image) Invfft(options *InvfftOptions) (error) {
 	if options != nil {
 		out, err := vipsgenInvfftWithOptions(r.image, options.Real)
@@ -6533,14 +7255,8 @@ type Jp2ksaveOptions struct {
 	Q int
 	// SubsampleMode Select chroma subsample operation mode
 	SubsampleMode Subsample
-	// Keep Which metadata to retain
-	Keep Keep
-	// Background Background value
-	Background []float64
-	// PageHeight Set page height for multipage save
-	PageHeight int
-	// Profile Filename of ICC profile to embed
-	Profile string
+	// CommonSaveOptions optional arguments shared by all savers
+	CommonSaveOptions
 }
 
 // DefaultJp2ksaveOptions creates default value for vips_jp2ksave optional arguments
@@ -6549,7 +7265,7 @@ func DefaultJp2ksaveOptions() *Jp2ksaveOptions {
 		TileWidth: 512,
 		TileHeight: 512,
 		Q: 48,
-		SubsampleMode: Subsample(2),
+		SubsampleMode: SubsampleOff,
 	}
 }
 
@@ -6583,14 +7299,8 @@ type Jp2ksaveBufferOptions struct {
 	Q int
 	// SubsampleMode Select chroma subsample operation mode
 	SubsampleMode Subsample
-	// Keep Which metadata to retain
-	Keep Keep
-	// Background Background value
-	Background []float64
-	// PageHeight Set page height for multipage save
-	PageHeight int
-	// Profile Filename of ICC profile to embed
-	Profile string
+	// CommonSaveOptions optional arguments shared by all savers
+	CommonSaveOptions
 }
 
 // DefaultJp2ksaveBufferOptions creates default value for vips_jp2ksave_buffer optional arguments
@@ -6599,7 +7309,7 @@ func DefaultJp2ksaveBufferOptions() *Jp2ksaveBufferOptions {
 		TileWidth: 512,
 		TileHeight: 512,
 		Q: 48,
-		SubsampleMode: Subsample(2),
+		SubsampleMode: SubsampleOff,
 	}
 }
 
@@ -6631,14 +7341,8 @@ type Jp2ksaveTargetOptions struct {
 	Q int
 	// SubsampleMode Select chroma subsample operation mode
 	SubsampleMode Subsample
-	// Keep Which metadata to retain
-	Keep Keep
-	// Background Background value
-	Background []float64
-	// PageHeight Set page height for multipage save
-	PageHeight int
-	// Profile Filename of ICC profile to embed
-	Profile string
+	// CommonSaveOptions optional arguments shared by all savers
+	CommonSaveOptions
 }
 
 // DefaultJp2ksaveTargetOptions creates default value for vips_jp2ksave_target optional arguments
@@ -6647,7 +7351,7 @@ func DefaultJp2ksaveTargetOptions() *Jp2ksaveTargetOptions {
 		TileWidth: 512,
 		TileHeight: 512,
 		Q: 48,
-		SubsampleMode: Subsample(2),
+		SubsampleMode: SubsampleOff,
 	}
 }
 
@@ -6677,26 +7381,20 @@ type JpegsaveOptions struct {
 	OptimizeCoding bool
 	// Interlace Generate an interlaced (progressive) jpeg
 	Interlace bool
-	// TrellisQuant Apply trellis quantisation to each 8x8 block
+	// TrellisQuant Apply trellis quantisation to each 8x8 block. Requires libjpeg built from mozjpeg, otherwise saving fails with ErrOperationNotSupported
 	TrellisQuant bool
-	// OvershootDeringing Apply overshooting to samples with extreme values
+	// OvershootDeringing Apply overshooting to samples with extreme values. Requires libjpeg built from mozjpeg, otherwise libvips ignores it with a warning
 	OvershootDeringing bool
-	// OptimizeScans Split spectrum of DCT coefficients into separate scans
+	// OptimizeScans Split spectrum of DCT coefficients into separate scans, with Interlace. Requires libjpeg built from mozjpeg, otherwise libvips ignores it with a warning
 	OptimizeScans bool
-	// QuantTable Use predefined quantization table with given index
+	// QuantTable Use predefined quantization table with given index. Requires libjpeg built from mozjpeg, otherwise libvips ignores it with a warning
 	QuantTable int
 	// SubsampleMode Select chroma subsample operation mode
 	SubsampleMode Subsample
 	// RestartInterval Add restart markers every specified number of mcu
 	RestartInterval int
-	// Keep Which metadata to retain
-	Keep Keep
-	// Background Background value
-	Background []float64
-	// PageHeight Set page height for multipage save
-	PageHeight int
-	// Profile Filename of ICC profile to embed
-	Profile string
+	// CommonSaveOptions optional arguments shared by all savers
+	CommonSaveOptions
 }
 
 // DefaultJpegsaveOptions creates default value for vips_jpegsave optional arguments
@@ -6709,7 +7407,15 @@ func DefaultJpegsaveOptions() *JpegsaveOptions {
 // Jpegsave vips_jpegsave save image to jpeg file
 //
 // The filename specifies filename to save to.
+//
+// JPEG has no alpha channel, so images with alpha are flattened onto Background,
+// black by default. Use Flatten or RemoveAlpha beforehand for more control.
 func (r *Image) Jpegsave(filename string, options *JpegsaveOptions) (error) {
+	if options != nil && options.TrellisQuant {
+		if err := checkTrellisQuant(); err != nil {
+			return err
+		}
+	}
 	if options != nil {
 		err := vipsgenJpegsaveWithOptions(r.image, filename, options.Q, options.OptimizeCoding, options.Interlace, options.TrellisQuant, options.OvershootDeringing, options.OptimizeScans, options.QuantTable, options.SubsampleMode, options.RestartInterval, options.Keep, options.Background, options.PageHeight, options.Profile)
 		if err != nil {
@@ -6732,26 +7438,20 @@ type JpegsaveBufferOptions struct {
 	OptimizeCoding bool
 	// Interlace Generate an interlaced (progressive) jpeg
 	Interlace bool
-	// TrellisQuant Apply trellis quantisation to each 8x8 block
+	// TrellisQuant Apply trellis quantisation to each 8x8 block. Requires libjpeg built from mozjpeg, otherwise saving fails with ErrOperationNotSupported
 	TrellisQuant bool
-	// OvershootDeringing Apply overshooting to samples with extreme values
+	// OvershootDeringing Apply overshooting to samples with extreme values. Requires libjpeg built from mozjpeg, otherwise libvips ignores it with a warning
 	OvershootDeringing bool
-	// OptimizeScans Split spectrum of DCT coefficients into separate scans
+	// OptimizeScans Split spectrum of DCT coefficients into separate scans, with Interlace. Requires libjpeg built from mozjpeg, otherwise libvips ignores it with a warning
 	OptimizeScans bool
-	// QuantTable Use predefined quantization table with given index
+	// QuantTable Use predefined quantization table with given index. Requires libjpeg built from mozjpeg, otherwise libvips ignores it with a warning
 	QuantTable int
 	// SubsampleMode Select chroma subsample operation mode
 	SubsampleMode Subsample
 	// RestartInterval Add restart markers every specified number of mcu
 	RestartInterval int
-	// Keep Which metadata to retain
-	Keep Keep
-	// Background Background value
-	Background []float64
-	// PageHeight Set page height for multipage save
-	PageHeight int
-	// Profile Filename of ICC profile to embed
-	Profile string
+	// CommonSaveOptions optional arguments shared by all savers
+	CommonSaveOptions
 }
 
 // DefaultJpegsaveBufferOptions creates default value for vips_jpegsave_buffer optional arguments
@@ -6762,7 +7462,15 @@ func DefaultJpegsaveBufferOptions() *JpegsaveBufferOptions {
 }
 
 // JpegsaveBuffer vips_jpegsave_buffer save image to jpeg buffer
+//
+// JPEG has no alpha channel, so images with alpha are flattened onto Background,
+// black by default. Use Flatten or RemoveAlpha beforehand for more control.
 func (r *Image) JpegsaveBuffer(options *JpegsaveBufferOptions) ([]byte, error) {
+	if options != nil && options.TrellisQuant {
+		if err := checkTrellisQuant(); err != nil {
+			return nil, err
+		}
+	}
 	if options != nil {
 		buf, err := vipsgenJpegsaveBufferWithOptions(r.image, options.Q, options.OptimizeCoding, options.Interlace, options.TrellisQuant, options.OvershootDeringing, options.OptimizeScans, options.QuantTable, options.SubsampleMode, options.RestartInterval, options.Keep, options.Background, options.PageHeight, options.Profile)
 		if err != nil {
@@ -6785,26 +7493,20 @@ type JpegsaveTargetOptions struct {
 	OptimizeCoding bool
 	// Interlace Generate an interlaced (progressive) jpeg
 	Interlace bool
-	// TrellisQuant Apply trellis quantisation to each 8x8 block
+	// TrellisQuant Apply trellis quantisation to each 8x8 block. Requires libjpeg built from mozjpeg, otherwise saving fails with ErrOperationNotSupported
 	TrellisQuant bool
-	// OvershootDeringing Apply overshooting to samples with extreme values
+	// OvershootDeringing Apply overshooting to samples with extreme values. Requires libjpeg built from mozjpeg, otherwise libvips ignores it with a warning
 	OvershootDeringing bool
-	// OptimizeScans Split spectrum of DCT coefficients into separate scans
+	// OptimizeScans Split spectrum of DCT coefficients into separate scans, with Interlace. Requires libjpeg built from mozjpeg, otherwise libvips ignores it with a warning
 	OptimizeScans bool
-	// QuantTable Use predefined quantization table with given index
+	// QuantTable Use predefined quantization table with given index. Requires libjpeg built from mozjpeg, otherwise libvips ignores it with a warning
 	QuantTable int
 	// SubsampleMode Select chroma subsample operation mode
 	SubsampleMode Subsample
 	// RestartInterval Add restart markers every specified number of mcu
 	RestartInterval int
-	// Keep Which metadata to retain
-	Keep Keep
-	// Background Background value
-	Background []float64
-	// PageHeight Set page height for multipage save
-	PageHeight int
-	// Profile Filename of ICC profile to embed
-	Profile string
+	// CommonSaveOptions optional arguments shared by all savers
+	CommonSaveOptions
 }
 
 // DefaultJpegsaveTargetOptions creates default value for vips_jpegsave_target optional arguments
@@ -6817,7 +7519,15 @@ func DefaultJpegsaveTargetOptions() *JpegsaveTargetOptions {
 // JpegsaveTarget vips_jpegsave_target save image to jpeg target
 //
 // The target specifies target to save to.
+//
+// JPEG has no alpha channel, so images with alpha are flattened onto Background,
+// black by default. Use Flatten or RemoveAlpha beforehand for more control.
 func (r *Image) JpegsaveTarget(target *Target, options *JpegsaveTargetOptions) (error) {
+	if options != nil && options.TrellisQuant {
+		if err := checkTrellisQuant(); err != nil {
+			return err
+		}
+	}
 	if options != nil {
 		err := vipsgenJpegsaveTargetWithOptions(r.image, target.target, options.Q, options.OptimizeCoding, options.Interlace, options.TrellisQuant, options.OvershootDeringing, options.OptimizeScans, options.QuantTable, options.SubsampleMode, options.RestartInterval, options.Keep, options.Background, options.PageHeight, options.Profile)
 		if err != nil {
@@ -6844,14 +7554,8 @@ type JxlsaveOptions struct {
 	Lossless bool
 	// Q Quality factor
 	Q int
-	// Keep Which metadata to retain
-	Keep Keep
-	// Background Background value
-	Background []float64
-	// PageHeight Set page height for multipage save
-	PageHeight int
-	// Profile Filename of ICC profile to embed
-	Profile string
+	// CommonSaveOptions optional arguments shared by all savers
+	CommonSaveOptions
 }
 
 // DefaultJxlsaveOptions creates default value for vips_jxlsave optional arguments
@@ -6893,14 +7597,8 @@ type JxlsaveBufferOptions struct {
 	Lossless bool
 	// Q Quality factor
 	Q int
-	// Keep Which metadata to retain
-	Keep Keep
-	// Background Background value
-	Background []float64
-	// PageHeight Set page height for multipage save
-	PageHeight int
-	// Profile Filename of ICC profile to embed
-	Profile string
+	// CommonSaveOptions optional arguments shared by all savers
+	CommonSaveOptions
 }
 
 // DefaultJxlsaveBufferOptions creates default value for vips_jxlsave_buffer optional arguments
@@ -6940,14 +7638,8 @@ type JxlsaveTargetOptions struct {
 	Lossless bool
 	// Q Quality factor
 	Q int
-	// Keep Which metadata to retain
-	Keep Keep
-	// Background Background value
-	Background []float64
-	// PageHeight Set page height for multipage save
-	PageHeight int
-	// Profile Filename of ICC profile to embed
-	Profile string
+	// CommonSaveOptions optional arguments shared by all savers
+	CommonSaveOptions
 }
 
 // DefaultJxlsaveTargetOptions creates default value for vips_jxlsave_target optional arguments
@@ -7023,6 +7715,9 @@ func DefaultLinearOptions() *LinearOptions {
 //
 // The a specifies multiply by this.
 // The b specifies add this.
+//
+// The result is float, even for uchar input, unless Uchar is set to round and clamp it to 0-255.
+// Use LinearScalar to apply one multiplier and offset to every band.
 func (r *Image) Linear(a []float64, b []float64, options *LinearOptions) (error) {
 	if options != nil {
 		out, err := vipsgenLinearWithOptions(r.image, a, b, options.Uchar)
@@ -7089,14 +7784,8 @@ type MagicksaveOptions struct {
 	OptimizeGifTransparency bool
 	// Bitdepth Number of bits per pixel
 	Bitdepth int
-	// Keep Which metadata to retain
-	Keep Keep
-	// Background Background value
-	Background []float64
-	// PageHeight Set page height for multipage save
-	PageHeight int
-	// Profile Filename of ICC profile to embed
-	Profile string
+	// CommonSaveOptions optional arguments shared by all savers
+	CommonSaveOptions
 }
 
 // DefaultMagicksaveOptions creates default value for vips_magicksave optional arguments
@@ -7135,14 +7824,8 @@ type MagicksaveBufferOptions struct {
 	OptimizeGifTransparency bool
 	// Bitdepth Number of bits per pixel
 	Bitdepth int
-	// Keep Which metadata to retain
-	Keep Keep
-	// Background Background value
-	Background []float64
-	// PageHeight Set page height for multipage save
-	PageHeight int
-	// Profile Filename of ICC profile to embed
-	Profile string
+	// CommonSaveOptions optional arguments shared by all savers
+	CommonSaveOptions
 }
 
 // DefaultMagicksaveBufferOptions creates default value for vips_magicksave_buffer optional arguments
@@ -7169,7 +7852,7 @@ func (r *Image) MagicksaveBuffer(options *MagicksaveBufferOptions) ([]byte, erro
 
 // MapimOptions optional arguments for vips_mapim
 type MapimOptions struct {
-	// Interpolate Interpolate pixels with this
+	// Interpolate Interpolate pixels with this, e.g. NewInterpolate(InterpolateBicubic). Nil uses bilinear interpolation
 	Interpolate *Interpolate
 	// Background Background value
 	Background []float64
@@ -7182,7 +7865,7 @@ type MapimOptions struct {
 // DefaultMapimOptions creates default value for vips_mapim optional arguments
 func DefaultMapimOptions() *MapimOptions {
 	return &MapimOptions{
-		Extend: Extend(5),
+		Extend: ExtendBackground,
 	}
 }
 
@@ -7341,14 +8024,8 @@ func (r *Image) Matrixinvert() (error) {
 
 // MatrixprintOptions optional arguments for vips_matrixprint
 type MatrixprintOptions struct {
-	// Keep Which metadata to retain
-	Keep Keep
-	// Background Background value
-	Background []float64
-	// PageHeight Set page height for multipage save
-	PageHeight int
-	// Profile Filename of ICC profile to embed
-	Profile string
+	// CommonSaveOptions optional arguments shared by all savers
+	CommonSaveOptions
 }
 
 // DefaultMatrixprintOptions creates default value for vips_matrixprint optional arguments
@@ -7375,14 +8052,8 @@ func (r *Image) Matrixprint(options *MatrixprintOptions) (error) {
 
 // MatrixsaveOptions optional arguments for vips_matrixsave
 type MatrixsaveOptions struct {
-	// Keep Which metadata to retain
-	Keep Keep
-	// Background Background value
-	Background []float64
-	// PageHeight Set page height for multipage save
-	PageHeight int
-	// Profile Filename of ICC profile to embed
-	Profile string
+	// CommonSaveOptions optional arguments shared by all savers
+	CommonSaveOptions
 }
 
 // DefaultMatrixsaveOptions creates default value for vips_matrixsave optional arguments
@@ -7411,14 +8082,8 @@ func (r *Image) Matrixsave(filename string, options *MatrixsaveOptions) (error)
 
 // MatrixsaveTargetOptions optional arguments for vips_matrixsave_target
 type MatrixsaveTargetOptions struct {
-	// Keep Which metadata to retain
-	Keep Keep
-	// Background Background value
-	Background []float64
-	// PageHeight Set page height for multipage save
-	PageHeight int
-	// Profile Filename of ICC profile to embed
-	Profile string
+	// CommonSaveOptions optional arguments shared by all savers
+	CommonSaveOptions
 }
 
 // DefaultMatrixsaveTargetOptions creates default value for vips_matrixsave_target optional arguments
@@ -7783,14 +8448,8 @@ func (r *Image) Multiply(right *Image) (error) {
 
 // NiftisaveOptions optional arguments for vips_niftisave
 type NiftisaveOptions struct {
-	// Keep Which metadata to retain
-	Keep Keep
-	// Background Background value
-	Background []float64
-	// PageHeight Set page height for multipage save
-	PageHeight int
-	// Profile Filename of ICC profile to embed
-	Profile string
+	// CommonSaveOptions optional arguments shared by all savers
+	CommonSaveOptions
 }
 
 // DefaultNiftisaveOptions creates default value for vips_niftisave optional arguments
@@ -7850,7 +8509,7 @@ type PngsaveOptions struct {
 	Interlace bool
 	// Filter libpng row filter flag(s)
 	Filter PngFilter
-	// Palette Quantise to 8bpp palette
+	// Palette Quantise to 8bpp palette. Needs libvips built with libimagequant or quantizr, otherwise the image is saved as truecolour
 	Palette bool
 	// Q Quantisation quality
 	Q int
@@ -7860,14 +8519,8 @@ type PngsaveOptions struct {
 	Bitdepth int
 	// Effort Quantisation CPU effort
 	Effort int
-	// Keep Which metadata to retain
-	Keep Keep
-	// Background Background value
-	Background []float64
-	// PageHeight Set page height for multipage save
-	PageHeight int
-	// Profile Filename of ICC profile to embed
-	Profile string
+	// CommonSaveOptions optional arguments shared by all savers
+	CommonSaveOptions
 }
 
 // DefaultPngsaveOptions creates default value for vips_pngsave optional arguments
@@ -7907,7 +8560,7 @@ type PngsaveBufferOptions struct {
 	Interlace bool
 	// Filter libpng row filter flag(s)
 	Filter PngFilter
-	// Palette Quantise to 8bpp palette
+	// Palette Quantise to 8bpp palette. Needs libvips built with libimagequant or quantizr, otherwise the image is saved as truecolour
 	Palette bool
 	// Q Quantisation quality
 	Q int
@@ -7917,14 +8570,8 @@ type PngsaveBufferOptions struct {
 	Bitdepth int
 	// Effort Quantisation CPU effort
 	Effort int
-	// Keep Which metadata to retain
-	Keep Keep
-	// Background Background value
-	Background []float64
-	// PageHeight Set page height for multipage save
-	PageHeight int
-	// Profile Filename of ICC profile to embed
-	Profile string
+	// CommonSaveOptions optional arguments shared by all savers
+	CommonSaveOptions
 }
 
 // DefaultPngsaveBufferOptions creates default value for vips_pngsave_buffer optional arguments
@@ -7962,7 +8609,7 @@ type PngsaveTargetOptions struct {
 	Interlace bool
 	// Filter libpng row filter flag(s)
 	Filter PngFilter
-	// Palette Quantise to 8bpp palette
+	// Palette Quantise to 8bpp palette. Needs libvips built with libimagequant or quantizr, otherwise the image is saved as truecolour
 	Palette bool
 	// Q Quantisation quality
 	Q int
@@ -7972,14 +8619,8 @@ type PngsaveTargetOptions struct {
 	Bitdepth int
 	// Effort Quantisation CPU effort
 	Effort int
-	// Keep Which metadata to retain
-	Keep Keep
-	// Background Background value
-	Background []float64
-	// PageHeight Set page height for multipage save
-	PageHeight int
-	// Profile Filename of ICC profile to embed
-	Profile string
+	// CommonSaveOptions optional arguments shared by all savers
+	CommonSaveOptions
 }
 
 // DefaultPngsaveTargetOptions creates default value for vips_pngsave_target optional arguments
@@ -8019,20 +8660,14 @@ type PpmsaveOptions struct {
 	Ascii bool
 	// Bitdepth Set to 1 to write as a 1 bit image
 	Bitdepth int
-	// Keep Which metadata to retain
-	Keep Keep
-	// Background Background value
-	Background []float64
-	// PageHeight Set page height for multipage save
-	PageHeight int
-	// Profile Filename of ICC profile to embed
-	Profile string
+	// CommonSaveOptions optional arguments shared by all savers
+	CommonSaveOptions
 }
 
 // DefaultPpmsaveOptions creates default value for vips_ppmsave optional arguments
 func DefaultPpmsaveOptions() *PpmsaveOptions {
 	return &PpmsaveOptions{
-		Format: PpmFormat(2),
+		Format: PpmFormatPpm,
 	}
 }
 
@@ -8062,20 +8697,14 @@ type PpmsaveTargetOptions struct {
 	Ascii bool
 	// Bitdepth Set to 1 to write as a 1 bit image
 	Bitdepth int
-	// Keep Which metadata to retain
-	Keep Keep
-	// Background Background value
-	Background []float64
-	// PageHeight Set page height for multipage save
-	PageHeight int
-	// Profile Filename of ICC profile to embed
-	Profile string
+	// CommonSaveOptions optional arguments shared by all savers
+	CommonSaveOptions
 }
 
 // DefaultPpmsaveTargetOptions creates default value for vips_ppmsave_target optional arguments
 func DefaultPpmsaveTargetOptions() *PpmsaveTargetOptions {
 	return &PpmsaveTargetOptions{
-		Format: PpmFormat(2),
+		Format: PpmFormatPpm,
 	}
 }
 
@@ -8141,6 +8770,9 @@ func (r *Image) Prewitt() (error) {
 
 
 // Profile vips_profile find image profiles
+//
+// Columns is a Width x 1 image with the first non-zero row of each column, and rows a 1 x Height image
+// with the first non-zero column of each row, e.g. to find where content starts. Close both when done.
 func (r *Image) Profile() (*Image, *Image, error) {
 	columns, rows, err := vipsgenProfile(r.image)
 	if err != nil {
@@ -8153,6 +8785,9 @@ func (r *Image) Profile() (*Image, *Image, error) {
 
 
 // Project vips_project find image projections
+//
+// Columns is a Width x 1 image with the sum of each column, and rows a 1 x Height image
+// with the sum of each row, e.g. to find content extents or gaps between text lines. Close both when done.
 func (r *Image) Project() (*Image, *Image, error) {
 	columns, rows, err := vipsgenProject(r.image)
 	if err != nil {
@@ -8208,14 +8843,8 @@ func (r *Image) Rad2float() (error) {
 
 // RadsaveOptions optional arguments for vips_radsave
 type RadsaveOptions struct {
-	// Keep Which metadata to retain
-	Keep Keep
-	// Background Background value
-	Background []float64
-	// PageHeight Set page height for multipage save
-	PageHeight int
-	// Profile Filename of ICC profile to embed
-	Profile string
+	// CommonSaveOptions optional arguments shared by all savers
+	CommonSaveOptions
 }
 
 // DefaultRadsaveOptions creates default value for vips_radsave optional arguments
@@ -8244,14 +8873,8 @@ func (r *Image) Radsave(filename string, options *RadsaveOptions) (error) {
 
 // RadsaveBufferOptions optional arguments for vips_radsave_buffer
 type RadsaveBufferOptions struct {
-	// Keep Which metadata to retain
-	Keep Keep
-	// Background Background value
-	Background []float64
-	// PageHeight Set page height for multipage save
-	PageHeight int
-	// Profile Filename of ICC profile to embed
-	Profile string
+	// CommonSaveOptions optional arguments shared by all savers
+	CommonSaveOptions
 }
 
 // DefaultRadsaveBufferOptions creates default value for vips_radsave_buffer optional arguments
@@ -8278,14 +8901,8 @@ func (r *Image) RadsaveBuffer(options *RadsaveBufferOptions) ([]byte, error) {
 
 // RadsaveTargetOptions optional arguments for vips_radsave_target
 type RadsaveTargetOptions struct {
-	// Keep Which metadata to retain
-	Keep Keep
-	// Background Background value
-	Background []float64
-	// PageHeight Set page height for multipage save
-	PageHeight int
-	// Profile Filename of ICC profile to embed
-	Profile string
+	// CommonSaveOptions optional arguments shared by all savers
+	CommonSaveOptions
 }
 
 // DefaultRadsaveTargetOptions creates default value for vips_radsave_target optional arguments
@@ -8329,14 +8946,8 @@ func (r *Image) Rank(width int, height int, index int) (error) {
 
 // RawsaveOptions optional arguments for vips_rawsave
 type RawsaveOptions struct {
-	// Keep Which metadata to retain
-	Keep Keep
-	// Background Background value
-	Background []float64
-	// PageHeight Set page height for multipage save
-	PageHeight int
-	// Profile Filename of ICC profile to embed
-	Profile string
+	// CommonSaveOptions optional arguments shared by all savers
+	CommonSaveOptions
 }
 
 // DefaultRawsaveOptions creates default value for vips_rawsave optional arguments
@@ -8348,6 +8959,9 @@ func DefaultRawsaveOptions() *RawsaveOptions {
 // Rawsave vips_rawsave save image to raw file
 //
 // The filename specifies filename to save to.
+//
+// The output has no header: Height rows of Width pixels, each with Bands interleaved samples
+// of SampleSize bytes in native byte order. Keep Width, Height, Bands and BandFormat to read it back.
 func (r *Image) Rawsave(filename string, options *RawsaveOptions) (error) {
 	if options != nil {
 		err := vipsgenRawsaveWithOptions(r.image, filename, options.Keep, options.Background, options.PageHeight, options.Profile)
@@ -8365,14 +8979,8 @@ func (r *Image) Rawsave(filename string, options *RawsaveOptions) (error) {
 
 // RawsaveBufferOptions optional arguments for vips_rawsave_buffer
 type RawsaveBufferOptions struct {
-	// Keep Which metadata to retain
-	Keep Keep
-	// Background Background value
-	Background []float64
-	// PageHeight Set page height for multipage save
-	PageHeight int
-	// Profile Filename of ICC profile to embed
-	Profile string
+	// CommonSaveOptions optional arguments shared by all savers
+	CommonSaveOptions
 }
 
 // DefaultRawsaveBufferOptions creates default value for vips_rawsave_buffer optional arguments
@@ -8382,6 +8990,9 @@ func DefaultRawsaveBufferOptions() *RawsaveBufferOptions {
 }
 
 // RawsaveBuffer vips_rawsave_buffer write raw image to buffer
+//
+// The output has no header: Height rows of Width pixels, each with Bands interleaved samples
+// of SampleSize bytes in native byte order. Keep Width, Height, Bands and BandFormat to read it back.
 func (r *Image) RawsaveBuffer(options *RawsaveBufferOptions) ([]byte, error) {
 	if options != nil {
 		buf, err := vipsgenRawsaveBufferWithOptions(r.image, options.Keep, options.Background, options.PageHeight, options.Profile)
@@ -8399,14 +9010,8 @@ func (r *Image) RawsaveBuffer(options *RawsaveBufferOptions) ([]byte, error) {
 
 // RawsaveTargetOptions optional arguments for vips_rawsave_target
 type RawsaveTargetOptions struct {
-	// Keep Which metadata to retain
-	Keep Keep
-	// Background Background value
-	Background []float64
-	// PageHeight Set page height for multipage save
-	PageHeight int
-	// Profile Filename of ICC profile to embed
-	Profile string
+	// CommonSaveOptions optional arguments shared by all savers
+	CommonSaveOptions
 }
 
 // DefaultRawsaveTargetOptions creates default value for vips_rawsave_target optional arguments
@@ -8418,6 +9023,9 @@ func DefaultRawsaveTargetOptions() *RawsaveTargetOptions {
 // RawsaveTarget vips_rawsave_target write raw image to target
 //
 // The target specifies target to save to.
+//
+// The output has no header: Height rows of Width pixels, each with Bands interleaved samples
+// of SampleSize bytes in native byte order. Keep Width, Height, Bands and BandFormat to read it back.
 func (r *Image) RawsaveTarget(target *Target, options *RawsaveTargetOptions) (error) {
 	if options != nil {
 		err := vipsgenRawsaveTargetWithOptions(r.image, target.target, options.Keep, options.Background, options.PageHeight, options.Profile)
@@ -8457,7 +9065,7 @@ type ReduceOptions struct {
 // DefaultReduceOptions creates default value for vips_reduce optional arguments
 func DefaultReduceOptions() *ReduceOptions {
 	return &ReduceOptions{
-		Kernel: Kernel(5),
+		Kernel: KernelLanczos3,
 	}
 }
 
@@ -8493,7 +9101,7 @@ type ReducehOptions struct {
 // DefaultReducehOptions creates default value for vips_reduceh optional arguments
 func DefaultReducehOptions() *ReducehOptions {
 	return &ReducehOptions{
-		Kernel: Kernel(5),
+		Kernel: KernelLanczos3,
 	}
 }
 
@@ -8528,7 +9136,7 @@ type ReducevOptions struct {
 // DefaultReducevOptions creates default value for vips_reducev optional arguments
 func DefaultReducevOptions() *ReducevOptions {
 	return &ReducevOptions{
-		Kernel: Kernel(5),
+		Kernel: KernelLanczos3,
 	}
 }
 
@@ -8633,7 +9241,7 @@ type ResizeOptions struct {
 // DefaultResizeOptions creates default value for vips_resize optional arguments
 func DefaultResizeOptions() *ResizeOptions {
 	return &ResizeOptions{
-		Kernel: Kernel(5),
+		Kernel: KernelLanczos3,
 		Gap: 2,
 	}
 }
@@ -8641,6 +9249,9 @@ func DefaultResizeOptions() *ResizeOptions {
 // Resize vips_resize resize an image
 //
 // The scale specifies scale image by this factor.
+//
+// Images with straight alpha should be premultiplied before resizing and unpremultiplied after,
+// otherwise transparent pixels bleed into the edges as dark fringes.
 func (r *Image) Resize(scale float64, options *ResizeOptions) (error) {
 	if options != nil {
 		out, err := vipsgenResizeWithOptions(r.image, scale, options.Kernel, options.Gap, options.Vscale)
@@ -8704,7 +9315,7 @@ func (r *Image) Rot45(options *Rot45Options) (error) {
 
 // RotateOptions optional arguments for vips_rotate
 type RotateOptions struct {
-	// Interpolate Interpolate pixels with this
+	// Interpolate Interpolate pixels with this, e.g. NewInterpolate(InterpolateBicubic). Nil uses bilinear interpolation
 	Interpolate *Interpolate
 	// Background Background value
 	Background []float64
@@ -8868,6 +9479,10 @@ func DefaultScaleOptions() *ScaleOptions {
 }
 
 // Scale vips_scale scale an image to uchar
+//
+// The image is replaced by uchar, stretched so that its minimum over all bands maps to 0 and its maximum
+// to 255, e.g. to display float or 16-bit data. Constant images become black. With Log set, values are
+// mapped through log10(1 + v^Exp) and scaled by the maximum, which suits Fourier spectra.
 func (r *Image) Scale(options *ScaleOptions) (error) {
 	if options != nil {
 		out, err := vipsgenScaleWithOptions(r.image, options.Exp, options.Log)
@@ -9086,7 +9701,7 @@ type SimilarityOptions struct {
 	Scale float64
 	// Angle Rotate clockwise by this many degrees
 	Angle float64
-	// Interpolate Interpolate pixels with this
+	// Interpolate Interpolate pixels with this, e.g. NewInterpolate(InterpolateBicubic). Nil uses bilinear interpolation
 	Interpolate *Interpolate
 	// Background Background value
 	Background []float64
@@ -9108,6 +9723,10 @@ func DefaultSimilarityOptions() *SimilarityOptions {
 }
 
 // Similarity vips_similarity similarity transform of an image
+//
+// Scale, Angle and the displacements are applied in a single interpolated pass, which avoids
+// the double resampling of Resize followed by Rotate. The output is sized to fit the transformed image,
+// with exposed corners filled by Background, black by default.
 func (r *Image) Similarity(options *SimilarityOptions) (error) {
 	if options != nil {
 		out, err := vipsgenSimilarityWithOptions(r.image, options.Scale, options.Angle, options.Interpolate, options.Background, options.Odx, options.Ody, options.Idx, options.Idy)
@@ -9140,7 +9759,7 @@ type SmartcropOptions struct {
 // DefaultSmartcropOptions creates default value for vips_smartcrop optional arguments
 func DefaultSmartcropOptions() *SmartcropOptions {
 	return &SmartcropOptions{
-		Interesting: Interesting(3),
+		Interesting: InterestingAttention,
 	}
 }
 
@@ -9191,6 +9810,9 @@ func (r *Image) Spcor(ref *Image) (error) {
 
 
 // Spectrum vips_spectrum make displayable power spectrum
+//
+// Non-complex images are transformed with Fwfft first. The image is replaced by its log scaled power spectrum
+// as uchar, with the zero frequency moved to the centre.
 func (r *Image) Spectrum() (error) {
 	out, err := vipsgenSpectrum(r.image)
 	if err != nil {
@@ -9306,7 +9928,7 @@ type ThumbnailImageOptions struct {
 	Height int
 	// Size Only upsize, only downsize, or both
 	Size Size
-	// NoRotate Don't use orientation tags to rotate image upright
+	// NoRotate Don't use orientation tags to rotate image upright. Width, Height and Size apply to the upright image, or to the stored pixel layout when NoRotate is set
 	NoRotate bool
 	// Crop Reduce to fill target rectangle, then crop
 	Crop Interesting
@@ -9326,7 +9948,7 @@ type ThumbnailImageOptions struct {
 func DefaultThumbnailImageOptions() *ThumbnailImageOptions {
 	return &ThumbnailImageOptions{
 		Height: 1,
-		Intent: Intent(1),
+		Intent: IntentRelative,
 	}
 }
 
@@ -9364,7 +9986,7 @@ type TiffsaveOptions struct {
 	TileWidth int
 	// TileHeight Tile height in pixels
 	TileHeight int
-	// Pyramid Write a pyramidal tiff
+	// Pyramid Write a pyramidal tiff. Pyramids are always tiled, so this implies Tile, and each level is saved as a further page unless Subifd is set
 	Pyramid bool
 	// Miniswhite Use 0 for white in 1-bit images
 	Miniswhite bool
@@ -9392,27 +10014,21 @@ type TiffsaveOptions struct {
 	Subifd bool
 	// Premultiply Save with premultiplied alpha
 	Premultiply bool
-	// Keep Which metadata to retain
-	Keep Keep
-	// Background Background value
-	Background []float64
-	// PageHeight Set page height for multipage save
-	PageHeight int
-	// Profile Filename of ICC profile to embed
-	Profile string
+	// CommonSaveOptions optional arguments shared by all savers
+	CommonSaveOptions
 }
 
 // DefaultTiffsaveOptions creates default value for vips_tiffsave optional arguments
 func DefaultTiffsaveOptions() *TiffsaveOptions {
 	return &TiffsaveOptions{
 		Q: 75,
-		Predictor: TiffPredictor(2),
+		Predictor: TiffPredictorHorizontal,
 		TileWidth: 128,
 		TileHeight: 128,
 		Xres: 1,
 		Yres: 1,
 		Level: 6,
-		Depth: DzDepth(1),
+		Depth: DzDepthOnetile,
 	}
 }
 
@@ -9448,7 +10064,7 @@ type TiffsaveBufferOptions struct {
 	TileWidth int
 	// TileHeight Tile height in pixels
 	TileHeight int
-	// Pyramid Write a pyramidal tiff
+	// Pyramid Write a pyramidal tiff. Pyramids are always tiled, so this implies Tile, and each level is saved as a further page unless Subifd is set
 	Pyramid bool
 	// Miniswhite Use 0 for white in 1-bit images
 	Miniswhite bool
@@ -9476,27 +10092,21 @@ type TiffsaveBufferOptions struct {
 	Subifd bool
 	// Premultiply Save with premultiplied alpha
 	Premultiply bool
-	// Keep Which metadata to retain
-	Keep Keep
-	// Background Background value
-	Background []float64
-	// PageHeight Set page height for multipage save
-	PageHeight int
-	// Profile Filename of ICC profile to embed
-	Profile string
+	// CommonSaveOptions optional arguments shared by all savers
+	CommonSaveOptions
 }
 
 // DefaultTiffsaveBufferOptions creates default value for vips_tiffsave_buffer optional arguments
 func DefaultTiffsaveBufferOptions() *TiffsaveBufferOptions {
 	return &TiffsaveBufferOptions{
 		Q: 75,
-		Predictor: TiffPredictor(2),
+		Predictor: TiffPredictorHorizontal,
 		TileWidth: 128,
 		TileHeight: 128,
 		Xres: 1,
 		Yres: 1,
 		Level: 6,
-		Depth: DzDepth(1),
+		Depth: DzDepthOnetile,
 	}
 }
 
@@ -9530,7 +10140,7 @@ type TiffsaveTargetOptions struct {
 	TileWidth int
 	// TileHeight Tile height in pixels
 	TileHeight int
-	// Pyramid Write a pyramidal tiff
+	// Pyramid Write a pyramidal tiff. Pyramids are always tiled, so this implies Tile, and each level is saved as a further page unless Subifd is set
 	Pyramid bool
 	// Miniswhite Use 0 for white in 1-bit images
 	Miniswhite bool
@@ -9558,27 +10168,21 @@ type TiffsaveTargetOptions struct {
 	Subifd bool
 	// Premultiply Save with premultiplied alpha
 	Premultiply bool
-	// Keep Which metadata to retain
-	Keep Keep
-	// Background Background value
-	Background []float64
-	// PageHeight Set page height for multipage save
-	PageHeight int
-	// Profile Filename of ICC profile to embed
-	Profile string
+	// CommonSaveOptions optional arguments shared by all savers
+	CommonSaveOptions
 }
 
 // DefaultTiffsaveTargetOptions creates default value for vips_tiffsave_target optional arguments
 func DefaultTiffsaveTargetOptions() *TiffsaveTargetOptions {
 	return &TiffsaveTargetOptions{
 		Q: 75,
-		Predictor: TiffPredictor(2),
+		Predictor: TiffPredictorHorizontal,
 		TileWidth: 128,
 		TileHeight: 128,
 		Xres: 1,
 		Yres: 1,
 		Level: 6,
-		Depth: DzDepth(1),
+		Depth: DzDepthOnetile,
 	}
 }
 
@@ -9709,14 +10313,8 @@ func (r *Image) Unpremultiply(options *UnpremultiplyOptions) (error) {
 
 // VipssaveOptions optional arguments for vips_vipssave
 type VipssaveOptions struct {
-	// Keep Which metadata to retain
-	Keep Keep
-	// Background Background value
-	Background []float64
-	// PageHeight Set page height for multipage save
-	PageHeight int
-	// Profile Filename of ICC profile to embed
-	Profile string
+	// CommonSaveOptions optional arguments shared by all savers
+	CommonSaveOptions
 }
 
 // DefaultVipssaveOptions creates default value for vips_vipssave optional arguments
@@ -9745,14 +10343,8 @@ func (r *Image) Vipssave(filename string, options *VipssaveOptions) (error) {
 
 // VipssaveTargetOptions optional arguments for vips_vipssave_target
 type VipssaveTargetOptions struct {
-	// Keep Which metadata to retain
-	Keep Keep
-	// Background Background value
-	Background []float64
-	// PageHeight Set page height for multipage save
-	PageHeight int
-	// Profile Filename of ICC profile to embed
-	Profile string
+	// CommonSaveOptions optional arguments shared by all savers
+	CommonSaveOptions
 }
 
 // DefaultVipssaveTargetOptions creates default value for vips_vipssave_target optional arguments
@@ -9789,7 +10381,7 @@ type WebpsaveOptions struct {
 	Preset WebpPreset
 	// SmartSubsample Enable high quality chroma subsampling
 	SmartSubsample bool
-	// NearLossless Enable preprocessing in lossless mode (uses Q)
+	// NearLossless Enable preprocessing in lossless mode (uses Q). Implies lossless encoding whether or not Lossless is set, with Q as the preprocessing level: 100 is plain lossless and lower values give smaller files
 	NearLossless bool
 	// AlphaQ Change alpha plane fidelity for lossy compression
 	AlphaQ int
@@ -9809,14 +10401,8 @@ type WebpsaveOptions struct {
 	SmartDeblock bool
 	// Passes Number of entropy-analysis passes (in [1..10])
 	Passes int
-	// Keep Which metadata to retain
-	Keep Keep
-	// Background Background value
-	Background []float64
-	// PageHeight Set page height for multipage save
-	PageHeight int
-	// Profile Filename of ICC profile to embed
-	Profile string
+	// CommonSaveOptions optional arguments shared by all savers
+	CommonSaveOptions
 }
 
 // DefaultWebpsaveOptions creates default value for vips_webpsave optional arguments
@@ -9859,7 +10445,7 @@ type WebpsaveBufferOptions struct {
 	Preset WebpPreset
 	// SmartSubsample Enable high quality chroma subsampling
 	SmartSubsample bool
-	// NearLossless Enable preprocessing in lossless mode (uses Q)
+	// NearLossless Enable preprocessing in lossless mode (uses Q). Implies lossless encoding whether or not Lossless is set, with Q as the preprocessing level: 100 is plain lossless and lower values give smaller files
 	NearLossless bool
 	// AlphaQ Change alpha plane fidelity for lossy compression
 	AlphaQ int
@@ -9879,14 +10465,8 @@ type WebpsaveBufferOptions struct {
 	SmartDeblock bool
 	// Passes Number of entropy-analysis passes (in [1..10])
 	Passes int
-	// Keep Which metadata to retain
-	Keep Keep
-	// Background Background value
-	Background []float64
-	// PageHeight Set page height for multipage save
-	PageHeight int
-	// Profile Filename of ICC profile to embed
-	Profile string
+	// CommonSaveOptions optional arguments shared by all savers
+	CommonSaveOptions
 }
 
 // DefaultWebpsaveBufferOptions creates default value for vips_webpsave_buffer optional arguments
@@ -9927,7 +10507,7 @@ type WebpsaveTargetOptions struct {
 	Preset WebpPreset
 	// SmartSubsample Enable high quality chroma subsampling
 	SmartSubsample bool
-	// NearLossless Enable preprocessing in lossless mode (uses Q)
+	// NearLossless Enable preprocessing in lossless mode (uses Q). Implies lossless encoding whether or not Lossless is set, with Q as the preprocessing level: 100 is plain lossless and lower values give smaller files
 	NearLossless bool
 	// AlphaQ Change alpha plane fidelity for lossy compression
 	AlphaQ int
@@ -9947,14 +10527,8 @@ type WebpsaveTargetOptions struct {
 	SmartDeblock bool
 	// Passes Number of entropy-analysis passes (in [1..10])
 	Passes int
-	// Keep Which metadata to retain
-	Keep Keep
-	// Background Background value
-	Background []float64
-	// PageHeight Set page height for multipage save
-	PageHeight int
-	// Profile Filename of ICC profile to embed
-	Profile string
+	// CommonSaveOptions optional arguments shared by all savers
+	CommonSaveOptions
 }
 
 // DefaultWebpsaveTargetOptions creates default value for vips_webpsave_target optional arguments
@@ -10064,7 +10638,8 @@ type LoadOptions struct {
 	Unlimited bool
 	// Memory Force open via memory
 	Memory bool
-	// Access Required access pattern for this file
+	// Access Required access pattern for this file.
+	// AccessSequential streams the image top-to-bottom without decoding it all into memory.
 	Access Access
 }
 
@@ -10126,11 +10701,64 @@ func NewImageFromSource(s *Source, options *LoadOptions) (*Image, error) {
 	}
 	vipsImage, err := vipsgenImageFromSource(s.src, options)
 	if err != nil {
+		return nil, wrapLoadError(err)
+	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
 		return nil, err
 	}
 	return newImageRef(vipsImage, vipsDetermineImageType(vipsImage), nil), nil
 }
 
+// NewImageFromReader loads an image from an io.Reader, such as an HTTP response body, without a Source to manage.
+// The image is rendered into memory before returning, so the reader is not used afterwards and is not closed.
+// If reading fails, the read error is returned rather than the resulting decode error.
+func NewImageFromReader(reader io.Reader, options *LoadOptions) (*Image, error) {
+	source := NewSource(nopReadCloser(reader))
+	defer source.Close()
+	img, err := NewImageFromSource(source, options)
+	if err == nil {
+		if err = img.Materialize(); err != nil {
+			img.Close()
+			err = wrapLoadError(err)
+		}
+	}
+	if err != nil {
+		if readErr := source.readError(); readErr != nil {
+			return nil, readErr
+		}
+		return nil, err
+	}
+	return img, nil
+}
+
+// NewImageFromFS loads the named file from fsys, such as an embed.FS, through a Source and creates a new Image.
+// Files that are not seekable are buffered as they are read, so formats needing random access still load.
+// The image is rendered into memory before returning, so the file is closed once loaded.
+// A name missing from fsys returns an error wrapping ErrFileNotFound.
+func NewImageFromFS(fsys fs.FS, name string, options *LoadOptions) (*Image, error) {
+	file, err := fsys.Open(name)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, fmt.Errorf("%w: %w", ErrFileNotFound, err)
+		}
+		return nil, err
+	}
+	defer file.Close()
+	return NewImageFromReader(file, options)
+}
+
+// WithImage loads an image, passes it to fn and closes it once fn returns, even if fn returns an error or panics.
+// The image must not be kept or used after fn returns. A load error is returned without calling fn.
+func WithImage(load func() (*Image, error), fn func(*Image) error) error {
+	img, err := load()
+	if err != nil {
+		return err
+	}
+	defer img.Close()
+	return fn(img)
+}
+
 // NewImageFromBuffer vips_image_new_from_buffer loads an image buffer and creates a new Image
 func NewImageFromBuffer(buf []byte, options *LoadOptions) (*Image, error) {
 	Startup(nil)
@@ -10139,9 +10767,32 @@ func NewImageFromBuffer(buf []byte, options *LoadOptions) (*Image, error) {
 	}
 	vipsImage, err := vipsgenImageFromBuffer(buf, options)
 	if err != nil {
+		return nil, wrapLoadError(err)
+	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
 		return nil, err
 	}
-	return newImageRef(vipsImage, vipsDetermineImageType(vipsImage), buf), nil
+	img := newImageRef(vipsImage, vipsDetermineImageType(vipsImage), buf)
+	loadOptions := *options
+	img.loadOptions = &loadOptions
+	return img, nil
+}
+
+// DetectImageType vips_foreign_find_load_buffer identifies the format of an image buffer from its header
+// without decoding any pixels, e.g. to reject unwanted uploads before loading them.
+// Buffers that no loader recognises, including empty ones, return ImageTypeUnknown with an error
+// wrapping ErrUnsupportedFormat. Formats loaded through a generic loader such as magick are reported as that loader.
+func DetectImageType(data []byte) (ImageType, error) {
+	Startup(nil)
+	if len(data) == 0 {
+		return ImageTypeUnknown, fmt.Errorf("%w: empty buffer", ErrUnsupportedFormat)
+	}
+	loader, err := vipsForeignFindLoadBuffer(data)
+	if err != nil {
+		return ImageTypeUnknown, err
+	}
+	return vipsImageTypeFromLoader(loader), nil
 }
 
 // NewImageFromFile vips_image_new_from_file loads an image from file and creates a new Image
@@ -10152,11 +10803,60 @@ func NewImageFromFile(file string, options *LoadOptions) (*Image, error) {
 	}
 	vipsImage, err := vipsgenImageFromFile(file, options)
 	if err != nil {
+		return nil, wrapLoadError(err)
+	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
 		return nil, err
 	}
 	return newImageRef(vipsImage, vipsDetermineImageType(vipsImage), nil), nil
 }
 
+// NewImageFromFileContext loads an image from file like NewImageFromFile, and decodes it into memory
+// while watching ctx, so that cancelling ctx aborts the decode with the context error.
+// With nil options the file is read sequentially, as one decode is all it needs;
+// options that are given, including their Access, are used as is.
+func NewImageFromFileContext(ctx context.Context, file string, options *LoadOptions) (*Image, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	Startup(nil)
+	if options == nil {
+		options = DefaultLoadOptions()
+		options.Access = AccessSequential
+	}
+	vipsImage, err := vipsgenImageFromFile(file, options)
+	if err != nil {
+		return nil, wrapLoadError(err)
+	}
+	defer clearImage(vipsImage)
+	if err = checkImageLimits(vipsImage); err != nil {
+		return nil, err
+	}
+	// context.AfterFunc only starts a goroutine once ctx is done, so fast loads pay next to nothing
+	if ctx.Done() != nil {
+		cancel := vipsSetCancel(vipsImage)
+		done := make(chan struct{})
+		stop := context.AfterFunc(ctx, func() {
+			defer close(done)
+			cancel()
+		})
+		defer func() {
+			if !stop() {
+				<-done
+			}
+		}()
+	}
+	out, err := vipsgenImageCopyMemory(vipsImage)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, fmt.Errorf("%w: %w", ctxErr, err)
+		}
+		return nil, err
+	}
+	return newImageRef(out, vipsDetermineImageType(vipsImage), nil), nil
+}
+
 // NewImageFromMemory vips_image_new_from_memory loads a raw RGB/RGBA image buffer and creates a new Image
 func NewImageFromMemory(buf []byte, width, height, bands int) (*Image, error) {
 	Startup(nil)
@@ -10164,15 +10864,237 @@ func NewImageFromMemory(buf []byte, width, height, bands int) (*Image, error) {
 	if err != nil {
 		return nil, err
 	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
 	return newImageRef(vipsImage, ImageTypeUnknown, buf), nil
 }
 
+// NewMatrixFromArray vips_image_new_matrix_from_array creates a one-band double matrix image
+// from width*height values in row-major order, such as a convolution kernel or morphology mask
+func NewMatrixFromArray(width, height int, array []float64) (*Image, error) {
+	Startup(nil)
+	if width <= 0 || height <= 0 || len(array) != width*height {
+		return nil, fmt.Errorf("matrix of %dx%d requires %d values, got %d", width, height, width*height, len(array))
+	}
+	vipsImage, err := vipsgenImageNewMatrixFromArray(width, height, array)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkImageLimits(vipsImage); err != nil {
+		clearImage(vipsImage)
+		return nil, err
+	}
+	return newImageRef(vipsImage, ImageTypeUnknown, nil), nil
+}
+
+// BuildLUT vips_buildlut expands control points into a lookup table for Maplut, such as a tone curve.
+// Each point is an x value followed by one or more output values, one per LUT band, and all points
+// must have the same length. Points are sorted by x and values in between are interpolated linearly.
+// The LUT spans from the lowest to the highest x, so tone curves should start at 0.
+func BuildLUT(points [][]float64) (*Image, error) {
+	if len(points) < 2 {
+		return nil, fmt.Errorf("build lut requires at least 2 points, got %d", len(points))
+	}
+	width := len(points[0])
+	if width < 2 {
+		return nil, fmt.Errorf("build lut points require an x and at least one value, got %d values", width)
+	}
+	for _, point := range points {
+		if len(point) != width {
+			return nil, fmt.Errorf("build lut points must all have %d values, got %d", width, len(point))
+		}
+	}
+	sorted := make([][]float64, len(points))
+	copy(sorted, points)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i][0] < sorted[j][0]
+	})
+	array := make([]float64, 0, width*len(sorted))
+	for i, point := range sorted {
+		if i > 0 && point[0] == sorted[i-1][0] {
+			return nil, fmt.Errorf("build lut has more than one point at x %g", point[0])
+		}
+		array = append(array, point...)
+	}
+	matrix, err := NewMatrixFromArray(width, len(sorted), array)
+	if err != nil {
+		return nil, err
+	}
+	if err = matrix.Buildlut(); err != nil {
+		matrix.Close()
+		return nil, err
+	}
+	return matrix, nil
+}
+
+
+// RenderSVG vips_svgload_buffer rasterizes an SVG, scaled to fit within width x height while keeping its aspect ratio.
+// Either width or height may be 0 to scale by the other dimension alone.
+// The SVG must have an intrinsic size, from its width and height or its viewBox.
+func RenderSVG(buf []byte, width, height int) (*Image, error) {
+	if width <= 0 && height <= 0 {
+		return nil, fmt.Errorf("render svg requires a positive width or height, got %dx%d", width, height)
+	}
+	probe, err := NewSvgloadBuffer(buf, nil)
+	if err != nil {
+		return nil, err
+	}
+	intrinsicWidth, intrinsicHeight := probe.Width(), probe.Height()
+	probe.Close()
+	if intrinsicWidth <= 0 || intrinsicHeight <= 0 {
+		return nil, fmt.Errorf("svg has no intrinsic size, set width and height or a viewBox")
+	}
+	scale := float64(width) / float64(intrinsicWidth)
+	if vscale := float64(height) / float64(intrinsicHeight); width <= 0 || (height > 0 && vscale < scale) {
+		scale = vscale
+	}
+	return NewSvgloadBuffer(buf, &SvgloadBufferOptions{Scale: scale})
+}
+
+
+
+// EncodeGIF vips_gifsave_buffer encodes frames of equal size as an animated GIF.
+// The frames are joined top to bottom into one image with the page height set to the frame height.
+// Delays are the display time of each frame in milliseconds, one per frame or none for the default,
+// and loop is the number of times to play the animation, 0 to loop forever.
+func EncodeGIF(frames []*Image, delays []int, loop int, options *GifsaveBufferOptions) ([]byte, error) {
+	if len(frames) == 0 {
+		return nil, fmt.Errorf("encode gif requires at least one frame")
+	}
+	width, height := frames[0].Width(), frames[0].Height()
+	for i, frame := range frames {
+		if frame.Width() != width || frame.Height() != height {
+			return nil, fmt.Errorf("frame %d is %dx%d, all frames must be %dx%d", i, frame.Width(), frame.Height(), width, height)
+		}
+	}
+	if len(delays) != 0 && len(delays) != len(frames) {
+		return nil, fmt.Errorf("got %d delays for %d frames", len(delays), len(frames))
+	}
+	strip, err := NewArrayjoin(frames, &ArrayjoinOptions{Across: 1})
+	if err != nil {
+		return nil, err
+	}
+	defer strip.Close()
+	// SetPages copies the joined image, so the metadata below is not set on a cached operation result
+	if err = strip.SetPages(len(frames)); err != nil {
+		return nil, err
+	}
+	if err = strip.SetPageHeight(height); err != nil {
+		return nil, err
+	}
+	if len(delays) > 0 {
+		if err = strip.SetArrayInt("delay", delays); err != nil {
+			return nil, err
+		}
+	}
+	strip.SetLoop(loop)
+	return strip.GifsaveBuffer(options)
+}
+
+
 // WriteToMemory vips_image_write_to_memory writes the image to a raw pixel byte slice.
 // Use Width(), Height(), Bands(), and BandFormat() to interpret the returned buffer.
 func (r *Image) WriteToMemory() ([]byte, error) {
 	return vipsgenImageWriteToMemory(r.image)
 }
 
+// WriteToFile vips_image_write_to_file saves the image to a file, picking the saver from the filename
+// extension as NewImageFromFile picks the loader, e.g. .jpg, .png, .webp, .tif or .gif.
+// Options are set on the saver by their libvips names, e.g. {"Q": 90} or {"keep": "none"}.
+// An unsupported extension returns an error listing the extensions this libvips build can save.
+func (r *Image) WriteToFile(filename string, options map[string]interface{}) error {
+	if !vipsForeignFindSave(filename) {
+		seen := make(map[string]bool)
+		var suffixes []string
+		for _, suffix := range vipsForeignGetSuffixes() {
+			if !seen[suffix] {
+				seen[suffix] = true
+				suffixes = append(suffixes, suffix)
+			}
+		}
+		sort.Strings(suffixes)
+		return fmt.Errorf("%w: no saver found for %q, supported extensions: %s", ErrUnsupportedFormat, filename, strings.Join(suffixes, ", "))
+	}
+	names := make([]string, 0, len(options))
+	for name := range options {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	values := make([]string, 0, len(names))
+	for _, name := range names {
+		values = append(values, fmt.Sprintf("%s=%v", name, options[name]))
+	}
+	return vipsgenImageWriteToFile(r.image, filename, strings.Join(values, ","))
+}
+
+// ProcessBatch loads each input buffer, runs fn on it and saves the result in the format it was loaded in,
+// or as PNG for formats libvips can load but not save, such as PDF, SVG or camera raw,
+// using at most concurrency workers, or one per CPU if concurrency is not positive.
+// Results and errors are indexed like inputs; an input that fails to load, process or save
+// leaves a nil result and its error without affecting the others. Every image is closed once saved.
+func ProcessBatch(inputs [][]byte, concurrency int, fn func(*Image) error) ([][]byte, []error) {
+	if concurrency <= 0 {
+		concurrency = runtime.NumCPU()
+	}
+	if concurrency > len(inputs) {
+		concurrency = len(inputs)
+	}
+	results := make([][]byte, len(inputs))
+	errs := make([]error, len(inputs))
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				results[i], errs[i] = processBatchItem(inputs[i], fn)
+			}
+		}()
+	}
+	for i := range inputs {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+	return results, errs
+}
+
+func processBatchItem(buf []byte, fn func(*Image) error) ([]byte, error) {
+	img, err := NewImageFromBuffer(buf, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer img.Close()
+	if err := fn(img); err != nil {
+		return nil, err
+	}
+	suffix := "." + string(img.Format())
+	if !vipsForeignFindSaveBuffer(suffix) {
+		suffix = ".png"
+	}
+	return vipsgenImageWriteToBuffer(img.image, suffix)
+}
+
+// checkImageLimits rejects a newly loaded or created image that exceeds Config.MaxWidth, Config.MaxHeight or Config.MaxPixels.
+// It runs in NewImageFrom* and in every generated New* creator, such as NewJpegload or NewThumbnailBuffer.
+// Only the header has been read at this point, so an oversized image fails before any pixels are decoded.
+// The width and height limits apply to each page and the pixel limit to all loaded pages together.
+func checkImageLimits(vipsImage *C.VipsImage) error {
+	width, height := int(vipsImage.Xsize), int(vipsImage.Ysize)
+	if maxImageWidth > 0 && width > maxImageWidth {
+		return fmt.Errorf("%w: width %d exceeds the limit of %d", ErrImageTooLarge, width, maxImageWidth)
+	}
+	if pageHeight := vipsGetPageHeight(vipsImage); maxImageHeight > 0 && pageHeight > maxImageHeight {
+		return fmt.Errorf("%w: height %d exceeds the limit of %d", ErrImageTooLarge, pageHeight, maxImageHeight)
+	}
+	if pixels := int64(width) * int64(height); maxImagePixels > 0 && pixels > maxImagePixels {
+		return fmt.Errorf("%w: %d pixels exceeds the limit of %d", ErrImageTooLarge, pixels, maxImagePixels)
+	}
+	return nil
+}
 
 func newImageRef(vipsImage *C.VipsImage, format ImageType, buf []byte) *Image {
 	imageRef := &Image{
@@ -10180,6 +11102,9 @@ func newImageRef(vipsImage *C.VipsImage, format ImageType, buf []byte) *Image {
 		format: format,
 		buf:    buf,
 	}
+	if operationTimeout > 0 && vipsImage != nil {
+		vipsSetEvalLimit(vipsImage, time.Time{}, operationTimeout)
+	}
 	log("vipsgen", LogLevelDebug, fmt.Sprintf("created imageRef %p", imageRef))
 	return imageRef
 }
@@ -10198,6 +11123,18 @@ func (r *Image) setImage(image *C.VipsImage) {
 	r.pageHeight = 0
 }
 
+// WithDeadline aborts the evaluation of this image, and of images derived from it, once the deadline passes.
+// The operation that is evaluating at that point returns an error wrapping ErrOperationTimeout.
+func (r *Image) WithDeadline(deadline time.Time) error {
+	out, err := vipsgenCopy(r.image)
+	if err != nil {
+		return err
+	}
+	vipsSetEvalLimit(out, deadline, operationTimeout)
+	r.setImage(out)
+	return nil
+}
+
 // Close closes the image and frees the memory
 func (r *Image) Close() {
 	if r == nil {
@@ -10243,6 +11180,40 @@ func (r *Image) ResY() float64 {
 	return float64(r.image.Yres)
 }
 
+// SetResolution sets the X and Y resolution in pixels per millimetre, as libvips stores it.
+// Savers write it to the file, e.g. as the PNG pHYs chunk or the JPEG JFIF density.
+func (r *Image) SetResolution(xres, yres float64) error {
+	if xres <= 0 || yres <= 0 {
+		return fmt.Errorf("resolution must be positive, got %g x %g", xres, yres)
+	}
+	out, err := vipsgenCopyWithOptions(r.image, 0, 0, 0, 0, 0, 0, xres, yres, 0, 0)
+	if err != nil {
+		return err
+	}
+	r.setImage(out)
+	return nil
+}
+
+// DPI returns the X and Y resolution in dots per inch
+func (r *Image) DPI() (float64, float64) {
+	return PixelsPerMMToDPI(r.ResX()), PixelsPerMMToDPI(r.ResY())
+}
+
+// SetDPI sets the X and Y resolution in dots per inch
+func (r *Image) SetDPI(xdpi, ydpi float64) error {
+	return r.SetResolution(DPIToPixelsPerMM(xdpi), DPIToPixelsPerMM(ydpi))
+}
+
+// DPIToPixelsPerMM converts a resolution in dots per inch to pixels per millimetre
+func DPIToPixelsPerMM(dpi float64) float64 {
+	return dpi / 25.4
+}
+
+// PixelsPerMMToDPI converts a resolution in pixels per millimetre to dots per inch
+func PixelsPerMMToDPI(res float64) float64 {
+	return res * 25.4
+}
+
 // OffsetX returns the X offset
 func (r *Image) OffsetX() int {
 	return int(r.image.Xoffset)
@@ -10258,6 +11229,11 @@ func (r *Image) BandFormat() BandFormat {
 	return BandFormat(int(r.image.BandFmt))
 }
 
+// SampleSize returns the size in bytes of one band of one pixel for the current band format
+func (r *Image) SampleSize() int {
+	return vipsFormatSizeof(r.BandFormat())
+}
+
 // Coding returns the image coding
 func (r *Image) Coding() Coding {
 	return Coding(int(r.image.Coding))
@@ -10268,6 +11244,18 @@ func (r *Image) Interpretation() Interpretation {
 	return Interpretation(int(r.image.Type))
 }
 
+// SetInterpretation relabels the colour space interpretation of the image.
+// This changes the label only, pixel values are left untouched; use Colourspace to convert them.
+func (r *Image) SetInterpretation(interpretation Interpretation) error {
+	out, err := vipsgenCopy(r.image)
+	if err != nil {
+		return err
+	}
+	out.Type = C.VipsInterpretation(interpretation)
+	r.setImage(out)
+	return nil
+}
+
 // IsColorSpaceSupported returns a boolean whether the image's color space is supported by libvips.
 func (r *Image) IsColorSpaceSupported() bool {
 	return vipsIsColorSpaceSupported(r.image)
@@ -10303,6 +11291,16 @@ func (r *Image) HasField(name string) bool {
     return vipsImageHasField(r.image, name)
 }
 
+// FieldType vips_image_get_typeof reports the type of a metadata field, or MetaTypeNone if the image has no such field.
+// Fields of a type with no MetaType equivalent return MetaTypeUnknown with an error.
+func (r *Image) FieldType(name string) (MetaType, error) {
+	t := vipsImageGetMetaType(r.image, name)
+	if t == MetaTypeUnknown {
+		return t, fmt.Errorf("field %q has an unsupported metadata type", name)
+	}
+	return t, nil
+}
+
 // GetBlob vips_image_get_blob retrieves binary metadata from the image by field name
 func (r *Image) GetBlob(name string) ([]byte, error) {
 	return vipsImageGetBlob(r.image, name)
@@ -10363,6 +11361,56 @@ func (r *Image) GetArrayInt(name string) ([]int, error) {
 	return vipsImageGetArrayInt(r.image, name)
 }
 
+// SetArrayImage sets an image array metadata value
+func (r *Image) SetArrayImage(name string, images []*Image) error {
+	return vipsImageSetArrayImage(r.image, name, convertImagesToVipsImages(images))
+}
+
+// GetArrayImage retrieves an image array metadata value.
+// Each returned image holds its own reference, so it stays valid after r is closed and must be closed independently.
+func (r *Image) GetArrayImage(name string) ([]*Image, error) {
+	vipsImages, err := vipsImageGetArrayImage(r.image, name)
+	if err != nil {
+		return nil, err
+	}
+	return convertVipsImagesToImages(vipsImages), nil
+}
+
+// Metadata reads every metadata field of the image into a map keyed by field name.
+// Values are typed by FieldType: int, float64, string, []byte for blobs, and []int or []float64 for arrays.
+// Image fields and fields of other types are returned in their string form.
+func (r *Image) Metadata() (map[string]interface{}, error) {
+	fields := vipsImageGetFields(r.image)
+	metadata := make(map[string]interface{}, len(fields))
+	for _, name := range fields {
+		var value interface{}
+		var err error
+		switch vipsImageGetMetaType(r.image, name) {
+		case MetaTypeNone:
+			continue
+		case MetaTypeInt:
+			value, err = vipsImageGetInt(r.image, name)
+		case MetaTypeDouble:
+			value, err = vipsImageGetDouble(r.image, name)
+		case MetaTypeString:
+			value, err = vipsImageGetString(r.image, name)
+		case MetaTypeBlob:
+			value, err = vipsImageGetBlob(r.image, name)
+		case MetaTypeArrayInt:
+			value, err = vipsImageGetArrayInt(r.image, name)
+		case MetaTypeArrayDouble:
+			value, err = vipsImageGetArrayDouble(r.image, name)
+		default:
+			value, err = vipsImageGetAsString(r.image, name)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read metadata field %q: %w", name, err)
+		}
+		metadata[name] = value
+	}
+	return metadata, nil
+}
+
 // Exif extracts all EXIF metadata from the image and returns it as a map of field names to string values
 func (r *Image) Exif() map[string]string {
 	fields := vipsImageGetFields(r.image)
@@ -10377,6 +11425,19 @@ func (r *Image) Exif() map[string]string {
 	return exifData
 }
 
+// EmbeddedThumbnail returns the JPEG thumbnail embedded in the EXIF data without decoding the image.
+// ErrNoEmbeddedThumbnail is returned when the image has no EXIF thumbnail.
+func (r *Image) EmbeddedThumbnail() ([]byte, error) {
+	if !vipsImageHasField(r.image, "exif-data") {
+		return nil, ErrNoEmbeddedThumbnail
+	}
+	data, err := vipsImageGetBlob(r.image, "exif-data")
+	if err != nil {
+		return nil, err
+	}
+	return exifThumbnail(data)
+}
+
 // SetOrientation sets the orientation in the EXIF header of the associated image.
 func (r *Image) SetOrientation(orientation int) error {
 	out, err := vipsgenCopy(r.image)
@@ -10441,11 +11502,87 @@ func (r *Image) SetPageHeight(height int) error {
 	return nil
 }
 
+// Page returns page n of a multi-page image, such as a TIFF, HEIF or animation, as a new single page Image.
+// Images loaded from a buffer decode the page again from the source buffer with the options they were
+// loaded with, rather than from the current pipeline, so each page keeps its own size, resolution
+// and other header fields even where pages differ, and processing applied since loading is not included.
+// The reloaded page is checked against the Config image limits like any other load.
+// Other images must hold all pages at the page height, and the page is extracted from them.
+func (r *Image) Page(n int) (*Image, error) {
+	pages := r.Pages()
+	if n < 0 || n >= pages {
+		return nil, fmt.Errorf("page %d out of range, image has %d pages", n, pages)
+	}
+	var page *Image
+	if pages > 1 && r.buf != nil && r.format != ImageTypeUnknown {
+		loadOptions := DefaultLoadOptions()
+		if r.loadOptions != nil {
+			*loadOptions = *r.loadOptions
+		}
+		loadOptions.N = 0
+		loadOptions.Page = n
+		vipsImage, err := vipsgenImageFromBuffer(r.buf, loadOptions)
+		if err != nil {
+			return nil, wrapLoadError(err)
+		}
+		if err = checkImageLimits(vipsImage); err != nil {
+			clearImage(vipsImage)
+			return nil, err
+		}
+		page = newImageRef(vipsImage, vipsDetermineImageType(vipsImage), r.buf)
+	} else {
+		pageHeight := r.PageHeight()
+		if pageHeight*pages != r.Height() {
+			return nil, fmt.Errorf("image height %d does not hold %d pages of height %d", r.Height(), pages, pageHeight)
+		}
+		var err error
+		if page, err = r.Copy(nil); err != nil {
+			return nil, err
+		}
+		if err = page.ExtractArea(0, n*pageHeight, r.Width(), pageHeight); err != nil {
+			page.Close()
+			return nil, err
+		}
+	}
+	// SetPages copies the page, so a cached load result is not modified
+	if err := page.SetPages(1); err != nil {
+		page.Close()
+		return nil, err
+	}
+	if err := page.SetPageHeight(page.Height()); err != nil {
+		page.Close()
+		return nil, err
+	}
+	return page, nil
+}
+
 // Background get the background of image.
 func (r *Image) Background() ([]float64, error) {
 	return vipsImageGetArrayDouble(r.image, "background")
 }
 
+// BackgroundOrDefault returns the background of the image, or def if the image has no background field.
+func (r *Image) BackgroundOrDefault(def []float64) []float64 {
+	if !vipsImageHasField(r.image, "background") {
+		return def
+	}
+	background, err := vipsImageGetArrayDouble(r.image, "background")
+	if err != nil {
+		return def
+	}
+	return background
+}
+
+// SetBackground sets the background metadata of the image, as read by Background.
+// It takes one value per band, or a single value for all bands, so it can be passed on as the
+// Background option of Embed, Flatten or Gravity.
+func (r *Image) SetBackground(background []float64) error {
+	if len(background) == 0 || (len(background) != 1 && len(background) != r.Bands()) {
+		return fmt.Errorf("background has %d values, image has %d bands", len(background), r.Bands())
+	}
+	return vipsImageSetArrayDouble(r.image, "background", background)
+}
+
 // PageDelay gets the page delay array for animation
 func (r *Image) PageDelay() ([]int, error) {
 	return vipsImageGetArrayInt(r.image, "delay")
@@ -10490,45 +11627,204 @@ func (r *Image) RemoveExif() error {
 	return nil
 }
 
-// Modulate the colors
-func (r *Image) Modulate(brightness, saturation, hue float64) error {
-	var err error
-	var multiplications []float64
-	var additions []float64
-	colorspace := r.Interpretation()
-	if colorspace == InterpretationRgb {
-		colorspace = InterpretationSrgb
-	}
-	multiplications = []float64{brightness, saturation, 1}
-	additions = []float64{0, 0, hue}
-	if r.HasAlpha() {
-		multiplications = append(multiplications, 1)
-		additions = append(additions, 0)
+// RemoveField vips_image_remove removes a metadata field from the image, it does nothing if the field is absent
+func (r *Image) RemoveField(name string) error {
+	if !vipsImageHasField(r.image, name) {
+		return nil
 	}
-	err = r.Colourspace(InterpretationLch, nil)
+	out, err := vipsgenCopy(r.image)
 	if err != nil {
 		return err
 	}
-	err = r.Linear(multiplications, additions, nil)
-	if err != nil {
-		return err
+	vipsImageRemoveField(out, name)
+	r.setImage(out)
+	return nil
+}
+
+// RemoveMetadataByPrefix removes every metadata field whose name starts with prefix, such as "exif-"
+func (r *Image) RemoveMetadataByPrefix(prefix string) error {
+	var names []string
+	for _, name := range vipsImageGetFields(r.image) {
+		if strings.HasPrefix(name, prefix) {
+			names = append(names, name)
+		}
 	}
-	err = r.Colourspace(colorspace, nil)
+	if len(names) == 0 {
+		return nil
+	}
+	out, err := vipsgenCopy(r.image)
 	if err != nil {
 		return err
 	}
+	for _, name := range names {
+		vipsImageRemoveField(out, name)
+	}
+	r.setImage(out)
 	return nil
 }
 
-// ModulateHSV modulates the image HSV values based on the supplier parameters.
-func (r *Image) ModulateHSV(brightness, saturation float64, hue int) error {
-	var err error
-	var multiplications []float64
-	var additions []float64
-	colorspace := r.Interpretation()
-	if colorspace == InterpretationRgb {
-		colorspace = InterpretationSrgb
-	}
+// imageHeaderFields are the fields vips_image_get_fields reports from the image header rather than its metadata
+var imageHeaderFields = map[string]bool{
+	"width": true, "height": true, "bands": true, "format": true, "coding": true, "interpretation": true,
+	"xoffset": true, "yoffset": true, "xres": true, "yres": true, "filename": true,
+}
+
+// CopyMetadataFrom copies the named metadata fields from src, or all of its metadata if no fields are given.
+// Fields absent from src are skipped. Header fields such as width, height, bands and resolution are never copied,
+// so the dimensions of the image are unchanged; copied fields such as orientation or page-height are taken as is
+// and may not describe the image after geometric processing.
+func (r *Image) CopyMetadataFrom(src *Image, fields ...string) error {
+	if len(fields) == 0 {
+		fields = vipsImageGetFields(src.image)
+	}
+	var names []string
+	for _, name := range fields {
+		if !imageHeaderFields[name] && vipsImageHasField(src.image, name) {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return nil
+	}
+	out, err := vipsgenCopy(r.image)
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		if err := vipsImageCopyField(src.image, out, name); err != nil {
+			clearImage(out)
+			return err
+		}
+	}
+	r.setImage(out)
+	return nil
+}
+
+// RemoveAlpha drops the alpha channel without blending, it does nothing if the image has no alpha.
+// Use Flatten to blend the image onto a background colour instead.
+func (r *Image) RemoveAlpha() error {
+	if !r.HasAlpha() {
+		return nil
+	}
+	out, err := vipsgenExtractBandWithOptions(r.image, 0, r.Bands()-1)
+	if err != nil {
+		return err
+	}
+	r.setImage(out)
+	return nil
+}
+
+// Materialize vips_image_copy_memory renders the pipeline into a plain memory image in place.
+// Later operations then start from the rendered pixels instead of recomputing the pipeline,
+// and the Source, buffer or file the image was loaded from can be released.
+// Use it before reusing an intermediate result many times, or before closing the input of a long-lived image.
+func (r *Image) Materialize() error {
+	out, err := vipsgenImageCopyMemory(r.image)
+	if err != nil {
+		return err
+	}
+	r.setImage(out)
+	r.lock.Lock()
+	r.buf = nil
+	r.lock.Unlock()
+	return nil
+}
+
+// PngsavePaletteBuffer vips_pngsave_buffer saves the image as a palette PNG, quantised to at most
+// 2^Bitdepth colours, e.g. Bitdepth 4 for 16 colours, or 256 colours when Bitdepth is unset.
+// Quantisation needs libvips built with libimagequant or quantizr. Without it libvips silently
+// writes truecolour, which is reported here as an error wrapping ErrOperationNotSupported.
+func (r *Image) PngsavePaletteBuffer(options *PngsaveBufferOptions) ([]byte, error) {
+	paletteOptions := DefaultPngsaveBufferOptions()
+	if options != nil {
+		*paletteOptions = *options
+	}
+	paletteOptions.Palette = true
+	buf, err := r.PngsaveBuffer(paletteOptions)
+	if err != nil {
+		return nil, err
+	}
+	// The IHDR chunk always comes first, its colour type is 3 for palette images
+	if len(buf) < 26 || buf[25] != 3 {
+		return nil, fmt.Errorf("%w: png palette quantisation is not available in this libvips build", ErrOperationNotSupported)
+	}
+	return buf, nil
+}
+
+// EncodeFaviconPNG encodes img as a square palette PNG at each of sizes, keyed by size, e.g. 16, 32 and 48 for a favicon.
+// Non-square images are scaled to fill the square and centre cropped. Sources smaller than a size are upscaled
+// rather than padded, so prefer a source at least as large as the largest size to avoid blurry icons.
+// It fails with ErrOperationNotSupported when libvips has no palette quantisation, see PngsavePaletteBuffer.
+func EncodeFaviconPNG(img *Image, sizes []int) (map[int][]byte, error) {
+	if len(sizes) == 0 {
+		return nil, fmt.Errorf("encode favicon requires at least one size")
+	}
+	for _, size := range sizes {
+		if size <= 0 {
+			return nil, fmt.Errorf("favicon size must be positive, got %d", size)
+		}
+	}
+	result := make(map[int][]byte, len(sizes))
+	for _, size := range sizes {
+		if _, ok := result[size]; ok {
+			continue
+		}
+		icon, err := img.Copy(nil)
+		if err != nil {
+			return nil, err
+		}
+		err = icon.SmartResize(size, size, InterestingCentre)
+		if err == nil {
+			result[size], err = icon.PngsavePaletteBuffer(nil)
+		}
+		icon.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// Modulate multiplies lightness and chroma by brightness and saturation and rotates the hue by hue degrees, via LCh.
+// The hue wraps around, so 370 is the same as 10 and -90 the same as 270.
+func (r *Image) Modulate(brightness, saturation, hue float64) error {
+	var err error
+	var multiplications []float64
+	var additions []float64
+	colorspace := r.Interpretation()
+	if colorspace == InterpretationRgb {
+		colorspace = InterpretationSrgb
+	}
+	multiplications = []float64{brightness, saturation, 1}
+	additions = []float64{0, 0, hue}
+	if r.HasAlpha() {
+		multiplications = append(multiplications, 1)
+		additions = append(additions, 0)
+	}
+	err = r.Colourspace(InterpretationLch, nil)
+	if err != nil {
+		return err
+	}
+	err = r.Linear(multiplications, additions, nil)
+	if err != nil {
+		return err
+	}
+	err = r.Colourspace(colorspace, nil)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// ModulateHSV modulates the image HSV values based on the supplier parameters.
+func (r *Image) ModulateHSV(brightness, saturation float64, hue int) error {
+	var err error
+	var multiplications []float64
+	var additions []float64
+	colorspace := r.Interpretation()
+	if colorspace == InterpretationRgb {
+		colorspace = InterpretationSrgb
+	}
 	if r.HasAlpha() {
 		multiplications = []float64{1, saturation, brightness, 1}
 		additions = []float64{float64(hue), 0, 0, 0}
@@ -10551,6 +11847,53 @@ func (r *Image) ModulateHSV(brightness, saturation float64, hue int) error {
 	return nil
 }
 
+// Tint colourises the image with an sRGB colour, keeping the lightness of each pixel and taking
+// the chroma and hue of the colour, via Lab. Any alpha channel is unchanged and greyscale images become sRGB.
+func (r *Image) Tint(color []float64) error {
+	if len(color) != 3 {
+		return fmt.Errorf("tint colour must have 3 values, got %d", len(color))
+	}
+	pixel := make([]byte, 3)
+	for i, v := range color {
+		if v < 0 || v > 255 {
+			return fmt.Errorf("tint colour value %g out of range 0-255", v)
+		}
+		pixel[i] = uint8(math.Round(v))
+	}
+	tint, err := NewImageFromMemory(pixel, 1, 1, 3)
+	if err != nil {
+		return err
+	}
+	defer tint.Close()
+	if err = tint.ToLab(); err != nil {
+		return err
+	}
+	lab, err := tint.Getpoint(0, 0, nil)
+	if err != nil {
+		return err
+	}
+	colorspace := r.Interpretation()
+	switch colorspace {
+	case InterpretationRgb, InterpretationBW:
+		colorspace = InterpretationSrgb
+	case InterpretationGrey16:
+		colorspace = InterpretationRgb16
+	}
+	multiplications := []float64{1, 0, 0}
+	additions := []float64{0, lab[1], lab[2]}
+	if r.HasAlpha() {
+		multiplications = append(multiplications, 1)
+		additions = append(additions, 0)
+	}
+	if err = r.Colourspace(InterpretationLab, nil); err != nil {
+		return err
+	}
+	if err = r.Linear(multiplications, additions, nil); err != nil {
+		return err
+	}
+	return r.Colourspace(colorspace, nil)
+}
+
 // EmbedMultiPageOptions are options for EmbedMultiPage method
 type EmbedMultiPageOptions struct {
 	// Extend determines how the image edges are extended
@@ -10649,3 +11992,1221 @@ func (r *Image) RotMultiPage(angle Angle) error {
 	return nil
 }
 
+// FlipBoth flips the image horizontally and vertically in one step, which is the same as Rot(AngleD180)
+func (r *Image) FlipBoth() error {
+	return r.Rot(AngleD180)
+}
+
+// Transpose flips the image across its main diagonal, so the pixel at x, y moves to y, x
+// and the width and height swap, as for a matrix transpose
+func (r *Image) Transpose() error {
+	if err := r.Rot(AngleD90); err != nil {
+		return err
+	}
+	return r.Flip(DirectionHorizontal)
+}
+
+
+// ResizeMultiPage resizes the image like Resize, working correctly with multi-page (animated) images
+// Each page is resized separately so frames do not bleed into each other, and the page height is updated.
+func (r *Image) ResizeMultiPage(scale float64, options *ResizeOptions) error {
+	if r.Height() == r.PageHeight() {
+		return r.Resize(scale, options)
+	}
+	// Zero values fall back to the libvips defaults, as with Resize
+	vscale, kernel, gap := scale, KernelLanczos3, 2.0
+	if options != nil {
+		if options.Vscale != 0 {
+			vscale = options.Vscale
+		}
+		if options.Kernel != 0 {
+			kernel = options.Kernel
+		}
+		if options.Gap != 0 {
+			gap = options.Gap
+		}
+	}
+	out, err := vipsgenResizeMultiPage(r.image, scale, vscale, kernel, gap)
+	if err != nil {
+		return err
+	}
+	r.setImage(out)
+	return nil
+}
+
+
+// Dilate vips_morph dilates a binary image with the given structuring element.
+// Mask values are 255 for set, 0 for clear and 128 for don't care.
+// The image must be a one-band uchar image, such as a thresholded mask.
+func (r *Image) Dilate(mask [][]int) error {
+	return r.morphMask(mask, OperationMorphologyDilate)
+}
+
+// Erode vips_morph erodes a binary image with the given structuring element.
+// Mask values are 255 for set, 0 for clear and 128 for don't care.
+// The image must be a one-band uchar image, such as a thresholded mask.
+func (r *Image) Erode(mask [][]int) error {
+	return r.morphMask(mask, OperationMorphologyErode)
+}
+
+func (r *Image) morphMask(mask [][]int, morph OperationMorphology) error {
+	if r.Bands() != 1 || r.BandFormat() != BandFormatUchar {
+		return fmt.Errorf("morph requires a 1-band uchar image, got %d bands of format %s", r.Bands(), r.BandFormat())
+	}
+	m, err := newMatrixFromRows(mask)
+	if err != nil {
+		return err
+	}
+	defer m.Close()
+	return r.Morph(m, morph)
+}
+
+// MedianFilter vips_rank replaces each pixel with the median of its size x size neighbourhood.
+// The size must be odd and at least 3.
+func (r *Image) MedianFilter(size int) error {
+	if size < 3 || size%2 == 0 {
+		return fmt.Errorf("median filter size must be an odd number >= 3, got %d", size)
+	}
+	return r.Rank(size, size, (size*size)/2)
+}
+
+// Threshold binarizes the image, pixels >= value become 255 and the rest 0.
+// Multi-band images are converted to greyscale first.
+func (r *Image) Threshold(value float64) error {
+	if err := r.toGreyscale(); err != nil {
+		return err
+	}
+	return r.RelationalConst(OperationRelationalMoreeq, []float64{value})
+}
+
+// AdaptiveThreshold binarizes the image against the local mean of a windowSize x windowSize
+// neighbourhood, pixels brighter than the local mean minus offset become 255 and the rest 0.
+// This copes with uneven lighting better than Threshold, e.g. when preparing scans for OCR.
+// The window size must be odd and at least 3. Multi-band images are converted to greyscale first.
+func (r *Image) AdaptiveThreshold(windowSize int, offset float64) error {
+	if windowSize < 3 || windowSize%2 == 0 {
+		return fmt.Errorf("adaptive threshold window size must be an odd number >= 3, got %d", windowSize)
+	}
+	if err := r.toGreyscale(); err != nil {
+		return err
+	}
+	ones := make([]float64, windowSize*windowSize)
+	for i := range ones {
+		ones[i] = 1
+	}
+	mask, err := NewMatrixFromArray(windowSize, windowSize, ones)
+	if err != nil {
+		return err
+	}
+	defer mask.Close()
+	mean, err := r.Copy(nil)
+	if err != nil {
+		return err
+	}
+	defer mean.Close()
+	if err = mean.Conv(mask, &ConvOptions{Precision: PrecisionFloat}); err != nil {
+		return err
+	}
+	if err = mean.Linear([]float64{1 / float64(len(ones))}, []float64{-offset}, nil); err != nil {
+		return err
+	}
+	return r.Relational(mean, OperationRelationalMore)
+}
+
+// toGreyscale converts a multi-band image to a single band greyscale image
+func (r *Image) toGreyscale() error {
+	if r.Bands() == 1 {
+		return nil
+	}
+	if err := r.Colourspace(InterpretationBW, nil); err != nil {
+		return err
+	}
+	return r.RemoveAlpha()
+}
+
+// BandAnd vips_bandbool collapses the bands into one band by bitwise AND, e.g. the intersection of masks.
+// Single-band images are left unchanged.
+func (r *Image) BandAnd() error {
+	if r.Bands() == 1 {
+		return nil
+	}
+	return r.Bandbool(OperationBooleanAnd)
+}
+
+// BandOr vips_bandbool collapses the bands into one band by bitwise OR, e.g. the union of masks.
+// Single-band images are left unchanged.
+func (r *Image) BandOr() error {
+	if r.Bands() == 1 {
+		return nil
+	}
+	return r.Bandbool(OperationBooleanOr)
+}
+
+// RecombMatrix vips_recomb multiplies the bands of each pixel by the matrix.
+// The matrix has one row per output band and one column per input band,
+// e.g. a single row of 0.299, 0.587, 0.114 maps RGB to luminance.
+func (r *Image) RecombMatrix(matrix [][]float64) error {
+	if len(matrix) > 0 && len(matrix[0]) != r.Bands() {
+		return fmt.Errorf("recomb matrix must have %d columns to match the image bands, got %d", r.Bands(), len(matrix[0]))
+	}
+	m, err := newMatrixFromFloatRows(matrix)
+	if err != nil {
+		return err
+	}
+	defer m.Close()
+	return r.Recomb(m)
+}
+
+// ReorderBands vips_extract_band picks bands by index into a new order,
+// e.g. 2, 1, 0 swaps RGB to BGR and 0, 0, 0 expands greyscale to three bands.
+// Bands may be repeated or left out.
+func (r *Image) ReorderBands(order []int) error {
+	if len(order) == 0 {
+		return fmt.Errorf("band order must not be empty")
+	}
+	bands := r.Bands()
+	for _, band := range order {
+		if band < 0 || band >= bands {
+			return fmt.Errorf("band index %d out of range for an image with %d bands", band, bands)
+		}
+	}
+	in := make([]*C.VipsImage, 0, len(order))
+	defer func() {
+		for _, img := range in {
+			clearImage(img)
+		}
+	}()
+	for _, band := range order {
+		out, err := vipsgenExtractBand(r.image, band)
+		if err != nil {
+			return err
+		}
+		in = append(in, out)
+	}
+	out, err := vipsgenBandjoin(in)
+	if err != nil {
+		return err
+	}
+	r.setImage(out)
+	return nil
+}
+
+// LinearScalar vips_linear calculates a * in + b with the same a and b for every band.
+// Uchar images stay uchar, with results rounded and clamped to 0-255, other formats become float.
+func (r *Image) LinearScalar(a, b float64) error {
+	return r.Linear([]float64{a}, []float64{b}, &LinearOptions{Uchar: r.BandFormat() == BandFormatUchar})
+}
+
+// Negate inverts the colours of the image, leaving any alpha channel untouched.
+// Invert computes max - x on the raw band values, which is only meaningful for sRGB, RGB16 and greyscale images.
+// Negate converts images in other colour spaces, e.g. Lab or CMYK, to sRGB first and converts the result back.
+func (r *Image) Negate() error {
+	interpretation := r.Interpretation()
+	convert := false
+	switch interpretation {
+	case InterpretationSrgb, InterpretationRgb, InterpretationBW, InterpretationRgb16, InterpretationGrey16:
+	default:
+		if !r.IsColorSpaceSupported() {
+			return fmt.Errorf("negate does not support interpretation %s", interpretation)
+		}
+		convert = true
+	}
+	if convert {
+		if err := r.Colourspace(InterpretationSrgb, nil); err != nil {
+			return err
+		}
+	}
+	if r.HasAlpha() {
+		alpha, err := r.Copy(nil)
+		if err != nil {
+			return err
+		}
+		defer alpha.Close()
+		if err := alpha.ExtractBand(r.Bands()-1, nil); err != nil {
+			return err
+		}
+		if err := r.RemoveAlpha(); err != nil {
+			return err
+		}
+		if err := r.Invert(); err != nil {
+			return err
+		}
+		out, err := vipsgenBandjoin([]*C.VipsImage{r.image, alpha.image})
+		if err != nil {
+			return err
+		}
+		r.setImage(out)
+	} else if err := r.Invert(); err != nil {
+		return err
+	}
+	if convert {
+		return r.Colourspace(interpretation, nil)
+	}
+	return nil
+}
+
+// CountDifferentColours counts the distinct pixel values of the image, with alpha as part of the colour,
+// e.g. to decide whether a palette PNG can hold the image without loss.
+// The whole image is rendered into memory and scanned, so this is expensive on large images.
+// Up to 3 bytes per pixel, such as 8-bit RGB, the colours are tracked in a fixed 2MB bitset,
+// while wider pixels such as RGBA or 16-bit images go through a map that grows with the colour count.
+func (r *Image) CountDifferentColours() (int, error) {
+	data, err := r.WriteToMemory()
+	if err != nil {
+		return 0, err
+	}
+	pixelSize := r.Bands() * r.SampleSize()
+	if pixelSize <= 3 {
+		seen := make([]uint64, (1<<24)/64)
+		count := 0
+		for i := 0; i+pixelSize <= len(data); i += pixelSize {
+			var key uint32
+			for _, b := range data[i : i+pixelSize] {
+				key = key<<8 | uint32(b)
+			}
+			if seen[key/64]&(1<<(key%64)) == 0 {
+				seen[key/64] |= 1 << (key % 64)
+				count++
+			}
+		}
+		return count, nil
+	}
+	seen := make(map[string]struct{})
+	for i := 0; i+pixelSize <= len(data); i += pixelSize {
+		seen[string(data[i:i+pixelSize])] = struct{}{}
+	}
+	return len(seen), nil
+}
+
+// MaxDifference returns the largest absolute difference between any pixel value of the image and other,
+// computed with vips_subtract, vips_abs and vips_max. Images that differ in size or number of bands
+// cannot be compared pixel by pixel and return +Inf.
+func (r *Image) MaxDifference(other *Image) (float64, error) {
+	if r.Width() != other.Width() || r.Height() != other.Height() || r.Bands() != other.Bands() {
+		return math.Inf(1), nil
+	}
+	diff, err := vipsgenSubtract(r.image, other.image)
+	if err != nil {
+		return 0, err
+	}
+	defer clearImage(diff)
+	absDiff, err := vipsgenAbs(diff)
+	if err != nil {
+		return 0, err
+	}
+	defer clearImage(absDiff)
+	return vipsgenMax(absDiff)
+}
+
+// GetpointMany reads the pixel values at many points in one call, as Getpoint does for a single point.
+// Each point is an {x, y} pair; a point outside the image returns an error, so clamp points beforehand if needed.
+// Coded images are decoded first. Complex images are not supported, use Getpoint for those.
+func (r *Image) GetpointMany(points [][2]int) ([][]float64, error) {
+	if format := r.BandFormat(); format == BandFormatComplex || format == BandFormatDpcomplex {
+		return nil, fmt.Errorf("GetpointMany does not support band format %s", format)
+	}
+	width, height := r.Width(), r.Height()
+	xy := make([]C.int, 0, 2*len(points))
+	for i, p := range points {
+		if p[0] < 0 || p[1] < 0 || p[0] >= width || p[1] >= height {
+			return nil, fmt.Errorf("point %d (%d, %d) is outside the %dx%d image", i, p[0], p[1], width, height)
+		}
+		xy = append(xy, C.int(p[0]), C.int(p[1]))
+	}
+	bands := r.Bands()
+	if r.Coding() != CodingNone {
+		// LabQ and Rad both decode to three float bands
+		bands = 3
+	}
+	values, err := vipsgenGetpointMany(r.image, xy, bands)
+	if err != nil {
+		return nil, err
+	}
+	result := make([][]float64, len(points))
+	for i := range result {
+		result[i] = values[i*bands : (i+1)*bands : (i+1)*bands]
+	}
+	return result, nil
+}
+
+// Equals reports whether the image and other have identical pixel values, e.g. to deduplicate images.
+// Images that differ in size or number of bands are not equal. Band formats may differ.
+func (r *Image) Equals(other *Image) (bool, error) {
+	return r.Similar(other, 0)
+}
+
+// Similar reports whether no pixel value of the image differs from other by more than tolerance,
+// e.g. to compare the output of lossy encoders. Images that differ in size or number of bands are not similar.
+func (r *Image) Similar(other *Image, tolerance float64) (bool, error) {
+	diff, err := r.MaxDifference(other)
+	if err != nil {
+		return false, err
+	}
+	return diff <= tolerance, nil
+}
+
+// DHash computes a 64-bit difference hash for finding near-duplicate images.
+// The image is reduced to a 9x8 greyscale grid, and each bit records whether a cell is brighter
+// than its right neighbour. Compare hashes with HammingDistance, where a few bits mean a close match.
+func (r *Image) DHash() (uint64, error) {
+	grid, err := r.hashGrid(9, 8)
+	if err != nil {
+		return 0, err
+	}
+	var hash uint64
+	for y := 0; y < 8; y++ {
+		for x := 0; x < 8; x++ {
+			if grid[y*9+x] > grid[y*9+x+1] {
+				hash |= 1 << (y*8 + x)
+			}
+		}
+	}
+	return hash, nil
+}
+
+// PHash computes a 64-bit perceptual hash for finding near-duplicate images.
+// The image is reduced to a 32x32 greyscale grid, and each bit records whether one of the
+// 8x8 lowest frequencies of its discrete cosine transform is above their median.
+// It is more robust than DHash to contrast and gamma changes, at a higher cost.
+func (r *Image) PHash() (uint64, error) {
+	const size, low = 32, 8
+	grid, err := r.hashGrid(size, size)
+	if err != nil {
+		return 0, err
+	}
+	cosines := make([]float64, low*size)
+	for u := 0; u < low; u++ {
+		for x := 0; x < size; x++ {
+			cosines[u*size+x] = math.Cos(float64((2*x+1)*u) * math.Pi / (2 * size))
+		}
+	}
+	// Separable DCT-II, rows first, keeping only the low frequencies
+	rows := make([]float64, size*low)
+	for y := 0; y < size; y++ {
+		for u := 0; u < low; u++ {
+			var sum float64
+			for x := 0; x < size; x++ {
+				sum += grid[y*size+x] * cosines[u*size+x]
+			}
+			rows[y*low+u] = sum
+		}
+	}
+	coefficients := make([]float64, low*low)
+	for v := 0; v < low; v++ {
+		for u := 0; u < low; u++ {
+			var sum float64
+			for y := 0; y < size; y++ {
+				sum += rows[y*low+u] * cosines[v*size+y]
+			}
+			coefficients[v*low+u] = sum
+		}
+	}
+	// The DC term is the mean brightness, which would skew the median
+	sorted := append([]float64(nil), coefficients[1:]...)
+	sort.Float64s(sorted)
+	median := sorted[len(sorted)/2]
+	var hash uint64
+	for i, c := range coefficients {
+		if c > median {
+			hash |= 1 << i
+		}
+	}
+	return hash, nil
+}
+
+// HammingDistance returns the number of differing bits between two hashes from DHash or PHash.
+// Distances up to about 10 usually indicate the same picture.
+func HammingDistance(a, b uint64) int {
+	return bits.OnesCount64(a ^ b)
+}
+
+// Sharpness scores how sharp the image is as the variance of its Laplacian, higher being sharper,
+// e.g. to filter out blurry uploads. The image is converted to greyscale first so colour and
+// greyscale images score consistently, and the image itself is not modified.
+// Scores depend on content and size, so tune any threshold on the kind of images being filtered.
+func (r *Image) Sharpness() (float64, error) {
+	grey, err := r.Copy(nil)
+	if err != nil {
+		return 0, err
+	}
+	defer grey.Close()
+	if err = grey.toGreyscale(); err != nil {
+		return 0, err
+	}
+	laplacian, err := NewMatrixFromArray(3, 3, []float64{0, 1, 0, 1, -4, 1, 0, 1, 0})
+	if err != nil {
+		return 0, err
+	}
+	defer laplacian.Close()
+	if err = grey.Conv(laplacian, &ConvOptions{Precision: PrecisionFloat}); err != nil {
+		return 0, err
+	}
+	deviation, err := grey.Deviate()
+	if err != nil {
+		return 0, err
+	}
+	return deviation * deviation, nil
+}
+
+// FreqMaskIdeal filters the image in the frequency domain with a sharp cutoff, via vips_mask_ideal and Freqmult.
+// Frequencies below frequencyCutoff are kept, or above it when highpass is set, where frequencyCutoff is a
+// fraction of the highest frequency, e.g. 0.3. Every band is filtered on its own, including any alpha.
+// The result is cast back to the band format of the image. Filtering needs libvips built with FFTW.
+func (r *Image) FreqMaskIdeal(highpass bool, frequencyCutoff float64) error {
+	mask, err := NewMaskIdeal(r.Width(), r.Height(), frequencyCutoff, &MaskIdealOptions{Reject: highpass})
+	if err != nil {
+		return err
+	}
+	return r.applyFreqMask(mask)
+}
+
+// FreqMaskButterworth filters the image in the frequency domain like FreqMaskIdeal, with a smooth
+// Butterworth cutoff of the given order whose response is amplitudeCutoff at frequencyCutoff.
+// Higher orders give a sharper cutoff.
+func (r *Image) FreqMaskButterworth(highpass bool, order, frequencyCutoff, amplitudeCutoff float64) error {
+	mask, err := NewMaskButterworth(r.Width(), r.Height(), order, frequencyCutoff, amplitudeCutoff,
+		&MaskButterworthOptions{Reject: highpass})
+	if err != nil {
+		return err
+	}
+	return r.applyFreqMask(mask)
+}
+
+// FreqMaskGaussian filters the image in the frequency domain like FreqMaskIdeal, with a Gaussian
+// cutoff whose response is amplitudeCutoff at frequencyCutoff.
+func (r *Image) FreqMaskGaussian(highpass bool, frequencyCutoff, amplitudeCutoff float64) error {
+	mask, err := NewMaskGaussian(r.Width(), r.Height(), frequencyCutoff, amplitudeCutoff,
+		&MaskGaussianOptions{Reject: highpass})
+	if err != nil {
+		return err
+	}
+	return r.applyFreqMask(mask)
+}
+
+// applyFreqMask multiplies every band of the image by mask in the frequency domain and closes mask
+func (r *Image) applyFreqMask(mask *Image) error {
+	defer mask.Close()
+	format := r.BandFormat()
+	if err := r.Freqmult(mask); err != nil {
+		return err
+	}
+	return r.Cast(format, nil)
+}
+
+// AverageColor vips_stats returns the mean of each band, e.g. to pick a placeholder colour for theming.
+// Images in other colourspaces, such as CMYK or Lab, are converted to sRGB first, while sRGB, greyscale
+// and images in colourspaces libvips cannot convert are measured as they are.
+// An alpha band is averaged like any other band.
+func (r *Image) AverageColor() ([]float64, error) {
+	stats, err := r.Copy(nil)
+	if err != nil {
+		return nil, err
+	}
+	defer stats.Close()
+	if interpretation := stats.Interpretation(); stats.IsColorSpaceSupported() &&
+		interpretation != InterpretationSrgb && interpretation != InterpretationBW {
+		if err = stats.ToSRGB(); err != nil {
+			return nil, err
+		}
+	}
+	bands := stats.Bands()
+	if err = stats.Stats(); err != nil {
+		return nil, err
+	}
+	// Row 0 of the stats matrix holds all bands and row i band i-1, with the mean in column 4
+	average := make([]float64, bands)
+	for band := range average {
+		value, err := stats.Getpoint(4, band+1, nil)
+		if err != nil {
+			return nil, err
+		}
+		average[band] = value[0]
+	}
+	return average, nil
+}
+
+// WhiteBalanceOptions are options for WhiteBalance method
+type WhiteBalanceOptions struct {
+	// Reference is the colour of something that should be neutral grey, e.g. sampled from a grey card,
+	// in the band values of the image. Empty uses the average colour of the image, the gray-world assumption.
+	Reference []float64
+	// MaxGain limits the factor by which any channel is scaled up or down, 2 when zero, so that images
+	// genuinely dominated by one colour, such as a sunset or a blue wall, are not pushed all the way to grey.
+	MaxGain float64
+}
+
+// WhiteBalance removes a colour cast by scaling the red, green and blue bands so that the reference colour,
+// by default the average colour of the image, becomes grey at the mean of its three values.
+// Images in other colourspaces, such as CMYK or Lab, are converted to sRGB first and back afterwards.
+// Greyscale images are left unchanged and any alpha channel is untouched.
+func (r *Image) WhiteBalance(options *WhiteBalanceOptions) error {
+	if options == nil {
+		options = &WhiteBalanceOptions{}
+	}
+	maxGain := options.MaxGain
+	if maxGain == 0 {
+		maxGain = 2
+	}
+	if maxGain < 1 {
+		return fmt.Errorf("white balance max gain must be at least 1, got %g", maxGain)
+	}
+	reference := options.Reference
+	if len(reference) != 0 && len(reference) != 3 {
+		return fmt.Errorf("white balance reference must have 3 values, got %d", len(reference))
+	}
+	interpretation := r.Interpretation()
+	convert := false
+	switch interpretation {
+	case InterpretationBW, InterpretationGrey16:
+		return nil
+	case InterpretationSrgb, InterpretationRgb, InterpretationRgb16:
+	default:
+		if !r.IsColorSpaceSupported() {
+			return fmt.Errorf("white balance does not support interpretation %s", interpretation)
+		}
+		convert = true
+	}
+	if convert {
+		if err := r.Colourspace(InterpretationSrgb, nil); err != nil {
+			return err
+		}
+	}
+	bands := r.Bands()
+	if colourBands := bands - boolToInt(r.HasAlpha()); colourBands != 3 {
+		return fmt.Errorf("white balance requires 3 colour bands, got %d", colourBands)
+	}
+	if len(reference) == 0 {
+		average, err := r.AverageColor()
+		if err != nil {
+			return err
+		}
+		reference = average[:3]
+	}
+	grey := (reference[0] + reference[1] + reference[2]) / 3
+	gains := make([]float64, bands)
+	for i := range gains {
+		gains[i] = 1
+		if i < 3 && reference[i] > 0 {
+			gains[i] = math.Max(1/maxGain, math.Min(maxGain, grey/reference[i]))
+		}
+	}
+	format := r.BandFormat()
+	if err := r.Linear(gains, make([]float64, bands), nil); err != nil {
+		return err
+	}
+	if err := r.Cast(format, nil); err != nil {
+		return err
+	}
+	if convert {
+		return r.Colourspace(interpretation, nil)
+	}
+	return nil
+}
+
+// DominantColor vips_hist_find_ndim returns the most common sRGB colour, found by counting pixels in
+// a coarse bins x bins x bins colour histogram and returning the centre of the fullest bucket.
+// Fewer bins merge similar shades, so 8 to 16 suit most photos. The image is converted to 8-bit sRGB
+// first and any alpha band is ignored, so the result is always three values from 0 to 255.
+func (r *Image) DominantColor(bins int) ([]float64, error) {
+	if bins < 1 || bins > 256 {
+		return nil, fmt.Errorf("dominant color bins must be between 1 and 256, got %d", bins)
+	}
+	hist, err := r.Copy(nil)
+	if err != nil {
+		return nil, err
+	}
+	defer hist.Close()
+	if hist.IsColorSpaceSupported() {
+		if err = hist.ToSRGB(); err != nil {
+			return nil, err
+		}
+	}
+	if err = hist.RemoveAlpha(); err != nil {
+		return nil, err
+	}
+	if hist.Bands() != 3 {
+		return nil, fmt.Errorf("dominant color requires a colour image, got %d bands", hist.Bands())
+	}
+	if err = hist.Cast(BandFormatUchar, nil); err != nil {
+		return nil, err
+	}
+	if err = hist.HistFindNdim(&HistFindNdimOptions{Bins: bins}); err != nil {
+		return nil, err
+	}
+	data, err := hist.WriteToMemory()
+	if err != nil {
+		return nil, err
+	}
+	// The histogram is bins x bins pixels of bins uint bands, indexed by red, green and blue
+	fullest, count := 0, uint32(0)
+	for i := 0; i < bins*bins*bins; i++ {
+		if n := binary.NativeEndian.Uint32(data[i*4:]); n > count {
+			fullest, count = i, n
+		}
+	}
+	bucket := 256 / float64(bins)
+	red, green, blue := fullest/bins%bins, fullest/(bins*bins), fullest%bins
+	return []float64{
+		(float64(red) + 0.5) * bucket,
+		(float64(green) + 0.5) * bucket,
+		(float64(blue) + 0.5) * bucket,
+	}, nil
+}
+
+// hashGrid returns the greyscale values of the image stretched to width x height,
+// upscaling images smaller than the grid
+func (r *Image) hashGrid(width, height int) ([]float64, error) {
+	grid, err := r.Copy(nil)
+	if err != nil {
+		return nil, err
+	}
+	defer grid.Close()
+	if err = grid.toGreyscale(); err != nil {
+		return nil, err
+	}
+	if err = grid.ResizeTo(width, height, nil); err != nil {
+		return nil, err
+	}
+	if err = grid.Cast(BandFormatDouble, nil); err != nil {
+		return nil, err
+	}
+	data, err := grid.WriteToMemory()
+	if err != nil {
+		return nil, err
+	}
+	values := make([]float64, width*height)
+	for i := range values {
+		values[i] = math.Float64frombits(binary.NativeEndian.Uint64(data[i*8:]))
+	}
+	return values, nil
+}
+
+// newMatrixFromRows creates a matrix image from rows of equal length
+func newMatrixFromRows(rows [][]int) (*Image, error) {
+	floatRows := make([][]float64, len(rows))
+	for i, row := range rows {
+		floatRows[i] = make([]float64, len(row))
+		for j, v := range row {
+			floatRows[i][j] = float64(v)
+		}
+	}
+	return newMatrixFromFloatRows(floatRows)
+}
+
+// newMatrixFromFloatRows creates a matrix image from rows of equal length
+func newMatrixFromFloatRows(rows [][]float64) (*Image, error) {
+	if len(rows) == 0 || len(rows[0]) == 0 {
+		return nil, fmt.Errorf("matrix must not be empty")
+	}
+	width, height := len(rows[0]), len(rows)
+	array := make([]float64, 0, width*height)
+	for _, row := range rows {
+		if len(row) != width {
+			return nil, fmt.Errorf("matrix rows must all have length %d, got %d", width, len(row))
+		}
+		array = append(array, row...)
+	}
+	return NewMatrixFromArray(width, height, array)
+}
+
+// ApplyCurve applies a tone curve to a uchar image using vips_maplut.
+// The points are output levels for evenly spaced input levels across 0-255,
+// linearly interpolated into a 256-entry lookup table, e.g. {255, 0} inverts the image.
+func (r *Image) ApplyCurve(points []float64) error {
+	if len(points) < 2 {
+		return fmt.Errorf("curve requires at least 2 points, got %d", len(points))
+	}
+	table := make([]byte, 256)
+	segments := len(points) - 1
+	for i := range table {
+		pos := float64(i) * float64(segments) / 255
+		j := int(pos)
+		if j >= segments {
+			j = segments - 1
+		}
+		v := points[j] + (points[j+1]-points[j])*(pos-float64(j))
+		table[i] = uint8(math.Max(0, math.Min(255, math.Round(v))))
+	}
+	lut, err := NewImageFromMemory(table, len(table), 1, 1)
+	if err != nil {
+		return err
+	}
+	defer lut.Close()
+	return r.Maplut(lut, nil)
+}
+
+// MatchHistogramOptions are options for MatchHistogram method
+type MatchHistogramOptions struct {
+	// Luminance matches only the lightness of colour images and keeps their colours.
+	// Otherwise every band is matched on its own, which also shifts the colour balance towards the reference.
+	Luminance bool
+}
+
+// MatchHistogram remaps the tones of the image so that its histogram follows the reference, e.g. for
+// consistent exposure across a batch, via vips_hist_find, vips_hist_match and vips_maplut.
+// The image is matched as 8-bit sRGB or greyscale, or by the L of Lab with Luminance set,
+// and converted back to its interpretation afterwards. Any alpha channel is unchanged.
+func (r *Image) MatchHistogram(reference *Image, options *MatchHistogramOptions) error {
+	if reference == nil {
+		return fmt.Errorf("match histogram requires a reference image")
+	}
+	if options == nil {
+		options = &MatchHistogramOptions{}
+	}
+	interpretation := r.Interpretation()
+	if !r.IsColorSpaceSupported() {
+		return fmt.Errorf("match histogram does not support interpretation %s", interpretation)
+	}
+	if !reference.IsColorSpaceSupported() {
+		return fmt.Errorf("match histogram does not support reference interpretation %s", reference.Interpretation())
+	}
+	space := InterpretationSrgb
+	if interpretation == InterpretationBW || interpretation == InterpretationGrey16 {
+		space = InterpretationBW
+	} else if options.Luminance {
+		space = InterpretationLab
+	}
+	if err := r.Colourspace(space, nil); err != nil {
+		return err
+	}
+	var alpha *Image
+	if r.HasAlpha() {
+		var err error
+		if alpha, err = r.Copy(nil); err != nil {
+			return err
+		}
+		defer alpha.Close()
+		if err = alpha.ExtractBand(r.Bands()-1, nil); err != nil {
+			return err
+		}
+		if err = r.RemoveAlpha(); err != nil {
+			return err
+		}
+	}
+	ref, err := reference.Copy(nil)
+	if err != nil {
+		return err
+	}
+	defer ref.Close()
+	if err = ref.Colourspace(space, nil); err != nil {
+		return err
+	}
+	if err = ref.RemoveAlpha(); err != nil {
+		return err
+	}
+	if space == InterpretationLab {
+		err = r.matchLightness(ref)
+	} else {
+		err = r.matchBands(ref)
+	}
+	if err != nil {
+		return err
+	}
+	if alpha != nil {
+		out, err := vipsgenBandjoin([]*C.VipsImage{r.image, alpha.image})
+		if err != nil {
+			return err
+		}
+		r.setImage(out)
+	}
+	return r.Colourspace(interpretation, &ColourspaceOptions{SourceSpace: space})
+}
+
+// matchBands maps every band of the image onto the histogram of the same band of ref, as uchar
+func (r *Image) matchBands(ref *Image) error {
+	for _, img := range []*Image{r, ref} {
+		if img.BandFormat() != BandFormatUchar {
+			if err := img.Cast(BandFormatUchar, nil); err != nil {
+				return err
+			}
+		}
+	}
+	lut, err := histogramMatchLUT(r, ref)
+	if err != nil {
+		return err
+	}
+	defer lut.Close()
+	if err = r.Maplut(lut, nil); err != nil {
+		return err
+	}
+	return r.Cast(BandFormatUchar, nil)
+}
+
+// matchLightness maps the L band of a Lab image onto the lightness histogram of the Lab ref, keeping a and b
+func (r *Image) matchLightness(ref *Image) error {
+	lightness, err := labLightness(r)
+	if err != nil {
+		return err
+	}
+	defer lightness.Close()
+	refLightness, err := labLightness(ref)
+	if err != nil {
+		return err
+	}
+	defer refLightness.Close()
+	lut, err := histogramMatchLUT(lightness, refLightness)
+	if err != nil {
+		return err
+	}
+	defer lut.Close()
+	if err = lightness.Maplut(lut, nil); err != nil {
+		return err
+	}
+	if err = lightness.Linear([]float64{100.0 / 255}, []float64{0}, nil); err != nil {
+		return err
+	}
+	chroma, err := r.Copy(nil)
+	if err != nil {
+		return err
+	}
+	defer chroma.Close()
+	if err = chroma.ExtractBand(1, &ExtractBandOptions{N: 2}); err != nil {
+		return err
+	}
+	out, err := vipsgenBandjoin([]*C.VipsImage{lightness.image, chroma.image})
+	if err != nil {
+		return err
+	}
+	r.setImage(out)
+	return nil
+}
+
+// labLightness returns the L band of a Lab image scaled from 0-100 to uchar 0-255
+func labLightness(lab *Image) (*Image, error) {
+	lightness, err := lab.Copy(nil)
+	if err != nil {
+		return nil, err
+	}
+	if err = lightness.ExtractBand(0, nil); err != nil {
+		lightness.Close()
+		return nil, err
+	}
+	if err = lightness.Linear([]float64{2.55}, []float64{0}, &LinearOptions{Uchar: true}); err != nil {
+		lightness.Close()
+		return nil, err
+	}
+	return lightness, nil
+}
+
+// histogramMatchLUT returns a lookup table that maps the uchar image onto the histogram of the uchar ref
+func histogramMatchLUT(in, ref *Image) (*Image, error) {
+	refHist, err := ref.Copy(nil)
+	if err != nil {
+		return nil, err
+	}
+	defer refHist.Close()
+	if err = refHist.HistFind(nil); err != nil {
+		return nil, err
+	}
+	lut, err := in.Copy(nil)
+	if err != nil {
+		return nil, err
+	}
+	if err = lut.HistFind(nil); err != nil {
+		lut.Close()
+		return nil, err
+	}
+	if err = lut.HistMatch(refHist); err != nil {
+		lut.Close()
+		return nil, err
+	}
+	return lut, nil
+}
+
+// ToSRGB converts the image to the sRGB colourspace using vips_colourspace
+func (r *Image) ToSRGB() error {
+	return r.Colourspace(InterpretationSrgb, nil)
+}
+
+// ToLab converts the image to the CIELAB colourspace using vips_colourspace
+func (r *Image) ToLab() error {
+	return r.Colourspace(InterpretationLab, nil)
+}
+
+// ToXYZ converts the image to the CIE XYZ colourspace using vips_colourspace
+func (r *Image) ToXYZ() error {
+	return r.Colourspace(InterpretationXyz, nil)
+}
+
+// CropOptions are options for Crop method
+type CropOptions struct {
+	// Clamp clips the crop area to the image bounds instead of returning an error
+	Clamp bool
+}
+
+// Crop extracts an area of the image like ExtractArea.
+// Negative left or top are relative to the right or bottom edge, e.g. left -100 starts 100 pixels from the right.
+// With Clamp the area is clipped to the image bounds, otherwise an area outside the image returns an error.
+func (r *Image) Crop(left, top, width, height int, options *CropOptions) error {
+	if left < 0 {
+		left += r.Width()
+	}
+	if top < 0 {
+		top += r.Height()
+	}
+	if options != nil && options.Clamp {
+		right := min(left+width, r.Width())
+		bottom := min(top+height, r.Height())
+		left = max(left, 0)
+		top = max(top, 0)
+		width = right - left
+		height = bottom - top
+		if width <= 0 || height <= 0 {
+			return fmt.Errorf("crop area does not overlap the %dx%d image", r.Width(), r.Height())
+		}
+	}
+	return r.ExtractArea(left, top, width, height)
+}
+
+// ResizeToOptions are options for ResizeTo method
+type ResizeToOptions struct {
+	// Kernel Resampling kernel
+	Kernel Kernel
+	// Crop fills the target size preserving the aspect ratio and crops the overflow using this strategy.
+	// InterestingNone stretches the image to the target size instead.
+	Crop Interesting
+}
+
+// ResizeTo resizes the image to exactly width x height pixels.
+// A zero width or height is computed from the other one to preserve the aspect ratio.
+func (r *Image) ResizeTo(width, height int, options *ResizeToOptions) error {
+	if options == nil {
+		options = &ResizeToOptions{}
+	}
+	inWidth, inHeight := r.Width(), r.Height()
+	if width <= 0 && height <= 0 {
+		return fmt.Errorf("resize to %dx%d requires a positive width or height", width, height)
+	}
+	if height <= 0 {
+		height = max(1, int(math.Round(float64(inHeight)*float64(width)/float64(inWidth))))
+	}
+	if width <= 0 {
+		width = max(1, int(math.Round(float64(inWidth)*float64(height)/float64(inHeight))))
+	}
+	hscale := float64(width) / float64(inWidth)
+	vscale := float64(height) / float64(inHeight)
+	if options.Crop != InterestingNone {
+		hscale = math.Max(hscale, vscale)
+		vscale = hscale
+	}
+	if err := r.Resize(hscale, &ResizeOptions{Kernel: options.Kernel, Vscale: vscale}); err != nil {
+		return err
+	}
+	// Trim the overflow of fill mode, and any pixel lost or gained to rounding
+	if r.Width() > width || r.Height() > height {
+		interesting := options.Crop
+		if interesting == InterestingNone {
+			interesting = InterestingCentre
+		}
+		if err := r.Smartcrop(min(r.Width(), width), min(r.Height(), height), &SmartcropOptions{
+			Interesting: interesting,
+		}); err != nil {
+			return err
+		}
+	}
+	if r.Width() < width || r.Height() < height {
+		return r.Embed(0, 0, width, height, &EmbedOptions{Extend: ExtendCopy})
+	}
+	return nil
+}
+
+// SmartResize resizes the image to exactly width x height pixels without distorting it.
+// The image is scaled to fill the target and the overflow is cropped using strategy,
+// e.g. InterestingAttention to keep the most salient region.
+// InterestingNone letterboxes instead: the image is scaled to fit and centred on a black,
+// or transparent with alpha, background.
+func (r *Image) SmartResize(width, height int, strategy Interesting) error {
+	if width <= 0 || height <= 0 {
+		return fmt.Errorf("smart resize requires a positive width and height, got %dx%d", width, height)
+	}
+	if strategy != InterestingNone {
+		return r.ResizeTo(width, height, &ResizeToOptions{Crop: strategy})
+	}
+	scale := math.Min(float64(width)/float64(r.Width()), float64(height)/float64(r.Height()))
+	if err := r.Resize(scale, nil); err != nil {
+		return err
+	}
+	// Resize may round a pixel over the target
+	if r.Width() > width || r.Height() > height {
+		if err := r.ExtractArea(0, 0, min(r.Width(), width), min(r.Height(), height)); err != nil {
+			return err
+		}
+	}
+	return r.Embed((width-r.Width())/2, (height-r.Height())/2, width, height, &EmbedOptions{Extend: ExtendBlack})
+}
+
+// CropPercent crops the image to a region given in percent of its width and height,
+// e.g. 10, 10, 80, 80 trims a 10% border. Pixel coordinates are rounded to the nearest pixel.
+func (r *Image) CropPercent(leftPct, topPct, widthPct, heightPct float64) error {
+	if leftPct < 0 || topPct < 0 || widthPct <= 0 || heightPct <= 0 ||
+		leftPct+widthPct > 100 || topPct+heightPct > 100 {
+		return fmt.Errorf("crop region %g%%, %g%% %g%% x %g%% is outside the image", leftPct, topPct, widthPct, heightPct)
+	}
+	inWidth, inHeight := r.Width(), r.Height()
+	left := int(math.Round(float64(inWidth) * leftPct / 100))
+	top := int(math.Round(float64(inHeight) * topPct / 100))
+	width := max(1, min(inWidth-left, int(math.Round(float64(inWidth)*widthPct/100))))
+	height := max(1, min(inHeight-top, int(math.Round(float64(inHeight)*heightPct/100))))
+	return r.ExtractArea(min(left, inWidth-1), min(top, inHeight-1), width, height)
+}
+
+// PadTo pads the image to width x height with the background colour, placing it according to gravity,
+// e.g. CompassDirectionCentre to centre it. It never crops: a dimension already larger than
+// the target is left as it is, so shrink the image first, e.g. with SmartResize, to get an exact size.
+func (r *Image) PadTo(width, height int, gravity CompassDirection, background []float64) error {
+	width, height = max(width, r.Width()), max(height, r.Height())
+	if width == r.Width() && height == r.Height() {
+		return nil
+	}
+	return r.Gravity(gravity, width, height, &GravityOptions{
+		Extend:     ExtendBackground,
+		Background: background,
+	})
+}
+
+// CompositeMasked composites overlay over the image at x, y using mask as the blend weight
+// instead of the overlay's own alpha. The mask must be a single-band image the size of the overlay,
+// where 0 keeps the image and the maximum value, e.g. 255 for uchar, shows the overlay.
+func (r *Image) CompositeMasked(overlay, mask *Image, x, y int) error {
+	if mask.Bands() != 1 {
+		return fmt.Errorf("composite mask must have 1 band, got %d", mask.Bands())
+	}
+	if mask.Width() != overlay.Width() || mask.Height() != overlay.Height() {
+		return fmt.Errorf("composite mask size %dx%d does not match overlay size %dx%d",
+			mask.Width(), mask.Height(), overlay.Width(), overlay.Height())
+	}
+	colour, err := overlay.Copy(nil)
+	if err != nil {
+		return err
+	}
+	defer colour.Close()
+	if colour.HasAlpha() {
+		if err := colour.ExtractBand(0, &ExtractBandOptions{N: colour.Bands() - 1}); err != nil {
+			return err
+		}
+	}
+	masked, err := NewBandjoin([]*Image{colour, mask})
+	if err != nil {
+		return err
+	}
+	defer masked.Close()
+	return r.Composite2(masked, BlendModeOver, &Composite2Options{X: x, Y: y})
+}
+
+var (
+	blendModesOnce sync.Once
+	blendModes     []BlendMode
+)
+
+// SupportedBlendModes returns the blend modes that Composite2 and NewComposite accept on this libvips build.
+// Modes are applied to premultiplied alpha, which libvips handles itself unless the Premultiplied option is set,
+// in which case inputs must already be premultiplied.
+func SupportedBlendModes() []BlendMode {
+	blendModesOnce.Do(func() {
+		Startup(nil)
+		blendModes = vipsBlendModes()
+	})
+	return append([]BlendMode(nil), blendModes...)
+}
+
+// checkBlendMode returns an error wrapping ErrOperationNotSupported for a blend mode this libvips build does not know
+func checkBlendMode(modes ...BlendMode) error {
+	supported := SupportedBlendModes()
+	for _, mode := range modes {
+		known := false
+		for _, s := range supported {
+			if s == mode {
+				known = true
+				break
+			}
+		}
+		if !known {
+			return fmt.Errorf("%w: blend mode %s", ErrOperationNotSupported, mode)
+		}
+	}
+	return nil
+}
+
+var (
+	trellisQuantOnce      sync.Once
+	trellisQuantSupported bool
+)
+
+// checkTrellisQuant returns an error wrapping ErrOperationNotSupported when libjpeg is not built from mozjpeg,
+// where libvips would otherwise only warn and save without trellis quantisation.
+// Support is probed once, by checking that the option changes a small saved jpeg.
+func checkTrellisQuant() error {
+	trellisQuantOnce.Do(func() {
+		Startup(nil)
+		trellisQuantSupported = vipsTrellisQuantSupported()
+	})
+	if !trellisQuantSupported {
+		return fmt.Errorf("%w: jpegsave trellis_quant needs libjpeg built from mozjpeg", ErrOperationNotSupported)
+	}
+	return nil
+}
+
+// EnforceSequential restricts downstream operations to reading the image top-to-bottom via vips_sequential.
+// Operations that need random access then fail with ErrSequentialAccess rather than stalling.
+func (r *Image) EnforceSequential() error {
+	out, err := vipsgenSequential(r.image)
+	if err != nil {
+		return err
+	}
+	r.setImage(out)
+	return nil
+}
+
+// MaxWithPosition returns the maximum value of the image and the position where it occurs,
+// as an alternative to reading the X and Y outputs of MaxOptions.
+func (r *Image) MaxWithPosition() (value float64, x, y int, err error) {
+	options := DefaultMaxOptions()
+	value, err = r.Max(options)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	return value, options.X, options.Y, nil
+}
+
+// MinWithPosition returns the minimum value of the image and the position where it occurs,
+// as an alternative to reading the X and Y outputs of MinOptions.
+func (r *Image) MinWithPosition() (value float64, x, y int, err error) {
+	options := DefaultMinOptions()
+	value, err = r.Min(options)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	return value, options.X, options.Y, nil
+}
+
+// SmartcropResult crops the image like Smartcrop and returns the attention centre,
+// in input image coordinates, instead of writing it to the options.
+// The options are not modified and may be nil to use the defaults.
+func (r *Image) SmartcropResult(width, height int, options *SmartcropOptions) (attentionX, attentionY int, err error) {
+	opts := DefaultSmartcropOptions()
+	if options != nil {
+		*opts = *options
+	}
+	if err := r.Smartcrop(width, height, opts); err != nil {
+		return 0, 0, err
+	}
+	return opts.AttentionX, opts.AttentionY, nil
+}
+
+// SmartcropRect crops the image to width x height using the given strategy and
+// returns the top-left corner of the chosen crop box in input image coordinates.
+// A requested size larger than the image is clamped to the image size, so the
+// crop box is left, top, r.Width(), r.Height() after the call.
+func (r *Image) SmartcropRect(width, height int, strategy Interesting) (left, top int, err error) {
+	if width <= 0 || height <= 0 {
+		return 0, 0, fmt.Errorf("invalid crop size %dx%d", width, height)
+	}
+	width = min(width, r.Width())
+	height = min(height, r.Height())
+	if err := r.Smartcrop(width, height, &SmartcropOptions{Interesting: strategy}); err != nil {
+		return 0, 0, err
+	}
+	// vips_smartcrop extracts the box from the input, which records the
+	// position of the area as a negative offset
+	return -r.OffsetX(), -r.OffsetY(), nil
+}
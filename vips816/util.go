@@ -6,9 +6,12 @@ package vips
 // #include "util.h"
 import "C"
 import (
+	"errors"
 	"fmt"
 	"runtime"
+	"strings"
 	"sync"
+	"time"
 	"unsafe"
 )
 
@@ -31,9 +34,41 @@ var (
 	isStarted     bool
 	isShutdown    bool
 	errorBufferMu sync.Mutex
+	// lastVipsError holds the messages drained by the most recent failed operation
+	lastVipsError string
+
+	operationTimeout time.Duration
+	maxImageWidth    int
+	maxImageHeight   int
+	maxImagePixels   int64
 )
 
+// ErrOperationTimeout is returned when an evaluation is aborted by Config.OperationTimeout or Image.WithDeadline
+var ErrOperationTimeout = errors.New("vips: operation timed out")
+
+// ErrSequentialAccess is returned when an image loaded or enforced as sequential is read out of order
+var ErrSequentialAccess = errors.New("vips: out of order read on sequential image")
+
+// ErrOperationNotSupported is returned when an operation is not available in the linked libvips build
+var ErrOperationNotSupported = errors.New("vips: operation not supported")
+
+// ErrPasswordRequired is returned when loading an encrypted document without the correct password
+var ErrPasswordRequired = errors.New("vips: password required or incorrect")
+
+// ErrFileNotFound is returned when loading a file that does not exist or saving into a missing directory
+var ErrFileNotFound = errors.New("vips: file not found")
+
+// ErrUnsupportedFormat is returned when no loader recognises the input or no saver matches the output
+var ErrUnsupportedFormat = errors.New("vips: unsupported format")
+
+// ErrInvalidImage is returned when a recognised image fails to load, e.g. truncated or corrupt data
+var ErrInvalidImage = errors.New("vips: invalid image")
+
+// ErrImageTooLarge is returned when a loaded or created image exceeds Config.MaxWidth, Config.MaxHeight or Config.MaxPixels
+var ErrImageTooLarge = errors.New("vips: image too large")
+
 type Config struct {
+	// ConcurrencyLevel sets the libvips worker thread count, 0 for the default based on the number of CPUs
 	ConcurrencyLevel     int
 	MaxCacheFiles        int
 	MaxCacheMem          int
@@ -42,6 +77,13 @@ type Config struct {
 	CacheTrace           bool
 	VectorEnabled        bool
 	VectorDisableTargets int64
+	// OperationTimeout aborts any single image evaluation that runs longer than this, zero for no limit
+	OperationTimeout time.Duration
+	// MaxWidth and MaxHeight reject loaded or created images with a wider or taller page, zero for no limit
+	MaxWidth  int
+	MaxHeight int
+	// MaxPixels rejects loaded or created images with more pixels across all loaded pages, zero for no limit
+	MaxPixels int64
 }
 
 // LogLevel log level
@@ -163,6 +205,16 @@ func startup(config *Config) {
 		C.vips_cache_set_trace(toGboolean(true))
 	}
 
+	if config != nil && config.OperationTimeout > 0 {
+		operationTimeout = config.OperationTimeout
+	}
+
+	if config != nil {
+		maxImageWidth = max(config.MaxWidth, 0)
+		maxImageHeight = max(config.MaxHeight, 0)
+		maxImagePixels = max(config.MaxPixels, 0)
+	}
+
 	log("vipsgen", LogLevelInfo, fmt.Sprintf("vips %s started with concurrency=%d cache_max_files=%d cache_max_mem=%d cache_max=%d",
 		Version,
 		int(C.vips_concurrency_get()),
@@ -191,6 +243,21 @@ func Shutdown() {
 	isShutdown = true
 }
 
+// SetConcurrency sets the number of libvips worker threads, 0 for the default based on the number of CPUs
+func SetConcurrency(n int) {
+	Startup(nil)
+	if n < 0 {
+		n = 0
+	}
+	C.vips_concurrency_set(C.int(n))
+}
+
+// Concurrency returns the number of libvips worker threads
+func Concurrency() int {
+	Startup(nil)
+	return int(C.vips_concurrency_get())
+}
+
 // MemoryStats is a data structure that houses various memory statistics from ReadVipsMemStats()
 type MemoryStats struct {
 	Mem     int64
@@ -209,36 +276,131 @@ func ReadVipsMemStats(stats *MemoryStats) {
 
 // HasOperation checks if a libvips operation exists
 func HasOperation(name string) bool {
+	return RequireOperation(name) == nil
+}
+
+// RequireOperation returns an error wrapping ErrOperationNotSupported if a libvips operation does not exist.
+// The operation is looked up by type rather than created, so the libvips error buffer is left untouched.
+func RequireOperation(name string) error {
 	Startup(nil)
 	cName := C.CString(name)
 	defer freeCString(cName)
-	vop := C.vips_operation_new(cName)
-	if vop == nil {
-		clearVipsError()
-		return false
+	gtype := C.vips_type_find(cachedCString("VipsOperation"), cName)
+	if gtype == 0 || C.g_type_test_flags(gtype, C.guint(C.G_TYPE_FLAG_ABSTRACT)) != 0 {
+		return fmt.Errorf("%w: %s not supported by this libvips build", ErrOperationNotSupported, name)
 	}
-	if C.is_gobject(unsafe.Pointer(vop)) != 0 {
-		C.g_object_unref(C.gpointer(vop))
+	return nil
+}
+
+// optionalOperations caches the availability of operations from optional libvips modules,
+// which the generated savers check on every call and which cannot change once libvips has started
+var optionalOperations sync.Map
+
+// requireOptionalOperation is RequireOperation with the result cached per operation
+func requireOptionalOperation(name string) error {
+	check, ok := optionalOperations.Load(name)
+	if !ok {
+		check, _ = optionalOperations.LoadOrStore(name, sync.OnceValue(func() error {
+			return RequireOperation(name)
+		}))
+	}
+	return check.(func() error)()
+}
+
+// wrapLoadError marks a loader failure as ErrInvalidImage unless another sentinel explains it,
+// since the input was found and recognised but could not be decoded
+func wrapLoadError(err error) error {
+	for _, sentinel := range []error{
+		ErrFileNotFound, ErrUnsupportedFormat, ErrPasswordRequired,
+		ErrOperationTimeout, ErrSequentialAccess, ErrOperationNotSupported,
+	} {
+		if errors.Is(err, sentinel) {
+			return err
+		}
+	}
+	return fmt.Errorf("%w: %v", ErrInvalidImage, err)
+}
+
+// checkOptionRange validates an integer option, where zero means the option is unset
+func checkOptionRange(operation, name string, value, min, max int) error {
+	if value != 0 && (value < min || value > max) {
+		return fmt.Errorf("%s: %s must be between %d and %d, got %d", operation, name, min, max, value)
 	}
-	return true
+	return nil
 }
 
-func handleImageError(out *C.VipsImage) error {
+func handleImageError(operation string, out *C.VipsImage) error {
 	if out != nil {
 		clearImage(out)
 	}
-	return handleVipsError()
+	return handleVipsError(operation)
+}
+
+// handleVipsError reads the libvips error buffer into an error prefixed with the failing operation,
+// wrapping the matching sentinel error where there is one
+func handleVipsError(operation string) error {
+	return fmt.Errorf("%s: %w", operation, vipsBufferError())
 }
 
-func handleVipsError() error {
+func vipsBufferError() error {
 	s := readVipsError()
 	if s == "" {
 		return fmt.Errorf("vips: empty error buffer")
 	}
+	// Evaluations are only killed by the eval limit set up with vipsSetEvalLimit
+	if strings.Contains(s, "killed for image") {
+		return fmt.Errorf("%w: %s", ErrOperationTimeout, s)
+	}
+	if strings.Contains(s, "out of order read") || strings.Contains(s, "non-sequential read") {
+		return fmt.Errorf("%w: %s", ErrSequentialAccess, s)
+	}
+	// Encrypted PDFs, as reported by poppler and pdfium respectively
+	if strings.Contains(s, "Document is encrypted") || strings.Contains(s, "incorrect password") {
+		return fmt.Errorf("%w: %s", ErrPasswordRequired, s)
+	}
+	// Loaders and savers of files, where the system error is appended to the libvips message
+	if strings.Contains(s, "does not exist") || strings.Contains(s, "No such file or directory") {
+		return fmt.Errorf("%w: %s", ErrFileNotFound, s)
+	}
+	// No loader or saver found for a file suffix, buffer or source
+	if strings.Contains(s, "is not a known") || strings.Contains(s, "is not in a known format") {
+		return fmt.Errorf("%w: %s", ErrUnsupportedFormat, s)
+	}
+	// Operations missing from the libvips build, either older than the
+	// generated bindings or compiled without the required module
+	if strings.Contains(s, "not supported in this libvips build") ||
+		(strings.Contains(s, "VipsOperation: class") && strings.Contains(s, "not found")) {
+		return fmt.Errorf("%w: %s", ErrOperationNotSupported, s)
+	}
 
 	return fmt.Errorf("%v", s)
 }
 
+// VipsError returns the libvips error messages of the most recent failed operation,
+// followed by anything logged to the libvips error buffer since, without clearing them.
+// Returned errors already carry these messages, after the name of the failing operation.
+// The most recent failure is shared by all goroutines, so when operations fail
+// concurrently the messages may belong to another goroutine.
+// The libvips error buffer itself is kept per OS thread, and Go may move a goroutine
+// to another thread between calls, so messages logged since are only those of the
+// calling thread. Lock the goroutine with runtime.LockOSThread around the operations
+// and this call to be sure of seeing what they logged.
+func VipsError() string {
+	errorBufferMu.Lock()
+	defer errorBufferMu.Unlock()
+	return lastVipsError + C.GoString(C.vips_error_buffer())
+}
+
+// ClearVipsError empties the libvips error buffer and forgets the most recent failure.
+// Only the error buffer of the calling OS thread is emptied, so lock the goroutine with
+// runtime.LockOSThread to clear the buffer of the thread that earlier calls ran on.
+func ClearVipsError() {
+	errorBufferMu.Lock()
+	defer errorBufferMu.Unlock()
+	lastVipsError = ""
+	C.vips_error_clear()
+}
+
 func clearVipsError() {
 	errorBufferMu.Lock()
 	defer errorBufferMu.Unlock()
@@ -250,6 +412,7 @@ func readVipsError() string {
 	defer errorBufferMu.Unlock()
 	s := C.GoString(C.vips_error_buffer())
 	C.vips_error_clear()
+	lastVipsError = s
 	return s
 }
 
@@ -298,7 +461,8 @@ func cachedCString(str string) *C.char {
 }
 
 // bufferToBytes converts a C buffer to Go bytes and frees the original buffer.
-// This function takes ownership of the buffer and will free it after conversion.
+// This function takes ownership of the buffer and will free it after conversion,
+// so it must only be given buffers the caller owns, such as save_buffer output.
 func bufferToBytes(buf unsafe.Pointer, length C.size_t) []byte {
 	if buf == nil {
 		return nil
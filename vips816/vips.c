@@ -1,10 +1,16 @@
 // Code generated by github.com/cshum/vipsgen from libvips 8.16.1; DO NOT EDIT.
 
 #include "vips.h"
+#include <string.h>
 #include <unistd.h>
 
 // Prerequisites to build, get outputs and cleanup a vips operation
 
+int vipsgen_operation_unsupported(const char *name) {
+    vips_error("vipsgen", "operation %s is not supported in this libvips build", name);
+    return 1;
+}
+
 int vipsgen_operation_execute(VipsOperation *operation, ...) {
     va_list ap;
     if (vips_cache_operation_buildp(&operation)) {
@@ -508,7 +514,11 @@ int vipsgen_cast_with_options(VipsImage* in, VipsImage** out, VipsBandFormat for
 }
 
 int vipsgen_clamp(VipsImage* in, VipsImage** out) {
+#if VIPSGEN_VERSION_AT_LEAST(8, 16)
     return vips_clamp(in, out, NULL);
+#else
+    return vipsgen_operation_unsupported("clamp");
+#endif
 }
 
 int vipsgen_clamp_with_options(VipsImage* in, VipsImage** out, double min, double max) {
@@ -3392,7 +3402,11 @@ int vipsgen_max_with_options(VipsImage* in, double* out, gint size, gint* x, gin
 }
 
 int vipsgen_maxpair(VipsImage* left, VipsImage* right, VipsImage** out) {
+#if VIPSGEN_VERSION_AT_LEAST(8, 16)
     return vips_maxpair(left, right, out, NULL);
+#else
+    return vipsgen_operation_unsupported("maxpair");
+#endif
 }
 
 int vipsgen_measure(VipsImage* in, VipsImage** out, gint h, gint v) {
@@ -3459,7 +3473,11 @@ int vipsgen_min_with_options(VipsImage* in, double* out, gint size, gint* x, gin
 }
 
 int vipsgen_minpair(VipsImage* left, VipsImage* right, VipsImage** out) {
+#if VIPSGEN_VERSION_AT_LEAST(8, 16)
     return vips_minpair(left, right, out, NULL);
+#else
+    return vipsgen_operation_unsupported("minpair");
+#endif
 }
 
 int vipsgen_morph(VipsImage* in, VipsImage** out, VipsImage* mask, VipsOperationMorphology morph) {
@@ -4626,7 +4644,11 @@ int vipsgen_scharr(VipsImage* in, VipsImage** out) {
 }
 
 int vipsgen_sdf(VipsImage** out, gint width, gint height, VipsSdfShape shape) {
+#if VIPSGEN_VERSION_AT_LEAST(8, 16)
     return vips_sdf(out, width, height, shape, NULL);
+#else
+    return vipsgen_operation_unsupported("sdf");
+#endif
 }
 
 int vipsgen_sdf_with_options(VipsImage** out, gint width, gint height, VipsSdfShape shape, double r, double* a, int a_n, double* b, int b_n, double* corners, int corners_n) {
@@ -5874,6 +5896,77 @@ int vipsgen_image_new_from_file_with_option(const char *name, VipsImage **out, c
   return 0;
 }
 
+int vipsgen_image_write_to_file(VipsImage *in, const char *name, const char *option_string) {
+  // As with loading, find the saver from the raw filename and set options via
+  // GObject properties, so that filenames with tokenizer-special characters work.
+  const char *saver = vips_foreign_find_save(name);
+  if (!saver) return 1;
+
+  VipsOperation *operation = vips_operation_new(saver);
+  if (!operation) return 1;
+
+  if (vips_object_set(VIPS_OBJECT(operation), "in", in, "filename", name, NULL)) {
+    g_object_unref(operation);
+    return 1;
+  }
+
+  if (option_string && strlen(option_string) > 0) {
+    if (vips_object_set_from_string(VIPS_OBJECT(operation), option_string)) {
+      g_object_unref(operation);
+      return 1;
+    }
+  }
+
+  if (vips_cache_operation_buildp(&operation)) {
+    vips_object_unref_outputs(VIPS_OBJECT(operation));
+    g_object_unref(operation);
+    return 1;
+  }
+  vips_object_unref_outputs(VIPS_OBJECT(operation));
+  g_object_unref(operation);
+  return 0;
+}
+
+int vipsgen_image_write_to_buffer(VipsImage *in, const char *suffix, void **buf, size_t *len) {
+  return vips_image_write_to_buffer(in, suffix, buf, len, NULL);
+}
+
+// vipsgen_getpoint_many reads n points, given as x, y pairs in xy, into out,
+// which holds n * bands doubles. The image is decoded and cast once and all
+// points are read through a single region, instead of building a pipeline per
+// point as vips_getpoint does.
+int vipsgen_getpoint_many(VipsImage *in, const int *xy, int n, double *out) {
+  VipsImage *decoded, *cast;
+  if (vips_image_decode(in, &decoded)) return 1;
+  if (vips_cast(decoded, &cast, VIPS_FORMAT_DOUBLE, NULL)) {
+    g_object_unref(decoded);
+    return 1;
+  }
+  g_object_unref(decoded);
+
+  VipsRegion *region = vips_region_new(cast);
+  if (!region) {
+    g_object_unref(cast);
+    return 1;
+  }
+  int bands = cast->Bands;
+  for (int i = 0; i < n; i++) {
+    VipsRect rect = { xy[2 * i], xy[2 * i + 1], 1, 1 };
+    if (vips_region_prepare(region, &rect)) {
+      g_object_unref(region);
+      g_object_unref(cast);
+      return 1;
+    }
+    double *p = (double *) VIPS_REGION_ADDR(region, rect.left, rect.top);
+    for (int b = 0; b < bands; b++) {
+      out[i * bands + b] = p[b];
+    }
+  }
+  g_object_unref(region);
+  g_object_unref(cast);
+  return 0;
+}
+
 int vipsgen_image_new_from_buffer(const void *buf, size_t len, VipsImage **out) {
   *out = vips_image_new_from_buffer(buf, len, "", NULL);
   if (!*out) return 1;
@@ -5886,6 +5979,25 @@ int vipsgen_image_new_from_memory(const void *buf, size_t len, int width, int he
   return 0;
 }
 
+// vipsgen_image_new_from_memory_like copies buf into a new image with the
+// dimensions, format, interpretation and resolution of like.
+int vipsgen_image_new_from_memory_like(VipsImage *like, const void *buf, size_t len, VipsImage **out) {
+  *out = vips_image_new_from_memory_copy(buf, len, like->Xsize, like->Ysize, like->Bands, like->BandFmt);
+  if (!*out) return 1;
+  (*out)->Type = like->Type;
+  (*out)->Xres = like->Xres;
+  (*out)->Yres = like->Yres;
+  (*out)->Xoffset = like->Xoffset;
+  (*out)->Yoffset = like->Yoffset;
+  return 0;
+}
+
+int vipsgen_image_new_matrix_from_array(int width, int height, const double *array, int size, VipsImage **out) {
+  *out = vips_image_new_matrix_from_array(width, height, array, size);
+  if (!*out) return 1;
+  return 0;
+}
+
 int vipsgen_image_write_to_memory(VipsImage *in, void **buf, size_t *len) {
   *buf = vips_image_write_to_memory(in, len);
   if (!*buf) return 1;
@@ -5903,6 +6015,71 @@ void vipsgen_clear_image(VipsImage **image) {
   if (G_IS_OBJECT(*image)) g_clear_object(image);
 }
 
+typedef struct {
+  gint64 deadline;
+  double timeout;
+} VipsgenEvalLimit;
+
+#define VIPSGEN_EVAL_LIMIT "vipsgen-eval-limit"
+
+static void vipsgen_eval_limit_cb(VipsImage *image, VipsProgress *progress, VipsgenEvalLimit *limit) {
+  gboolean expired = FALSE;
+  if (limit->deadline > 0 && g_get_real_time() >= limit->deadline) expired = TRUE;
+  if (limit->timeout > 0 && g_timer_elapsed(progress->start, NULL) >= limit->timeout) expired = TRUE;
+  // kill the image being computed, the evaluation then fails with "killed for image"
+  if (expired) vips_image_set_kill(progress->im, TRUE);
+}
+
+// vipsgen_set_eval_limit aborts evaluation of images derived from in once the absolute
+// deadline (microseconds since the epoch) passes, or a single evaluation exceeds timeout seconds.
+// Zero disables either limit.
+// libvips only signals eval on the progress image of a pipeline, which images inherit from their
+// inputs, so in becomes its own progress image and keeps the tighter of its new limits and those
+// of the progress image it inherited.
+void vipsgen_set_eval_limit(VipsImage *in, gint64 deadline, double timeout) {
+  VipsImage *upstream = in->progress_signal;
+  VipsgenEvalLimit *limit = upstream ? g_object_get_data(G_OBJECT(upstream), VIPSGEN_EVAL_LIMIT) : NULL;
+  if (limit && upstream != in) {
+    VipsgenEvalLimit *inherited = limit;
+    limit = g_new(VipsgenEvalLimit, 1);
+    *limit = *inherited;
+    g_object_set_data_full(G_OBJECT(in), VIPSGEN_EVAL_LIMIT, limit, g_free);
+    g_signal_connect(in, "eval", G_CALLBACK(vipsgen_eval_limit_cb), limit);
+  } else if (!limit) {
+    limit = g_new0(VipsgenEvalLimit, 1);
+    g_object_set_data_full(G_OBJECT(in), VIPSGEN_EVAL_LIMIT, limit, g_free);
+    g_signal_connect(in, "eval", G_CALLBACK(vipsgen_eval_limit_cb), limit);
+  }
+  if (deadline > 0 && (limit->deadline == 0 || deadline < limit->deadline)) limit->deadline = deadline;
+  if (timeout > 0 && (limit->timeout == 0 || timeout < limit->timeout)) limit->timeout = timeout;
+  vips_image_set_progress(in, FALSE);
+  vips_image_set_progress(in, TRUE);
+}
+
+static void vipsgen_cancel_cb(VipsImage *image, VipsProgress *progress, gint *cancelled) {
+  if (g_atomic_int_get(cancelled)) vips_image_set_kill(progress->im, TRUE);
+}
+
+// vipsgen_set_cancel returns a flag that, once set with vipsgen_cancel, aborts evaluation of
+// images derived from in. The flag is freed with in.
+gint *vipsgen_set_cancel(VipsImage *in) {
+  gint *cancelled = g_new0(gint, 1);
+  // make in its own progress image without dropping the limits it inherited
+  vipsgen_set_eval_limit(in, 0, 0);
+  g_signal_connect_data(in, "eval", G_CALLBACK(vipsgen_cancel_cb), cancelled, (GClosureNotify) g_free, 0);
+  return cancelled;
+}
+
+void vipsgen_cancel(gint *cancelled) {
+  g_atomic_int_set(cancelled, 1);
+}
+
+// vipsgen_image_copy_memory renders in into a new memory image, detached from its pipeline
+int vipsgen_image_copy_memory(VipsImage *in, VipsImage **out) {
+  *out = vips_image_copy_memory(in);
+  return *out == NULL;
+}
+
 int vipsgen_remove_exif(VipsImage *in, VipsImage **out) {
   static double default_resolution = 72.0 / 25.4;
 
@@ -6079,3 +6256,145 @@ int vipsgen_rot_multi_page(VipsImage *in, VipsImage **out, VipsAngle angle) {
   return 0;
 }
 
+int vipsgen_resize_multi_page(VipsImage *in, VipsImage **out, double scale, double vscale, VipsKernel kernel, double gap) {
+  VipsObject *base = VIPS_OBJECT(vips_image_new());
+  int page_height = vips_image_get_page_height(in);
+  int in_width = in->Xsize;
+  int n_pages = in->Ysize / page_height;
+
+  VipsImage **page = (VipsImage **) vips_object_local_array(base, n_pages);
+  VipsImage **resized_page = (VipsImage **) vips_object_local_array(base, n_pages);
+  VipsImage **copy = (VipsImage **) vips_object_local_array(base, 1);
+
+  // split image into frames and resize each frame separately
+  for (int i = 0; i < n_pages; i++) {
+    if (
+      vips_extract_area(in, &page[i], 0, page_height * i, in_width, page_height, NULL) ||
+      vips_resize(page[i], &resized_page[i], scale, "vscale", vscale, "kernel", kernel, "gap", gap, NULL)
+    ) {
+      g_object_unref(base);
+      return -1;
+    }
+  }
+  // reassemble frames and set page height to the resized frame height
+  // copy before modifying metadata
+  if(
+    vips_arrayjoin(resized_page, &copy[0], n_pages, "across", 1, NULL) ||
+    vips_copy(copy[0], out, NULL)
+  ) {
+    g_object_unref(base);
+    return -1;
+  }
+  vips_image_set_int(*out, VIPS_META_PAGE_HEIGHT, resized_page[0]->Ysize);
+  g_object_unref(base);
+  return 0;
+}
+
+int vipsgen_image_set_array_image(VipsImage *in, const char *name, VipsImage **images, int n) {
+  VipsArrayImage *array = vips_array_image_new(images, n);
+  if (!array) return 1;
+  GValue value = G_VALUE_INIT;
+  g_value_init(&value, VIPS_TYPE_ARRAY_IMAGE);
+  g_value_set_boxed(&value, array);
+  vips_image_set(in, name, &value);
+  g_value_unset(&value);
+  vips_area_unref(VIPS_AREA(array));
+  return 0;
+}
+
+// vipsgen_image_get_array_image returns a g_malloc'd array of images, each
+// with its own reference so they outlive the metadata field.
+int vipsgen_image_get_array_image(VipsImage *in, const char *name, VipsImage ***out, int *n) {
+  GValue value = G_VALUE_INIT;
+  if (vips_image_get(in, name, &value)) return 1;
+  if (G_VALUE_TYPE(&value) != VIPS_TYPE_ARRAY_IMAGE) {
+    g_value_unset(&value);
+    vips_error("vipsgen", "field \"%s\" is not an image array", name);
+    return 1;
+  }
+  int count = 0;
+  VipsImage **images = vips_value_get_array_image(&value, &count);
+  *out = g_new(VipsImage *, count);
+  for (int i = 0; i < count; i++) {
+    (*out)[i] = images[i];
+    g_object_ref(images[i]);
+  }
+  *n = count;
+  g_value_unset(&value);
+  return 0;
+}
+
+// vipsgen_image_get_meta_type maps the GType of a metadata field to the
+// MetaType enum in types.go. Absent fields are 0.
+int vipsgen_image_get_meta_type(VipsImage *in, const char *name) {
+  GType type = vips_image_get_typeof(in, name);
+  if (type == 0) return 0;
+  if (type == G_TYPE_INT) return 1;
+  if (type == G_TYPE_DOUBLE) return 2;
+  if (type == VIPS_TYPE_REF_STRING || type == G_TYPE_STRING) return 3;
+  if (type == VIPS_TYPE_BLOB) return 4;
+  if (type == VIPS_TYPE_ARRAY_INT) return 5;
+  if (type == VIPS_TYPE_ARRAY_DOUBLE) return 6;
+  if (type == VIPS_TYPE_IMAGE) return 7;
+  if (type == VIPS_TYPE_ARRAY_IMAGE) return 8;
+  return 9;
+}
+
+// vipsgen_blend_modes writes up to max blend modes known to libvips into out
+// and returns how many there are.
+int vipsgen_blend_modes(int *out, int max) {
+  GEnumClass *klass = g_type_class_ref(VIPS_TYPE_BLEND_MODE);
+  int n = 0;
+  for (guint i = 0; i < klass->n_values; i++) {
+    if (g_str_equal(klass->values[i].value_nick, "last")) continue;
+    if (n < max) out[n] = klass->values[i].value;
+    n++;
+  }
+  g_type_class_unref(klass);
+  return n;
+}
+
+// vipsgen_trellis_quant_supported saves a small noise image with and without trellis_quant
+// and reports whether the two differ. libjpeg-turbo ignores trellis_quant with a warning,
+// so only a libjpeg built from mozjpeg changes the output.
+int vipsgen_trellis_quant_supported(void) {
+  VipsImage *noise, *cast, *memory;
+  void *plain = NULL, *trellis = NULL;
+  size_t plain_length = 0, trellis_length = 0;
+  int supported = 0;
+
+  if (vips_gaussnoise(&noise, 32, 32, NULL)) {
+    vips_error_clear();
+    return 0;
+  }
+  if (vips_cast_uchar(noise, &cast, NULL)) {
+    g_object_unref(noise);
+    vips_error_clear();
+    return 0;
+  }
+  g_object_unref(noise);
+  memory = vips_image_copy_memory(cast);
+  g_object_unref(cast);
+  if (!memory) {
+    vips_error_clear();
+    return 0;
+  }
+
+  if (!vips_jpegsave_buffer(memory, &plain, &plain_length, "Q", 75, NULL) &&
+      !vips_jpegsave_buffer(memory, &trellis, &trellis_length, "Q", 75, "trellis_quant", TRUE, NULL)) {
+    supported = plain_length != trellis_length || memcmp(plain, trellis, plain_length) != 0;
+  }
+  vips_error_clear();
+  g_free(plain);
+  g_free(trellis);
+  g_object_unref(memory);
+  return supported;
+}
+
+int vipsgen_image_copy_field(VipsImage *from, VipsImage *to, const char *name) {
+  GValue value = G_VALUE_INIT;
+  if (vips_image_get(from, name, &value)) return 1;
+  vips_image_set(to, name, &value);
+  g_value_unset(&value);
+  return 0;
+}
@@ -8,6 +8,7 @@ import "C"
 import (
 	"fmt"
 	"io"
+	"os"
 	"runtime/cgo"
 	"sync"
 	"sync/atomic"
@@ -15,12 +16,18 @@ import (
 
 // Source contains a libvips VipsSourceCustom and manages its lifecycle.
 type Source struct {
-	reader io.ReadCloser
-	seeker io.Seeker
-	src    *C.VipsSourceCustom
-	handle cgo.Handle
-	deleted atomic.Uint32
-	lock   sync.Mutex
+	reader    io.ReadCloser
+	seeker    io.Seeker
+	readerAt  io.ReaderAt
+	spool     *sourceSpool
+	offset    int64
+	size      int64
+	readErr   error
+	bytesRead atomic.Int64
+	src       *C.VipsSourceCustom
+	handle    cgo.Handle
+	deleted   atomic.Uint32
+	lock      sync.Mutex
 }
 
 func newSourceHandle(source *Source) cgo.Handle {
@@ -56,18 +63,28 @@ func goHandleDelete(handle C.uintptr_t) {
 	}
 }
 
-// NewSource creates Source from reader
+// NewSource creates Source from reader.
+// Readers implementing io.Seeker, or io.ReaderAt otherwise, are seekable by libvips,
+// which avoids buffering formats such as TIFF that need random access.
+// For io.ReaderAt, seeking from the end requires a Size() int64 method.
+// Other readers are spooled as they are read, in memory up to 32MB and then
+// to a temporary file, so that loaders requiring seek such as RAW still work.
 func NewSource(reader io.ReadCloser) *Source {
 	Startup(nil)
-	s := &Source{reader: reader}
+	s := &Source{reader: reader, size: -1}
 	s.handle = newSourceHandle(s)
-	seeker, ok := reader.(io.Seeker)
-	if ok {
+	if seeker, ok := reader.(io.Seeker); ok {
 		s.seeker = seeker
-		s.src = C.create_go_custom_source_with_seek(C.uintptr_t(uintptr(s.handle)))
+	} else if readerAt, ok := reader.(io.ReaderAt); ok {
+		s.readerAt = readerAt
+		if sizer, ok := reader.(interface{ Size() int64 }); ok {
+			s.size = sizer.Size()
+		}
 	} else {
-		s.src = C.create_go_custom_source(C.uintptr_t(uintptr(s.handle)))
+		s.spool = &sourceSpool{reader: reader}
+		s.readerAt = s.spool
 	}
+	s.src = C.create_go_custom_source_with_seek(C.uintptr_t(uintptr(s.handle)))
 	if s.src == nil {
 		s.deleteHandle(s.handle)
 		s.handle = 0
@@ -75,6 +92,164 @@ func NewSource(reader io.ReadCloser) *Source {
 	return s
 }
 
+// BytesRead returns the number of bytes the Source has passed to libvips so far.
+// Data that libvips seeks back to and reads again is counted again,
+// so this can exceed the size of the input for formats needing random access.
+func (s *Source) BytesRead() int64 {
+	return s.bytesRead.Load()
+}
+
+// readError returns the last error from the underlying reader other than io.EOF
+func (s *Source) readError() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.readErr
+}
+
+type readSeekNopCloser struct {
+	io.ReadSeeker
+}
+
+func (readSeekNopCloser) Close() error { return nil }
+
+// nopReadCloser wraps a reader with a no-op Close, keeping it seekable by NewSource if it was
+func nopReadCloser(reader io.Reader) io.ReadCloser {
+	if readSeeker, ok := reader.(io.ReadSeeker); ok {
+		return readSeekNopCloser{readSeeker}
+	}
+	return io.NopCloser(reader)
+}
+
+// readAt reads from the tracked offset of an io.ReaderAt source
+func (s *Source) readAt(buf []byte) (int, error) {
+	n, err := s.readerAt.ReadAt(buf, s.offset)
+	s.offset += int64(n)
+	return n, err
+}
+
+// seekReaderAt emulates io.Seeker for an io.ReaderAt source, returning -1 on failure
+func (s *Source) seekReaderAt(offset int64, whence int) int64 {
+	var abs int64
+	switch whence {
+	case io.SeekStart:
+		abs = offset
+	case io.SeekCurrent:
+		abs = s.offset + offset
+	case io.SeekEnd:
+		if s.spool != nil {
+			if err := s.spool.fill(-1); err != nil {
+				s.readErr = err
+				return -1
+			}
+			s.size = s.spool.size
+		}
+		if s.size < 0 {
+			return -1
+		}
+		abs = s.size + offset
+	default:
+		return -1
+	}
+	if abs < 0 {
+		return -1
+	}
+	s.offset = abs
+	return abs
+}
+
+// sourceSpoolMemoryLimit is the number of bytes of a non-seekable reader
+// retained in memory before spooling the remainder to a temporary file
+var sourceSpoolMemoryLimit int64 = 32 << 20
+
+// sourceSpool provides random access to a non-seekable reader by retaining
+// everything read from it
+type sourceSpool struct {
+	reader io.Reader
+	buf    []byte
+	file   *os.File
+	size   int64
+	eof    bool
+}
+
+// fill reads from the underlying reader until at least n bytes are retained,
+// or until EOF if n is negative
+func (s *sourceSpool) fill(n int64) error {
+	var chunk []byte
+	for !s.eof && (n < 0 || s.size < n) {
+		if chunk == nil {
+			chunk = make([]byte, 64<<10)
+		}
+		m, err := s.reader.Read(chunk)
+		if m > 0 {
+			if err := s.retain(chunk[:m]); err != nil {
+				return err
+			}
+		}
+		if err == io.EOF {
+			s.eof = true
+		} else if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *sourceSpool) retain(p []byte) error {
+	if s.file == nil && int64(len(s.buf)+len(p)) > sourceSpoolMemoryLimit {
+		file, err := os.CreateTemp("", "vipsgen-source-*")
+		if err != nil {
+			return err
+		}
+		s.file = file
+	}
+	if s.file != nil {
+		if _, err := s.file.WriteAt(p, s.size-int64(len(s.buf))); err != nil {
+			return err
+		}
+	} else {
+		s.buf = append(s.buf, p...)
+	}
+	s.size += int64(len(p))
+	return nil
+}
+
+// ReadAt implements io.ReaderAt, reading ahead from the underlying reader as needed
+func (s *sourceSpool) ReadAt(p []byte, off int64) (int, error) {
+	if err := s.fill(off + int64(len(p))); err != nil {
+		return 0, err
+	}
+	if off >= s.size {
+		return 0, io.EOF
+	}
+	if end := s.size - off; int64(len(p)) > end {
+		p = p[:end]
+	}
+	n := 0
+	if off < int64(len(s.buf)) {
+		n = copy(p, s.buf[off:])
+	}
+	if n < len(p) {
+		m, err := s.file.ReadAt(p[n:], off+int64(n)-int64(len(s.buf)))
+		n += m
+		if err != nil && err != io.EOF {
+			return n, err
+		}
+	}
+	if s.eof && off+int64(n) >= s.size {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+func (s *sourceSpool) close() {
+	if s.file != nil {
+		_ = s.file.Close()
+		_ = os.Remove(s.file.Name())
+		s.file = nil
+	}
+	s.buf = nil
+}
+
 // Close source
 func (s *Source) Close() {
 	if s == nil {
@@ -88,6 +263,11 @@ func (s *Source) Close() {
 	reader := s.reader
 	s.reader = nil
 	s.seeker = nil
+	s.readerAt = nil
+	if s.spool != nil {
+		s.spool.close()
+		s.spool = nil
+	}
 	if s.src != nil {
 		C.clear_source(&s.src)
 	} else {
@@ -100,6 +280,14 @@ func (s *Source) Close() {
 	log("vipsgen", LogLevelDebug, fmt.Sprintf("closing source %p", s))
 }
 
+// WithSource creates a Source from reader, passes it to fn and closes it once fn returns,
+// even if fn returns an error or panics. Closing the Source also closes the reader.
+func WithSource(reader io.ReadCloser, fn func(*Source) error) error {
+	source := NewSource(reader)
+	defer source.Close()
+	return fn(source)
+}
+
 // Target contains a libvips VipsTargetCustom and manages its lifecycle.
 type Target struct {
 	writer io.WriteCloser
@@ -108,6 +296,7 @@ type Target struct {
 	handle cgo.Handle
 	deleted atomic.Uint32
 	lock   sync.Mutex
+	bytesWritten atomic.Int64
 }
 
 func newTargetHandle(target *Target) cgo.Handle {
@@ -174,3 +363,17 @@ func (t *Target) Close() {
 	}
 	log("vipsgen", LogLevelDebug, fmt.Sprintf("closing target %p", t))
 }
+
+// BytesWritten returns the number of bytes libvips has written to the Target so far.
+// Data that libvips seeks back to and rewrites is counted again.
+func (t *Target) BytesWritten() int64 {
+	return t.bytesWritten.Load()
+}
+
+// WithTarget creates a Target from writer, passes it to fn and closes it once fn returns,
+// even if fn returns an error or panics. Closing the Target also closes the writer.
+func WithTarget(writer io.WriteCloser, fn func(*Target) error) error {
+	target := NewTarget(writer)
+	defer target.Close()
+	return fn(target)
+}
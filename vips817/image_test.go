@@ -3,20 +3,34 @@
 package vips
 
 import (
+	"archive/zip"
 	"bytes"
+	"context"
+	"crypto/md5"
+	"crypto/rc4"
+	"encoding/binary"
+	"errors"
 	"fmt"
+	"hash/crc32"
 	"image"
 	"image/color"
 	"image/draw"
 	"image/jpeg"
 	"image/png"
 	"io"
+	"io/fs"
+	"math"
 	"math/rand"
 	"os"
 	"path/filepath"
 	"reflect"
 	"runtime"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"testing/fstest"
+	"testing/iotest"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -88,6 +102,70 @@ func createTestPngBuffer(t *testing.T, width, height int) []byte {
 	return buf.Bytes()
 }
 
+// createTestPdfBuffer creates a PDF of blank pages sized in points,
+// encrypted with the standard security handler when userPassword is set
+func createTestPdfBuffer(pages int, width, height float64, userPassword string) []byte {
+	var buf bytes.Buffer
+	var offsets []int
+	writeObject := func(body string) {
+		offsets = append(offsets, buf.Len())
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	buf.WriteString("%PDF-1.4\n")
+	writeObject("<< /Type /Catalog /Pages 2 0 R >>")
+	kids := make([]string, pages)
+	for i := range kids {
+		kids[i] = fmt.Sprintf("%d 0 R", i+3)
+	}
+	writeObject(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), pages))
+	for i := 0; i < pages; i++ {
+		writeObject(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %g %g] >>", width, height))
+	}
+
+	var trailer string
+	if userPassword != "" {
+		id := []byte("vipsgen-test-pdf")
+		o, u := pdfStandardSecurity(userPassword, "owner", id, -4)
+		writeObject(fmt.Sprintf("<< /Filter /Standard /V 1 /R 2 /O <%x> /U <%x> /P -4 >>", o, u))
+		trailer = fmt.Sprintf(" /Encrypt %d 0 R /ID [<%x> <%x>]", len(offsets), id, id)
+	}
+
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R%s >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, trailer, xref)
+	return buf.Bytes()
+}
+
+// pdfStandardSecurity computes the /O and /U entries of a revision 2, 40-bit RC4 PDF security handler
+func pdfStandardSecurity(userPassword, ownerPassword string, id []byte, permissions int32) (o, u []byte) {
+	padding := []byte{
+		0x28, 0xbf, 0x4e, 0x5e, 0x4e, 0x75, 0x8a, 0x41, 0x64, 0x00, 0x4e, 0x56, 0xff, 0xfa, 0x01, 0x08,
+		0x2e, 0x2e, 0x00, 0xb6, 0xd0, 0x68, 0x3e, 0x80, 0x2f, 0x0c, 0xa9, 0xfe, 0x64, 0x53, 0x69, 0x7a,
+	}
+	pad := func(password string) []byte {
+		return append([]byte(password), padding...)[:32]
+	}
+
+	ownerKey := md5.Sum(pad(ownerPassword))
+	cipher, _ := rc4.NewCipher(ownerKey[:5])
+	o = make([]byte, 32)
+	cipher.XORKeyStream(o, pad(userPassword))
+
+	h := md5.New()
+	h.Write(pad(userPassword))
+	h.Write(o)
+	binary.Write(h, binary.LittleEndian, permissions)
+	h.Write(id)
+	cipher, _ = rc4.NewCipher(h.Sum(nil)[:5])
+	u = make([]byte, 32)
+	cipher.XORKeyStream(u, padding)
+	return o, u
+}
+
 // createTestJpegBuffer creates a test JPEG image with a pattern
 func createTestJpegBuffer(t *testing.T, width, height int) []byte {
 	img := image.NewRGBA(image.Rect(0, 0, width, height))
@@ -129,6 +207,34 @@ func createTestGradientImage(t *testing.T, width, height int) (*Image, error) {
 	return NewImageFromBuffer(buf.Bytes(), nil)
 }
 
+// createTestAnimatedGif creates an animated GIF where frame i is filled with grey level i*50
+func createTestAnimatedGif(t *testing.T, width, pageHeight, pages int) []byte {
+	frameSize := width * pageHeight * 3
+	data := make([]byte, frameSize*pages)
+	for i := 0; i < pages; i++ {
+		for j := 0; j < frameSize; j++ {
+			data[i*frameSize+j] = byte(i * 50)
+		}
+	}
+	img, err := NewImageFromMemory(data, width, pageHeight*pages, 3)
+	require.NoError(t, err)
+	defer img.Close()
+	require.NoError(t, img.SetPageHeight(pageHeight))
+
+	buf, err := img.GifsaveBuffer(nil)
+	require.NoError(t, err)
+	return buf
+}
+
+// readSeekCloser wraps an io.ReadSeeker to make it an io.ReadCloser that can seek
+type readSeekCloser struct {
+	io.ReadSeeker
+}
+
+func (r *readSeekCloser) Close() error {
+	return nil
+}
+
 // ensureTestDir creates a test directory if it doesn't exist
 func ensureTestDir(t *testing.T) string {
 	dir := filepath.Join(os.TempDir(), "vipsgen-test")
@@ -173,6 +279,75 @@ func TestMemoryStats(t *testing.T) {
 		stats.Mem, stats.MemHigh, stats.Files, stats.Allocs)
 }
 
+func TestConcurrency(t *testing.T) {
+	prev := Concurrency()
+	defer SetConcurrency(prev)
+
+	SetConcurrency(1)
+	assert.Equal(t, 1, Concurrency())
+
+	SetConcurrency(3)
+	assert.Equal(t, 3, Concurrency())
+
+	// Zero restores the default based on the number of CPUs
+	SetConcurrency(0)
+	assert.Greater(t, Concurrency(), 0)
+}
+
+func TestSequentialAccess(t *testing.T) {
+	width, height := 1500, 2000
+	jpegData := createTestJpegBuffer(t, width, height)
+	testFile := filepath.Join(ensureTestDir(t), "test_sequential.jpg")
+	require.NoError(t, os.WriteFile(testFile, jpegData, 0644))
+	defer os.Remove(testFile)
+
+	t.Run("streaming load and save", func(t *testing.T) {
+		var before MemoryStats
+		ReadVipsMemStats(&before)
+
+		img, err := NewImageFromFile(testFile, &LoadOptions{Access: AccessSequential})
+		require.NoError(t, err)
+		defer img.Close()
+		out, err := img.JpegsaveBuffer(nil)
+		require.NoError(t, err)
+
+		var after MemoryStats
+		ReadVipsMemStats(&after)
+		t.Logf("Peak memory growth: %d bytes", after.MemHigh-before.MemHigh)
+		assert.Less(t, after.MemHigh-before.MemHigh, int64(width*height*3),
+			"sequential load should not decode the whole image into memory")
+
+		saved, err := NewImageFromBuffer(out, nil)
+		require.NoError(t, err)
+		defer saved.Close()
+		assert.Equal(t, width, saved.Width())
+		assert.Equal(t, height, saved.Height())
+	})
+
+	t.Run("random access after sequential hint", func(t *testing.T) {
+		img, err := NewImageFromFile(testFile, &LoadOptions{Access: AccessSequential})
+		require.NoError(t, err)
+		defer img.Close()
+
+		// Flipping vertically reads from the bottom up
+		require.NoError(t, img.Flip(DirectionVertical))
+		_, err = img.JpegsaveBuffer(nil)
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrSequentialAccess)
+	})
+
+	t.Run("enforce sequential", func(t *testing.T) {
+		img, err := NewImageFromBuffer(jpegData, nil)
+		require.NoError(t, err)
+		defer img.Close()
+
+		require.NoError(t, img.EnforceSequential())
+		out, err := img.JpegsaveBuffer(nil)
+		require.NoError(t, err)
+		assert.NotEmpty(t, out)
+	})
+}
+
 func TestImageType_MimeType(t *testing.T) {
 	tests := []struct {
 		imageType    ImageType
@@ -359,6 +534,357 @@ func TestSource(t *testing.T) {
 	assert.Equal(t, 50, imgFromSource.Height())
 }
 
+// readerAtCloser exposes io.ReaderAt with a Size method but hides io.Seeker
+type readerAtCloser struct {
+	r *bytes.Reader
+}
+
+func (r *readerAtCloser) Read(p []byte) (int, error) {
+	return r.r.Read(p)
+}
+
+func (r *readerAtCloser) ReadAt(p []byte, off int64) (int, error) {
+	return r.r.ReadAt(p, off)
+}
+
+func (r *readerAtCloser) Size() int64 {
+	return r.r.Size()
+}
+
+func (r *readerAtCloser) Close() error {
+	return nil
+}
+
+func TestSource_SeekableTiff(t *testing.T) {
+	img, err := createTestGradientImage(t, 64, 48)
+	require.NoError(t, err)
+	defer img.Close()
+
+	tiffData, err := img.TiffsaveBuffer(&TiffsaveBufferOptions{Tile: true, TileWidth: 16, TileHeight: 16})
+	require.NoError(t, err)
+
+	expected, err := img.Getpoint(40, 30, nil)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name   string
+		reader io.ReadCloser
+	}{
+		{"read seeker", &readSeekCloser{bytes.NewReader(tiffData)}},
+		{"reader at", &readerAtCloser{bytes.NewReader(tiffData)}},
+		{"non-seekable fallback", io.NopCloser(bytes.NewReader(tiffData))},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			source := NewSource(tc.reader)
+			defer source.Close()
+
+			loaded, err := NewImageFromSource(source, nil)
+			require.NoError(t, err)
+			defer loaded.Close()
+
+			assert.Equal(t, ImageTypeTiff, loaded.Format())
+			assert.Equal(t, 64, loaded.Width())
+			assert.Equal(t, 48, loaded.Height())
+
+			pixel, err := loaded.Getpoint(40, 30, nil)
+			require.NoError(t, err)
+			assert.Equal(t, expected, pixel)
+		})
+	}
+}
+
+func TestSource_ReaderAtSeek(t *testing.T) {
+	s := &Source{readerAt: bytes.NewReader([]byte("0123456789")), size: -1}
+
+	assert.Equal(t, int64(4), s.seekReaderAt(4, io.SeekStart))
+	assert.Equal(t, int64(6), s.seekReaderAt(2, io.SeekCurrent))
+	assert.Equal(t, int64(-1), s.seekReaderAt(-1, io.SeekEnd), "seeking from the end needs a known size")
+	assert.Equal(t, int64(-1), s.seekReaderAt(-7, io.SeekCurrent), "negative offsets are rejected")
+
+	buf := make([]byte, 3)
+	n, err := s.readAt(buf)
+	require.NoError(t, err)
+	assert.Equal(t, "678", string(buf[:n]))
+
+	s.size = 10
+	assert.Equal(t, int64(8), s.seekReaderAt(-2, io.SeekEnd))
+}
+
+func TestSource_SpoolsNonSeekableReader(t *testing.T) {
+	prevLimit := sourceSpoolMemoryLimit
+	sourceSpoolMemoryLimit = 1024
+	defer func() { sourceSpoolMemoryLimit = prevLimit }()
+
+	img, err := createTestGradientImage(t, 64, 48)
+	require.NoError(t, err)
+	defer img.Close()
+
+	tiffData, err := img.TiffsaveBuffer(&TiffsaveBufferOptions{Tile: true, TileWidth: 16, TileHeight: 16})
+	require.NoError(t, err)
+	require.Greater(t, len(tiffData), 1024)
+
+	source := NewSource(io.NopCloser(bytes.NewReader(tiffData)))
+	require.NotNil(t, source.spool, "non-seekable readers should be spooled")
+
+	loaded, err := NewTiffloadSource(source, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 64, loaded.Width())
+	assert.Equal(t, 48, loaded.Height())
+
+	expected, err := img.Getpoint(40, 30, nil)
+	require.NoError(t, err)
+	pixel, err := loaded.Getpoint(40, 30, nil)
+	require.NoError(t, err)
+	assert.Equal(t, expected, pixel)
+	loaded.Close()
+
+	require.NotNil(t, source.spool.file, "data beyond the memory limit should spool to a temporary file")
+	spoolFile := source.spool.file.Name()
+	source.Close()
+	_, err = os.Stat(spoolFile)
+	assert.True(t, os.IsNotExist(err), "temporary spool file should be removed on close")
+}
+
+func TestImage_Materialize(t *testing.T) {
+	pngData := createTestPngBuffer(t, 50, 40)
+	source := NewSource(&readSeekCloser{bytes.NewReader(pngData)})
+
+	img, err := NewImageFromSource(source, nil)
+	require.NoError(t, err)
+	defer img.Close()
+	require.NoError(t, img.Flip(DirectionHorizontal))
+
+	expected, err := img.Getpoint(10, 10, nil)
+	require.NoError(t, err)
+
+	require.NoError(t, img.Materialize())
+	source.Close()
+
+	// The pipeline no longer depends on the closed source
+	pixel, err := img.Getpoint(10, 10, nil)
+	require.NoError(t, err)
+	assert.Equal(t, expected, pixel)
+
+	require.NoError(t, img.Resize(0.5, nil))
+	assert.Equal(t, 25, img.Width())
+	assert.Equal(t, 20, img.Height())
+	buf, err := img.PngsaveBuffer(nil)
+	require.NoError(t, err)
+	assert.NotEmpty(t, buf)
+}
+
+func TestNewImageFromReader(t *testing.T) {
+	pngData := createTestPngBuffer(t, 50, 40)
+
+	for name, reader := range map[string]io.Reader{
+		"bytes reader": bytes.NewReader(pngData),
+		"plain reader": struct{ io.Reader }{bytes.NewReader(pngData)},
+	} {
+		t.Run(name, func(t *testing.T) {
+			img, err := NewImageFromReader(reader, nil)
+			require.NoError(t, err)
+			defer img.Close()
+			assert.Equal(t, 50, img.Width())
+			assert.Equal(t, 40, img.Height())
+			assert.Equal(t, ImageTypePng, img.Format())
+
+			// The image is materialized, so it keeps working without the reader
+			require.NoError(t, img.Flip(DirectionVertical))
+			_, err = img.PngsaveBuffer(nil)
+			require.NoError(t, err)
+		})
+	}
+
+	t.Run("read error", func(t *testing.T) {
+		errRead := errors.New("connection reset")
+		reader := io.MultiReader(bytes.NewReader(pngData[:64]), iotest.ErrReader(errRead))
+
+		img, err := NewImageFromReader(reader, nil)
+		require.Error(t, err)
+		assert.Nil(t, img)
+		assert.ErrorIs(t, err, errRead)
+	})
+}
+
+// closeTracker records whether Close was called on the wrapped reader or writer
+type closeTracker struct {
+	io.Reader
+	io.Writer
+	closed bool
+}
+
+func (c *closeTracker) Close() error {
+	c.closed = true
+	return nil
+}
+
+func TestWithImage(t *testing.T) {
+	pngData := createTestPngBuffer(t, 20, 20)
+	load := func() (*Image, error) {
+		return NewImageFromBuffer(pngData, nil)
+	}
+
+	var kept *Image
+	errCallback := errors.New("callback failed")
+	err := WithImage(load, func(img *Image) error {
+		kept = img
+		assert.Equal(t, 20, img.Width())
+		return errCallback
+	})
+	assert.ErrorIs(t, err, errCallback)
+	require.NotNil(t, kept)
+	assert.Nil(t, kept.image, "image should be closed after the callback returns an error")
+
+	kept = nil
+	assert.Panics(t, func() {
+		_ = WithImage(load, func(img *Image) error {
+			kept = img
+			panic("callback panicked")
+		})
+	})
+	require.NotNil(t, kept)
+	assert.Nil(t, kept.image, "image should be closed after the callback panics")
+
+	called := false
+	err = WithImage(func() (*Image, error) {
+		return NewImageFromBuffer([]byte("not an image"), nil)
+	}, func(img *Image) error {
+		called = true
+		return nil
+	})
+	assert.ErrorIs(t, err, ErrUnsupportedFormat)
+	assert.False(t, called, "callback should not run when loading fails")
+}
+
+func TestWithSourceAndTarget(t *testing.T) {
+	reader := &closeTracker{Reader: bytes.NewReader(createTestPngBuffer(t, 20, 20))}
+	var buf bytes.Buffer
+	writer := &closeTracker{Writer: &buf}
+
+	err := WithSource(reader, func(source *Source) error {
+		return WithImage(func() (*Image, error) {
+			return NewImageFromSource(source, nil)
+		}, func(img *Image) error {
+			return WithTarget(writer, func(target *Target) error {
+				return img.PngsaveTarget(target, nil)
+			})
+		})
+	})
+	require.NoError(t, err)
+	assert.True(t, reader.closed, "source should close the reader")
+	assert.True(t, writer.closed, "target should close the writer")
+	assert.True(t, bytes.HasPrefix(buf.Bytes(), []byte("\x89PNG")))
+}
+
+func TestSourceTargetByteCounters(t *testing.T) {
+	img, err := createTestGradientImage(t, 200, 200)
+	require.NoError(t, err)
+	pngData, err := img.PngsaveBuffer(nil)
+	img.Close()
+	require.NoError(t, err)
+
+	source := NewSource(io.NopCloser(bytes.NewReader(pngData)))
+	defer source.Close()
+	assert.Equal(t, int64(0), source.BytesRead())
+
+	loaded, err := NewImageFromSource(source, nil)
+	require.NoError(t, err)
+	defer loaded.Close()
+
+	var buf bytes.Buffer
+	target := NewTarget(&writeCloser{Writer: &buf})
+	defer target.Close()
+	require.NoError(t, loaded.PngsaveTarget(target, nil))
+
+	// The whole input is decoded, and header sniffing may read the start twice
+	assert.GreaterOrEqual(t, source.BytesRead(), int64(len(pngData)))
+	assert.LessOrEqual(t, source.BytesRead(), int64(2*len(pngData)))
+	assert.Greater(t, target.BytesWritten(), int64(0))
+	assert.Equal(t, int64(buf.Len()), target.BytesWritten())
+}
+
+func TestImage_WriteToFile(t *testing.T) {
+	img, err := NewImageFromBuffer(createTestPngBuffer(t, 50, 40), nil)
+	require.NoError(t, err)
+	defer img.Close()
+
+	dir := t.TempDir()
+	for name, signature := range map[string][]byte{
+		"out.jpg": {0xFF, 0xD8, 0xFF},
+		"out.png": []byte("\x89PNG"),
+	} {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(dir, name)
+			require.NoError(t, img.WriteToFile(path, nil))
+
+			data, err := os.ReadFile(path)
+			require.NoError(t, err)
+			assert.True(t, bytes.HasPrefix(data, signature), "unexpected signature % x", data[:4])
+		})
+	}
+
+	t.Run("options", func(t *testing.T) {
+		high := filepath.Join(dir, "high.jpg")
+		low := filepath.Join(dir, "low.jpg")
+		require.NoError(t, img.WriteToFile(high, map[string]interface{}{"Q": 95}))
+		require.NoError(t, img.WriteToFile(low, map[string]interface{}{"Q": 5, "keep": "none"}))
+
+		highInfo, err := os.Stat(high)
+		require.NoError(t, err)
+		lowInfo, err := os.Stat(low)
+		require.NoError(t, err)
+		assert.Less(t, lowInfo.Size(), highInfo.Size())
+	})
+
+	t.Run("unknown extension", func(t *testing.T) {
+		path := filepath.Join(dir, "out.xyz")
+		err := img.WriteToFile(path, nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "supported extensions")
+		assert.Contains(t, err.Error(), ".png")
+		_, statErr := os.Stat(path)
+		assert.True(t, os.IsNotExist(statErr))
+	})
+}
+
+func TestRawImageFromSource(t *testing.T) {
+	// RAW loaders seek heavily, so loading from a non-seekable source relies on spooling.
+	// Set VIPSGEN_TEST_RAW_FILE to a camera RAW file such as an ARW to run this test.
+	rawFile := os.Getenv("VIPSGEN_TEST_RAW_FILE")
+	if rawFile == "" {
+		t.Skip("VIPSGEN_TEST_RAW_FILE not set")
+	}
+	if !HasOperation("dcrawload_source") {
+		t.Skip("libvips built without libraw support")
+	}
+
+	fromFile, err := NewImageFromFile(rawFile, nil)
+	require.NoError(t, err)
+	defer fromFile.Close()
+
+	data, err := os.ReadFile(rawFile)
+	require.NoError(t, err)
+
+	for name, reader := range map[string]io.ReadCloser{
+		"seekable":     &readSeekCloser{bytes.NewReader(data)},
+		"non-seekable": io.NopCloser(bytes.NewReader(data)),
+	} {
+		t.Run(name, func(t *testing.T) {
+			source := NewSource(reader)
+			defer source.Close()
+
+			fromSource, err := NewImageFromSource(source, nil)
+			require.NoError(t, err)
+			defer fromSource.Close()
+
+			assert.Equal(t, fromFile.Width(), fromSource.Width())
+			assert.Equal(t, fromFile.Height(), fromSource.Height())
+		})
+	}
+}
+
 func TestImageTransformations(t *testing.T) {
 	// Create a test image
 	width, height := 100, 80
@@ -2165,86 +2691,439 @@ func TestImage_GetFields(t *testing.T) {
 	assert.True(t, hasCustomNumber, "Should contain custom-number")
 }
 
-func TestImage_GetAsString(t *testing.T) {
+func TestImage_FieldType(t *testing.T) {
 	img, err := createWhiteImage(100, 100)
 	require.NoError(t, err)
 	defer img.Close()
 
-	// Set different types of metadata
-	img.SetInt("test-int", 42)
 	img.SetDouble("test-double", 3.14)
+	img.SetInt("test-int", 42)
 	img.SetString("test-string", "hello")
-
-	// Test getting as string
-	intAsString, err := img.GetAsString("test-int")
-	assert.NoError(t, err)
-	assert.Equal(t, "42", intAsString, "Integer should convert to string")
-
-	doubleAsString, err := img.GetAsString("test-double")
-	assert.NoError(t, err)
-	assert.Contains(t, doubleAsString, "3.14", "Double should convert to string")
-
-	stringAsString, err := img.GetAsString("test-string")
-	assert.NoError(t, err)
-	assert.Equal(t, "hello", stringAsString, "String should remain string")
+	require.NoError(t, img.SetArrayInt("test-array-int", []int{1, 2, 3}))
+	require.NoError(t, img.SetArrayDouble("test-array-double", []float64{1.5, 2.5}))
+
+	tests := map[string]MetaType{
+		"test-double":       MetaTypeDouble,
+		"test-int":          MetaTypeInt,
+		"test-string":       MetaTypeString,
+		"test-array-int":    MetaTypeArrayInt,
+		"test-array-double": MetaTypeArrayDouble,
+		"test-missing":      MetaTypeNone,
+	}
+	for name, want := range tests {
+		got, err := img.FieldType(name)
+		require.NoError(t, err, name)
+		assert.Equal(t, want, got, "field %s is %s", name, got)
+	}
+	assert.Equal(t, "double", MetaTypeDouble.String())
+	assert.Equal(t, "MetaType(42)", MetaType(42).String())
 }
 
-func TestImage_ExifData(t *testing.T) {
-	// Create image with some EXIF-like metadata
+func TestImage_Metadata(t *testing.T) {
 	img, err := createWhiteImage(100, 100)
 	require.NoError(t, err)
 	defer img.Close()
 
-	// Set some EXIF-like fields
-	img.SetString("exif-Make", "Test Camera")
-	img.SetString("exif-Model", "Test Model")
-	img.SetInt("exif-Orientation", 1)
-	img.SetString("non-exif-field", "should not appear")
+	img.SetString("test-string", "hello")
+	img.SetInt("test-int", 42)
+	img.SetDouble("test-double", 3.14)
+	require.NoError(t, img.SetArrayInt("test-array-int", []int{1, 2, 3}))
+	require.NoError(t, img.SetArrayDouble("test-array-double", []float64{1.5, 2.5}))
 
-	// Get EXIF data
-	exifData := img.Exif()
+	metadata, err := img.Metadata()
+	require.NoError(t, err)
 
-	// Check that only EXIF fields are returned
-	assert.Contains(t, exifData, "exif-Make", "Should contain EXIF Make field")
-	assert.Contains(t, exifData, "exif-Model", "Should contain EXIF Model field")
-	assert.NotContains(t, exifData, "non-exif-field", "Should not contain non-EXIF field")
+	assert.Equal(t, "hello", metadata["test-string"])
+	assert.Equal(t, 42, metadata["test-int"])
+	assert.InDelta(t, 3.14, metadata["test-double"], 0.00001)
+	assert.IsType(t, float64(0), metadata["test-double"])
+	assert.Equal(t, []int{1, 2, 3}, metadata["test-array-int"])
+	assert.Equal(t, []float64{1.5, 2.5}, metadata["test-array-double"])
 
-	// Check values
-	assert.Equal(t, "Test Camera", exifData["exif-Make"], "EXIF Make should match")
-	assert.Equal(t, "Test Model", exifData["exif-Model"], "EXIF Model should match")
+	// Header fields are included alongside custom ones
+	assert.Equal(t, 100, metadata["width"])
+	assert.Len(t, metadata, len(img.GetFields()))
 }
 
-func TestImage_IsColorSpaceSupported(t *testing.T) {
+func TestImage_RemoveField(t *testing.T) {
 	img, err := createWhiteImage(100, 100)
 	require.NoError(t, err)
 	defer img.Close()
 
-	// RGB should be supported
-	assert.True(t, img.IsColorSpaceSupported(), "RGB colorspace should be supported")
+	img.SetString("custom-a", "a")
+	img.SetInt("custom-b", 2)
+	img.SetDouble("custom-c", 3.5)
+	img.SetString("other-d", "d")
+
+	require.NoError(t, img.RemoveField("custom-b"))
+	assert.False(t, img.HasField("custom-b"))
+	assert.True(t, img.HasField("custom-a"))
+	assert.True(t, img.HasField("custom-c"))
+	assert.True(t, img.HasField("other-d"))
+
+	// Removing a field that does not exist is a no-op
+	require.NoError(t, img.RemoveField("custom-b"))
+	require.NoError(t, img.RemoveField("never-set"))
+
+	require.NoError(t, img.RemoveMetadataByPrefix("custom-"))
+	assert.False(t, img.HasField("custom-a"))
+	assert.False(t, img.HasField("custom-c"))
+	assert.True(t, img.HasField("other-d"))
+	assert.Equal(t, 100, img.Width())
+
+	require.NoError(t, img.RemoveMetadataByPrefix("missing-"))
 }
 
-func TestImage_RemoveICCProfile(t *testing.T) {
-	img, err := createWhiteImage(100, 100)
+func TestImage_CopyMetadataFrom(t *testing.T) {
+	original, err := NewImageFromBuffer(createTestJpegBuffer(t, 160, 120), nil)
 	require.NoError(t, err)
-	defer img.Close()
-
-	// Test removing non-existent profile (should not error)
-	err = img.RemoveICCProfile()
-	require.NoError(t, err, "Removing non-existent ICC profile should not error")
-
-	// Verify still no profile
-	assert.False(t, img.HasICCProfile(), "Should still not have ICC profile")
-}
+	defer original.Close()
+	exif := buildExifWithThumbnail(createTestJpegBuffer(t, 16, 12))
+	vipsImageSetBlob(original.image, "exif-data", exif)
+	original.SetString("exif-ifd0-Make", "Test Camera")
+	original.SetString("custom-note", "keep me")
 
-func TestImage_MetadataWithRealImage(t *testing.T) {
-	// Test with actual PNG data
-	pngData := createTestPngBuffer(t, 50, 50)
-	img, err := NewImageFromBuffer(pngData, nil)
+	processed, err := original.Copy(nil)
 	require.NoError(t, err)
-	defer img.Close()
+	defer processed.Close()
+	require.NoError(t, processed.Resize(0.5, nil))
+	require.NoError(t, processed.RemoveExif())
+	require.False(t, processed.HasField("exif-data"))
 
-	// Test basic properties
-	assert.Equal(t, 50, img.Width(), "Width should be 50")
+	t.Run("selected fields", func(t *testing.T) {
+		img, err := processed.Copy(nil)
+		require.NoError(t, err)
+		defer img.Close()
+
+		require.NoError(t, img.CopyMetadataFrom(original, "exif-data", "not-on-source"))
+		blob, err := img.GetBlob("exif-data")
+		require.NoError(t, err)
+		assert.Equal(t, exif, blob)
+		assert.False(t, img.HasField("custom-note"))
+		assert.False(t, img.HasField("not-on-source"))
+	})
+
+	t.Run("all fields", func(t *testing.T) {
+		img, err := processed.Copy(nil)
+		require.NoError(t, err)
+		defer img.Close()
+
+		require.NoError(t, img.CopyMetadataFrom(original))
+		assert.Equal(t, "Test Camera", img.Exif()["exif-ifd0-Make"])
+		note, err := img.GetString("custom-note")
+		require.NoError(t, err)
+		assert.Equal(t, "keep me", note)
+
+		// Dimensions stay those of the processed image
+		assert.Equal(t, 80, img.Width())
+		assert.Equal(t, 60, img.Height())
+	})
+}
+
+func TestStripMetadata(t *testing.T) {
+	exif := buildExifWithThumbnail(createTestJpegBuffer(t, 8, 8))
+
+	t.Run("jpeg is stripped losslessly", func(t *testing.T) {
+		original := createTestJpegBuffer(t, 64, 48)
+		segment := func(marker byte, payload []byte) []byte {
+			seg := []byte{0xFF, marker}
+			seg = binary.BigEndian.AppendUint16(seg, uint16(len(payload)+2))
+			return append(seg, payload...)
+		}
+		var data []byte
+		data = append(data, original[:2]...)
+		data = append(data, segment(0xE1, exif)...)
+		data = append(data, segment(0xFE, []byte("secret comment"))...)
+		data = append(data, original[2:]...)
+
+		img, err := NewImageFromBuffer(data, nil)
+		require.NoError(t, err)
+		require.True(t, img.HasField("exif-data"))
+		img.Close()
+
+		stripped, err := StripMetadata(data, ImageTypeJpeg)
+		require.NoError(t, err)
+		assert.Equal(t, original, stripped, "only the metadata segments are removed")
+
+		img, err = NewImageFromBuffer(stripped, nil)
+		require.NoError(t, err)
+		defer img.Close()
+		assert.False(t, img.HasField("exif-data"))
+	})
+
+	t.Run("png is stripped losslessly", func(t *testing.T) {
+		original := createTestPngBuffer(t, 32, 32)
+		chunk := func(chunkType string, payload []byte) []byte {
+			c := binary.BigEndian.AppendUint32(nil, uint32(len(payload)))
+			c = append(c, chunkType...)
+			c = append(c, payload...)
+			return binary.BigEndian.AppendUint32(c, crc32.ChecksumIEEE(c[4:]))
+		}
+		// Metadata chunks go after the 8 byte signature and the 25 byte IHDR chunk
+		var data []byte
+		data = append(data, original[:33]...)
+		data = append(data, chunk("eXIf", exif[6:])...)
+		data = append(data, chunk("tEXt", []byte("Comment\x00secret"))...)
+		data = append(data, original[33:]...)
+
+		img, err := NewImageFromBuffer(data, nil)
+		require.NoError(t, err)
+		img.Close()
+
+		stripped, err := StripMetadata(data, ImageTypeUnknown)
+		require.NoError(t, err)
+		assert.Equal(t, original, stripped)
+	})
+
+	t.Run("other formats are re-encoded", func(t *testing.T) {
+		img, err := NewImageFromBuffer(createTestJpegBuffer(t, 64, 48), nil)
+		require.NoError(t, err)
+		defer img.Close()
+		vipsImageSetBlob(img.image, "exif-data", exif)
+		webp, err := img.WebpsaveBuffer(nil)
+		require.NoError(t, err)
+
+		stripped, err := StripMetadata(webp, ImageTypeWebp)
+		require.NoError(t, err)
+		out, err := NewImageFromBuffer(stripped, nil)
+		require.NoError(t, err)
+		defer out.Close()
+		assert.Equal(t, ImageTypeWebp, out.Format())
+		assert.False(t, out.HasField("exif-data"))
+	})
+
+	t.Run("mismatched type", func(t *testing.T) {
+		_, err := StripMetadata(createTestPngBuffer(t, 8, 8), ImageTypeJpeg)
+		assert.ErrorIs(t, err, ErrUnsupportedFormat)
+	})
+}
+
+func TestImage_SetBackground(t *testing.T) {
+	img, err := createWhiteImage(100, 100)
+	require.NoError(t, err)
+	defer img.Close()
+
+	def := []float64{0, 0, 0}
+	assert.Equal(t, def, img.BackgroundOrDefault(def), "unset background falls back to the default")
+
+	require.NoError(t, img.SetBackground([]float64{255, 128, 0}))
+	background, err := img.Background()
+	require.NoError(t, err)
+	assert.Equal(t, []float64{255, 128, 0}, background)
+	assert.Equal(t, []float64{255, 128, 0}, img.BackgroundOrDefault(def))
+
+	require.NoError(t, img.SetBackground([]float64{64}), "a single value applies to all bands")
+	assert.Equal(t, []float64{64}, img.BackgroundOrDefault(def))
+
+	assert.Error(t, img.SetBackground(nil))
+	assert.Error(t, img.SetBackground([]float64{1, 2, 3, 4, 5}), "more values than bands")
+	assert.Equal(t, []float64{64}, img.BackgroundOrDefault(def), "rejected backgrounds are not stored")
+}
+
+func TestProcessBatch(t *testing.T) {
+	inputs := [][]byte{
+		createTestPngBuffer(t, 100, 80),
+		createTestJpegBuffer(t, 120, 60),
+		[]byte("not an image"),
+		createTestPngBuffer(t, 40, 40),
+		createTestJpegBuffer(t, 64, 64),
+	}
+	errFail := errors.New("refused")
+	var calls atomic.Int32
+
+	results, errs := ProcessBatch(inputs, 2, func(img *Image) error {
+		calls.Add(1)
+		if img.Width() == 40 {
+			return errFail
+		}
+		return img.Resize(0.5, nil)
+	})
+	require.Len(t, results, len(inputs))
+	require.Len(t, errs, len(inputs))
+	assert.Equal(t, int32(4), calls.Load(), "fn runs for every image that loads")
+
+	assert.Error(t, errs[2], "invalid buffer fails on its own")
+	assert.Nil(t, results[2])
+	assert.ErrorIs(t, errs[3], errFail)
+	assert.Nil(t, results[3])
+
+	want := map[int][3]interface{}{
+		0: {ImageTypePng, 50, 40},
+		1: {ImageTypeJpeg, 60, 30},
+		4: {ImageTypeJpeg, 32, 32},
+	}
+	for i, w := range want {
+		require.NoError(t, errs[i], "input %d", i)
+		img, err := NewImageFromBuffer(results[i], nil)
+		require.NoError(t, err, "input %d", i)
+		assert.Equal(t, w[0], img.Format(), "input %d keeps its format", i)
+		assert.Equal(t, w[1], img.Width(), "input %d", i)
+		assert.Equal(t, w[2], img.Height(), "input %d", i)
+		img.Close()
+	}
+
+	results, errs = ProcessBatch(nil, 0, func(*Image) error { return nil })
+	assert.Empty(t, results)
+	assert.Empty(t, errs)
+}
+
+func TestProcessBatchLoadOnlyFormat(t *testing.T) {
+	assert.True(t, vipsForeignFindSaveBuffer(".png"))
+	assert.False(t, vipsForeignFindSaveBuffer(".pdf"), "libvips has no PDF saver")
+
+	if !HasOperation("svgload_buffer") {
+		t.Skip("libvips built without SVG support")
+	}
+	svg := []byte(`<svg xmlns="http://www.w3.org/2000/svg" width="100" height="50">` +
+		`<rect x="0" y="0" width="100" height="50" fill="red"/></svg>`)
+
+	results, errs := ProcessBatch([][]byte{svg}, 1, func(img *Image) error {
+		return img.Resize(0.5, nil)
+	})
+	require.NoError(t, errs[0])
+	imageType, err := DetectImageType(results[0])
+	require.NoError(t, err)
+	assert.Equal(t, ImageTypePng, imageType, "SVG results are saved as PNG")
+}
+
+func TestImage_GetpointMany(t *testing.T) {
+	img, err := NewImageFromBuffer(createTestPngBuffer(t, 100, 80), nil)
+	require.NoError(t, err)
+	defer img.Close()
+
+	points := [][2]int{
+		{0, 0}, {99, 79}, {10, 20}, {50, 40}, {10, 20},
+	}
+	values, err := img.GetpointMany(points)
+	require.NoError(t, err)
+	require.Len(t, values, len(points))
+	for i, p := range points {
+		want, err := img.Getpoint(p[0], p[1], nil)
+		require.NoError(t, err)
+		assert.Equal(t, want, values[i], "point %v", p)
+	}
+
+	values, err = img.GetpointMany(nil)
+	require.NoError(t, err)
+	assert.Empty(t, values)
+
+	_, err = img.GetpointMany([][2]int{
+		{0, 0}, {100, 0},
+	})
+	assert.Error(t, err, "x outside the image")
+	_, err = img.GetpointMany([][2]int{
+		{0, -1},
+	})
+	assert.Error(t, err, "negative y")
+}
+
+func BenchmarkGetpointMany(b *testing.B) {
+	img, err := createWhiteImage(256, 256)
+	require.NoError(b, err)
+	defer img.Close()
+	points := make([][2]int, 1000)
+	for i := range points {
+		points[i] = [2]int{(i * 37) % 256, (i * 91) % 256}
+	}
+
+	b.Run("Getpoint", func(b *testing.B) {
+		for n := 0; n < b.N; n++ {
+			for _, p := range points {
+				if _, err := img.Getpoint(p[0], p[1], nil); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+	b.Run("GetpointMany", func(b *testing.B) {
+		for n := 0; n < b.N; n++ {
+			if _, err := img.GetpointMany(points); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func TestImage_GetAsString(t *testing.T) {
+	img, err := createWhiteImage(100, 100)
+	require.NoError(t, err)
+	defer img.Close()
+
+	// Set different types of metadata
+	img.SetInt("test-int", 42)
+	img.SetDouble("test-double", 3.14)
+	img.SetString("test-string", "hello")
+
+	// Test getting as string
+	intAsString, err := img.GetAsString("test-int")
+	assert.NoError(t, err)
+	assert.Equal(t, "42", intAsString, "Integer should convert to string")
+
+	doubleAsString, err := img.GetAsString("test-double")
+	assert.NoError(t, err)
+	assert.Contains(t, doubleAsString, "3.14", "Double should convert to string")
+
+	stringAsString, err := img.GetAsString("test-string")
+	assert.NoError(t, err)
+	assert.Equal(t, "hello", stringAsString, "String should remain string")
+}
+
+func TestImage_ExifData(t *testing.T) {
+	// Create image with some EXIF-like metadata
+	img, err := createWhiteImage(100, 100)
+	require.NoError(t, err)
+	defer img.Close()
+
+	// Set some EXIF-like fields
+	img.SetString("exif-Make", "Test Camera")
+	img.SetString("exif-Model", "Test Model")
+	img.SetInt("exif-Orientation", 1)
+	img.SetString("non-exif-field", "should not appear")
+
+	// Get EXIF data
+	exifData := img.Exif()
+
+	// Check that only EXIF fields are returned
+	assert.Contains(t, exifData, "exif-Make", "Should contain EXIF Make field")
+	assert.Contains(t, exifData, "exif-Model", "Should contain EXIF Model field")
+	assert.NotContains(t, exifData, "non-exif-field", "Should not contain non-EXIF field")
+
+	// Check values
+	assert.Equal(t, "Test Camera", exifData["exif-Make"], "EXIF Make should match")
+	assert.Equal(t, "Test Model", exifData["exif-Model"], "EXIF Model should match")
+}
+
+func TestImage_IsColorSpaceSupported(t *testing.T) {
+	img, err := createWhiteImage(100, 100)
+	require.NoError(t, err)
+	defer img.Close()
+
+	// RGB should be supported
+	assert.True(t, img.IsColorSpaceSupported(), "RGB colorspace should be supported")
+}
+
+func TestImage_RemoveICCProfile(t *testing.T) {
+	img, err := createWhiteImage(100, 100)
+	require.NoError(t, err)
+	defer img.Close()
+
+	// Test removing non-existent profile (should not error)
+	err = img.RemoveICCProfile()
+	require.NoError(t, err, "Removing non-existent ICC profile should not error")
+
+	// Verify still no profile
+	assert.False(t, img.HasICCProfile(), "Should still not have ICC profile")
+}
+
+func TestImage_MetadataWithRealImage(t *testing.T) {
+	// Test with actual PNG data
+	pngData := createTestPngBuffer(t, 50, 50)
+	img, err := NewImageFromBuffer(pngData, nil)
+	require.NoError(t, err)
+	defer img.Close()
+
+	// Test basic properties
+	assert.Equal(t, 50, img.Width(), "Width should be 50")
 	assert.Equal(t, 50, img.Height(), "Height should be 50")
 	assert.Equal(t, 3, img.Bands(), "PNG RGB should have 3 bands")
 
@@ -2335,6 +3214,95 @@ func TestHasOperation(t *testing.T) {
 	assert.False(t, HasOperation("operation with spaces"), "operation with spaces should return false")
 }
 
+func TestRequireOperationNotSupported(t *testing.T) {
+	require.NoError(t, RequireOperation("copy"))
+
+	// Simulate an operation missing from this libvips build
+	err := RequireOperation("nonexistent_operation")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrOperationNotSupported)
+	assert.Contains(t, err.Error(), "nonexistent_operation")
+
+	// Abstract operation classes cannot be run
+	assert.ErrorIs(t, RequireOperation("foreign"), ErrOperationNotSupported)
+
+	// Probing leaves the libvips error buffer alone, so earlier errors can still be read
+	img, err := createWhiteImage(10, 10)
+	require.NoError(t, err)
+	defer img.Close()
+	require.Error(t, img.ExtractArea(5, 5, 20, 20))
+	msg := VipsError()
+	require.NotEmpty(t, msg)
+	assert.False(t, HasOperation("nonexistent_operation"))
+	assert.Equal(t, msg, VipsError())
+	ClearVipsError()
+	require.NoError(t, img.Invert())
+}
+
+func TestVipsError(t *testing.T) {
+	img, err := createWhiteImage(10, 10)
+	require.NoError(t, err)
+	defer img.Close()
+
+	// Extracting outside the image fails inside libvips
+	err = img.ExtractArea(5, 5, 20, 20)
+	require.Error(t, err)
+	// Returned errors are prefixed with the operation that failed
+	assert.True(t, strings.HasPrefix(err.Error(), "extract_area: "), err.Error())
+
+	msg := VipsError()
+	assert.NotEmpty(t, msg)
+	assert.Contains(t, err.Error(), strings.TrimSpace(msg))
+	// Peeking does not clear the buffer
+	assert.Equal(t, msg, VipsError())
+
+	ClearVipsError()
+	assert.Empty(t, VipsError())
+
+	// Successful operations leave the buffer empty
+	require.NoError(t, img.Invert())
+	assert.Empty(t, VipsError())
+}
+
+// TestOptionalModuleSavers tests that savers from optional libvips modules fail clearly when missing
+func TestOptionalModuleSavers(t *testing.T) {
+	img, err := createTestGradientImage(t, 32, 32)
+	require.NoError(t, err)
+	defer img.Close()
+
+	savers := map[string]func() ([]byte, error){
+		"heifsave_buffer": func() ([]byte, error) { return img.HeifsaveBuffer(nil) },
+		"jxlsave_buffer":  func() ([]byte, error) { return img.JxlsaveBuffer(nil) },
+	}
+	for name, save := range savers {
+		t.Run(name, func(t *testing.T) {
+			buf, err := save()
+			if HasOperation(name) {
+				if err != nil {
+					// The module is present but may lack an encoder, e.g. libheif without HEVC
+					assert.NotErrorIs(t, err, ErrOperationNotSupported)
+					return
+				}
+				assert.NotEmpty(t, buf)
+				return
+			}
+			require.Error(t, err)
+			assert.ErrorIs(t, err, ErrOperationNotSupported)
+			assert.Contains(t, err.Error(), name+" not supported by this libvips build")
+			assert.Nil(t, buf)
+		})
+	}
+
+	// The savers look availability up once and reuse it
+	for range 2 {
+		err = requireOptionalOperation("heifsave_nonexistent")
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrOperationNotSupported)
+		assert.Contains(t, err.Error(), "heifsave_nonexistent not supported by this libvips build")
+	}
+	assert.Equal(t, RequireOperation("heifsave_buffer"), requireOptionalOperation("heifsave_buffer"))
+}
+
 func TestParameterBinding(t *testing.T) {
 	img, err := createWhiteImage(100, 100)
 	require.NoError(t, err)
@@ -2550,12 +3518,13 @@ func TestErrorPropagation(t *testing.T) {
 	// 1. Invalid file loading
 	_, err := NewImageFromFile("/nonexistent/file.png", nil)
 	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "does not exist")
+	assert.ErrorIs(t, err, ErrFileNotFound)
 
 	// 2. Invalid buffer loading
 	invalidBuf := []byte{0x00, 0x01, 0x02, 0x03}
 	_, err = NewImageFromBuffer(invalidBuf, nil)
 	assert.Error(t, err)
+	assert.ErrorIs(t, err, ErrUnsupportedFormat)
 
 	// 3. Invalid operation parameters
 	img, err := createWhiteImage(100, 100)
@@ -2571,66 +3540,280 @@ func TestErrorPropagation(t *testing.T) {
 	assert.Error(t, err)
 }
 
-func TestNilParameterHandling(t *testing.T) {
-	img, err := createWhiteImage(100, 100)
-	require.NoError(t, err)
-	defer img.Close()
+func TestErrorSentinels(t *testing.T) {
+	dir := t.TempDir()
+	pngData := createTestPngBuffer(t, 20, 20)
 
-	// Test that nil options are handled correctly
+	t.Run("file not found", func(t *testing.T) {
+		_, err := NewImageFromFile(filepath.Join(dir, "missing.png"), nil)
+		assert.ErrorIs(t, err, ErrFileNotFound)
 
-	// 1. Operations with nil options should use defaults
-	err = img.Resize(0.5, nil)
-	require.NoError(t, err)
+		img, err := NewImageFromBuffer(pngData, nil)
+		require.NoError(t, err)
+		defer img.Close()
+		err = img.Pngsave(filepath.Join(dir, "missing", "out.png"), nil)
+		assert.ErrorIs(t, err, ErrFileNotFound)
+		err = img.WriteToFile(filepath.Join(dir, "missing", "out.png"), nil)
+		assert.ErrorIs(t, err, ErrFileNotFound)
+	})
 
-	err = img.Gaussblur(2.0, nil)
-	require.NoError(t, err)
+	t.Run("unsupported format", func(t *testing.T) {
+		_, err := NewImageFromBuffer([]byte("not an image at all"), nil)
+		assert.ErrorIs(t, err, ErrUnsupportedFormat)
 
-	err = img.Embed(10, 10, 120, 120, nil)
-	require.NoError(t, err)
+		path := filepath.Join(dir, "unknown.dat")
+		require.NoError(t, os.WriteFile(path, []byte("not an image at all"), 0644))
+		_, err = NewImageFromFile(path, nil)
+		assert.ErrorIs(t, err, ErrUnsupportedFormat)
 
-	// 2. Buffer operations with nil options
-	buf, err := img.PngsaveBuffer(nil)
-	require.NoError(t, err)
-	assert.NotEmpty(t, buf)
+		source := NewSource(io.NopCloser(bytes.NewReader([]byte("not an image at all"))))
+		defer source.Close()
+		_, err = NewImageFromSource(source, nil)
+		assert.ErrorIs(t, err, ErrUnsupportedFormat)
 
-	buf, err = img.JpegsaveBuffer(nil)
-	require.NoError(t, err)
-	assert.NotEmpty(t, buf)
+		img, err := NewImageFromBuffer(pngData, nil)
+		require.NoError(t, err)
+		defer img.Close()
+		err = img.WriteToFile(filepath.Join(dir, "out.xyz"), nil)
+		assert.ErrorIs(t, err, ErrUnsupportedFormat)
+	})
+
+	t.Run("invalid image", func(t *testing.T) {
+		// Corrupt the IHDR checksum so the PNG is recognised but its header is rejected
+		corrupt := append([]byte(nil), pngData...)
+		corrupt[29] ^= 0xFF
+		_, err := NewImageFromBuffer(corrupt, nil)
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrInvalidImage)
+		assert.NotErrorIs(t, err, ErrUnsupportedFormat)
+	})
 }
 
-func TestImageLifecycle(t *testing.T) {
-	// Test proper image lifecycle management
+// unseekableFS hides Seek and ReadAt of the files it opens
+type unseekableFS struct {
+	fs.FS
+}
 
-	// 1. Create and immediately close
-	img, err := createWhiteImage(100, 100)
-	require.NoError(t, err)
+func (u unseekableFS) Open(name string) (fs.File, error) {
+	file, err := u.FS.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	return struct{ fs.File }{file}, nil
+}
 
-	err = img.Resize(0.5, nil)
+func TestNewImageFromFS(t *testing.T) {
+	fsys := fstest.MapFS{
+		"images/test.png": {Data: createTestPngBuffer(t, 48, 32)},
+		"images/pages.tif": {Data: buildMultiPageTiff([]tiffPage{
+			{width: 20, height: 10, dpi: 72, value: 50},
+		})},
+	}
+
+	img, err := NewImageFromFS(fsys, "images/test.png", nil)
 	require.NoError(t, err)
+	defer img.Close()
+	assert.Equal(t, 48, img.Width())
+	assert.Equal(t, 32, img.Height())
+	assert.Equal(t, ImageTypePng, img.Format())
+
+	for _, name := range []string{"images/test.png", "images/pages.tif"} {
+		img, err := NewImageFromFS(unseekableFS{fsys}, name, nil)
+		require.NoError(t, err, "unseekable %s", name)
+		assert.Positive(t, img.Width())
+		img.Close()
+	}
 
-	// 2. Multiple close calls should be safe
-	img.Close()
-	img.Close()
-	img.Close()
+	_, err = NewImageFromFS(fsys, "images/missing.png", nil)
+	assert.ErrorIs(t, err, ErrFileNotFound)
+	assert.ErrorIs(t, err, fs.ErrNotExist)
 }
 
-func TestImageCopySemantics(t *testing.T) {
-	// Test that image copies work correctly
+func TestImageLimits(t *testing.T) {
+	// Startup runs once, so set the limits it would copy from the Config directly
+	setLimits := func(width, height int, pixels int64) {
+		maxImageWidth, maxImageHeight, maxImagePixels = width, height, pixels
+	}
+	t.Cleanup(func() { setLimits(0, 0, 0) })
 
-	original, err := createTestGradientImage(t, 100, 100)
-	require.NoError(t, err)
-	defer original.Close()
+	pngData := createTestPngBuffer(t, 200, 150)
 
-	// 1. Copy should create independent image
-	copied, err := original.Copy(nil)
-	require.NoError(t, err)
-	defer copied.Close()
+	t.Run("max pixels", func(t *testing.T) {
+		setLimits(0, 0, 10000)
+		_, err := NewImageFromBuffer(pngData, nil)
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrImageTooLarge)
+		assert.Contains(t, err.Error(), "image too large")
 
-	assert.Equal(t, original.Width(), copied.Width())
-	assert.Equal(t, original.Height(), copied.Height())
-	assert.Equal(t, original.Bands(), copied.Bands())
+		path := filepath.Join(t.TempDir(), "large.png")
+		require.NoError(t, os.WriteFile(path, pngData, 0644))
+		_, err = NewImageFromFile(path, nil)
+		assert.ErrorIs(t, err, ErrImageTooLarge)
 
-	// 2. Modifying copy should not affect original
+		_, err = NewImageFromReader(bytes.NewReader(pngData), nil)
+		assert.ErrorIs(t, err, ErrImageTooLarge)
+
+		setLimits(0, 0, 200*150)
+		img, err := NewImageFromBuffer(pngData, nil)
+		require.NoError(t, err)
+		img.Close()
+	})
+
+	t.Run("max width and height", func(t *testing.T) {
+		setLimits(199, 0, 0)
+		_, err := NewImageFromBuffer(pngData, nil)
+		assert.ErrorIs(t, err, ErrImageTooLarge)
+
+		setLimits(0, 149, 0)
+		_, err = NewImageFromBuffer(pngData, nil)
+		assert.ErrorIs(t, err, ErrImageTooLarge)
+
+		setLimits(200, 150, 0)
+		img, err := NewImageFromBuffer(pngData, nil)
+		require.NoError(t, err)
+		img.Close()
+	})
+
+	t.Run("generated loaders", func(t *testing.T) {
+		setLimits(199, 0, 0)
+		_, err := NewPngloadBuffer(pngData, nil)
+		assert.ErrorIs(t, err, ErrImageTooLarge)
+
+		_, err = NewPngloadBuffer(pngData, &PngloadBufferOptions{})
+		assert.ErrorIs(t, err, ErrImageTooLarge)
+
+		_, err = NewThumbnailBuffer(pngData, 300, nil)
+		assert.ErrorIs(t, err, ErrImageTooLarge)
+
+		img, err := NewThumbnailBuffer(pngData, 100, nil)
+		require.NoError(t, err)
+		img.Close()
+	})
+
+	t.Run("multi-page", func(t *testing.T) {
+		tiffData := buildMultiPageTiff([]tiffPage{
+			{width: 40, height: 30, dpi: 72, value: 10},
+			{width: 40, height: 30, dpi: 72, value: 20},
+			{width: 40, height: 30, dpi: 72, value: 30},
+		})
+		setLimits(0, 30, 2*40*30)
+
+		// A single page is within the limits
+		img, err := NewImageFromBuffer(tiffData, nil)
+		require.NoError(t, err)
+		img.Close()
+
+		// All pages together exceed the pixel limit, while each page is within the height limit
+		_, err = NewImageFromBuffer(tiffData, &LoadOptions{N: -1})
+		assert.ErrorIs(t, err, ErrImageTooLarge)
+
+		setLimits(0, 30, 3*40*30)
+		img, err = NewImageFromBuffer(tiffData, &LoadOptions{N: -1})
+		require.NoError(t, err)
+		defer img.Close()
+		assert.Equal(t, 90, img.Height())
+	})
+}
+
+func TestDetectImageType(t *testing.T) {
+	img, err := createTestGradientImage(t, 32, 32)
+	require.NoError(t, err)
+	defer img.Close()
+	webpData, err := img.WebpsaveBuffer(nil)
+	require.NoError(t, err)
+	gifData, err := img.GifsaveBuffer(nil)
+	require.NoError(t, err)
+
+	for expected, data := range map[ImageType][]byte{
+		ImageTypePng:  createTestPngBuffer(t, 32, 32),
+		ImageTypeJpeg: createTestJpegBuffer(t, 32, 32),
+		ImageTypeWebp: webpData,
+		ImageTypeGif:  gifData,
+	} {
+		t.Run(string(expected), func(t *testing.T) {
+			imageType, err := DetectImageType(data)
+			require.NoError(t, err)
+			assert.Equal(t, expected, imageType)
+
+			// Only the header is inspected, so truncated data is still identified
+			imageType, err = DetectImageType(data[:32])
+			require.NoError(t, err)
+			assert.Equal(t, expected, imageType)
+		})
+	}
+
+	for name, data := range map[string][]byte{
+		"unknown bytes": []byte("definitely not an image header"),
+		"empty":         nil,
+	} {
+		t.Run(name, func(t *testing.T) {
+			imageType, err := DetectImageType(data)
+			assert.ErrorIs(t, err, ErrUnsupportedFormat)
+			assert.Equal(t, ImageTypeUnknown, imageType)
+		})
+	}
+}
+
+func TestNilParameterHandling(t *testing.T) {
+	img, err := createWhiteImage(100, 100)
+	require.NoError(t, err)
+	defer img.Close()
+
+	// Test that nil options are handled correctly
+
+	// 1. Operations with nil options should use defaults
+	err = img.Resize(0.5, nil)
+	require.NoError(t, err)
+
+	err = img.Gaussblur(2.0, nil)
+	require.NoError(t, err)
+
+	err = img.Embed(10, 10, 120, 120, nil)
+	require.NoError(t, err)
+
+	// 2. Buffer operations with nil options
+	buf, err := img.PngsaveBuffer(nil)
+	require.NoError(t, err)
+	assert.NotEmpty(t, buf)
+
+	buf, err = img.JpegsaveBuffer(nil)
+	require.NoError(t, err)
+	assert.NotEmpty(t, buf)
+}
+
+func TestImageLifecycle(t *testing.T) {
+	// Test proper image lifecycle management
+
+	// 1. Create and immediately close
+	img, err := createWhiteImage(100, 100)
+	require.NoError(t, err)
+
+	err = img.Resize(0.5, nil)
+	require.NoError(t, err)
+
+	// 2. Multiple close calls should be safe
+	img.Close()
+	img.Close()
+	img.Close()
+}
+
+func TestImageCopySemantics(t *testing.T) {
+	// Test that image copies work correctly
+
+	original, err := createTestGradientImage(t, 100, 100)
+	require.NoError(t, err)
+	defer original.Close()
+
+	// 1. Copy should create independent image
+	copied, err := original.Copy(nil)
+	require.NoError(t, err)
+	defer copied.Close()
+
+	assert.Equal(t, original.Width(), copied.Width())
+	assert.Equal(t, original.Height(), copied.Height())
+	assert.Equal(t, original.Bands(), copied.Bands())
+
+	// 2. Modifying copy should not affect original
 	originalWidth := original.Width()
 	err = copied.Resize(0.5, nil)
 	require.NoError(t, err)
@@ -2671,6 +3854,8 @@ func TestDefaultOptions(t *testing.T) {
 	resizeOpts := DefaultResizeOptions()
 	assert.NotNil(t, resizeOpts)
 	assert.IsType(t, &ResizeOptions{}, resizeOpts)
+	assert.Equal(t, KernelLanczos3, resizeOpts.Kernel)
+	assert.Equal(t, 2.0, resizeOpts.Gap)
 
 	// 2. Gaussblur options
 	blurOpts := DefaultGaussblurOptions()
@@ -2681,11 +3866,13 @@ func TestDefaultOptions(t *testing.T) {
 	pngOpts := DefaultPngsaveBufferOptions()
 	assert.NotNil(t, pngOpts)
 	assert.IsType(t, &PngsaveBufferOptions{}, pngOpts)
+	assert.Equal(t, 6, pngOpts.Compression)
 
 	// 4. JPEG save options
 	jpegOpts := DefaultJpegsaveBufferOptions()
 	assert.NotNil(t, jpegOpts)
 	assert.IsType(t, &JpegsaveBufferOptions{}, jpegOpts)
+	assert.Equal(t, 75, jpegOpts.Q)
 
 	// 5. Load options
 	loadOpts := DefaultLoadOptions()
@@ -3113,10 +4300,11 @@ func TestInterpolationBinding(t *testing.T) {
 		assert.NotNil(t, interp)
 
 		// Test with operations that accept interpolation
-		err = testImg.Resize(0.5, &ResizeOptions{
-			Kernel: KernelLanczos3,
+		err = testImg.Affine(0.5, 0, 0, 0.5, &AffineOptions{
+			Interpolate: interp,
 		})
 		require.NoError(t, err, "Interpolation %v should work", interpType)
+		assert.Equal(t, 50, testImg.Width())
 
 		// Clean up
 		interp.Close()
@@ -3142,6 +4330,45 @@ func TestInterpolationLifecycle(t *testing.T) {
 	invalidInterp.Close()
 }
 
+func TestAffineInterpolation(t *testing.T) {
+	// A hard vertical edge, black on the left and white on the right
+	data := make([]byte, 16*16)
+	for y := 0; y < 16; y++ {
+		for x := 8; x < 16; x++ {
+			data[y*16+x] = 255
+		}
+	}
+	enlarge := func(interp *Interpolate) map[float64]bool {
+		img, err := NewImageFromMemory(data, 16, 16, 1)
+		require.NoError(t, err)
+		defer img.Close()
+		require.NoError(t, img.Affine(2.5, 0, 0, 2.5, &AffineOptions{Interpolate: interp}))
+		assert.Equal(t, 40, img.Width())
+
+		levels := make(map[float64]bool)
+		for x := 0; x < img.Width(); x++ {
+			pixel, err := img.Getpoint(x, 20, nil)
+			require.NoError(t, err)
+			levels[pixel[0]] = true
+		}
+		return levels
+	}
+
+	nearest := NewInterpolate(InterpolateNearest)
+	defer nearest.Close()
+	bicubic := NewInterpolate(InterpolateBicubic)
+	defer bicubic.Close()
+
+	// Nearest neighbour keeps the edge hard, bicubic blends it over several pixels
+	nearestLevels := enlarge(nearest)
+	assert.Equal(t, map[float64]bool{0: true, 255: true}, nearestLevels)
+	bicubicLevels := enlarge(bicubic)
+	assert.Greater(t, len(bicubicLevels), 2, "bicubic should produce intermediate levels")
+
+	// Nil falls back to bilinear, which also blends the edge
+	assert.Greater(t, len(enlarge(nil)), 2)
+}
+
 func TestSourceParameterBinding(t *testing.T) {
 	pngData := createTestPngBuffer(t, 100, 100)
 
@@ -3245,6 +4472,37 @@ func TestOptionStructureGeneration(t *testing.T) {
 	}
 }
 
+func TestCommonSaveOptions(t *testing.T) {
+	img, err := NewImageFromBuffer(createTestPngBuffer(t, 40, 30), nil)
+	require.NoError(t, err)
+	defer img.Close()
+
+	// Fields of the embedded CommonSaveOptions are promoted into each saver's options
+	jpegOpts := &JpegsaveBufferOptions{Q: 80}
+	jpegOpts.Keep = KeepNone
+	optsType := reflect.TypeOf(jpegOpts).Elem()
+	for _, fieldName := range []string{"Q", "Keep", "Background", "PageHeight", "Profile"} {
+		_, found := optsType.FieldByName(fieldName)
+		assert.True(t, found, "JpegsaveBufferOptions should have %s field", fieldName)
+	}
+
+	// SaveOptions allows handling the options of any saver alike
+	pngOpts := DefaultPngsaveBufferOptions()
+	for _, opts := range []SaveOptions{jpegOpts, pngOpts} {
+		opts.CommonOptions().Keep = KeepNone
+	}
+	assert.Equal(t, KeepNone, pngOpts.Keep)
+
+	buf, err := img.JpegsaveBuffer(jpegOpts)
+	require.NoError(t, err)
+	imageType, err := DetectImageType(buf)
+	require.NoError(t, err)
+	assert.Equal(t, ImageTypeJpeg, imageType)
+	buf, err = img.PngsaveBuffer(pngOpts)
+	require.NoError(t, err)
+	assert.NotEmpty(t, buf)
+}
+
 func TestEnumConstantGeneration(t *testing.T) {
 	// Test that enum constants are properly generated
 
@@ -3285,6 +4543,45 @@ func TestEnumConstantGeneration(t *testing.T) {
 	}
 }
 
+func TestEnumString(t *testing.T) {
+	assert.Equal(t, "lanczos3", KernelLanczos3.String())
+	assert.Equal(t, "srgb", InterpretationSrgb.String())
+	assert.Equal(t, "b-w", InterpretationBW.String())
+	assert.Equal(t, "colour-dodge", BlendModeColourDodge.String())
+	assert.Equal(t, "none", KeepNone.String())
+	assert.Equal(t, "sequential-unbuffered", AccessSequentialUnbuffered.String())
+
+	// Unknown values print the type and number
+	assert.Equal(t, "Kernel(99)", Kernel(99).String())
+	assert.Equal(t, "kernel=lanczos3", fmt.Sprintf("kernel=%v", KernelLanczos3))
+}
+
+func TestParseEnum(t *testing.T) {
+	interpretation, err := ParseInterpretation("srgb")
+	require.NoError(t, err)
+	assert.Equal(t, InterpretationSrgb, interpretation)
+
+	kernel, err := ParseKernel("Lanczos3")
+	require.NoError(t, err)
+	assert.Equal(t, KernelLanczos3, kernel)
+
+	keep, err := ParseKeep("NONE")
+	require.NoError(t, err)
+	assert.Equal(t, KeepNone, keep)
+
+	// Every nickname parses back to its value
+	for _, blend := range []BlendMode{BlendModeOver, BlendModeDestOver, BlendModeColourDodge} {
+		parsed, err := ParseBlendMode(blend.String())
+		require.NoError(t, err)
+		assert.Equal(t, blend, parsed)
+	}
+
+	_, err = ParseInterpretation("rgbish")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unknown Interpretation "rgbish"`)
+	assert.Contains(t, err.Error(), "srgb")
+}
+
 func TestFormatSpecificOptions(t *testing.T) {
 	img, err := createWhiteImage(100, 100)
 	require.NoError(t, err)
@@ -3873,6 +5170,49 @@ func TestNewThumbnail_Options(t *testing.T) {
 	}
 }
 
+func TestNewThumbnail_NoRotate(t *testing.T) {
+	// Stored as 400x200 landscape, displayed as 200x400 portrait via orientation 6
+	img, err := createWhiteImage(400, 200)
+	require.NoError(t, err)
+	defer img.Close()
+	require.NoError(t, img.SetOrientation(6))
+
+	jpegData, err := img.JpegsaveBuffer(nil)
+	require.NoError(t, err)
+
+	t.Run("buffer", func(t *testing.T) {
+		rotated, err := NewThumbnailBuffer(jpegData, 100, &ThumbnailBufferOptions{Height: 1000})
+		require.NoError(t, err)
+		defer rotated.Close()
+		assert.Equal(t, 100, rotated.Width(), "width applies to the upright image")
+		assert.Equal(t, 200, rotated.Height())
+
+		unrotated, err := NewThumbnailBuffer(jpegData, 100, &ThumbnailBufferOptions{Height: 1000, NoRotate: true})
+		require.NoError(t, err)
+		defer unrotated.Close()
+		assert.Equal(t, 100, unrotated.Width(), "width applies to the stored pixel layout")
+		assert.Equal(t, 50, unrotated.Height())
+	})
+
+	t.Run("source", func(t *testing.T) {
+		source := NewSource(io.NopCloser(bytes.NewReader(jpegData)))
+		defer source.Close()
+		rotated, err := NewThumbnailSource(source, 100, &ThumbnailSourceOptions{Height: 1000})
+		require.NoError(t, err)
+		defer rotated.Close()
+		assert.Equal(t, 100, rotated.Width())
+		assert.Equal(t, 200, rotated.Height())
+
+		source2 := NewSource(io.NopCloser(bytes.NewReader(jpegData)))
+		defer source2.Close()
+		unrotated, err := NewThumbnailSource(source2, 100, &ThumbnailSourceOptions{Height: 1000, NoRotate: true})
+		require.NoError(t, err)
+		defer unrotated.Close()
+		assert.Equal(t, 100, unrotated.Width())
+		assert.Equal(t, 50, unrotated.Height())
+	})
+}
+
 func TestImage_SetArrayInt(t *testing.T) {
 	img, err := createWhiteImage(100, 100)
 	require.NoError(t, err)
@@ -3933,6 +5273,49 @@ func TestImage_SetArrayDouble(t *testing.T) {
 	assert.Equal(t, negativeArray, retrievedNegative, "Retrieved negative array should match")
 }
 
+func TestImage_SetArrayImage(t *testing.T) {
+	img, err := createWhiteImage(100, 100)
+	require.NoError(t, err)
+	defer img.Close()
+
+	small, err := createWhiteImage(20, 10)
+	require.NoError(t, err)
+	defer small.Close()
+	large, err := createTestGradientImage(t, 64, 48)
+	require.NoError(t, err)
+	defer large.Close()
+
+	require.NoError(t, img.SetArrayImage("test-images", []*Image{small, large}))
+
+	images, err := img.GetArrayImage("test-images")
+	require.NoError(t, err)
+	require.Len(t, images, 2)
+	assert.Equal(t, 20, images[0].Width())
+	assert.Equal(t, 10, images[0].Height())
+	assert.Equal(t, 64, images[1].Width())
+	assert.Equal(t, 48, images[1].Height())
+
+	// Returned images hold their own references and remain usable after the parent is closed
+	img.Close()
+	pixel, err := images[1].Getpoint(10, 10, nil)
+	require.NoError(t, err)
+	assert.NotEmpty(t, pixel)
+	for _, image := range images {
+		image.Close()
+	}
+
+	other, err := createWhiteImage(10, 10)
+	require.NoError(t, err)
+	defer other.Close()
+
+	_, err = other.GetArrayImage("missing-images")
+	assert.Error(t, err, "missing field should return an error")
+
+	other.SetInt("not-images", 1)
+	_, err = other.GetArrayImage("not-images")
+	assert.Error(t, err, "non image array field should return an error")
+}
+
 // TestSmartcropOptionalOutputs tests smartcrop's attention coordinates
 func TestSmartcropOptionalOutputs(t *testing.T) {
 	// Create an image with a bright spot in a known location
@@ -3986,6 +5369,71 @@ func TestSmartcropOptionalOutputs(t *testing.T) {
 	assert.InDelta(t, 50, options.AttentionY, 50, "AttentionY should be near the bright spot")
 }
 
+func TestImage_SmartcropRect(t *testing.T) {
+	original, err := NewBlack(200, 120, &BlackOptions{Bands: 3})
+	require.NoError(t, err)
+	defer original.Close()
+	require.NoError(t, original.DrawRect([]float64{60, 60, 60}, 0, 0, 200, 120, &DrawRectOptions{Fill: true}))
+	require.NoError(t, original.DrawCircle([]float64{255, 220, 40}, 150, 40, 18, &DrawCircleOptions{Fill: true}))
+
+	strategies := map[string]Interesting{
+		"none":      InterestingNone,
+		"centre":    InterestingCentre,
+		"entropy":   InterestingEntropy,
+		"attention": InterestingAttention,
+		"low":       InterestingLow,
+		"high":      InterestingHigh,
+	}
+	for name, strategy := range strategies {
+		t.Run(name, func(t *testing.T) {
+			cropped, err := original.Copy(nil)
+			require.NoError(t, err)
+			defer cropped.Close()
+
+			left, top, err := cropped.SmartcropRect(80, 60, strategy)
+			require.NoError(t, err)
+			assert.Equal(t, 80, cropped.Width())
+			assert.Equal(t, 60, cropped.Height())
+			assert.GreaterOrEqual(t, left, 0)
+			assert.GreaterOrEqual(t, top, 0)
+			assert.LessOrEqual(t, left+cropped.Width(), original.Width())
+			assert.LessOrEqual(t, top+cropped.Height(), original.Height())
+
+			expected, err := original.Copy(nil)
+			require.NoError(t, err)
+			defer expected.Close()
+			require.NoError(t, expected.ExtractArea(left, top, cropped.Width(), cropped.Height()))
+
+			equal, err := cropped.Equals(expected)
+			require.NoError(t, err)
+			assert.True(t, equal, "returned rectangle should match the extracted region")
+		})
+	}
+
+	t.Run("clamped", func(t *testing.T) {
+		cropped, err := original.Copy(nil)
+		require.NoError(t, err)
+		defer cropped.Close()
+
+		left, top, err := cropped.SmartcropRect(500, 60, InterestingAttention)
+		require.NoError(t, err)
+		assert.Equal(t, 0, left)
+		assert.Equal(t, 200, cropped.Width())
+		assert.Equal(t, 60, cropped.Height())
+		assert.GreaterOrEqual(t, top, 0)
+		assert.LessOrEqual(t, top+60, 120)
+	})
+
+	t.Run("invalid size", func(t *testing.T) {
+		cropped, err := original.Copy(nil)
+		require.NoError(t, err)
+		defer cropped.Close()
+
+		_, _, err = cropped.SmartcropRect(0, 60, InterestingCentre)
+		assert.Error(t, err)
+	})
+}
+
 // TestMaxMinOptionalOutputs tests max/min operations with position outputs
 func TestMaxMinOptionalOutputs(t *testing.T) {
 	// Create a gradient image where we know the min/max locations
@@ -4039,216 +5487,3426 @@ func TestMaxMinOptionalOutputs(t *testing.T) {
 	assert.Equal(t, height-1, maxOptions.Y, "Max Y should be height-1")
 }
 
-// TestDrawFloodOptionalOutputs tests draw_flood's affected area outputs
-func TestDrawFloodOptionalOutputs(t *testing.T) {
-	// Create a white image with a small colored region
-	width, height := 200, 150
-	img, err := createWhiteImage(width, height)
+func TestPositionResultAPIs(t *testing.T) {
+	img, err := createTestGradientImage(t, 100, 80)
 	require.NoError(t, err)
 	defer img.Close()
 
-	// Draw a small red rectangle that we'll flood fill
-	redColor := []float64{255, 0, 0}
-	err = img.DrawRect(redColor, 50, 40, 30, 20, &DrawRectOptions{Fill: true})
-	require.NoError(t, err)
-
-	// Test flood fill with optional outputs
-	options := DefaultDrawFloodOptions()
-	require.NotNil(t, options)
+	t.Run("max", func(t *testing.T) {
+		options := DefaultMaxOptions()
+		expected, err := img.Max(options)
+		require.NoError(t, err)
 
-	// Flood fill from a point inside the red rectangle
-	floodColor := []float64{0, 255, 0} // Green
-	startX, startY := 60, 50
+		value, x, y, err := img.MaxWithPosition()
+		require.NoError(t, err)
+		assert.Equal(t, expected, value)
+		assert.Equal(t, options.X, x)
+		assert.Equal(t, options.Y, y)
+	})
 
-	err = img.DrawFlood(floodColor, startX, startY, options)
-	require.NoError(t, err)
+	t.Run("min", func(t *testing.T) {
+		options := DefaultMinOptions()
+		expected, err := img.Min(options)
+		require.NoError(t, err)
 
-	t.Logf("Flood fill affected area: left=%d, top=%d, width=%d, height=%d",
-		options.Left, options.Top, options.Width, options.Height)
+		value, x, y, err := img.MinWithPosition()
+		require.NoError(t, err)
+		assert.Equal(t, expected, value)
+		assert.Equal(t, options.X, x)
+		assert.Equal(t, options.Y, y)
+	})
 
-	// Verify the affected area bounds are reasonable
-	assert.GreaterOrEqual(t, options.Left, 0, "Left should be >= 0")
-	assert.GreaterOrEqual(t, options.Top, 0, "Top should be >= 0")
-	assert.Greater(t, options.Width, 0, "Width should be > 0")
-	assert.Greater(t, options.Height, 0, "Height should be > 0")
+	t.Run("smartcrop", func(t *testing.T) {
+		viaOptions, err := img.Copy(nil)
+		require.NoError(t, err)
+		defer viaOptions.Close()
+		options := &SmartcropOptions{Interesting: InterestingAttention}
+		require.NoError(t, viaOptions.Smartcrop(40, 40, options))
 
-	// The affected area should encompass our red rectangle
-	assert.LessOrEqual(t, options.Left, 50, "Left should be <= 50")
-	assert.LessOrEqual(t, options.Top, 40, "Top should be <= 40")
-	assert.GreaterOrEqual(t, options.Left+options.Width, 80, "Right edge should be >= 80")
-	assert.GreaterOrEqual(t, options.Top+options.Height, 60, "Bottom edge should be >= 60")
+		viaResult, err := img.Copy(nil)
+		require.NoError(t, err)
+		defer viaResult.Close()
+		input := &SmartcropOptions{Interesting: InterestingAttention}
+		attentionX, attentionY, err := viaResult.SmartcropResult(40, 40, input)
+		require.NoError(t, err)
 
-	// Calculate total affected pixels
-	totalPixels := options.Width * options.Height
-	t.Logf("Total affected pixels: %d", totalPixels)
-	assert.Greater(t, totalPixels, 0, "Should have affected some pixels")
+		assert.Equal(t, options.AttentionX, attentionX)
+		assert.Equal(t, options.AttentionY, attentionY)
+		assert.Equal(t, 0, input.AttentionX, "options passed to SmartcropResult are not modified")
+		assert.Equal(t, 40, viaResult.Width())
+		assert.Equal(t, 40, viaResult.Height())
+	})
 }
 
-// TestOptionalOutputsWithNilOptions tests that optional outputs work with nil options
-func TestOptionalOutputsWithNilOptions(t *testing.T) {
-	// Create test images with textured patterns for better mosaic matching
-	img1, err := createCheckboardImage(t, 200, 150, 20)
-	require.NoError(t, err)
-	defer img1.Close()
-
-	img2, err := createCheckboardImage(t, 150, 150, 20)
+// TestResizePremultiplied tests that premultiplying around resize avoids dark fringes
+func TestResizePremultiplied(t *testing.T) {
+	// Opaque white on the left half, fully transparent black on the right half
+	width, height := 20, 4
+	buf := make([]byte, width*height*4)
+	for y := 0; y < height; y++ {
+		for x := 0; x < width/2; x++ {
+			i := (y*width + x) * 4
+			buf[i], buf[i+1], buf[i+2], buf[i+3] = 255, 255, 255, 255
+		}
+	}
+	img, err := NewImageFromMemory(buf, width, height, 4)
 	require.NoError(t, err)
-	defer img2.Close()
+	defer img.Close()
 
-	// Test that operations work with nil options (should use defaults)
-	// Use realistic tie points for mosaic operation
-	err = img1.Mosaic(img2, DirectionHorizontal, 150, 75, 75, 75, nil)
-	require.NoError(t, err, "Mosaic should work with nil options")
+	require.NoError(t, img.Premultiply(nil))
+	require.NoError(t, img.Resize(0.3, &ResizeOptions{Kernel: KernelLinear}))
+	require.NoError(t, img.Unpremultiply(nil))
+	require.NoError(t, img.Cast(BandFormatUchar, nil))
 
-	// Test smartcrop with nil options
-	err = img1.Smartcrop(50, 50, nil)
-	require.NoError(t, err, "Smartcrop should work with nil options")
+	edges := 0
+	for x := 0; x < img.Width(); x++ {
+		pixel, err := img.Getpoint(x, 0, nil)
+		require.NoError(t, err)
+		require.Len(t, pixel, 4)
+		if pixel[3] == 0 {
+			continue
+		}
+		if pixel[3] < 255 {
+			edges++
+		}
+		for band := 0; band < 3; band++ {
+			assert.GreaterOrEqual(t, pixel[band], 250.0, "dark fringe at x=%d alpha=%v", x, pixel[3])
+		}
+	}
+	assert.Greater(t, edges, 0, "resize should produce partially transparent edge pixels")
+}
 
-	// Test min/max with nil options
-	_, err = img1.Min(nil)
-	require.NoError(t, err, "Min should work with nil options")
+// TestSaveAlphaToJpeg tests that alpha is flattened onto the background when saving to JPEG
+func TestSaveAlphaToJpeg(t *testing.T) {
+	// Opaque red on the left half, fully transparent on the right half
+	width, height := 32, 16
+	buf := make([]byte, width*height*4)
+	for y := 0; y < height; y++ {
+		for x := 0; x < width/2; x++ {
+			i := (y*width + x) * 4
+			buf[i], buf[i+3] = 255, 255
+		}
+	}
+	img, err := NewImageFromMemory(buf, width, height, 4)
+	require.NoError(t, err)
+	defer img.Close()
 
-	_, err = img1.Max(nil)
-	require.NoError(t, err, "Max should work with nil options")
+	t.Run("jpeg flattens onto background", func(t *testing.T) {
+		options := DefaultJpegsaveBufferOptions()
+		options.Background = []float64{255, 255, 255}
+		jpegData, err := img.JpegsaveBuffer(options)
+		require.NoError(t, err)
 
-	// Test draw flood with nil options
-	err = img1.DrawFlood([]float64{0, 255, 0}, 25, 25, nil)
-	require.NoError(t, err, "DrawFlood should work with nil options")
+		saved, err := NewImageFromBuffer(jpegData, nil)
+		require.NoError(t, err)
+		defer saved.Close()
+		assert.Equal(t, 3, saved.Bands())
+		assert.False(t, saved.HasAlpha())
+
+		transparent, err := saved.Getpoint(width-4, height/2, nil)
+		require.NoError(t, err)
+		for band := 0; band < 3; band++ {
+			assert.InDelta(t, 255, transparent[band], 8, "transparent area should be the background colour")
+		}
+
+		opaque, err := saved.Getpoint(4, height/2, nil)
+		require.NoError(t, err)
+		assert.InDelta(t, 255, opaque[0], 8)
+		assert.InDelta(t, 0, opaque[1], 8)
+		assert.InDelta(t, 0, opaque[2], 8)
+	})
+
+	t.Run("png keeps alpha", func(t *testing.T) {
+		pngData, err := img.PngsaveBuffer(nil)
+		require.NoError(t, err)
+
+		saved, err := NewImageFromBuffer(pngData, nil)
+		require.NoError(t, err)
+		defer saved.Close()
+		assert.Equal(t, 4, saved.Bands())
+		assert.True(t, saved.HasAlpha())
+	})
+
+	t.Run("remove alpha", func(t *testing.T) {
+		copied, err := img.Copy(nil)
+		require.NoError(t, err)
+		defer copied.Close()
+
+		require.NoError(t, copied.RemoveAlpha())
+		assert.Equal(t, 3, copied.Bands())
+		assert.False(t, copied.HasAlpha())
+
+		require.NoError(t, copied.RemoveAlpha())
+		assert.Equal(t, 3, copied.Bands())
+	})
 }
 
-func TestImage_Loop(t *testing.T) {
-	buf := createTestPngBuffer(t, 10, 10)
-	img, err := NewImageFromBuffer(buf, nil)
+// TestWebpsaveNearLossless tests that near lossless encoding is smaller than plain lossless
+func TestWebpsaveNearLossless(t *testing.T) {
+	img, err := NewGaussnoise(128, 128, &GaussnoiseOptions{Sigma: 30, Mean: 128})
 	require.NoError(t, err)
 	defer img.Close()
+	require.NoError(t, img.Cast(BandFormatUchar, nil))
 
-	// Default: no loop metadata set, should return 0
-	assert.Equal(t, 0, img.Loop())
+	lossless, err := img.WebpsaveBuffer(&WebpsaveBufferOptions{Lossless: true})
+	require.NoError(t, err)
 
-	// Set and retrieve a non-zero loop count
-	img.SetLoop(3)
-	assert.Equal(t, 3, img.Loop())
+	nearLossless, err := img.WebpsaveBuffer(&WebpsaveBufferOptions{NearLossless: true, Q: 60})
+	require.NoError(t, err)
 
-	// Set to 0 (infinite looping)
-	img.SetLoop(0)
-	assert.Equal(t, 0, img.Loop())
+	assert.Less(t, len(nearLossless), len(lossless), "near lossless should be smaller than lossless")
+
+	decoded, err := NewImageFromBuffer(nearLossless, nil)
+	require.NoError(t, err)
+	defer decoded.Close()
+	assert.Equal(t, 128, decoded.Width())
+	assert.Equal(t, 128, decoded.Height())
 }
 
-func TestImage_RemoveExif_RetainsLoop(t *testing.T) {
-	buf := createTestPngBuffer(t, 10, 10)
-	img, err := NewImageFromBuffer(buf, nil)
+// TestJpegsaveSubsampleMode tests that disabling chroma subsampling changes the encoded file
+func TestJpegsaveSubsampleMode(t *testing.T) {
+	img, err := createTestGradientImage(t, 128, 96)
 	require.NoError(t, err)
 	defer img.Close()
 
-	img.SetLoop(5)
-	require.Equal(t, 5, img.Loop())
+	defaultBuf, err := img.JpegsaveBuffer(&JpegsaveBufferOptions{Q: 75})
+	require.NoError(t, err)
 
-	err = img.RemoveExif()
+	noSubsampleBuf, err := img.JpegsaveBuffer(&JpegsaveBufferOptions{Q: 75, SubsampleMode: SubsampleOff})
 	require.NoError(t, err)
 
-	assert.Equal(t, 5, img.Loop(), "loop metadata should be preserved after RemoveExif")
+	assert.NotEqual(t, defaultBuf, noSubsampleBuf, "4:4:4 output should differ from default 4:2:0")
+	assert.Greater(t, len(noSubsampleBuf), len(defaultBuf), "full resolution chroma should take more space")
+
+	decoded, err := NewImageFromBuffer(noSubsampleBuf, nil)
+	require.NoError(t, err)
+	defer decoded.Close()
+	assert.Equal(t, 128, decoded.Width())
+	assert.Equal(t, 96, decoded.Height())
+
+	// the other mozjpeg-only options are ignored with a warning when libjpeg does not support them
+	_, err = img.JpegsaveBuffer(&JpegsaveBufferOptions{
+		Q:                  75,
+		OvershootDeringing: true,
+		Interlace:          true,
+		OptimizeScans:      true,
+		QuantTable:         3,
+	})
+	require.NoError(t, err)
+
+	// trellis quantisation fails clearly without mozjpeg
+	trellisBuf, err := img.JpegsaveBuffer(&JpegsaveBufferOptions{Q: 75, TrellisQuant: true})
+	if checkTrellisQuant() != nil {
+		assert.ErrorIs(t, err, ErrOperationNotSupported)
+		assert.Contains(t, err.Error(), "mozjpeg")
+		assert.Error(t, img.Jpegsave(filepath.Join(t.TempDir(), "trellis.jpg"), &JpegsaveOptions{TrellisQuant: true}))
+	} else {
+		require.NoError(t, err)
+		assert.NotEqual(t, defaultBuf, trellisBuf)
+	}
 }
 
-func TestWriteToMemory_RoundTrip(t *testing.T) {
-	// Create a known 2x2 RGBA image via NewImageFromMemory
-	// Pixels: red, green, blue, white (each 4 bytes RGBA)
-	input := []byte{
-		255, 0, 0, 255, // red
-		0, 255, 0, 255, // green
-		0, 0, 255, 255, // blue
-		255, 255, 255, 255, // white
+// TestTiffsavePyramid tests saving a pyramidal tiff and loading a reduced resolution level
+func TestTiffsavePyramid(t *testing.T) {
+	img, err := createTestGradientImage(t, 512, 384)
+	require.NoError(t, err)
+	defer img.Close()
+
+	for name, options := range map[string]*TiffsaveBufferOptions{
+		"tiled":           {Pyramid: true, Tile: true, TileWidth: 128, TileHeight: 128, Compression: TiffCompressionDeflate, Predictor: TiffPredictorHorizontal},
+		"implicit tiling": {Pyramid: true, Compression: TiffCompressionJpeg, Q: 80},
+	} {
+		t.Run(name, func(t *testing.T) {
+			tiffData, err := img.TiffsaveBuffer(options)
+			require.NoError(t, err)
+
+			full, err := NewTiffloadBuffer(tiffData, nil)
+			require.NoError(t, err)
+			defer full.Close()
+			assert.Equal(t, 512, full.Width())
+			assert.Equal(t, 384, full.Height())
+
+			level, err := NewTiffloadBuffer(tiffData, &TiffloadBufferOptions{Page: 1})
+			require.NoError(t, err)
+			defer level.Close()
+			assert.Equal(t, 256, level.Width())
+			assert.Equal(t, 192, level.Height())
+		})
 	}
-	img, err := NewImageFromMemory(input, 2, 2, 4)
+}
+
+// tiffPage describes one page of an uncompressed greyscale tiff built by buildMultiPageTiff
+type tiffPage struct {
+	width, height int
+	dpi           uint32
+	value         byte
+	orientation   uint32
+}
+
+// buildMultiPageTiff builds a little-endian tiff with one IFD per page, each with its own size and resolution
+func buildMultiPageTiff(pages []tiffPage) []byte {
+	le := binary.LittleEndian
+	var tiff []byte
+	tiff = append(tiff, 'I', 'I')
+	tiff = le.AppendUint16(tiff, 42)
+	tiff = le.AppendUint32(tiff, 8)
+
+	entry := func(tag, kind uint16, value uint32) {
+		tiff = le.AppendUint16(tiff, tag)
+		tiff = le.AppendUint16(tiff, kind)
+		tiff = le.AppendUint32(tiff, 1)
+		tiff = le.AppendUint32(tiff, value)
+	}
+	for i, page := range pages {
+		// IFD entries, the next IFD offset, two resolution rationals, then the pixels
+		entries := 12
+		if page.orientation != 0 {
+			entries++
+		}
+		ifd := len(tiff)
+		resolution := ifd + 2 + entries*12 + 4
+		pixels := resolution + 16
+		next := pixels + page.width*page.height
+		next += next % 2
+		if i == len(pages)-1 {
+			next = 0
+		}
+
+		tiff = le.AppendUint16(tiff, uint16(entries))
+		entry(256, 4, uint32(page.width))
+		entry(257, 4, uint32(page.height))
+		entry(258, 3, 8)
+		entry(259, 3, 1)
+		entry(262, 3, 1)
+		entry(273, 4, uint32(pixels))
+		if page.orientation != 0 {
+			entry(274, 3, page.orientation)
+		}
+		entry(277, 3, 1)
+		entry(278, 4, uint32(page.height))
+		entry(279, 4, uint32(page.width*page.height))
+		entry(282, 5, uint32(resolution))
+		entry(283, 5, uint32(resolution+8))
+		entry(296, 3, 2)
+		tiff = le.AppendUint32(tiff, uint32(next))
+
+		for range 2 {
+			tiff = le.AppendUint32(tiff, page.dpi)
+			tiff = le.AppendUint32(tiff, 1)
+		}
+		tiff = append(tiff, bytes.Repeat([]byte{page.value}, page.width*page.height)...)
+		if next != 0 {
+			tiff = append(tiff, make([]byte, next-len(tiff))...)
+		}
+	}
+	return tiff
+}
+
+func TestImage_Page(t *testing.T) {
+	pages := []tiffPage{
+		{width: 64, height: 48, dpi: 72, value: 40},
+		{width: 32, height: 32, dpi: 150, value: 120},
+		{width: 80, height: 20, dpi: 300, value: 200},
+	}
+	img, err := NewImageFromBuffer(buildMultiPageTiff(pages), nil)
 	require.NoError(t, err)
 	defer img.Close()
+	require.Equal(t, 3, img.Pages())
+	assert.Equal(t, 64, img.Width())
+
+	for i, expected := range pages {
+		page, err := img.Page(i)
+		require.NoError(t, err, "page %d", i)
+		assert.Equal(t, expected.width, page.Width(), "page %d", i)
+		assert.Equal(t, expected.height, page.Height(), "page %d", i)
+		assert.Equal(t, 1, page.Pages(), "page %d", i)
+		xdpi, ydpi := page.DPI()
+		assert.InDelta(t, float64(expected.dpi), xdpi, 0.01, "page %d", i)
+		assert.InDelta(t, float64(expected.dpi), ydpi, 0.01, "page %d", i)
+		pixel, err := page.Getpoint(0, 0, nil)
+		require.NoError(t, err)
+		assert.Equal(t, float64(expected.value), pixel[0], "page %d", i)
+		page.Close()
+	}
 
-	// Round-trip back to raw bytes
-	got, err := img.WriteToMemory()
+	// Pages are reloaded, so the loaded image keeps its own header
+	xdpi, _ := img.DPI()
+	assert.InDelta(t, 72, xdpi, 0.01)
+
+	_, err = img.Page(3)
+	assert.Error(t, err)
+	_, err = img.Page(-1)
+	assert.Error(t, err)
+
+	t.Run("keeps load options", func(t *testing.T) {
+		rotated := []tiffPage{
+			{width: 64, height: 48, dpi: 72, value: 40},
+			{width: 32, height: 16, dpi: 72, value: 120, orientation: 6},
+		}
+		img, err := NewImageFromBuffer(buildMultiPageTiff(rotated), &LoadOptions{Autorotate: true, FailOnError: true})
+		require.NoError(t, err)
+		defer img.Close()
+
+		page, err := img.Page(1)
+		require.NoError(t, err)
+		defer page.Close()
+		assert.Equal(t, 16, page.Width())
+		assert.Equal(t, 32, page.Height())
+	})
+
+	t.Run("checks image limits", func(t *testing.T) {
+		maxImageWidth = 70
+		defer func() {
+			maxImageWidth = 0
+		}()
+		page, err := img.Page(0)
+		require.NoError(t, err)
+		page.Close()
+		_, err = img.Page(2)
+		assert.ErrorIs(t, err, ErrImageTooLarge)
+	})
+
+	t.Run("extracted from strip", func(t *testing.T) {
+		first, err := createWhiteImage(16, 8)
+		require.NoError(t, err)
+		defer first.Close()
+		second, err := NewBlack(16, 8, &BlackOptions{Bands: 3})
+		require.NoError(t, err)
+		defer second.Close()
+
+		strip, err := NewArrayjoin([]*Image{first, second}, &ArrayjoinOptions{Across: 1})
+		require.NoError(t, err)
+		defer strip.Close()
+		require.NoError(t, strip.SetPages(2))
+		require.NoError(t, strip.SetPageHeight(8))
+
+		page, err := strip.Page(1)
+		require.NoError(t, err)
+		defer page.Close()
+		assert.Equal(t, 16, page.Width())
+		assert.Equal(t, 8, page.Height())
+		assert.Equal(t, 1, page.Pages())
+		equal, err := page.Equals(second)
+		require.NoError(t, err)
+		assert.True(t, equal)
+	})
+}
+
+// TestDzsave tests generating deep zoom pyramids as a zip buffer and as a directory
+func TestDzsave(t *testing.T) {
+	img, err := createTestGradientImage(t, 1024, 1024)
 	require.NoError(t, err)
+	defer img.Close()
 
-	assert.Equal(t, len(input), len(got), "buffer length should match width*height*bands")
-	assert.Equal(t, input, got, "pixel data should survive the round-trip")
+	t.Run("zip buffer", func(t *testing.T) {
+		buf, err := img.DzsaveBuffer(&DzsaveBufferOptions{Imagename: "pyramid", TileSize: 256})
+		require.NoError(t, err)
+
+		archive, err := zip.NewReader(bytes.NewReader(buf), int64(len(buf)))
+		require.NoError(t, err)
+		names := make(map[string]bool)
+		for _, file := range archive.File {
+			names[filepath.ToSlash(file.Name)] = true
+		}
+		var descriptor bool
+		for name := range names {
+			if filepath.Ext(name) == ".dzi" {
+				descriptor = true
+			}
+		}
+		assert.True(t, descriptor, "zip should contain a .dzi descriptor")
+		// 1024x1024 has levels 0 to 10, the full resolution level is 4x4 tiles of 256
+		assert.True(t, names["pyramid/pyramid_files/10/3_3.jpeg"] || names["pyramid_files/10/3_3.jpeg"],
+			"zip should contain the full resolution tiles")
+	})
+
+	t.Run("directory", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, img.Dzsave(filepath.Join(dir, "pyramid"), &DzsaveOptions{TileSize: 512}))
+
+		_, err := os.Stat(filepath.Join(dir, "pyramid.dzi"))
+		require.NoError(t, err)
+		_, err = os.Stat(filepath.Join(dir, "pyramid_files", "10", "1_1.jpeg"))
+		require.NoError(t, err)
+	})
 }
 
-func TestWriteToMemory_SizeMatchesDimensions(t *testing.T) {
-	width, height, bands := 10, 8, 3
-	data := make([]byte, width*height*bands)
-	for i := range data {
-		data[i] = byte(i % 256)
+// TestPdfloadBuffer tests rendering PDF pages with PDF specific load options
+func TestPdfloadBuffer(t *testing.T) {
+	if !HasOperation("pdfload_buffer") {
+		t.Skip("libvips built without PDF support")
 	}
 
-	img, err := NewImageFromMemory(data, width, height, bands)
+	// Two pages of 200x100 points render at 150 dpi to about 417x208 pixels each
+	pdfData := createTestPdfBuffer(2, 200, 100, "")
+
+	img, err := NewPdfloadBuffer(pdfData, &PdfloadBufferOptions{N: -1, Dpi: 150, Background: []float64{255, 255, 255}})
 	require.NoError(t, err)
 	defer img.Close()
+	assert.Equal(t, 2, img.Pages())
+	assert.InDelta(t, 417, img.Width(), 2)
+	assert.InDelta(t, 208, img.PageHeight(), 2)
+	assert.Equal(t, 2*img.PageHeight(), img.Height())
 
-	buf, err := img.WriteToMemory()
+	second, err := NewPdfloadBuffer(pdfData, &PdfloadBufferOptions{Page: 1, Scale: 2})
 	require.NoError(t, err)
+	defer second.Close()
+	assert.InDelta(t, 400, second.Width(), 2)
+	assert.InDelta(t, 200, second.Height(), 2)
 
-	assert.Equal(t, width*height*bands, len(buf))
-	assert.Equal(t, width, img.Width())
-	assert.Equal(t, height, img.Height())
-	assert.Equal(t, bands, img.Bands())
+	t.Run("encrypted", func(t *testing.T) {
+		encrypted := createTestPdfBuffer(1, 200, 100, "secret")
+
+		_, err := NewPdfloadBuffer(encrypted, nil)
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrPasswordRequired)
+
+		_, err = NewPdfloadBuffer(encrypted, &PdfloadBufferOptions{Password: "wrong"})
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrPasswordRequired)
+
+		decrypted, err := NewPdfloadBuffer(encrypted, &PdfloadBufferOptions{Password: "secret"})
+		require.NoError(t, err)
+		defer decrypted.Close()
+		assert.InDelta(t, 200, decrypted.Width(), 2)
+	})
 }
 
-// TestImageFromFileWithSpecialCharsInName verifies that NewImageFromFile correctly
-// handles filenames containing characters that are special to the libvips filename
-// string parser (commas, brackets, backslashes, apostrophes).
-func TestImageFromFileWithSpecialCharsInName(t *testing.T) {
-	testDir := ensureTestDir(t)
+// TestSvgload tests rasterizing SVGs with load options and RenderSVG
+func TestSvgload(t *testing.T) {
+	if !HasOperation("svgload_buffer") {
+		t.Skip("libvips built without SVG support")
+	}
+	svg := []byte(`<svg xmlns="http://www.w3.org/2000/svg" width="100" height="50">` +
+		`<rect x="0" y="0" width="100" height="50" fill="red"/></svg>`)
 
-	// Create a small PNG to use as test data
-	pngData := createTestPngBuffer(t, 10, 10)
+	img, err := NewSvgloadBuffer(svg, nil)
+	require.NoError(t, err)
+	defer img.Close()
+	assert.Equal(t, 100, img.Width())
+	assert.Equal(t, 50, img.Height())
 
-	testCases := []struct {
-		name     string
-		filename string
-	}{
-		{
-			name:     "comma and apostrophe at start",
-			filename: ",'special.png",
-		},
-		{
-			name:     "brackets in middle",
-			filename: "image[1].png",
-		},
-		{
-			name:     "comma in middle",
-			filename: "image,comma.png",
-		},
-		{
-			name:     "backslash in name",
-			filename: "image\\backslash.png",
-		},
-		{
-			name:     "brackets at start",
-			filename: "[bracket]image.png",
-		},
+	scaled, err := NewSvgloadBuffer(svg, &SvgloadBufferOptions{Scale: 2})
+	require.NoError(t, err)
+	defer scaled.Close()
+	assert.Equal(t, 200, scaled.Width())
+	assert.Equal(t, 100, scaled.Height())
+
+	highDpi, err := NewSvgloadBuffer(svg, &SvgloadBufferOptions{Dpi: 144})
+	require.NoError(t, err)
+	defer highDpi.Close()
+	assert.Equal(t, 200, highDpi.Width())
+
+	t.Run("render to size", func(t *testing.T) {
+		fit, err := RenderSVG(svg, 300, 300)
+		require.NoError(t, err)
+		defer fit.Close()
+		assert.Equal(t, 300, fit.Width())
+		assert.Equal(t, 150, fit.Height())
+
+		byHeight, err := RenderSVG(svg, 0, 100)
+		require.NoError(t, err)
+		defer byHeight.Close()
+		assert.Equal(t, 200, byHeight.Width())
+		assert.Equal(t, 100, byHeight.Height())
+
+		_, err = RenderSVG(svg, 0, 0)
+		require.Error(t, err)
+	})
+}
+
+// TestGifsaveBitdepth tests reducing the GIF palette with Bitdepth
+func TestGifsaveBitdepth(t *testing.T) {
+	img, err := createTestGradientImage(t, 128, 128)
+	require.NoError(t, err)
+	defer img.Close()
+
+	full, err := img.GifsaveBuffer(&GifsaveBufferOptions{Bitdepth: 8, Dither: 1, Effort: 7})
+	require.NoError(t, err)
+
+	reduced, err := img.GifsaveBuffer(&GifsaveBufferOptions{Bitdepth: 4, Dither: 1, Effort: 7})
+	require.NoError(t, err)
+	assert.Less(t, len(reduced), len(full), "a 4-bit palette should give a smaller file")
+
+	loaded, err := NewImageFromBuffer(reduced, nil)
+	require.NoError(t, err)
+	defer loaded.Close()
+	assert.Equal(t, ImageTypeGif, loaded.Format())
+	bitDepth, err := loaded.GetInt("palette-bit-depth")
+	if err == nil {
+		assert.LessOrEqual(t, bitDepth, 4)
 	}
 
-	for _, tc := range testCases {
-		t.Run(tc.name, func(t *testing.T) {
-			filePath := filepath.Join(testDir, tc.filename)
+	for _, bitdepth := range []int{-1, 9} {
+		_, err := img.GifsaveBuffer(&GifsaveBufferOptions{Bitdepth: bitdepth})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "bitdepth must be between 1 and 8")
+	}
+}
 
-			// Write the PNG data to the file with the special name
-			err := os.WriteFile(filePath, pngData, 0644)
-			require.NoError(t, err, "should be able to create file with special name")
-			defer os.Remove(filePath)
+// TestPngsavePalette tests quantising PNG output to a small palette
+func TestPngsavePalette(t *testing.T) {
+	img, err := createTestGradientImage(t, 64, 64)
+	require.NoError(t, err)
+	defer img.Close()
 
-			// Test loading without options
-			img, err := NewImageFromFile(filePath, nil)
-			require.NoError(t, err, "NewImageFromFile should load file with special chars: %s", tc.filename)
-			defer img.Close()
+	buf, err := img.PngsavePaletteBuffer(&PngsaveBufferOptions{Bitdepth: 4, Dither: 0.5})
+	if errors.Is(err, ErrOperationNotSupported) {
+		t.Skip("libvips built without quantisation support")
+	}
+	require.NoError(t, err)
 
-			assert.Equal(t, 10, img.Width(), "width should be 10")
-			assert.Equal(t, 10, img.Height(), "height should be 10")
+	truecolour, err := img.PngsaveBuffer(nil)
+	require.NoError(t, err)
+	assert.Less(t, len(buf), len(truecolour))
 
-			// Test loading with options (exercises the with_option C function path)
-			img2, err := NewImageFromFile(filePath, &LoadOptions{FailOnError: true})
-			require.NoError(t, err, "NewImageFromFile with options should load file with special chars: %s", tc.filename)
-			defer img2.Close()
+	loaded, err := NewImageFromBuffer(buf, nil)
+	require.NoError(t, err)
+	defer loaded.Close()
+	require.NoError(t, loaded.Colourspace(InterpretationSrgb, nil))
+	require.NoError(t, loaded.RemoveAlpha())
 
-			assert.Equal(t, 10, img2.Width(), "width should be 10 (with options)")
-			assert.Equal(t, 10, img2.Height(), "height should be 10 (with options)")
-		})
+	pixels, err := loaded.WriteToMemory()
+	require.NoError(t, err)
+	colours := make(map[[3]byte]bool)
+	for i := 0; i+2 < len(pixels); i += 3 {
+		colours[[3]byte{pixels[i], pixels[i+1], pixels[i+2]}] = true
+	}
+	assert.LessOrEqual(t, len(colours), 16, "a 4-bit palette has at most 16 colours")
+}
+
+func TestEncodeFaviconPNG(t *testing.T) {
+	img, err := NewImageFromBuffer(createTestPngBuffer(t, 120, 90), nil)
+	require.NoError(t, err)
+	defer img.Close()
+
+	icons, err := EncodeFaviconPNG(img, []int{16, 32, 48, 32, 256})
+	if errors.Is(err, ErrOperationNotSupported) {
+		t.Skip("libvips built without quantisation support")
+	}
+	require.NoError(t, err)
+	require.Len(t, icons, 4)
+
+	for _, size := range []int{16, 32, 48, 256} {
+		buf := icons[size]
+		require.NotEmpty(t, buf, "size %d", size)
+		assert.Equal(t, byte(3), buf[25], "size %d is a palette PNG", size)
+		icon, err := NewImageFromBuffer(buf, nil)
+		require.NoError(t, err)
+		assert.Equal(t, size, icon.Width(), "size %d", size)
+		assert.Equal(t, size, icon.Height(), "size %d", size)
+		icon.Close()
 	}
+	assert.Equal(t, 120, img.Width(), "source is left untouched")
+
+	_, err = EncodeFaviconPNG(img, nil)
+	assert.Error(t, err)
+	_, err = EncodeFaviconPNG(img, []int{16, 0})
+	assert.Error(t, err)
+}
+
+func TestImage_Negate(t *testing.T) {
+	t.Run("rgba keeps alpha", func(t *testing.T) {
+		src := image.NewNRGBA(image.Rect(0, 0, 4, 4))
+		for y := 0; y < 4; y++ {
+			for x := 0; x < 4; x++ {
+				src.Set(x, y, color.NRGBA{R: 200, G: 100, B: 20, A: uint8(60 * y)})
+			}
+		}
+		var buf bytes.Buffer
+		require.NoError(t, png.Encode(&buf, src))
+		img, err := NewImageFromBuffer(buf.Bytes(), nil)
+		require.NoError(t, err)
+		defer img.Close()
+		require.Equal(t, 4, img.Bands())
+
+		require.NoError(t, img.Negate())
+		assert.Equal(t, 4, img.Bands())
+		for y := 0; y < 4; y++ {
+			pixel, err := img.Getpoint(1, y, nil)
+			require.NoError(t, err)
+			assert.Equal(t, []float64{55, 155, 235, float64(60 * y)}, pixel, "row %d", y)
+		}
+	})
+
+	t.Run("lab converts back", func(t *testing.T) {
+		img, err := createWhiteImage(8, 8)
+		require.NoError(t, err)
+		defer img.Close()
+		require.NoError(t, img.ToLab())
+
+		require.NoError(t, img.Negate())
+		assert.Equal(t, InterpretationLab, img.Interpretation())
+		pixel, err := img.Getpoint(0, 0, nil)
+		require.NoError(t, err)
+		assert.InDelta(t, 0, pixel[0], 1, "white negates to black, L near 0")
+	})
+}
+
+func TestImage_FlipBoth(t *testing.T) {
+	buf := createTestPngBuffer(t, 60, 40)
+	img, err := NewImageFromBuffer(buf, nil)
+	require.NoError(t, err)
+	defer img.Close()
+	rotated, err := NewImageFromBuffer(buf, nil)
+	require.NoError(t, err)
+	defer rotated.Close()
+
+	require.NoError(t, img.FlipBoth())
+	require.NoError(t, rotated.Rot(AngleD180))
+	equal, err := img.Equals(rotated)
+	require.NoError(t, err)
+	assert.True(t, equal, "FlipBoth should match a 180 degree rotation")
+}
+
+func TestImage_Transpose(t *testing.T) {
+	buf := createTestPngBuffer(t, 60, 40)
+	original, err := NewImageFromBuffer(buf, nil)
+	require.NoError(t, err)
+	defer original.Close()
+	img, err := NewImageFromBuffer(buf, nil)
+	require.NoError(t, err)
+	defer img.Close()
+
+	require.NoError(t, img.Transpose())
+	assert.Equal(t, 40, img.Width())
+	assert.Equal(t, 60, img.Height())
+	for _, p := range [][2]int{
+		{0, 0}, {59, 0}, {0, 39}, {13, 27},
+	} {
+		want, err := original.Getpoint(p[0], p[1], nil)
+		require.NoError(t, err)
+		got, err := img.Getpoint(p[1], p[0], nil)
+		require.NoError(t, err)
+		assert.Equal(t, want, got, "pixel %v", p)
+	}
+}
+
+// solidImage creates a width x height sRGB image filled with one colour
+func solidImage(t *testing.T, width, height int, pixel ...byte) *Image {
+	data := bytes.Repeat(pixel, width*height)
+	img, err := NewImageFromMemory(data, width, height, len(pixel))
+	require.NoError(t, err)
+	return img
+}
+
+// lchAt returns the LCh values of the pixel at x, y, leaving img unchanged
+func lchAt(t *testing.T, img *Image, x, y int) []float64 {
+	lch, err := img.Copy(nil)
+	require.NoError(t, err)
+	defer lch.Close()
+	require.NoError(t, lch.Colourspace(InterpretationLch, nil))
+	pixel, err := lch.Getpoint(x, y, nil)
+	require.NoError(t, err)
+	return pixel
+}
+
+func TestImage_Modulate(t *testing.T) {
+	t.Run("saturation", func(t *testing.T) {
+		img := solidImage(t, 8, 8, 150, 120, 120)
+		defer img.Close()
+		before := lchAt(t, img, 0, 0)
+
+		require.NoError(t, img.Modulate(1, 2, 0))
+		assert.Equal(t, InterpretationSrgb, img.Interpretation())
+		after := lchAt(t, img, 0, 0)
+		assert.Greater(t, after[1], before[1]*1.5, "chroma should increase")
+		assert.InDelta(t, before[0], after[0], 2, "lightness should be kept")
+	})
+
+	t.Run("hue rotation", func(t *testing.T) {
+		for _, hue := range []float64{90, 450, -270} {
+			img := solidImage(t, 8, 8, 255, 0, 0)
+			require.NoError(t, img.Modulate(1, 1, hue))
+			pixel, err := img.Getpoint(0, 0, nil)
+			require.NoError(t, err)
+			assert.Greater(t, pixel[1], pixel[0], "hue %g shifts red toward green", hue)
+			assert.Greater(t, pixel[1], pixel[2], "hue %g shifts red toward green", hue)
+			img.Close()
+		}
+	})
+}
+
+func TestImage_Tint(t *testing.T) {
+	img := solidImage(t, 8, 8, 128, 128, 128, 100)
+	defer img.Close()
+	before := lchAt(t, img, 0, 0)
+
+	require.NoError(t, img.Tint([]float64{255, 0, 0}))
+	assert.Equal(t, 4, img.Bands())
+	pixel, err := img.Getpoint(0, 0, nil)
+	require.NoError(t, err)
+	assert.Greater(t, pixel[0], pixel[1], "red tint")
+	assert.Greater(t, pixel[0], pixel[2], "red tint")
+	assert.Equal(t, float64(100), pixel[3], "alpha is unchanged")
+	assert.InDelta(t, before[0], lchAt(t, img, 0, 0)[0], 3, "lightness should be kept")
+
+	assert.Error(t, img.Tint([]float64{255, 0}))
+	assert.Error(t, img.Tint([]float64{300, 0, 0}))
+}
+
+// buildCubeLUT renders a .cube LUT of the given size, mapping each lattice colour through fn
+func buildCubeLUT(size int, fn func(r, g, b float64) (float64, float64, float64)) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "TITLE \"test\"\n# generated\nLUT_3D_SIZE %d\n\n", size)
+	for b := 0; b < size; b++ {
+		for g := 0; g < size; g++ {
+			for r := 0; r < size; r++ {
+				step := float64(size - 1)
+				or, og, ob := fn(float64(r)/step, float64(g)/step, float64(b)/step)
+				fmt.Fprintf(&sb, "%.6f %.6f %.6f\n", or, og, ob)
+			}
+		}
+	}
+	return sb.String()
+}
+
+func TestLoadCubeLUT(t *testing.T) {
+	lut, err := LoadCubeLUT(strings.NewReader(buildCubeLUT(3, func(r, g, b float64) (float64, float64, float64) {
+		return r, g, b
+	})))
+	require.NoError(t, err)
+	assert.Equal(t, "test", lut.Title)
+	assert.Equal(t, 3, lut.Size)
+	assert.Len(t, lut.Table, 27)
+	assert.Equal(t, [3]float64{0.5, 0, 0}, lut.Table[1], "red changes fastest")
+	assert.Equal(t, [3]float64{0, 0, 1}, lut.Table[26-8])
+
+	for name, cube := range map[string]string{
+		"1d":             "LUT_1D_SIZE 2\n0 0 0\n1 1 1\n",
+		"no size":        "0 0 0\n",
+		"short table":    "LUT_3D_SIZE 2\n0 0 0\n1 1 1\n",
+		"bad value":      "LUT_3D_SIZE 2\n0 0 x\n",
+		"bad domain":     "DOMAIN_MIN 1 1 1\nDOMAIN_MAX 0 0 0\n" + buildCubeLUT(2, func(r, g, b float64) (float64, float64, float64) { return r, g, b }),
+		"size too small": "LUT_3D_SIZE 1\n0 0 0\n",
+	} {
+		_, err := LoadCubeLUT(strings.NewReader(cube))
+		assert.Error(t, err, name)
+	}
+}
+
+func TestImage_ApplyLUT3D(t *testing.T) {
+	t.Run("identity", func(t *testing.T) {
+		lut, err := LoadCubeLUT(strings.NewReader(buildCubeLUT(17, func(r, g, b float64) (float64, float64, float64) {
+			return r, g, b
+		})))
+		require.NoError(t, err)
+		buf := createTestPngBuffer(t, 64, 48)
+		original, err := NewImageFromBuffer(buf, nil)
+		require.NoError(t, err)
+		defer original.Close()
+		img, err := NewImageFromBuffer(buf, nil)
+		require.NoError(t, err)
+		defer img.Close()
+		img.SetString("custom-note", "kept")
+
+		require.NoError(t, img.ApplyLUT3D(lut))
+		assert.Equal(t, 64, img.Width())
+		assert.Equal(t, InterpretationSrgb, img.Interpretation())
+		diff, err := img.MaxDifference(original)
+		require.NoError(t, err)
+		assert.LessOrEqual(t, diff, 1.0, "identity LUT should be a near no-op")
+		note, err := img.GetString("custom-note")
+		require.NoError(t, err)
+		assert.Equal(t, "kept", note)
+	})
+
+	t.Run("swap and invert with alpha", func(t *testing.T) {
+		lut, err := LoadCubeLUT(strings.NewReader(buildCubeLUT(2, func(r, g, b float64) (float64, float64, float64) {
+			return b, 1 - g, r
+		})))
+		require.NoError(t, err)
+		img := solidImage(t, 4, 4, 200, 100, 20, 77)
+		defer img.Close()
+
+		require.NoError(t, img.ApplyLUT3D(lut))
+		pixel, err := img.Getpoint(0, 0, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []float64{20, 155, 200, 77}, pixel)
+	})
+
+	img := solidImage(t, 4, 4, 0, 0, 0)
+	defer img.Close()
+	assert.Error(t, img.ApplyLUT3D(nil))
+}
+
+func TestImage_Composite2BlendModes(t *testing.T) {
+	supported := SupportedBlendModes()
+	require.Contains(t, supported, BlendModeOver)
+
+	for mode := BlendModeClear; mode <= BlendModeExclusion+1; mode++ {
+		base := solidImage(t, 8, 8, 200, 100, 50, 255)
+		overlay := solidImage(t, 8, 8, 20, 40, 250, 128)
+		err := base.Composite2(overlay, mode, nil)
+		if err != nil {
+			assert.ErrorIs(t, err, ErrOperationNotSupported, "mode %s", mode)
+			assert.Contains(t, err.Error(), "blend mode "+mode.String())
+			assert.NotContains(t, supported, mode)
+		} else {
+			assert.Contains(t, supported, mode)
+			assert.Equal(t, 4, base.Bands(), "mode %s", mode)
+		}
+		base.Close()
+		overlay.Close()
+	}
+
+	base := solidImage(t, 8, 8, 200, 100, 50)
+	defer base.Close()
+	_, err := NewComposite([]*Image{base, base}, []BlendMode{BlendMode(99)}, nil)
+	assert.ErrorIs(t, err, ErrOperationNotSupported)
+}
+
+func TestNewGaussmat_ConvMatchesGaussblur(t *testing.T) {
+	src := image.NewGray(image.Rect(0, 0, 48, 48))
+	for y := 0; y < 48; y++ {
+		for x := 0; x < 48; x++ {
+			if (x/6+y/6)%2 == 0 {
+				src.SetGray(x, y, color.Gray{Y: 255})
+			}
+		}
+	}
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, src))
+
+	blurred, err := NewImageFromBuffer(buf.Bytes(), nil)
+	require.NoError(t, err)
+	defer blurred.Close()
+	require.NoError(t, blurred.Gaussblur(2, nil))
+
+	t.Run("full kernel with Conv", func(t *testing.T) {
+		kernel, err := NewGaussmat(2, 0.2, &GaussmatOptions{Precision: PrecisionFloat})
+		require.NoError(t, err)
+		defer kernel.Close()
+		assert.Equal(t, kernel.Width(), kernel.Height())
+		assert.Equal(t, 1, kernel.Bands())
+
+		img, err := NewImageFromBuffer(buf.Bytes(), nil)
+		require.NoError(t, err)
+		defer img.Close()
+		require.NoError(t, img.Conv(kernel, nil))
+		require.NoError(t, img.Cast(BandFormatUchar, nil))
+		diff, err := img.MaxDifference(blurred)
+		require.NoError(t, err)
+		assert.LessOrEqual(t, diff, 2.0)
+	})
+
+	t.Run("separable kernel with Convsep", func(t *testing.T) {
+		kernel, err := NewGaussmat(2, 0.2, &GaussmatOptions{Separable: true})
+		require.NoError(t, err)
+		defer kernel.Close()
+		assert.Equal(t, 1, kernel.Height(), "a separable kernel is a single row")
+
+		img, err := NewImageFromBuffer(buf.Bytes(), nil)
+		require.NoError(t, err)
+		defer img.Close()
+		require.NoError(t, img.Convsep(kernel, &ConvsepOptions{Precision: PrecisionInteger}))
+		diff, err := img.MaxDifference(blurred)
+		require.NoError(t, err)
+		assert.LessOrEqual(t, diff, 1.0)
+	})
+
+	t.Run("logmat", func(t *testing.T) {
+		kernel, err := NewLogmat(2, 0.2, &LogmatOptions{Precision: PrecisionFloat})
+		require.NoError(t, err)
+		defer kernel.Close()
+		centre, err := kernel.Getpoint(kernel.Width()/2, kernel.Height()/2, nil)
+		require.NoError(t, err)
+		corner, err := kernel.Getpoint(0, 0, nil)
+		require.NoError(t, err)
+		assert.Greater(t, math.Abs(centre[0]), math.Abs(corner[0]), "the kernel peaks at its centre")
+	})
+}
+
+func TestImage_FwfftInvfft(t *testing.T) {
+	if !HasOperation("fwfft") {
+		t.Skip("libvips built without FFTW support")
+	}
+	buf := createTestPngBuffer(t, 64, 48)
+	original, err := NewImageFromBuffer(buf, nil)
+	require.NoError(t, err)
+	defer original.Close()
+
+	img, err := NewImageFromBuffer(buf, nil)
+	require.NoError(t, err)
+	defer img.Close()
+	require.NoError(t, img.Fwfft())
+	assert.Equal(t, BandFormatDpcomplex, img.BandFormat())
+	assert.Equal(t, 64, img.Width())
+	assert.Equal(t, 48, img.Height())
+
+	require.NoError(t, img.Invfft(&InvfftOptions{Real: true}))
+	assert.Equal(t, BandFormatDouble, img.BandFormat())
+	require.NoError(t, img.Cast(BandFormatUchar, nil))
+	diff, err := img.MaxDifference(original)
+	require.NoError(t, err)
+	assert.LessOrEqual(t, diff, 1.0, "inverse transform should reconstruct the image")
+
+	spectrum, err := NewImageFromBuffer(buf, nil)
+	require.NoError(t, err)
+	defer spectrum.Close()
+	require.NoError(t, spectrum.Spectrum())
+	assert.Equal(t, BandFormatUchar, spectrum.BandFormat())
+	assert.Equal(t, 64, spectrum.Width())
+}
+
+func TestImage_FreqMask(t *testing.T) {
+	if !HasOperation("fwfft") {
+		t.Skip("libvips built without FFTW support")
+	}
+	rng := rand.New(rand.NewSource(1))
+	src := image.NewGray(image.Rect(0, 0, 64, 64))
+	for i := range src.Pix {
+		src.Pix[i] = uint8(128 + rng.Intn(81) - 40)
+	}
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, src))
+
+	noisy, err := NewImageFromBuffer(buf.Bytes(), nil)
+	require.NoError(t, err)
+	defer noisy.Close()
+	before, err := noisy.Sharpness()
+	require.NoError(t, err)
+
+	filters := map[string]func(img *Image) error{
+		"ideal": func(img *Image) error {
+			return img.FreqMaskIdeal(false, 0.3)
+		},
+		"butterworth": func(img *Image) error {
+			return img.FreqMaskButterworth(false, 2, 0.3, 0.5)
+		},
+		"gaussian": func(img *Image) error {
+			return img.FreqMaskGaussian(false, 0.3, 0.5)
+		},
+	}
+	for name, filter := range filters {
+		t.Run(name, func(t *testing.T) {
+			img, err := NewImageFromBuffer(buf.Bytes(), nil)
+			require.NoError(t, err)
+			defer img.Close()
+
+			require.NoError(t, filter(img))
+			assert.Equal(t, BandFormatUchar, img.BandFormat())
+			assert.Equal(t, 64, img.Width())
+			after, err := img.Sharpness()
+			require.NoError(t, err)
+			assert.Less(t, after, before/2, "low-pass should remove high frequency noise")
+		})
+	}
+
+	highpass, err := NewImageFromBuffer(buf.Bytes(), nil)
+	require.NoError(t, err)
+	defer highpass.Close()
+	require.NoError(t, highpass.FreqMaskIdeal(true, 0.3))
+	assert.Equal(t, BandFormatUchar, highpass.BandFormat())
+}
+
+func TestNewMaskIdeal(t *testing.T) {
+	mask, err := NewMaskIdeal(64, 64, 0.5, &MaskIdealOptions{Optical: true})
+	require.NoError(t, err)
+	defer mask.Close()
+	assert.Equal(t, 64, mask.Width())
+	assert.Equal(t, 1, mask.Bands())
+
+	centre, err := mask.Getpoint(32, 32, nil)
+	require.NoError(t, err)
+	assert.InDelta(t, 1.0, centre[0], 1e-6, "low-pass passes the centre frequencies")
+	corner, err := mask.Getpoint(0, 0, nil)
+	require.NoError(t, err)
+	assert.InDelta(t, 0.0, corner[0], 1e-6, "low-pass rejects the highest frequencies")
+
+	for _, cutoff := range []float64{-0.1, 1.5} {
+		_, err := NewMaskIdeal(64, 64, cutoff, nil)
+		assert.Error(t, err, "cutoff %g", cutoff)
+		_, err = NewMaskGaussianBand(64, 64, 0.5, cutoff, 0.1, 0.5, nil)
+		assert.Error(t, err, "cutoff %g", cutoff)
+	}
+}
+
+func TestBuildLUT(t *testing.T) {
+	// Unsorted points are sorted by x first
+	lut, err := BuildLUT([][]float64{
+		{128, 200},
+		{0, 0},
+		{255, 255},
+	})
+	require.NoError(t, err)
+	defer lut.Close()
+	assert.Equal(t, 1, lut.Height())
+	assert.GreaterOrEqual(t, lut.Width(), 255)
+
+	for x, want := range map[int]float64{
+		0:   0,
+		64:  100,
+		128: 200,
+		200: 200 + 55*72.0/127,
+	} {
+		v, err := lut.Getpoint(x, 0, nil)
+		require.NoError(t, err)
+		assert.InDelta(t, want, v[0], 0.01, "lut at %d", x)
+	}
+
+	img, err := NewImageFromMemory([]byte{0, 64, 128}, 3, 1, 1)
+	require.NoError(t, err)
+	defer img.Close()
+	require.NoError(t, img.Maplut(lut, nil))
+	v, err := img.Getpoint(1, 0, nil)
+	require.NoError(t, err)
+	assert.InDelta(t, 100, v[0], 0.01)
+
+	_, err = BuildLUT([][]float64{
+		{0, 0},
+	})
+	assert.Error(t, err, "single point")
+	_, err = BuildLUT([][]float64{
+		{0, 0},
+		{255},
+	})
+	assert.Error(t, err, "ragged points")
+	_, err = BuildLUT([][]float64{
+		{0, 0},
+		{0, 10},
+		{255, 255},
+	})
+	assert.Error(t, err, "duplicate x")
+}
+
+func TestImage_Scale(t *testing.T) {
+	values := []float64{0.1, 0.2, 0.3, 0.4, 0.5, 0.6, 0.7, 0.8, 0.9}
+
+	t.Run("linear stretch", func(t *testing.T) {
+		img, err := NewMatrixFromArray(len(values), 1, values)
+		require.NoError(t, err)
+		defer img.Close()
+
+		require.NoError(t, img.Scale(nil))
+		assert.Equal(t, BandFormatUchar, img.BandFormat())
+		low, err := img.Min(nil)
+		require.NoError(t, err)
+		high, err := img.Max(nil)
+		require.NoError(t, err)
+		assert.InDelta(t, 0, low, 1)
+		assert.InDelta(t, 255, high, 1)
+		mid, err := img.Getpoint(4, 0, nil)
+		require.NoError(t, err)
+		assert.InDelta(t, 128, mid[0], 1)
+	})
+
+	t.Run("log stretch", func(t *testing.T) {
+		img, err := NewMatrixFromArray(len(values), 1, values)
+		require.NoError(t, err)
+		defer img.Close()
+
+		require.NoError(t, img.Scale(&ScaleOptions{Log: true, Exp: 0.25}))
+		assert.Equal(t, BandFormatUchar, img.BandFormat())
+		high, err := img.Max(nil)
+		require.NoError(t, err)
+		assert.InDelta(t, 255, high, 1)
+		prev := -1.0
+		for x := range values {
+			v, err := img.Getpoint(x, 0, nil)
+			require.NoError(t, err)
+			assert.GreaterOrEqual(t, v[0], prev, "log stretch keeps the order at %d", x)
+			prev = v[0]
+		}
+	})
+
+	t.Run("constant image", func(t *testing.T) {
+		img, err := NewMatrixFromArray(3, 1, []float64{0.5, 0.5, 0.5})
+		require.NoError(t, err)
+		defer img.Close()
+
+		require.NoError(t, img.Scale(nil))
+		assert.Equal(t, BandFormatUchar, img.BandFormat())
+		buf, err := img.WriteToMemory()
+		require.NoError(t, err)
+		assert.Equal(t, []byte{0, 0, 0}, buf)
+	})
+}
+
+func TestImage_MatchHistogram(t *testing.T) {
+	pngData := createTestPngBuffer(t, 100, 100)
+	load := func(t *testing.T, a, b float64) *Image {
+		img, err := NewImageFromBuffer(pngData, nil)
+		require.NoError(t, err)
+		require.NoError(t, img.Linear([]float64{a}, []float64{b}, &LinearOptions{Uchar: true}))
+		return img
+	}
+	reference := load(t, 0.4, 150)
+	defer reference.Close()
+	refMean, err := reference.Avg()
+	require.NoError(t, err)
+
+	for name, options := range map[string]*MatchHistogramOptions{
+		"per band":  nil,
+		"luminance": {Luminance: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			img := load(t, 0.3, 0)
+			defer img.Close()
+			before, err := img.Avg()
+			require.NoError(t, err)
+
+			require.NoError(t, img.MatchHistogram(reference, options))
+			assert.Equal(t, 3, img.Bands())
+			assert.Equal(t, InterpretationSrgb, img.Interpretation())
+			after, err := img.Avg()
+			require.NoError(t, err)
+			assert.Greater(t, after, before)
+			assert.Less(t, math.Abs(after-refMean), math.Abs(before-refMean)/2,
+				"mean %.1f should move from %.1f towards the reference %.1f", after, before, refMean)
+		})
+	}
+
+	t.Run("keeps alpha", func(t *testing.T) {
+		img := load(t, 0.3, 0)
+		defer img.Close()
+		require.NoError(t, img.BandjoinConst([]float64{128}))
+
+		require.NoError(t, img.MatchHistogram(reference, nil))
+		assert.Equal(t, 4, img.Bands())
+		pixel, err := img.Getpoint(10, 10, nil)
+		require.NoError(t, err)
+		assert.InDelta(t, 128, pixel[3], 0.5)
+	})
+
+	t.Run("requires a reference", func(t *testing.T) {
+		img := load(t, 0.3, 0)
+		defer img.Close()
+		assert.Error(t, img.MatchHistogram(nil, nil))
+	})
+}
+
+func TestNewSines(t *testing.T) {
+	img, err := NewSines(256, 256, nil)
+	require.NoError(t, err)
+	defer img.Close()
+	assert.Equal(t, 256, img.Width())
+	assert.Equal(t, 256, img.Height())
+	assert.Equal(t, 1, img.Bands())
+
+	// Four horizontal cycles cross zero about eight times along a row
+	wave, err := NewSines(256, 256, &SinesOptions{Hfreq: 4})
+	require.NoError(t, err)
+	defer wave.Close()
+	var crossings int
+	prev, err := wave.Getpoint(0, 10, nil)
+	require.NoError(t, err)
+	for x := 4; x < 256; x += 4 {
+		pixel, err := wave.Getpoint(x, 10, nil)
+		require.NoError(t, err)
+		if (pixel[0] < 0) != (prev[0] < 0) {
+			crossings++
+		}
+		prev = pixel
+	}
+	assert.GreaterOrEqual(t, crossings, 6, "the pattern should oscillate")
+	minValue, err := wave.Min(nil)
+	require.NoError(t, err)
+	maxValue, err := wave.Max(nil)
+	require.NoError(t, err)
+	assert.InDelta(t, -1, minValue, 0.05)
+	assert.InDelta(t, 1, maxValue, 0.05)
+}
+
+func TestNewIdentity(t *testing.T) {
+	lut, err := NewIdentity(&IdentityOptions{Bands: 3})
+	require.NoError(t, err)
+	defer lut.Close()
+	assert.Equal(t, 256, lut.Width())
+	assert.Equal(t, 1, lut.Height())
+	assert.Equal(t, 3, lut.Bands())
+	pixel, err := lut.Getpoint(200, 0, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []float64{200, 200, 200}, pixel)
+
+	img, err := NewImageFromBuffer(createTestPngBuffer(t, 32, 32), nil)
+	require.NoError(t, err)
+	defer img.Close()
+	original, err := img.Copy(nil)
+	require.NoError(t, err)
+	defer original.Close()
+	require.NoError(t, img.Maplut(lut, nil))
+	equal, err := img.Equals(original)
+	require.NoError(t, err)
+	assert.True(t, equal, "mapping through an identity LUT is a no-op")
+
+	grey, err := NewGrey(64, 8, nil)
+	require.NoError(t, err)
+	defer grey.Close()
+	pixel, err = grey.Getpoint(63, 0, nil)
+	require.NoError(t, err)
+	assert.InDelta(t, 1, pixel[0], 0.02, "grey ramps from 0 to 1 across the width")
+}
+
+// TestRawsaveBuffer tests that raw output is headerless interleaved pixel data
+func TestRawsaveBuffer(t *testing.T) {
+	img, err := createTestGradientImage(t, 4, 4)
+	require.NoError(t, err)
+	defer img.Close()
+
+	bands := img.Bands()
+	raw, err := img.RawsaveBuffer(nil)
+	require.NoError(t, err)
+	assert.Equal(t, 1, img.SampleSize())
+	assert.Len(t, raw, img.Width()*img.Height()*img.Bands()*img.SampleSize())
+
+	pixels, err := img.WriteToMemory()
+	require.NoError(t, err)
+	assert.Equal(t, pixels, raw)
+
+	require.NoError(t, img.Cast(BandFormatUshort, nil))
+	raw16, err := img.RawsaveBuffer(nil)
+	require.NoError(t, err)
+	assert.Equal(t, 2, img.SampleSize())
+	assert.Len(t, raw16, 4*4*bands*2)
+
+	// Reading the raw bytes back needs the layout kept alongside them
+	restored, err := NewImageFromMemory(raw, 4, 4, bands)
+	require.NoError(t, err)
+	defer restored.Close()
+	assert.Equal(t, 4, restored.Width())
+	assert.Equal(t, BandFormatUchar, restored.BandFormat())
+}
+
+// TestDrawFloodOptionalOutputs tests draw_flood's affected area outputs
+func TestDrawFloodOptionalOutputs(t *testing.T) {
+	// Create a white image with a small colored region
+	width, height := 200, 150
+	img, err := createWhiteImage(width, height)
+	require.NoError(t, err)
+	defer img.Close()
+
+	// Draw a small red rectangle that we'll flood fill
+	redColor := []float64{255, 0, 0}
+	err = img.DrawRect(redColor, 50, 40, 30, 20, &DrawRectOptions{Fill: true})
+	require.NoError(t, err)
+
+	// Test flood fill with optional outputs
+	options := DefaultDrawFloodOptions()
+	require.NotNil(t, options)
+
+	// Flood fill from a point inside the red rectangle
+	floodColor := []float64{0, 255, 0} // Green
+	startX, startY := 60, 50
+
+	err = img.DrawFlood(floodColor, startX, startY, options)
+	require.NoError(t, err)
+
+	t.Logf("Flood fill affected area: left=%d, top=%d, width=%d, height=%d",
+		options.Left, options.Top, options.Width, options.Height)
+
+	// Verify the affected area bounds are reasonable
+	assert.GreaterOrEqual(t, options.Left, 0, "Left should be >= 0")
+	assert.GreaterOrEqual(t, options.Top, 0, "Top should be >= 0")
+	assert.Greater(t, options.Width, 0, "Width should be > 0")
+	assert.Greater(t, options.Height, 0, "Height should be > 0")
+
+	// The affected area should encompass our red rectangle
+	assert.LessOrEqual(t, options.Left, 50, "Left should be <= 50")
+	assert.LessOrEqual(t, options.Top, 40, "Top should be <= 40")
+	assert.GreaterOrEqual(t, options.Left+options.Width, 80, "Right edge should be >= 80")
+	assert.GreaterOrEqual(t, options.Top+options.Height, 60, "Bottom edge should be >= 60")
+
+	// Calculate total affected pixels
+	totalPixels := options.Width * options.Height
+	t.Logf("Total affected pixels: %d", totalPixels)
+	assert.Greater(t, totalPixels, 0, "Should have affected some pixels")
+}
+
+// TestOptionalOutputsWithNilOptions tests that optional outputs work with nil options
+func TestOptionalOutputsWithNilOptions(t *testing.T) {
+	// Create test images with textured patterns for better mosaic matching
+	img1, err := createCheckboardImage(t, 200, 150, 20)
+	require.NoError(t, err)
+	defer img1.Close()
+
+	img2, err := createCheckboardImage(t, 150, 150, 20)
+	require.NoError(t, err)
+	defer img2.Close()
+
+	// Test that operations work with nil options (should use defaults)
+	// Use realistic tie points for mosaic operation
+	err = img1.Mosaic(img2, DirectionHorizontal, 150, 75, 75, 75, nil)
+	require.NoError(t, err, "Mosaic should work with nil options")
+
+	// Test smartcrop with nil options
+	err = img1.Smartcrop(50, 50, nil)
+	require.NoError(t, err, "Smartcrop should work with nil options")
+
+	// Test min/max with nil options
+	_, err = img1.Min(nil)
+	require.NoError(t, err, "Min should work with nil options")
+
+	_, err = img1.Max(nil)
+	require.NoError(t, err, "Max should work with nil options")
+
+	// Test draw flood with nil options
+	err = img1.DrawFlood([]float64{0, 255, 0}, 25, 25, nil)
+	require.NoError(t, err, "DrawFlood should work with nil options")
+}
+
+func TestImage_Loop(t *testing.T) {
+	buf := createTestPngBuffer(t, 10, 10)
+	img, err := NewImageFromBuffer(buf, nil)
+	require.NoError(t, err)
+	defer img.Close()
+
+	// Default: no loop metadata set, should return 0
+	assert.Equal(t, 0, img.Loop())
+
+	// Set and retrieve a non-zero loop count
+	img.SetLoop(3)
+	assert.Equal(t, 3, img.Loop())
+
+	// Set to 0 (infinite looping)
+	img.SetLoop(0)
+	assert.Equal(t, 0, img.Loop())
+}
+
+func TestEncodeGIF(t *testing.T) {
+	var frames []*Image
+	for i := 0; i < 3; i++ {
+		data := make([]byte, 20*10*3)
+		for j := range data {
+			data[j] = byte(i * 100)
+		}
+		frame, err := NewImageFromMemory(data, 20, 10, 3)
+		require.NoError(t, err)
+		defer frame.Close()
+		frames = append(frames, frame)
+	}
+
+	buf, err := EncodeGIF(frames, []int{100, 200, 300}, 3, nil)
+	require.NoError(t, err)
+
+	img, err := NewImageFromBuffer(buf, &LoadOptions{N: -1})
+	require.NoError(t, err)
+	defer img.Close()
+	assert.Equal(t, ImageTypeGif, img.Format())
+	assert.Equal(t, 3, img.Pages())
+	assert.Equal(t, 10, img.PageHeight())
+	assert.Equal(t, 20, img.Width())
+	assert.Equal(t, 3, img.Loop())
+	delays, err := img.PageDelay()
+	require.NoError(t, err)
+	assert.Equal(t, []int{100, 200, 300}, delays)
+	for i := 0; i < 3; i++ {
+		pixel, err := img.Getpoint(5, i*10+5, nil)
+		require.NoError(t, err)
+		assert.InDelta(t, float64(i*100), pixel[0], 2, "frame %d", i)
+	}
+
+	// Frames must share dimensions
+	small, err := NewBlack(10, 10, &BlackOptions{Bands: 3})
+	require.NoError(t, err)
+	defer small.Close()
+	_, err = EncodeGIF([]*Image{frames[0], small}, nil, 0, nil)
+	assert.Error(t, err)
+	_, err = EncodeGIF(frames, []int{100}, 0, nil)
+	assert.Error(t, err)
+	_, err = EncodeGIF(nil, nil, 0, nil)
+	assert.Error(t, err)
+}
+
+func TestImage_RemoveExif_RetainsLoop(t *testing.T) {
+	buf := createTestPngBuffer(t, 10, 10)
+	img, err := NewImageFromBuffer(buf, nil)
+	require.NoError(t, err)
+	defer img.Close()
+
+	img.SetLoop(5)
+	require.Equal(t, 5, img.Loop())
+
+	err = img.RemoveExif()
+	require.NoError(t, err)
+
+	assert.Equal(t, 5, img.Loop(), "loop metadata should be preserved after RemoveExif")
+}
+
+func TestWriteToMemory_RoundTrip(t *testing.T) {
+	// Create a known 2x2 RGBA image via NewImageFromMemory
+	// Pixels: red, green, blue, white (each 4 bytes RGBA)
+	input := []byte{
+		255, 0, 0, 255, // red
+		0, 255, 0, 255, // green
+		0, 0, 255, 255, // blue
+		255, 255, 255, 255, // white
+	}
+	img, err := NewImageFromMemory(input, 2, 2, 4)
+	require.NoError(t, err)
+	defer img.Close()
+
+	// Round-trip back to raw bytes
+	got, err := img.WriteToMemory()
+	require.NoError(t, err)
+
+	assert.Equal(t, len(input), len(got), "buffer length should match width*height*bands")
+	assert.Equal(t, input, got, "pixel data should survive the round-trip")
+}
+
+func TestWriteToMemory_SizeMatchesDimensions(t *testing.T) {
+	width, height, bands := 10, 8, 3
+	data := make([]byte, width*height*bands)
+	for i := range data {
+		data[i] = byte(i % 256)
+	}
+
+	img, err := NewImageFromMemory(data, width, height, bands)
+	require.NoError(t, err)
+	defer img.Close()
+
+	buf, err := img.WriteToMemory()
+	require.NoError(t, err)
+
+	assert.Equal(t, width*height*bands, len(buf))
+	assert.Equal(t, width, img.Width())
+	assert.Equal(t, height, img.Height())
+	assert.Equal(t, bands, img.Bands())
+}
+
+// TestImageFromFileWithSpecialCharsInName verifies that NewImageFromFile correctly
+// handles filenames containing characters that are special to the libvips filename
+// string parser (commas, brackets, backslashes, apostrophes).
+func TestImageFromFileWithSpecialCharsInName(t *testing.T) {
+	testDir := ensureTestDir(t)
+
+	// Create a small PNG to use as test data
+	pngData := createTestPngBuffer(t, 10, 10)
+
+	testCases := []struct {
+		name     string
+		filename string
+	}{
+		{
+			name:     "comma and apostrophe at start",
+			filename: ",'special.png",
+		},
+		{
+			name:     "brackets in middle",
+			filename: "image[1].png",
+		},
+		{
+			name:     "comma in middle",
+			filename: "image,comma.png",
+		},
+		{
+			name:     "backslash in name",
+			filename: "image\\backslash.png",
+		},
+		{
+			name:     "brackets at start",
+			filename: "[bracket]image.png",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			filePath := filepath.Join(testDir, tc.filename)
+
+			// Write the PNG data to the file with the special name
+			err := os.WriteFile(filePath, pngData, 0644)
+			require.NoError(t, err, "should be able to create file with special name")
+			defer os.Remove(filePath)
+
+			// Test loading without options
+			img, err := NewImageFromFile(filePath, nil)
+			require.NoError(t, err, "NewImageFromFile should load file with special chars: %s", tc.filename)
+			defer img.Close()
+
+			assert.Equal(t, 10, img.Width(), "width should be 10")
+			assert.Equal(t, 10, img.Height(), "height should be 10")
+
+			// Test loading with options (exercises the with_option C function path)
+			img2, err := NewImageFromFile(filePath, &LoadOptions{FailOnError: true})
+			require.NoError(t, err, "NewImageFromFile with options should load file with special chars: %s", tc.filename)
+			defer img2.Close()
+
+			assert.Equal(t, 10, img2.Width(), "width should be 10 (with options)")
+			assert.Equal(t, 10, img2.Height(), "height should be 10 (with options)")
+		})
+	}
+}
+
+func TestImage_HoughLine(t *testing.T) {
+	img, err := NewBlack(100, 100, nil)
+	require.NoError(t, err)
+	defer img.Close()
+
+	// A single horizontal line at y=50
+	require.NoError(t, img.DrawLine([]float64{255}, 0, 50, 99, 50))
+
+	require.NoError(t, img.HoughLine(&HoughLineOptions{Width: 180, Height: 200}))
+	assert.Equal(t, 180, img.Width())
+	assert.Equal(t, 200, img.Height())
+
+	options := DefaultMaxOptions()
+	peak, err := img.Max(options)
+	require.NoError(t, err)
+	// Every pixel of the line votes for the same angle and distance
+	assert.GreaterOrEqual(t, peak, 90.0)
+	// The normal of a horizontal line is at 90 degrees
+	assert.InDelta(t, 90, options.X, 2)
+
+	rgb, err := NewBlack(100, 100, &BlackOptions{Bands: 3})
+	require.NoError(t, err)
+	defer rgb.Close()
+	assert.Error(t, rgb.HoughLine(nil), "hough_line should reject multi-band images")
+}
+
+func TestImage_ProjectProfile(t *testing.T) {
+	img, err := NewBlack(20, 10, nil)
+	require.NoError(t, err)
+	defer img.Close()
+
+	// A vertical stripe at x=12..13 from y=3 down
+	require.NoError(t, img.DrawRect([]float64{255}, 12, 3, 2, 7, &DrawRectOptions{Fill: true}))
+
+	columns, rows, err := img.Project()
+	require.NoError(t, err)
+	defer columns.Close()
+	defer rows.Close()
+	assert.Equal(t, 20, columns.Width())
+	assert.Equal(t, 1, columns.Height())
+	assert.Equal(t, 1, rows.Width())
+	assert.Equal(t, 10, rows.Height())
+
+	options := DefaultMaxOptions()
+	peak, err := columns.Max(options)
+	require.NoError(t, err)
+	assert.Equal(t, 255.0*7, peak)
+	assert.Contains(t, []int{12, 13}, options.X)
+	for _, x := range []int{0, 11, 14, 19} {
+		pixel, err := columns.Getpoint(x, 0, nil)
+		require.NoError(t, err)
+		assert.Equal(t, 0.0, pixel[0], "column %d should be empty", x)
+	}
+	pixel, err := rows.Getpoint(0, 5, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 255.0*2, pixel[0])
+
+	columns, rows, err = img.Profile()
+	require.NoError(t, err)
+	defer columns.Close()
+	defer rows.Close()
+	pixel, err = columns.Getpoint(12, 0, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 3.0, pixel[0], "stripe should start at row 3")
+	pixel, err = rows.Getpoint(0, 5, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 12.0, pixel[0], "stripe should start at column 12")
+}
+
+func TestImage_Similarity(t *testing.T) {
+	// Left half red, right half blue
+	newImage := func() *Image {
+		data := make([]byte, 0, 40*20*3)
+		for y := 0; y < 20; y++ {
+			for x := 0; x < 40; x++ {
+				if x < 20 {
+					data = append(data, 255, 0, 0)
+				} else {
+					data = append(data, 0, 0, 255)
+				}
+			}
+		}
+		img, err := NewImageFromMemory(data, 40, 20, 3)
+		require.NoError(t, err)
+		return img
+	}
+
+	img := newImage()
+	defer img.Close()
+	require.NoError(t, img.Similarity(&SimilarityOptions{Scale: 0.5, Angle: 90}))
+
+	expected := newImage()
+	defer expected.Close()
+	require.NoError(t, expected.Resize(0.5, nil))
+	require.NoError(t, expected.Rot(AngleD90))
+
+	assert.InDelta(t, expected.Width(), img.Width(), 1)
+	assert.InDelta(t, expected.Height(), img.Height(), 1)
+	// Rotating clockwise moves the red left half to the top
+	for _, y := range []int{4, 15} {
+		want, err := expected.Getpoint(5, y, nil)
+		require.NoError(t, err)
+		got, err := img.Getpoint(5, y, nil)
+		require.NoError(t, err)
+		assert.InDeltaSlice(t, want, got, 10, "pixel at (5,%d)", y)
+	}
+
+	t.Run("background", func(t *testing.T) {
+		img := newImage()
+		defer img.Close()
+		require.NoError(t, img.Similarity(&SimilarityOptions{Angle: 45, Background: []float64{0, 255, 0}}))
+		assert.Greater(t, img.Width(), 40)
+		corner, err := img.Getpoint(0, 0, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []float64{0, 255, 0}, corner)
+	})
+}
+
+func TestImage_SetDPI(t *testing.T) {
+	img, err := createWhiteImage(20, 20)
+	require.NoError(t, err)
+	defer img.Close()
+
+	require.NoError(t, img.SetDPI(300, 150))
+	assert.InDelta(t, 300/25.4, img.ResX(), 1e-9)
+	assert.InDelta(t, 150/25.4, img.ResY(), 1e-9)
+	xdpi, ydpi := img.DPI()
+	assert.InDelta(t, 300, xdpi, 1e-9)
+	assert.InDelta(t, 150, ydpi, 1e-9)
+
+	// PNG stores whole pixels per metre and JPEG whole dots per inch, so allow for rounding
+	savers := map[string]func() ([]byte, error){
+		"png":  func() ([]byte, error) { return img.PngsaveBuffer(nil) },
+		"jpeg": func() ([]byte, error) { return img.JpegsaveBuffer(nil) },
+	}
+	for name, save := range savers {
+		t.Run(name, func(t *testing.T) {
+			buf, err := save()
+			require.NoError(t, err)
+			loaded, err := NewImageFromBuffer(buf, nil)
+			require.NoError(t, err)
+			defer loaded.Close()
+			xdpi, ydpi := loaded.DPI()
+			assert.InDelta(t, 300, xdpi, 0.01)
+			assert.InDelta(t, 150, ydpi, 0.01)
+		})
+	}
+
+	assert.Error(t, img.SetResolution(0, 10))
+	assert.Error(t, img.SetDPI(-72, 72))
+}
+
+func TestImage_CopyOverrides(t *testing.T) {
+	img, err := createWhiteImage(10, 10)
+	require.NoError(t, err)
+	defer img.Close()
+
+	relabelled, err := img.Copy(&CopyOptions{
+		Xres:           11.811, // 300 DPI in pixels/mm
+		Yres:           5.905,
+		Xoffset:        3,
+		Yoffset:        4,
+		Interpretation: InterpretationMultiband,
+	})
+	require.NoError(t, err)
+	defer relabelled.Close()
+	assert.InDelta(t, 11.811, relabelled.ResX(), 1e-6)
+	assert.InDelta(t, 5.905, relabelled.ResY(), 1e-6)
+	assert.Equal(t, 3, relabelled.OffsetX())
+	assert.Equal(t, 4, relabelled.OffsetY())
+	assert.Equal(t, InterpretationMultiband, relabelled.Interpretation())
+	// Unset options keep the original header
+	assert.Equal(t, 3, relabelled.Bands())
+	assert.Equal(t, BandFormatUchar, relabelled.BandFormat())
+	// The source image is unchanged
+	assert.NotEqual(t, 11.811, img.ResX())
+
+	// 4 uchar bands reinterpreted as 2 ushort bands keep the same bytes per pixel
+	rgba, err := NewImageFromMemory(make([]byte, 10*10*4), 10, 10, 4)
+	require.NoError(t, err)
+	defer rgba.Close()
+	ushort, err := rgba.Copy(&CopyOptions{Bands: 2, Format: BandFormatUshort})
+	require.NoError(t, err)
+	defer ushort.Close()
+	assert.Equal(t, 2, ushort.Bands())
+	assert.Equal(t, BandFormatUshort, ushort.BandFormat())
+
+	// Changing the bytes per pixel is rejected
+	_, err = img.Copy(&CopyOptions{Bands: 4})
+	assert.Error(t, err)
+}
+
+func TestImage_DilateErode(t *testing.T) {
+	img, err := NewBlack(9, 9, nil)
+	require.NoError(t, err)
+	defer img.Close()
+
+	// Single white pixel in the center
+	require.NoError(t, img.DrawRect([]float64{255}, 4, 4, 1, 1, &DrawRectOptions{Fill: true}))
+
+	mask := [][]int{
+		{255, 255, 255},
+		{255, 255, 255},
+		{255, 255, 255},
+	}
+	require.NoError(t, img.Dilate(mask))
+
+	for y := 0; y < 9; y++ {
+		for x := 0; x < 9; x++ {
+			pixel, err := img.Getpoint(x, y, nil)
+			require.NoError(t, err)
+			if x >= 3 && x <= 5 && y >= 3 && y <= 5 {
+				assert.Equal(t, 255.0, pixel[0], "pixel (%d,%d) should be set after dilate", x, y)
+			} else {
+				assert.Equal(t, 0.0, pixel[0], "pixel (%d,%d) should be clear after dilate", x, y)
+			}
+		}
+	}
+
+	// Eroding the 3x3 block with the same mask leaves the center pixel only
+	require.NoError(t, img.Erode(mask))
+	center, err := img.Getpoint(4, 4, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 255.0, center[0])
+	edge, err := img.Getpoint(3, 3, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 0.0, edge[0])
+
+	// Multi-band images are rejected
+	rgb, err := createWhiteImage(9, 9)
+	require.NoError(t, err)
+	defer rgb.Close()
+	err = rgb.Dilate(mask)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "1-band uchar")
+
+	// Ragged masks are rejected
+	ragged := [][]int{
+		{255, 255},
+		{255},
+	}
+	require.Error(t, img.Dilate(ragged))
+}
+
+func TestImage_MedianFilter(t *testing.T) {
+	// Mid grey with sparse salt and pepper noise
+	width, height := 32, 32
+	data := make([]byte, width*height)
+	for i := range data {
+		switch {
+		case i%14 == 0:
+			data[i] = 255
+		case i%7 == 0:
+			data[i] = 0
+		default:
+			data[i] = 128
+		}
+	}
+	img, err := NewImageFromMemory(data, width, height, 1)
+	require.NoError(t, err)
+	defer img.Close()
+
+	before, err := img.Deviate()
+	require.NoError(t, err)
+
+	require.NoError(t, img.MedianFilter(3))
+	assert.Equal(t, width, img.Width())
+	assert.Equal(t, height, img.Height())
+
+	after, err := img.Deviate()
+	require.NoError(t, err)
+	assert.Less(t, after, before, "median filter should reduce deviation")
+
+	center, err := img.Getpoint(14, 0, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 128.0, center[0], "noise pixel should be replaced by the median")
+
+	// Even and too small sizes are rejected
+	err = img.MedianFilter(4)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "odd number")
+	require.Error(t, img.MedianFilter(1))
+}
+
+func TestImage_Threshold(t *testing.T) {
+	// Horizontal gradient from 0 to 255
+	width, height := 256, 4
+	data := make([]byte, width*height)
+	for i := range data {
+		data[i] = byte(i % width)
+	}
+	img, err := NewImageFromMemory(data, width, height, 1)
+	require.NoError(t, err)
+	defer img.Close()
+
+	require.NoError(t, img.Threshold(128))
+	assert.Equal(t, 1, img.Bands())
+	for _, x := range []int{0, 64, 127, 128, 200, 255} {
+		pixel, err := img.Getpoint(x, 1, nil)
+		require.NoError(t, err)
+		if x < 128 {
+			assert.Equal(t, 0.0, pixel[0], "pixel at x=%d should be 0", x)
+		} else {
+			assert.Equal(t, 255.0, pixel[0], "pixel at x=%d should be 255", x)
+		}
+	}
+
+	// Multi-band images are converted to greyscale
+	rgb, err := createWhiteImage(16, 16)
+	require.NoError(t, err)
+	defer rgb.Close()
+	require.NoError(t, rgb.Threshold(128))
+	assert.Equal(t, 1, rgb.Bands())
+	pixel, err := rgb.Getpoint(8, 8, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 255.0, pixel[0])
+}
+
+func TestImage_AdaptiveThreshold(t *testing.T) {
+	// Unevenly lit background with darker ink columns
+	width, height := 64, 16
+	data := make([]byte, width*height)
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			v := 60 + x*2
+			if x%8 == 4 {
+				v -= 50
+			}
+			data[y*width+x] = byte(v)
+		}
+	}
+	img, err := NewImageFromMemory(data, width, height, 1)
+	require.NoError(t, err)
+	defer img.Close()
+
+	require.NoError(t, img.AdaptiveThreshold(7, 10))
+	assert.Equal(t, 1, img.Bands())
+	assert.Equal(t, width, img.Width())
+	assert.Equal(t, height, img.Height())
+
+	for x := 8; x < width-8; x++ {
+		pixel, err := img.Getpoint(x, height/2, nil)
+		require.NoError(t, err)
+		if x%8 == 4 {
+			assert.Equal(t, 0.0, pixel[0], "ink at x=%d should be 0", x)
+		} else {
+			assert.Equal(t, 255.0, pixel[0], "background at x=%d should be 255", x)
+		}
+	}
+
+	err = img.AdaptiveThreshold(4, 0)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "odd number")
+}
+
+func TestImage_BandOr(t *testing.T) {
+	// Each band of a 3-band mask has a different white column
+	width, height := 6, 2
+	data := make([]byte, width*height*3)
+	for y := 0; y < height; y++ {
+		for band := 0; band < 3; band++ {
+			data[(y*width+band*2)*3+band] = 255
+		}
+	}
+	newMask := func() *Image {
+		img, err := NewImageFromMemory(data, width, height, 3)
+		require.NoError(t, err)
+		return img
+	}
+
+	union := newMask()
+	defer union.Close()
+	require.NoError(t, union.BandOr())
+	assert.Equal(t, 1, union.Bands())
+	for x := 0; x < width; x++ {
+		pixel, err := union.Getpoint(x, 1, nil)
+		require.NoError(t, err)
+		if x%2 == 0 {
+			assert.Equal(t, 255.0, pixel[0], "pixel at x=%d should be in the union", x)
+		} else {
+			assert.Equal(t, 0.0, pixel[0], "pixel at x=%d should be clear", x)
+		}
+	}
+
+	intersection := newMask()
+	defer intersection.Close()
+	require.NoError(t, intersection.BandAnd())
+	assert.Equal(t, 1, intersection.Bands())
+	maxValue, err := intersection.Max(nil)
+	require.NoError(t, err)
+	assert.Equal(t, 0.0, maxValue, "the bands do not overlap")
+
+	mean := newMask()
+	defer mean.Close()
+	require.NoError(t, mean.Bandmean())
+	assert.Equal(t, 1, mean.Bands())
+	pixel, err := mean.Getpoint(0, 0, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 85.0, pixel[0])
+
+	// Single-band images are a no-op
+	require.NoError(t, union.BandOr())
+	assert.Equal(t, 1, union.Bands())
+}
+
+func TestImage_ReorderBands(t *testing.T) {
+	newRGB := func() *Image {
+		data := make([]byte, 4*4*3)
+		for i := 0; i < len(data); i += 3 {
+			data[i], data[i+1], data[i+2] = 10, 20, 30
+		}
+		img, err := NewImageFromMemory(data, 4, 4, 3)
+		require.NoError(t, err)
+		return img
+	}
+
+	bgr := newRGB()
+	defer bgr.Close()
+	require.NoError(t, bgr.ReorderBands([]int{2, 1, 0}))
+	assert.Equal(t, 3, bgr.Bands())
+	pixel, err := bgr.Getpoint(1, 1, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []float64{30, 20, 10}, pixel)
+
+	repeated := newRGB()
+	defer repeated.Close()
+	require.NoError(t, repeated.ReorderBands([]int{0, 0, 0, 1}))
+	assert.Equal(t, 4, repeated.Bands())
+	pixel, err = repeated.Getpoint(1, 1, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []float64{10, 10, 10, 20}, pixel)
+
+	invalid := newRGB()
+	defer invalid.Close()
+	assert.Error(t, invalid.ReorderBands([]int{0, 3}))
+	assert.Error(t, invalid.ReorderBands([]int{-1}))
+	assert.Error(t, invalid.ReorderBands(nil))
+	assert.Equal(t, 3, invalid.Bands())
+}
+
+func TestImage_RecombMatrix(t *testing.T) {
+	data := make([]byte, 4*4*3)
+	for i := 0; i < len(data); i += 3 {
+		data[i], data[i+1], data[i+2] = 10, 20, 30
+	}
+	img, err := NewImageFromMemory(data, 4, 4, 3)
+	require.NoError(t, err)
+	defer img.Close()
+
+	// Swap red and blue, and add a band with the sum of all three
+	require.NoError(t, img.RecombMatrix([][]float64{
+		{0, 0, 1},
+		{0, 1, 0},
+		{1, 0, 0},
+		{1, 1, 1},
+	}))
+	assert.Equal(t, 4, img.Bands())
+	pixel, err := img.Getpoint(2, 2, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []float64{30, 20, 10, 60}, pixel)
+
+	// The matrix needs one column per input band
+	assert.Error(t, img.RecombMatrix([][]float64{
+		{1, 1},
+	}))
+	assert.Error(t, img.RecombMatrix(nil))
+}
+
+func TestImage_LinearScalar(t *testing.T) {
+	data := []byte{10, 100, 230, 20, 110, 240}
+	img, err := NewImageFromMemory(data, 2, 1, 3)
+	require.NoError(t, err)
+	defer img.Close()
+
+	require.NoError(t, img.LinearScalar(1, 50))
+	assert.Equal(t, BandFormatUchar, img.BandFormat())
+	assert.Equal(t, 3, img.Bands())
+	pixel, err := img.Getpoint(0, 0, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []float64{60, 150, 255}, pixel, "results above 255 should be clamped")
+	pixel, err = img.Getpoint(1, 0, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []float64{70, 160, 255}, pixel)
+
+	// Other formats are not clamped
+	floatImg, err := NewImageFromMemory(data, 2, 1, 3)
+	require.NoError(t, err)
+	defer floatImg.Close()
+	require.NoError(t, floatImg.Cast(BandFormatFloat, nil))
+	require.NoError(t, floatImg.LinearScalar(2, -30))
+	assert.Equal(t, BandFormatFloat, floatImg.BandFormat())
+	pixel, err = floatImg.Getpoint(0, 0, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []float64{-10, 170, 430}, pixel)
+}
+
+func TestImage_CountDifferentColours(t *testing.T) {
+	// 4 colours repeated across a 4x4 image
+	colours := [][]byte{
+		{255, 0, 0},
+		{0, 255, 0},
+		{0, 0, 255},
+		{255, 255, 255},
+	}
+	data := make([]byte, 0, 4*4*3)
+	for i := 0; i < 16; i++ {
+		data = append(data, colours[i%4]...)
+	}
+	img, err := NewImageFromMemory(data, 4, 4, 3)
+	require.NoError(t, err)
+	defer img.Close()
+
+	count, err := img.CountDifferentColours()
+	require.NoError(t, err)
+	assert.Equal(t, 4, count)
+
+	// Alpha takes the wider pixel path and counts as part of the colour
+	require.NoError(t, img.BandjoinConst([]float64{255}))
+	count, err = img.CountDifferentColours()
+	require.NoError(t, err)
+	assert.Equal(t, 4, count)
+	require.NoError(t, img.DrawRect([]float64{255, 0, 0, 128}, 0, 0, 1, 1, &DrawRectOptions{Fill: true}))
+	count, err = img.CountDifferentColours()
+	require.NoError(t, err)
+	assert.Equal(t, 5, count)
+
+	// A red-green gradient has one colour per pixel
+	gradient := make([]byte, 0, 256*256*3)
+	for y := 0; y < 256; y++ {
+		for x := 0; x < 256; x++ {
+			gradient = append(gradient, byte(x), byte(y), 128)
+		}
+	}
+	gradientImg, err := NewImageFromMemory(gradient, 256, 256, 3)
+	require.NoError(t, err)
+	defer gradientImg.Close()
+	count, err = gradientImg.CountDifferentColours()
+	require.NoError(t, err)
+	assert.Equal(t, 256*256, count)
+}
+
+func TestImage_Equals(t *testing.T) {
+	img, err := createTestGradientImage(t, 64, 64)
+	require.NoError(t, err)
+	defer img.Close()
+
+	copied, err := img.Copy(nil)
+	require.NoError(t, err)
+	defer copied.Close()
+	equal, err := img.Equals(copied)
+	require.NoError(t, err)
+	assert.True(t, equal)
+	diff, err := img.MaxDifference(copied)
+	require.NoError(t, err)
+	assert.Equal(t, 0.0, diff)
+
+	// A single changed pixel is enough to differ
+	changed, err := img.Copy(nil)
+	require.NoError(t, err)
+	defer changed.Close()
+	pixel, err := changed.Getpoint(10, 10, nil)
+	require.NoError(t, err)
+	ink := make([]float64, len(pixel))
+	for i, v := range pixel {
+		ink[i] = 255 - v
+	}
+	require.NoError(t, changed.DrawRect(ink, 10, 10, 1, 1, &DrawRectOptions{Fill: true}))
+	equal, err = img.Equals(changed)
+	require.NoError(t, err)
+	assert.False(t, equal)
+
+	blurred, err := img.Copy(nil)
+	require.NoError(t, err)
+	defer blurred.Close()
+	require.NoError(t, blurred.Gaussblur(2, nil))
+	equal, err = img.Equals(blurred)
+	require.NoError(t, err)
+	assert.False(t, equal)
+	diff, err = img.MaxDifference(blurred)
+	require.NoError(t, err)
+	assert.Greater(t, diff, 0.0)
+	similar, err := img.Similar(blurred, diff)
+	require.NoError(t, err)
+	assert.True(t, similar)
+	similar, err = img.Similar(blurred, diff-1)
+	require.NoError(t, err)
+	assert.False(t, similar)
+
+	// Differing dimensions are never equal
+	smaller, err := createTestGradientImage(t, 32, 32)
+	require.NoError(t, err)
+	defer smaller.Close()
+	equal, err = img.Equals(smaller)
+	require.NoError(t, err)
+	assert.False(t, equal)
+}
+
+func TestImage_PerceptualHash(t *testing.T) {
+	shapes, err := NewBlack(128, 128, &BlackOptions{Bands: 3})
+	require.NoError(t, err)
+	defer shapes.Close()
+	require.NoError(t, shapes.DrawCircle([]float64{255, 255, 255}, 40, 40, 25, &DrawCircleOptions{Fill: true}))
+	require.NoError(t, shapes.DrawRect([]float64{200, 100, 50}, 70, 70, 45, 45, &DrawRectOptions{Fill: true}))
+	require.NoError(t, shapes.Gaussblur(1.5, nil))
+
+	jpegData, err := shapes.JpegsaveBuffer(&JpegsaveBufferOptions{Q: 70})
+	require.NoError(t, err)
+	recompressed, err := NewImageFromBuffer(jpegData, nil)
+	require.NoError(t, err)
+	defer recompressed.Close()
+
+	stripes, err := NewBlack(128, 128, &BlackOptions{Bands: 3})
+	require.NoError(t, err)
+	defer stripes.Close()
+	for x := 0; x < 128; x += 32 {
+		require.NoError(t, stripes.DrawRect([]float64{255, 255, 255}, x, 0, 16, 128, &DrawRectOptions{Fill: true}))
+	}
+
+	hashes := map[string]func(*Image) (uint64, error){
+		"dhash": (*Image).DHash,
+		"phash": (*Image).PHash,
+	}
+	for name, hash := range hashes {
+		t.Run(name, func(t *testing.T) {
+			original, err := hash(shapes)
+			require.NoError(t, err)
+			again, err := hash(shapes)
+			require.NoError(t, err)
+			assert.Equal(t, original, again, "hash should be deterministic")
+
+			similar, err := hash(recompressed)
+			require.NoError(t, err)
+			assert.LessOrEqual(t, HammingDistance(original, similar), 8)
+
+			different, err := hash(stripes)
+			require.NoError(t, err)
+			assert.GreaterOrEqual(t, HammingDistance(original, different), 16)
+
+			// Images smaller than the hash grid are upscaled
+			tiny, err := createWhiteImage(4, 4)
+			require.NoError(t, err)
+			defer tiny.Close()
+			_, err = hash(tiny)
+			require.NoError(t, err)
+		})
+	}
+
+	assert.Equal(t, 0, HammingDistance(0xFF00, 0xFF00))
+	assert.Equal(t, 64, HammingDistance(0, ^uint64(0)))
+}
+
+func TestImage_Sharpness(t *testing.T) {
+	sharp, err := NewBlack(100, 100, &BlackOptions{Bands: 3})
+	require.NoError(t, err)
+	defer sharp.Close()
+	require.NoError(t, sharp.DrawRect([]float64{255, 255, 255}, 25, 25, 50, 50, &DrawRectOptions{Fill: true}))
+	for x := 0; x < 100; x += 10 {
+		require.NoError(t, sharp.DrawLine([]float64{128, 64, 200}, x, 0, x, 99))
+	}
+
+	blurred, err := sharp.Copy(nil)
+	require.NoError(t, err)
+	defer blurred.Close()
+	require.NoError(t, blurred.Gaussblur(5, nil))
+
+	sharpScore, err := sharp.Sharpness()
+	require.NoError(t, err)
+	blurredScore, err := blurred.Sharpness()
+	require.NoError(t, err)
+	assert.Greater(t, sharpScore, 0.0)
+	assert.Greater(t, sharpScore, blurredScore)
+	assert.Equal(t, 3, sharp.Bands(), "image should not be modified")
+
+	// Greyscale scoring makes the score independent of the number of bands
+	grey, err := sharp.Copy(nil)
+	require.NoError(t, err)
+	defer grey.Close()
+	require.NoError(t, grey.Colourspace(InterpretationBW, nil))
+	greyScore, err := grey.Sharpness()
+	require.NoError(t, err)
+	assert.InDelta(t, sharpScore, greyScore, sharpScore*0.01)
+
+	flat, err := NewBlack(50, 50, nil)
+	require.NoError(t, err)
+	defer flat.Close()
+	flatScore, err := flat.Sharpness()
+	require.NoError(t, err)
+	assert.Zero(t, flatScore)
+}
+
+func TestImage_AverageAndDominantColor(t *testing.T) {
+	// Mostly red with a blue corner
+	img, err := NewImageFromBuffer(createTestPngBuffer(t, 100, 100), nil)
+	require.NoError(t, err)
+	defer img.Close()
+	require.NoError(t, img.DrawRect([]float64{220, 20, 20}, 0, 0, 100, 100, &DrawRectOptions{Fill: true}))
+	require.NoError(t, img.DrawRect([]float64{20, 20, 220}, 0, 0, 30, 30, &DrawRectOptions{Fill: true}))
+
+	average, err := img.AverageColor()
+	require.NoError(t, err)
+	require.Len(t, average, 3)
+	assert.InDelta(t, 0.91*220+0.09*20, average[0], 0.5)
+	assert.InDelta(t, 20, average[1], 0.5)
+	assert.InDelta(t, 0.91*20+0.09*220, average[2], 0.5)
+
+	dominant, err := img.DominantColor(8)
+	require.NoError(t, err)
+	require.Len(t, dominant, 3)
+	assert.InDelta(t, 220, dominant[0], 16)
+	assert.InDelta(t, 20, dominant[1], 16)
+	assert.InDelta(t, 20, dominant[2], 16)
+
+	// Lab images are converted to sRGB first
+	lab, err := img.Copy(nil)
+	require.NoError(t, err)
+	defer lab.Close()
+	require.NoError(t, lab.ToLab())
+	labAverage, err := lab.AverageColor()
+	require.NoError(t, err)
+	require.Len(t, labAverage, 3)
+	assert.InDelta(t, average[0], labAverage[0], 3)
+	labDominant, err := lab.DominantColor(8)
+	require.NoError(t, err)
+	assert.Equal(t, dominant, labDominant)
+
+	_, err = img.DominantColor(0)
+	assert.Error(t, err)
+}
+
+func TestImage_WhiteBalance(t *testing.T) {
+	spread := func(t *testing.T, img *Image) float64 {
+		average, err := img.AverageColor()
+		require.NoError(t, err)
+		return math.Max(average[0], math.Max(average[1], average[2])) -
+			math.Min(average[0], math.Min(average[1], average[2]))
+	}
+
+	t.Run("gray world removes a blue cast", func(t *testing.T) {
+		img, err := NewImageFromBuffer(createTestPngBuffer(t, 100, 100), nil)
+		require.NoError(t, err)
+		defer img.Close()
+		require.NoError(t, img.Linear([]float64{0.7, 0.8, 1}, []float64{0, 0, 60}, &LinearOptions{Uchar: true}))
+		before := spread(t, img)
+
+		require.NoError(t, img.WhiteBalance(nil))
+		assert.Equal(t, BandFormatUchar, img.BandFormat())
+		assert.Equal(t, InterpretationSrgb, img.Interpretation())
+		after := spread(t, img)
+		assert.Less(t, after, before/3, "channel means should be balanced, spread %.1f before and %.1f after", before, after)
+	})
+
+	t.Run("reference grey point", func(t *testing.T) {
+		img := solidImage(t, 8, 8, 100, 120, 160)
+		defer img.Close()
+		require.NoError(t, img.WhiteBalance(&WhiteBalanceOptions{Reference: []float64{100, 120, 160}}))
+		pixel, err := img.Getpoint(0, 0, nil)
+		require.NoError(t, err)
+		for _, v := range pixel {
+			assert.InDelta(t, 126.7, v, 1)
+		}
+	})
+
+	t.Run("monochromatic images are not over-corrected", func(t *testing.T) {
+		grey := solidImage(t, 8, 8, 128, 128, 128)
+		defer grey.Close()
+		require.NoError(t, grey.WhiteBalance(nil))
+		pixel, err := grey.Getpoint(0, 0, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []float64{128, 128, 128}, pixel)
+
+		red := solidImage(t, 8, 8, 200, 40, 40)
+		defer red.Close()
+		require.NoError(t, red.WhiteBalance(nil))
+		pixel, err = red.Getpoint(0, 0, nil)
+		require.NoError(t, err)
+		assert.InDelta(t, 100, pixel[0], 1, "gain is limited by MaxGain")
+		assert.InDelta(t, 80, pixel[1], 1, "gain is limited by MaxGain")
+
+		bw := solidImage(t, 8, 8, 90)
+		defer bw.Close()
+		require.NoError(t, bw.WhiteBalance(nil))
+		pixel, err = bw.Getpoint(0, 0, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []float64{90}, pixel)
+	})
+
+	t.Run("keeps alpha", func(t *testing.T) {
+		img := solidImage(t, 8, 8, 100, 120, 160, 77)
+		defer img.Close()
+		require.NoError(t, img.WhiteBalance(nil))
+		pixel, err := img.Getpoint(0, 0, nil)
+		require.NoError(t, err)
+		assert.Equal(t, 4, len(pixel))
+		assert.InDelta(t, 77, pixel[3], 0.5)
+	})
+
+	t.Run("invalid options", func(t *testing.T) {
+		img := solidImage(t, 8, 8, 100, 120, 160)
+		defer img.Close()
+		assert.Error(t, img.WhiteBalance(&WhiteBalanceOptions{Reference: []float64{1, 2}}))
+		assert.Error(t, img.WhiteBalance(&WhiteBalanceOptions{MaxGain: 0.5}))
+	})
+}
+
+func TestImage_BooleanConst(t *testing.T) {
+	data := []byte{0x00, 0x1F, 0xAB, 0xFF, 0x7E, 0xC3}
+	img, err := NewImageFromMemory(data, 2, 1, 3)
+	require.NoError(t, err)
+	defer img.Close()
+
+	require.NoError(t, img.BooleanConst(OperationBooleanAnd, []float64{0x0F}))
+	require.NoError(t, img.Cast(BandFormatUchar, nil))
+
+	out, err := img.WriteToMemory()
+	require.NoError(t, err)
+	require.Len(t, out, len(data))
+	for i, v := range data {
+		assert.Equal(t, v&0x0F, out[i], "low nibble should be preserved at index %d", i)
+	}
+}
+
+func TestImage_BooleanBroadcast(t *testing.T) {
+	data := []byte{0xFF, 0xF0, 0x0F, 0xAA, 0x55, 0x33}
+	img, err := NewImageFromMemory(data, 2, 1, 3)
+	require.NoError(t, err)
+	defer img.Close()
+
+	// A single-band operand is applied to every band
+	mask, err := NewImageFromMemory([]byte{0x3C, 0x00}, 2, 1, 1)
+	require.NoError(t, err)
+	defer mask.Close()
+
+	require.NoError(t, img.Boolean(mask, OperationBooleanOr))
+	require.NoError(t, img.Cast(BandFormatUchar, nil))
+	assert.Equal(t, 3, img.Bands())
+
+	out, err := img.WriteToMemory()
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0xFF, 0xFC, 0x3F, 0xAA, 0x55, 0x33}, out)
+}
+
+func TestImage_Maplut(t *testing.T) {
+	table := make([]byte, 256)
+	for i := range table {
+		table[i] = byte(255 - i)
+	}
+	lut, err := NewImageFromMemory(table, 256, 1, 1)
+	require.NoError(t, err)
+	defer lut.Close()
+
+	img, err := createTestGradientImage(t, 64, 64)
+	require.NoError(t, err)
+	defer img.Close()
+	require.NoError(t, img.Maplut(lut, nil))
+
+	inverted, err := createTestGradientImage(t, 64, 64)
+	require.NoError(t, err)
+	defer inverted.Close()
+	require.NoError(t, inverted.Invert())
+
+	got, err := img.WriteToMemory()
+	require.NoError(t, err)
+	want, err := inverted.WriteToMemory()
+	require.NoError(t, err)
+	assert.Equal(t, want, got, "inversion LUT should match Invert")
+
+	// A LUT with a band count other than 1 or the image's bands is rejected
+	twoBand, err := NewImageFromMemory(make([]byte, 256*2), 256, 1, 2)
+	require.NoError(t, err)
+	defer twoBand.Close()
+	assert.Error(t, img.Maplut(twoBand, nil))
+}
+
+func TestImage_ApplyCurve(t *testing.T) {
+	img, err := createTestGradientImage(t, 64, 64)
+	require.NoError(t, err)
+	defer img.Close()
+	require.NoError(t, img.ApplyCurve([]float64{255, 0}))
+
+	inverted, err := createTestGradientImage(t, 64, 64)
+	require.NoError(t, err)
+	defer inverted.Close()
+	require.NoError(t, inverted.Invert())
+
+	got, err := img.WriteToMemory()
+	require.NoError(t, err)
+	want, err := inverted.WriteToMemory()
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+
+	// A steep contrast curve clips both ends
+	poster, err := createTestGradientImage(t, 64, 64)
+	require.NoError(t, err)
+	defer poster.Close()
+	require.NoError(t, poster.ApplyCurve([]float64{0, 0, 255, 255}))
+	dark, err := poster.Getpoint(0, 0, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 0.0, dark[0])
+	light, err := poster.Getpoint(63, 63, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 255.0, light[0])
+
+	assert.Error(t, img.ApplyCurve([]float64{128}))
+}
+
+func TestImage_GammaRoundTrip(t *testing.T) {
+	// vips_gamma raises pixel values, scaled to the band format maximum, to the
+	// given exponent. It does not inspect the interpretation, so the caller decides
+	// whether the data is linear light or gamma encoded sRGB.
+	img, err := createTestGradientImage(t, 64, 64)
+	require.NoError(t, err)
+	defer img.Close()
+
+	original := make(map[[2]int][]float64)
+	points := [][2]int{
+		{32, 32},
+		{48, 48},
+		{63, 63},
+		{20, 60},
+	}
+	for _, p := range points {
+		pixel, err := img.Getpoint(p[0], p[1], nil)
+		require.NoError(t, err)
+		original[p] = pixel
+	}
+
+	require.NoError(t, img.Gamma(&GammaOptions{Exponent: 2.2}))
+	darkened, err := img.Getpoint(32, 32, nil)
+	require.NoError(t, err)
+	assert.Less(t, darkened[0], original[[2]int{32, 32}][0], "exponent above 1 should darken midtones")
+
+	require.NoError(t, img.Gamma(&GammaOptions{Exponent: 1 / 2.2}))
+	for _, p := range points {
+		pixel, err := img.Getpoint(p[0], p[1], nil)
+		require.NoError(t, err)
+		assert.InDelta(t, original[p][0], pixel[0], 4, "gamma round-trip at %v", p)
+	}
+
+	// Default exponent is 1/2.4
+	assert.InDelta(t, 1/2.4, DefaultGammaOptions().Exponent, 1e-9)
+}
+
+func TestImage_Falsecolour(t *testing.T) {
+	img, err := createTestGradientImage(t, 64, 64)
+	require.NoError(t, err)
+	defer img.Close()
+	require.NoError(t, img.ExtractBand(0, nil))
+	require.Equal(t, 1, img.Bands())
+
+	require.NoError(t, img.Falsecolour())
+	assert.Equal(t, 3, img.Bands())
+	assert.Equal(t, BandFormatUchar, img.BandFormat())
+
+	// Dark and bright ends of the gradient map to different colours
+	dark, err := img.Getpoint(0, 0, nil)
+	require.NoError(t, err)
+	bright, err := img.Getpoint(63, 63, nil)
+	require.NoError(t, err)
+	assert.NotEqual(t, dark, bright)
+
+	t.Run("rejects multi-band images", func(t *testing.T) {
+		rgb, err := NewBlack(16, 16, &BlackOptions{Bands: 3})
+		require.NoError(t, err)
+		defer rgb.Close()
+
+		err = rgb.Falsecolour()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "3 bands")
+		assert.Equal(t, 3, rgb.Bands())
+	})
+}
+
+func TestImage_ColourspaceHelpers(t *testing.T) {
+	img, err := createSolidColorImage(t, 16, 16, color.RGBA{200, 100, 50, 255})
+	require.NoError(t, err)
+	defer img.Close()
+
+	require.NoError(t, img.ToLab())
+	assert.Equal(t, InterpretationLab, img.Interpretation())
+
+	require.NoError(t, img.ToXYZ())
+	assert.Equal(t, InterpretationXyz, img.Interpretation())
+
+	require.NoError(t, img.ToSRGB())
+	assert.Equal(t, InterpretationSrgb, img.Interpretation())
+
+	pixel, err := img.Getpoint(8, 8, nil)
+	require.NoError(t, err)
+	assert.InDelta(t, 200, pixel[0], 2)
+	assert.InDelta(t, 100, pixel[1], 2)
+	assert.InDelta(t, 50, pixel[2], 2)
+}
+
+// buildExifWithThumbnail builds a little-endian EXIF blob whose IFD1 points at thumbnail
+func buildExifWithThumbnail(thumbnail []byte) []byte {
+	le := binary.LittleEndian
+	var tiff []byte
+	tiff = append(tiff, 'I', 'I')
+	tiff = le.AppendUint16(tiff, 42)
+	tiff = le.AppendUint32(tiff, 8)
+
+	// IFD0 with a single orientation entry, followed by the IFD1 offset
+	ifd1 := 8 + 2 + 12 + 4
+	tiff = le.AppendUint16(tiff, 1)
+	tiff = le.AppendUint16(tiff, 0x0112)
+	tiff = le.AppendUint16(tiff, 3)
+	tiff = le.AppendUint32(tiff, 1)
+	tiff = le.AppendUint32(tiff, 1)
+	tiff = le.AppendUint32(tiff, uint32(ifd1))
+
+	// IFD1 with compression, thumbnail offset and thumbnail length
+	data := ifd1 + 2 + 3*12 + 4
+	tiff = le.AppendUint16(tiff, 3)
+	tiff = le.AppendUint16(tiff, 0x0103)
+	tiff = le.AppendUint16(tiff, 3)
+	tiff = le.AppendUint32(tiff, 1)
+	tiff = le.AppendUint32(tiff, 6)
+	tiff = le.AppendUint16(tiff, 0x0201)
+	tiff = le.AppendUint16(tiff, 4)
+	tiff = le.AppendUint32(tiff, 1)
+	tiff = le.AppendUint32(tiff, uint32(data))
+	tiff = le.AppendUint16(tiff, 0x0202)
+	tiff = le.AppendUint16(tiff, 4)
+	tiff = le.AppendUint32(tiff, 1)
+	tiff = le.AppendUint32(tiff, uint32(len(thumbnail)))
+	tiff = le.AppendUint32(tiff, 0)
+	tiff = append(tiff, thumbnail...)
+
+	return append([]byte("Exif\x00\x00"), tiff...)
+}
+
+func TestImage_EmbeddedThumbnail(t *testing.T) {
+	thumbnail := createTestJpegBuffer(t, 16, 12)
+
+	img, err := NewImageFromBuffer(createTestJpegBuffer(t, 160, 120), nil)
+	require.NoError(t, err)
+	defer img.Close()
+
+	_, err = img.EmbeddedThumbnail()
+	assert.ErrorIs(t, err, ErrNoEmbeddedThumbnail, "plain JPEG has no embedded thumbnail")
+
+	vipsImageSetBlob(img.image, "exif-data", buildExifWithThumbnail(thumbnail))
+
+	got, err := img.EmbeddedThumbnail()
+	require.NoError(t, err)
+	assert.Equal(t, thumbnail, got)
+
+	thumb, err := NewImageFromBuffer(got, nil)
+	require.NoError(t, err)
+	defer thumb.Close()
+	assert.Equal(t, ImageTypeJpeg, thumb.Format())
+	assert.Less(t, thumb.Width(), img.Width())
+	assert.Equal(t, 16, thumb.Width())
+	assert.Equal(t, 12, thumb.Height())
+}
+
+func TestExifThumbnail_Malformed(t *testing.T) {
+	valid := buildExifWithThumbnail([]byte{0xFF, 0xD8, 0xFF, 0xD9})
+
+	for name, data := range map[string][]byte{
+		"empty":       nil,
+		"bad order":   append([]byte("XX"), valid[8:]...),
+		"truncated":   valid[:len(valid)-3],
+		"no ifd1":     valid[:6+8+2+12],
+		"header only": valid[:14],
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := exifThumbnail(data)
+			assert.ErrorIs(t, err, ErrNoEmbeddedThumbnail)
+		})
+	}
+}
+
+func TestImage_SetInterpretation(t *testing.T) {
+	img, err := NewImageFromBuffer(createTestPngBuffer(t, 20, 20), nil)
+	require.NoError(t, err)
+	defer img.Close()
+	assert.Equal(t, InterpretationSrgb, img.Interpretation())
+
+	before, err := img.WriteToMemory()
+	require.NoError(t, err)
+
+	require.NoError(t, img.SetInterpretation(InterpretationRgb16))
+	assert.Equal(t, InterpretationRgb16, img.Interpretation())
+	assert.Equal(t, BandFormatUchar, img.BandFormat(), "relabelling must not convert the pixel format")
+
+	after, err := img.WriteToMemory()
+	require.NoError(t, err)
+	assert.Equal(t, before, after, "relabelling must not change pixel data")
+
+	require.NoError(t, img.SetInterpretation(InterpretationMultiband))
+	assert.Equal(t, InterpretationMultiband, img.Interpretation())
+}
+
+func TestImage_DrawImage(t *testing.T) {
+	canvas, err := createWhiteImage(100, 100)
+	require.NoError(t, err)
+	defer canvas.Close()
+
+	red, err := createSolidColorImage(t, 20, 20, color.RGBA{255, 0, 0, 255})
+	require.NoError(t, err)
+	defer red.Close()
+
+	require.NoError(t, canvas.DrawImage(red, 40, 40, nil))
+	assert.Equal(t, 100, canvas.Width())
+	assert.Equal(t, 100, canvas.Height())
+
+	inside, err := canvas.Getpoint(50, 50, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []float64{255, 0, 0}, inside)
+
+	outsidePoints := [][2]int{
+		{39, 39},
+		{60, 60},
+		{10, 90},
+	}
+	for _, p := range outsidePoints {
+		outside, err := canvas.Getpoint(p[0], p[1], nil)
+		require.NoError(t, err)
+		assert.Equal(t, []float64{255, 255, 255}, outside, "pixel %v should stay white", p)
+	}
+
+	// A sub image extending beyond the canvas is clipped
+	require.NoError(t, canvas.DrawImage(red, 90, 90, nil))
+	assert.Equal(t, 100, canvas.Width())
+	assert.Equal(t, 100, canvas.Height())
+	corner, err := canvas.Getpoint(99, 99, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []float64{255, 0, 0}, corner)
+}
+
+func TestImage_DrawSmudge(t *testing.T) {
+	img, err := createBlackImage(100, 100)
+	require.NoError(t, err)
+	defer img.Close()
+
+	// Sharp vertical edge between x=49 and x=50
+	require.NoError(t, img.DrawRect([]float64{255, 255, 255}, 50, 0, 50, 100, &DrawRectOptions{Fill: true}))
+
+	require.NoError(t, img.DrawSmudge(40, 40, 20, 20))
+
+	left, err := img.Getpoint(49, 50, nil)
+	require.NoError(t, err)
+	right, err := img.Getpoint(50, 50, nil)
+	require.NoError(t, err)
+	assert.Greater(t, left[0], 0.0, "dark side of the edge should be lightened")
+	assert.Less(t, right[0], 255.0, "light side of the edge should be darkened")
+
+	// Outside the smudged region the edge stays sharp
+	outsideLeft, err := img.Getpoint(49, 10, nil)
+	require.NoError(t, err)
+	outsideRight, err := img.Getpoint(50, 10, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 0.0, outsideLeft[0])
+	assert.Equal(t, 255.0, outsideRight[0])
+}
+
+func TestImage_DrawMask(t *testing.T) {
+	canvas, err := createWhiteImage(100, 100)
+	require.NoError(t, err)
+	defer canvas.Close()
+
+	// Soft circle: opaque in the middle fading out towards the edge
+	size := 21
+	data := make([]byte, size*size)
+	for y := 0; y < size; y++ {
+		for x := 0; x < size; x++ {
+			dx, dy := float64(x-size/2), float64(y-size/2)
+			d := math.Sqrt(dx*dx+dy*dy) / float64(size/2)
+			if d < 1 {
+				data[y*size+x] = uint8(255 * (1 - d))
+			}
+		}
+	}
+	mask, err := NewImageFromMemory(data, size, size, 1)
+	require.NoError(t, err)
+	defer mask.Close()
+
+	require.NoError(t, canvas.DrawMask([]float64{255, 0, 0}, mask, 40, 40))
+
+	center, err := canvas.Getpoint(50, 50, nil)
+	require.NoError(t, err)
+	assert.InDelta(t, 255, center[0], 1)
+	assert.InDelta(t, 0, center[1], 2, "center of the stamp should be fully inked")
+
+	halfway, err := canvas.Getpoint(55, 50, nil)
+	require.NoError(t, err)
+	assert.Greater(t, halfway[1], center[1], "ink should fade towards the edge")
+	assert.Less(t, halfway[1], 255.0)
+
+	outside, err := canvas.Getpoint(10, 10, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []float64{255, 255, 255}, outside)
+
+	// The mask must be single-band
+	rgbMask, err := createWhiteImage(size, size)
+	require.NoError(t, err)
+	defer rgbMask.Close()
+	assert.Error(t, canvas.DrawMask([]float64{255, 0, 0}, rgbMask, 40, 40))
+}
+
+func TestImage_Crop(t *testing.T) {
+	t.Run("strict", func(t *testing.T) {
+		img, err := createWhiteImage(100, 80)
+		require.NoError(t, err)
+		defer img.Close()
+
+		require.NoError(t, img.Crop(10, 20, 30, 40, nil))
+		assert.Equal(t, 30, img.Width())
+		assert.Equal(t, 40, img.Height())
+
+		assert.Error(t, img.Crop(10, 10, 100, 100, nil), "out of bounds crop should fail without Clamp")
+	})
+
+	t.Run("clamped over bounds", func(t *testing.T) {
+		img, err := createWhiteImage(100, 80)
+		require.NoError(t, err)
+		defer img.Close()
+
+		require.NoError(t, img.Crop(60, 50, 100, 100, &CropOptions{Clamp: true}))
+		assert.Equal(t, 40, img.Width())
+		assert.Equal(t, 30, img.Height())
+	})
+
+	t.Run("negative origin", func(t *testing.T) {
+		img, err := createBlackImage(100, 80)
+		require.NoError(t, err)
+		defer img.Close()
+		require.NoError(t, img.DrawRect([]float64{255, 255, 255}, 90, 70, 10, 10, &DrawRectOptions{Fill: true}))
+
+		// Bottom-right 10x10 corner
+		require.NoError(t, img.Crop(-10, -10, 10, 10, nil))
+		assert.Equal(t, 10, img.Width())
+		assert.Equal(t, 10, img.Height())
+		pixel, err := img.Getpoint(0, 0, nil)
+		require.NoError(t, err)
+		assert.Equal(t, 255.0, pixel[0])
+	})
+
+	t.Run("clamped negative origin", func(t *testing.T) {
+		img, err := createWhiteImage(100, 80)
+		require.NoError(t, err)
+		defer img.Close()
+
+		// top resolves to -120, so only rows 0-9 of the requested area overlap the image
+		require.NoError(t, img.Crop(-20, -200, 50, 130, &CropOptions{Clamp: true}))
+		assert.Equal(t, 20, img.Width())
+		assert.Equal(t, 10, img.Height())
+	})
+
+	t.Run("clamped without overlap", func(t *testing.T) {
+		img, err := createWhiteImage(100, 80)
+		require.NoError(t, err)
+		defer img.Close()
+
+		assert.Error(t, img.Crop(200, 0, 10, 10, &CropOptions{Clamp: true}))
+	})
+}
+
+func TestImage_TilecacheLinecache(t *testing.T) {
+	// Caches return a new image that is a pipeline node over the receiver.
+	// Insert them right after an expensive or sequential source, before the
+	// operation that reads it in a random order, and close both images.
+	expected, err := createTestGradientImage(t, 128, 96)
+	require.NoError(t, err)
+	defer expected.Close()
+	require.NoError(t, expected.Rot(AngleD90))
+	want, err := expected.WriteToMemory()
+	require.NoError(t, err)
+
+	t.Run("tilecache", func(t *testing.T) {
+		img, err := createTestGradientImage(t, 128, 96)
+		require.NoError(t, err)
+		defer img.Close()
+
+		cached, err := img.Tilecache(&TilecacheOptions{
+			TileWidth:  32,
+			TileHeight: 32,
+			MaxTiles:   16,
+			Access:     AccessRandom,
+			Threaded:   true,
+		})
+		require.NoError(t, err)
+		defer cached.Close()
+
+		require.NoError(t, cached.Rot(AngleD90))
+		got, err := cached.WriteToMemory()
+		require.NoError(t, err)
+		assert.Equal(t, want, got, "tilecache must not change the result")
+	})
+
+	t.Run("linecache", func(t *testing.T) {
+		img, err := createTestGradientImage(t, 128, 96)
+		require.NoError(t, err)
+		defer img.Close()
+
+		cached, err := img.Linecache(&LinecacheOptions{
+			TileHeight: 8,
+			Threaded:   true,
+		})
+		require.NoError(t, err)
+		defer cached.Close()
+
+		require.NoError(t, cached.Rot(AngleD90))
+		got, err := cached.WriteToMemory()
+		require.NoError(t, err)
+		assert.Equal(t, want, got, "linecache must not change the result")
+	})
+}
+
+func TestFrameReader(t *testing.T) {
+	gifData := createTestAnimatedGif(t, 30, 20, 4)
+
+	all, err := NewImageFromBuffer(gifData, &LoadOptions{N: -1})
+	require.NoError(t, err)
+	defer all.Close()
+	require.Equal(t, 4, all.Pages())
+
+	source := NewSource(&readSeekCloser{bytes.NewReader(gifData)})
+	defer source.Close()
+
+	reader, err := NewFrameReaderFromSource(source, nil)
+	require.NoError(t, err)
+	assert.Equal(t, all.Pages(), reader.Pages())
+
+	count := 0
+	for {
+		frame, err := reader.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+
+		assert.Equal(t, 30, frame.Width())
+		assert.Equal(t, 20, frame.Height(), "each frame should be a single page")
+		pixel, err := frame.Getpoint(15, 10, nil)
+		require.NoError(t, err)
+		assert.InDelta(t, count*50, pixel[0], 2, "frame %d should have its own content", count)
+
+		frame.Close()
+		count++
+	}
+	assert.Equal(t, all.Pages(), count)
+
+	// Reading past the end keeps returning io.EOF
+	_, err = reader.Next()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestImage_Join(t *testing.T) {
+	t.Run("horizontal", func(t *testing.T) {
+		left, err := createWhiteImage(50, 100)
+		require.NoError(t, err)
+		defer left.Close()
+		right, err := createBlackImage(30, 100)
+		require.NoError(t, err)
+		defer right.Close()
+
+		require.NoError(t, left.Join(right, DirectionHorizontal, nil))
+		assert.Equal(t, 80, left.Width())
+		assert.Equal(t, 100, left.Height())
+
+		pixel, err := left.Getpoint(10, 50, nil)
+		require.NoError(t, err)
+		assert.Equal(t, 255.0, pixel[0])
+		pixel, err = left.Getpoint(60, 50, nil)
+		require.NoError(t, err)
+		assert.Equal(t, 0.0, pixel[0])
+	})
+
+	t.Run("vertical", func(t *testing.T) {
+		top, err := createWhiteImage(40, 30)
+		require.NoError(t, err)
+		defer top.Close()
+		bottom, err := createBlackImage(40, 20)
+		require.NoError(t, err)
+		defer bottom.Close()
+
+		require.NoError(t, top.Join(bottom, DirectionVertical, nil))
+		assert.Equal(t, 40, top.Width())
+		assert.Equal(t, 50, top.Height())
+	})
+
+	t.Run("differing heights", func(t *testing.T) {
+		left, err := createWhiteImage(50, 100)
+		require.NoError(t, err)
+		defer left.Close()
+		right, err := createBlackImage(30, 60)
+		require.NoError(t, err)
+		defer right.Close()
+
+		require.NoError(t, left.Join(right, DirectionHorizontal, &JoinOptions{
+			Expand:     true,
+			Align:      AlignCentre,
+			Background: []float64{255, 0, 0},
+		}))
+		assert.Equal(t, 80, left.Width())
+		assert.Equal(t, 100, left.Height())
+
+		// Above the centred right image is background, its middle is image content
+		pixel, err := left.Getpoint(65, 5, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []float64{255, 0, 0}, pixel)
+		pixel, err = left.Getpoint(65, 50, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []float64{0, 0, 0}, pixel)
+	})
+}
+
+func TestImage_Insert(t *testing.T) {
+	t.Run("within bounds", func(t *testing.T) {
+		img, err := createWhiteImage(50, 50)
+		require.NoError(t, err)
+		defer img.Close()
+		sub, err := createBlackImage(10, 10)
+		require.NoError(t, err)
+		defer sub.Close()
+
+		require.NoError(t, img.Insert(sub, 20, 20, nil))
+		assert.Equal(t, 50, img.Width())
+		assert.Equal(t, 50, img.Height())
+		pixel, err := img.Getpoint(25, 25, nil)
+		require.NoError(t, err)
+		assert.Equal(t, 0.0, pixel[0])
+	})
+
+	t.Run("expand with negative position", func(t *testing.T) {
+		img, err := createWhiteImage(50, 50)
+		require.NoError(t, err)
+		defer img.Close()
+		sub, err := createBlackImage(20, 20)
+		require.NoError(t, err)
+		defer sub.Close()
+
+		require.NoError(t, img.Insert(sub, -10, -10, &InsertOptions{
+			Expand:     true,
+			Background: []float64{0, 0, 255},
+		}))
+		// The canvas grows by 10 pixels on the top and left
+		assert.Equal(t, 60, img.Width())
+		assert.Equal(t, 60, img.Height())
+
+		sample := func(x, y int) []float64 {
+			pixel, err := img.Getpoint(x, y, nil)
+			require.NoError(t, err)
+			return pixel
+		}
+		assert.Equal(t, []float64{0, 0, 0}, sample(5, 5), "inserted image at the new origin")
+		assert.Equal(t, []float64{0, 0, 255}, sample(40, 5), "exposed area uses the background")
+		assert.Equal(t, []float64{255, 255, 255}, sample(40, 40), "original image is shifted")
+	})
+}
+
+func TestImage_WithDeadline(t *testing.T) {
+	t.Run("expired deadline aborts evaluation", func(t *testing.T) {
+		img, err := NewBlack(2000, 2000, &BlackOptions{Bands: 3})
+		require.NoError(t, err)
+		defer img.Close()
+
+		require.NoError(t, img.WithDeadline(time.Now().Add(-time.Second)))
+		require.NoError(t, img.Gaussblur(20, nil))
+
+		_, err = img.WriteToMemory()
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrOperationTimeout)
+	})
+
+	t.Run("future deadline does not interfere", func(t *testing.T) {
+		img, err := NewBlack(100, 100, &BlackOptions{Bands: 3})
+		require.NoError(t, err)
+		defer img.Close()
+
+		require.NoError(t, img.WithDeadline(time.Now().Add(time.Hour)))
+		require.NoError(t, img.Gaussblur(2, nil))
+
+		buf, err := img.WriteToMemory()
+		require.NoError(t, err)
+		assert.Len(t, buf, 100*100*3)
+	})
+
+	t.Run("deadline after an operation timeout", func(t *testing.T) {
+		previous := operationTimeout
+		operationTimeout = time.Hour
+		defer func() {
+			operationTimeout = previous
+		}()
+		img, err := NewBlack(2000, 2000, &BlackOptions{Bands: 3})
+		require.NoError(t, err)
+		defer img.Close()
+
+		require.NoError(t, img.WithDeadline(time.Now().Add(-time.Second)))
+		require.NoError(t, img.Gaussblur(20, nil))
+
+		_, err = img.WriteToMemory()
+		assert.ErrorIs(t, err, ErrOperationTimeout)
+	})
+
+	t.Run("deadline after a context load and an earlier deadline", func(t *testing.T) {
+		src, err := NewBlack(500, 500, &BlackOptions{Bands: 3})
+		require.NoError(t, err)
+		defer src.Close()
+		path := filepath.Join(t.TempDir(), "black.png")
+		require.NoError(t, src.Pngsave(path, nil))
+
+		img, err := NewImageFromFileContext(context.Background(), path, nil)
+		require.NoError(t, err)
+		defer img.Close()
+		require.NoError(t, img.WithDeadline(time.Now().Add(time.Hour)))
+		require.NoError(t, img.WithDeadline(time.Now().Add(-time.Second)))
+		require.NoError(t, img.Gaussblur(20, nil))
+
+		_, err = img.WriteToMemory()
+		assert.ErrorIs(t, err, ErrOperationTimeout)
+	})
+}
+
+func TestNewImageFromFileContext(t *testing.T) {
+	noise, err := NewGaussnoise(3000, 3000, &GaussnoiseOptions{Sigma: 60, Mean: 128})
+	require.NoError(t, err)
+	defer noise.Close()
+	require.NoError(t, noise.Cast(BandFormatUchar, nil))
+	path := filepath.Join(t.TempDir(), "large.tif")
+	require.NoError(t, noise.Tiffsave(path, &TiffsaveOptions{Compression: TiffCompressionDeflate}))
+
+	t.Run("loads into memory", func(t *testing.T) {
+		img, err := NewImageFromFileContext(context.Background(), path, nil)
+		require.NoError(t, err)
+		defer img.Close()
+		assert.Equal(t, ImageTypeTiff, img.Format())
+		assert.Equal(t, 3000, img.Width())
+		_, err = img.Getpoint(2999, 2999, nil)
+		require.NoError(t, err)
+	})
+
+	t.Run("explicit random access", func(t *testing.T) {
+		img, err := NewImageFromFileContext(context.Background(), path, &LoadOptions{Access: AccessRandom})
+		require.NoError(t, err)
+		defer img.Close()
+		assert.Equal(t, 3000, img.Height())
+		_, err = img.Getpoint(0, 0, nil)
+		require.NoError(t, err)
+	})
+
+	t.Run("cancelled during decode", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+		defer cancel()
+		_, err := NewImageFromFileContext(ctx, path, nil)
+		require.Error(t, err)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("cancelled before load", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := NewImageFromFileContext(ctx, path, nil)
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		_, err := NewImageFromFileContext(context.Background(), filepath.Join(t.TempDir(), "missing.tif"), nil)
+		assert.ErrorIs(t, err, ErrFileNotFound)
+	})
+}
+
+func TestImage_ResizeTo(t *testing.T) {
+	t.Run("stretch to exact size", func(t *testing.T) {
+		img, err := createTestGradientImage(t, 400, 300)
+		require.NoError(t, err)
+		defer img.Close()
+
+		require.NoError(t, img.ResizeTo(200, 100, nil))
+		assert.Equal(t, 200, img.Width())
+		assert.Equal(t, 100, img.Height())
+	})
+
+	t.Run("fill and crop", func(t *testing.T) {
+		img, err := createTestGradientImage(t, 400, 300)
+		require.NoError(t, err)
+		defer img.Close()
+
+		require.NoError(t, img.ResizeTo(200, 100, &ResizeToOptions{Crop: InterestingCentre}))
+		assert.Equal(t, 200, img.Width())
+		assert.Equal(t, 100, img.Height())
+	})
+
+	t.Run("preserve aspect ratio", func(t *testing.T) {
+		img, err := createTestGradientImage(t, 400, 300)
+		require.NoError(t, err)
+		defer img.Close()
+
+		require.NoError(t, img.ResizeTo(200, 0, nil))
+		assert.Equal(t, 200, img.Width())
+		assert.Equal(t, 150, img.Height())
+
+		require.NoError(t, img.ResizeTo(0, 50, nil))
+		assert.Equal(t, 67, img.Width())
+		assert.Equal(t, 50, img.Height())
+	})
+
+	t.Run("awkward ratios", func(t *testing.T) {
+		sizes := [][2]int{
+			{333, 77},
+			{1, 1},
+			{401, 299},
+			{123, 457},
+		}
+		for _, size := range sizes {
+			img, err := createTestGradientImage(t, 400, 300)
+			require.NoError(t, err)
+			require.NoError(t, img.ResizeTo(size[0], size[1], nil))
+			assert.Equal(t, size[0], img.Width(), "width for %v", size)
+			assert.Equal(t, size[1], img.Height(), "height for %v", size)
+			img.Close()
+		}
+	})
+
+	t.Run("invalid size", func(t *testing.T) {
+		img, err := createTestGradientImage(t, 40, 30)
+		require.NoError(t, err)
+		defer img.Close()
+		assert.Error(t, img.ResizeTo(0, 0, nil))
+	})
+}
+
+func TestImage_SmartResize(t *testing.T) {
+	for _, strategy := range []Interesting{InterestingAttention, InterestingCentre, InterestingEntropy} {
+		img, err := createTestGradientImage(t, 400, 300)
+		require.NoError(t, err)
+		require.NoError(t, img.SmartResize(200, 200, strategy))
+		assert.Equal(t, 200, img.Width(), "strategy %v", strategy)
+		assert.Equal(t, 200, img.Height(), "strategy %v", strategy)
+		img.Close()
+	}
+
+	t.Run("letterbox", func(t *testing.T) {
+		img, err := createWhiteImage(400, 300)
+		require.NoError(t, err)
+		defer img.Close()
+		require.NoError(t, img.SmartResize(200, 200, InterestingNone))
+		assert.Equal(t, 200, img.Width())
+		assert.Equal(t, 200, img.Height())
+
+		// 400x300 fits as 200x150, centred with 25 pixel bars above and below
+		bar, err := img.Getpoint(100, 10, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []float64{0, 0, 0}, bar)
+		content, err := img.Getpoint(100, 100, nil)
+		require.NoError(t, err)
+		assert.InDeltaSlice(t, []float64{255, 255, 255}, content, 1)
+	})
+
+	img, err := createWhiteImage(40, 30)
+	require.NoError(t, err)
+	defer img.Close()
+	assert.Error(t, img.SmartResize(0, 20, InterestingAttention))
+}
+
+func TestImage_CropPercent(t *testing.T) {
+	img, err := createWhiteImage(400, 300)
+	require.NoError(t, err)
+	defer img.Close()
+
+	require.NoError(t, img.CropPercent(10, 20, 50, 50))
+	assert.Equal(t, 200, img.Width())
+	assert.Equal(t, 150, img.Height())
+
+	require.NoError(t, img.CropPercent(0, 0, 100, 100))
+	assert.Equal(t, 200, img.Width())
+	assert.Equal(t, 150, img.Height())
+
+	assert.Error(t, img.CropPercent(60, 0, 50, 50))
+	assert.Error(t, img.CropPercent(0, 0, 0, 50))
+	assert.Error(t, img.CropPercent(-1, 0, 50, 50))
+	assert.Equal(t, 200, img.Width())
+}
+
+func TestImage_PadTo(t *testing.T) {
+	newRed := func() *Image {
+		data := make([]byte, 0, 50*50*3)
+		for i := 0; i < 50*50; i++ {
+			data = append(data, 255, 0, 0)
+		}
+		img, err := NewImageFromMemory(data, 50, 50, 3)
+		require.NoError(t, err)
+		return img
+	}
+	white := []float64{255, 255, 255}
+	red := []float64{255, 0, 0}
+
+	img := newRed()
+	defer img.Close()
+	require.NoError(t, img.PadTo(100, 100, CompassDirectionCentre, white))
+	assert.Equal(t, 100, img.Width())
+	assert.Equal(t, 100, img.Height())
+	border := [][2]int{
+		{0, 0}, {99, 99}, {50, 10}, {10, 50}, {24, 24}, {75, 75},
+	}
+	for _, point := range border {
+		pixel, err := img.Getpoint(point[0], point[1], nil)
+		require.NoError(t, err)
+		assert.Equal(t, white, pixel, "border at %v should be white", point)
+	}
+	content := [][2]int{
+		{25, 25}, {50, 50}, {74, 74},
+	}
+	for _, point := range content {
+		pixel, err := img.Getpoint(point[0], point[1], nil)
+		require.NoError(t, err)
+		assert.Equal(t, red, pixel, "content at %v should be red", point)
+	}
+
+	t.Run("gravity", func(t *testing.T) {
+		img := newRed()
+		defer img.Close()
+		require.NoError(t, img.PadTo(80, 60, CompassDirectionSouthEast, white))
+		pixel, err := img.Getpoint(79, 59, nil)
+		require.NoError(t, err)
+		assert.Equal(t, red, pixel)
+		pixel, err = img.Getpoint(29, 9, nil)
+		require.NoError(t, err)
+		assert.Equal(t, white, pixel)
+	})
+
+	t.Run("larger than target", func(t *testing.T) {
+		img := newRed()
+		defer img.Close()
+		require.NoError(t, img.PadTo(30, 80, CompassDirectionCentre, white))
+		assert.Equal(t, 50, img.Width(), "width larger than the target should not be cropped")
+		assert.Equal(t, 80, img.Height())
+	})
+}
+
+func TestImage_Shrink(t *testing.T) {
+	img, err := createTestGradientImage(t, 100, 60)
+	require.NoError(t, err)
+	defer img.Close()
+
+	require.NoError(t, img.Shrink(2.0, 2.0, nil))
+	assert.Equal(t, 50, img.Width())
+	assert.Equal(t, 30, img.Height())
+
+	// Shrink factors below 1 would enlarge and are rejected
+	assert.Error(t, img.Shrink(0.5, 0.5, nil))
+	assert.Equal(t, 50, img.Width(), "failed shrink leaves the image untouched")
+}
+
+func TestImage_Reduce(t *testing.T) {
+	reduceWith := func(kernel Kernel) *Image {
+		img, err := createCheckboardImage(t, 100, 100, 5)
+		require.NoError(t, err)
+		require.NoError(t, img.Reduce(2.5, 2.5, &ReduceOptions{Kernel: kernel}))
+		return img
+	}
+
+	linear := reduceWith(KernelLinear)
+	defer linear.Close()
+	lanczos := reduceWith(KernelLanczos3)
+	defer lanczos.Close()
+
+	assert.Equal(t, 40, linear.Width())
+	assert.Equal(t, 40, linear.Height())
+	assert.Equal(t, 40, lanczos.Width())
+	assert.Equal(t, 40, lanczos.Height())
+
+	linearPixels, err := linear.WriteToMemory()
+	require.NoError(t, err)
+	lanczosPixels, err := lanczos.WriteToMemory()
+	require.NoError(t, err)
+	assert.NotEqual(t, linearPixels, lanczosPixels, "the kernel should affect the resampled pixels")
+
+	assert.Error(t, linear.Reduce(0.5, 0.5, nil))
+}
+
+func TestImage_Zoom(t *testing.T) {
+	// 2x2 checkerboard: white, black / black, white
+	img, err := NewImageFromMemory([]byte{255, 0, 0, 255}, 2, 2, 1)
+	require.NoError(t, err)
+	defer img.Close()
+
+	require.NoError(t, img.Zoom(4, 4))
+	assert.Equal(t, 8, img.Width())
+	assert.Equal(t, 8, img.Height())
+
+	for y := 0; y < 8; y++ {
+		for x := 0; x < 8; x++ {
+			expected := 0.0
+			if (x/4+y/4)%2 == 0 {
+				expected = 255
+			}
+			pixel, err := img.Getpoint(x, y, nil)
+			require.NoError(t, err)
+			assert.Equal(t, expected, pixel[0], "pixel (%d,%d) should belong to a 4x4 block", x, y)
+		}
+	}
+
+	assert.Error(t, img.Zoom(0, 1), "zoom factors must be at least 1")
+}
+
+func TestImage_ResizeMultiPage(t *testing.T) {
+	gifData := createTestAnimatedGif(t, 40, 30, 4)
+	img, err := NewImageFromBuffer(gifData, &LoadOptions{N: -1})
+	require.NoError(t, err)
+	defer img.Close()
+	require.Equal(t, 4, img.Pages())
+	require.Equal(t, 30, img.PageHeight())
+
+	require.NoError(t, img.ResizeMultiPage(0.5, nil))
+	assert.Equal(t, 20, img.Width())
+	assert.Equal(t, 15, img.PageHeight(), "page height should be halved")
+	assert.Equal(t, 60, img.Height())
+	assert.Equal(t, 4, img.Pages(), "page count should be preserved")
+
+	// Frames keep their own content without bleeding into neighbours
+	for i := 0; i < 4; i++ {
+		pixel, err := img.Getpoint(10, i*15+7, nil)
+		require.NoError(t, err)
+		assert.InDelta(t, i*50, pixel[0], 2, "frame %d", i)
+	}
+
+	t.Run("single page behaves like Resize", func(t *testing.T) {
+		single, err := createTestGradientImage(t, 40, 30)
+		require.NoError(t, err)
+		defer single.Close()
+
+		require.NoError(t, single.ResizeMultiPage(0.5, nil))
+		assert.Equal(t, 20, single.Width())
+		assert.Equal(t, 15, single.Height())
+		assert.Equal(t, 15, single.PageHeight())
+	})
+}
+
+func TestImage_CompositeMasked(t *testing.T) {
+	base, err := createWhiteImage(100, 50)
+	require.NoError(t, err)
+	defer base.Close()
+
+	overlay, err := createSolidColorImage(t, 100, 50, color.RGBA{255, 0, 0, 255})
+	require.NoError(t, err)
+	defer overlay.Close()
+
+	// Horizontal gradient mask from transparent on the left to opaque on the right
+	maskData := make([]byte, 100*50)
+	for y := 0; y < 50; y++ {
+		for x := 0; x < 100; x++ {
+			maskData[y*100+x] = uint8(x * 255 / 99)
+		}
+	}
+	mask, err := NewImageFromMemory(maskData, 100, 50, 1)
+	require.NoError(t, err)
+	defer mask.Close()
+
+	require.NoError(t, base.CompositeMasked(overlay, mask, 0, 0))
+	assert.Equal(t, 100, base.Width())
+	assert.Equal(t, 50, base.Height())
+
+	// Green falls smoothly from white to red across x
+	previous := 256.0
+	for x := 0; x < 100; x += 11 {
+		pixel, err := base.Getpoint(x, 25, nil)
+		require.NoError(t, err)
+		assert.InDelta(t, 255, pixel[0], 1, "red stays saturated at x=%d", x)
+		assert.Less(t, pixel[1], previous, "green should decrease at x=%d", x)
+		assert.InDelta(t, 255-float64(x*255/99), pixel[1], 3, "green at x=%d follows the mask", x)
+		previous = pixel[1]
+	}
+
+	// The overlay itself is not modified
+	assert.Equal(t, 3, overlay.Bands())
+
+	t.Run("mask must be single band", func(t *testing.T) {
+		rgbMask, err := createWhiteImage(100, 50)
+		require.NoError(t, err)
+		defer rgbMask.Close()
+		assert.Error(t, base.CompositeMasked(overlay, rgbMask, 0, 0))
+	})
+
+	t.Run("mask must match overlay size", func(t *testing.T) {
+		small, err := NewImageFromMemory(make([]byte, 10*10), 10, 10, 1)
+		require.NoError(t, err)
+		defer small.Close()
+		assert.Error(t, base.CompositeMasked(overlay, small, 0, 0))
+	})
 }
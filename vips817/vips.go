@@ -5,6 +5,7 @@ package vips
 import "C"
 import (
 	"runtime"
+	"time"
 	"unsafe"
 )
 
@@ -13,7 +14,7 @@ import (
 func vipsgenCMC2LCh(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_CMC2LCh(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("CMC2LCh", out)
 	}
 	return out, nil
 }
@@ -22,7 +23,7 @@ func vipsgenCMC2LCh(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenCMYK2XYZ(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_CMYK2XYZ(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("CMYK2XYZ", out)
 	}
 	return out, nil
 }
@@ -31,7 +32,7 @@ func vipsgenCMYK2XYZ(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenHSV2sRGB(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_HSV2sRGB(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("HSV2sRGB", out)
 	}
 	return out, nil
 }
@@ -40,7 +41,7 @@ func vipsgenHSV2sRGB(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenLCh2CMC(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_LCh2CMC(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("LCh2CMC", out)
 	}
 	return out, nil
 }
@@ -49,7 +50,7 @@ func vipsgenLCh2CMC(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenLCh2Lab(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_LCh2Lab(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("LCh2Lab", out)
 	}
 	return out, nil
 }
@@ -58,7 +59,7 @@ func vipsgenLCh2Lab(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenLab2LCh(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_Lab2LCh(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("Lab2LCh", out)
 	}
 	return out, nil
 }
@@ -67,7 +68,7 @@ func vipsgenLab2LCh(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenLab2LabQ(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_Lab2LabQ(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("Lab2LabQ", out)
 	}
 	return out, nil
 }
@@ -76,7 +77,7 @@ func vipsgenLab2LabQ(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenLab2LabS(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_Lab2LabS(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("Lab2LabS", out)
 	}
 	return out, nil
 }
@@ -85,7 +86,7 @@ func vipsgenLab2LabS(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenLab2XYZ(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_Lab2XYZ(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("Lab2XYZ", out)
 	}
 	return out, nil
 }
@@ -101,7 +102,7 @@ func vipsgenLab2XYZWithOptions(in *C.VipsImage, temp []float64) (*C.VipsImage, e
 		defer freeDoubleArray(ctemp)
 	}
 	if err := C.vipsgen_Lab2XYZ_with_options(in, &out, ctemp, ctempLength); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("Lab2XYZ", out)
 	}
 	return out, nil
 }
@@ -110,7 +111,7 @@ func vipsgenLab2XYZWithOptions(in *C.VipsImage, temp []float64) (*C.VipsImage, e
 func vipsgenLabQ2Lab(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_LabQ2Lab(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("LabQ2Lab", out)
 	}
 	return out, nil
 }
@@ -119,7 +120,7 @@ func vipsgenLabQ2Lab(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenLabQ2LabS(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_LabQ2LabS(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("LabQ2LabS", out)
 	}
 	return out, nil
 }
@@ -128,7 +129,7 @@ func vipsgenLabQ2LabS(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenLabQ2sRGB(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_LabQ2sRGB(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("LabQ2sRGB", out)
 	}
 	return out, nil
 }
@@ -137,7 +138,7 @@ func vipsgenLabQ2sRGB(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenLabS2Lab(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_LabS2Lab(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("LabS2Lab", out)
 	}
 	return out, nil
 }
@@ -146,7 +147,7 @@ func vipsgenLabS2Lab(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenLabS2LabQ(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_LabS2LabQ(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("LabS2LabQ", out)
 	}
 	return out, nil
 }
@@ -155,7 +156,7 @@ func vipsgenLabS2LabQ(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenXYZ2CMYK(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_XYZ2CMYK(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("XYZ2CMYK", out)
 	}
 	return out, nil
 }
@@ -164,7 +165,7 @@ func vipsgenXYZ2CMYK(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenXYZ2Lab(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_XYZ2Lab(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("XYZ2Lab", out)
 	}
 	return out, nil
 }
@@ -180,7 +181,7 @@ func vipsgenXYZ2LabWithOptions(in *C.VipsImage, temp []float64) (*C.VipsImage, e
 		defer freeDoubleArray(ctemp)
 	}
 	if err := C.vipsgen_XYZ2Lab_with_options(in, &out, ctemp, ctempLength); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("XYZ2Lab", out)
 	}
 	return out, nil
 }
@@ -189,7 +190,7 @@ func vipsgenXYZ2LabWithOptions(in *C.VipsImage, temp []float64) (*C.VipsImage, e
 func vipsgenXYZ2Yxy(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_XYZ2Yxy(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("XYZ2Yxy", out)
 	}
 	return out, nil
 }
@@ -198,7 +199,7 @@ func vipsgenXYZ2Yxy(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenXYZ2scRGB(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_XYZ2scRGB(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("XYZ2scRGB", out)
 	}
 	return out, nil
 }
@@ -207,7 +208,7 @@ func vipsgenXYZ2scRGB(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenYxy2XYZ(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_Yxy2XYZ(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("Yxy2XYZ", out)
 	}
 	return out, nil
 }
@@ -216,7 +217,7 @@ func vipsgenYxy2XYZ(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenAbs(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_abs(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("abs", out)
 	}
 	return out, nil
 }
@@ -225,7 +226,7 @@ func vipsgenAbs(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenAdd(left *C.VipsImage, right *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_add(left, right, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("add", out)
 	}
 	return out, nil
 }
@@ -234,7 +235,7 @@ func vipsgenAdd(left *C.VipsImage, right *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenAddalpha(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_addalpha(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("addalpha", out)
 	}
 	return out, nil
 }
@@ -243,7 +244,7 @@ func vipsgenAddalpha(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenAffine(in *C.VipsImage, a float64, b float64, c float64, d float64) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_affine(in, &out, C.double(a), C.double(b), C.double(c), C.double(d)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("affine", out)
 	}
 	return out, nil
 }
@@ -266,7 +267,7 @@ func vipsgenAffineWithOptions(in *C.VipsImage, a float64, b float64, c float64,
 		defer freeDoubleArray(cbackground)
 	}
 	if err := C.vipsgen_affine_with_options(in, &out, C.double(a), C.double(b), C.double(c), C.double(d), vipsInterpolateToC(interpolate), coarea, coareaLength, C.double(odx), C.double(ody), C.double(idx), C.double(idy), cbackground, cbackgroundLength, C.int(boolToInt(premultiplied)), C.VipsExtend(extend)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("affine", out)
 	}
 	return out, nil
 }
@@ -277,7 +278,7 @@ func vipsgenAnalyzeload(filename string) (*C.VipsImage, error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_analyzeload(cfilename, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("analyzeload", out)
 	}
 	return out, nil
 }
@@ -288,7 +289,7 @@ func vipsgenAnalyzeloadWithOptions(filename string, memory bool, access Access,
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_analyzeload_with_options(cfilename, &out, C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("analyzeload", out)
 	}
 	return out, nil
 }
@@ -307,7 +308,7 @@ func vipsgenArrayjoin(in []*C.VipsImage) (*C.VipsImage, error) {
 		defer freeImageArray(cin)
 	}
 	if err := C.vipsgen_arrayjoin((**C.VipsImage)(cin), &out, C.int(len(in))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("arrayjoin", out)
 	}
 	return out, nil
 }
@@ -333,7 +334,7 @@ func vipsgenArrayjoinWithOptions(in []*C.VipsImage, across int, shim int, backgr
 		defer freeDoubleArray(cbackground)
 	}
 	if err := C.vipsgen_arrayjoin_with_options(cin, &out, C.int(len(in)), C.gint(across), C.gint(shim), cbackground, cbackgroundLength, C.VipsAlign(halign), C.VipsAlign(valign), C.gint(hspacing), C.gint(vspacing)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("arrayjoin", out)
 	}
 	return out, nil
 }
@@ -342,7 +343,7 @@ func vipsgenArrayjoinWithOptions(in []*C.VipsImage, across int, shim int, backgr
 func vipsgenAutorot(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_autorot(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("autorot", out)
 	}
 	return out, nil
 }
@@ -356,7 +357,7 @@ func vipsgenAutorotWithOptions(in *C.VipsImage, flip *bool) (*C.VipsImage, error
 		cflip = &cflipValue
 	}
 	if err := C.vipsgen_autorot_with_options(in, &out, cflip); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("autorot", out)
 	}
 	if flip != nil {
 		*flip = cflipValue != 0
@@ -369,7 +370,7 @@ func vipsgenAvg(in *C.VipsImage) (float64, error) {
 	var out float64
 	cout := new(C.double)
 	if err := C.vipsgen_avg(in, cout); err != 0 {
-		return 0, handleVipsError()
+		return 0, handleVipsError("avg")
 	}
 	out = float64(*cout)
 	return out, nil
@@ -379,7 +380,7 @@ func vipsgenAvg(in *C.VipsImage) (float64, error) {
 func vipsgenBandbool(in *C.VipsImage, boolean OperationBoolean) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_bandbool(in, &out, C.VipsOperationBoolean(boolean)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("bandbool", out)
 	}
 	return out, nil
 }
@@ -388,7 +389,7 @@ func vipsgenBandbool(in *C.VipsImage, boolean OperationBoolean) (*C.VipsImage, e
 func vipsgenBandfold(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_bandfold(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("bandfold", out)
 	}
 	return out, nil
 }
@@ -397,7 +398,7 @@ func vipsgenBandfold(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenBandfoldWithOptions(in *C.VipsImage, factor int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_bandfold_with_options(in, &out, C.gint(factor)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("bandfold", out)
 	}
 	return out, nil
 }
@@ -416,7 +417,7 @@ func vipsgenBandjoin(in []*C.VipsImage) (*C.VipsImage, error) {
 		defer freeImageArray(cin)
 	}
 	if err := C.vipsgen_bandjoin((**C.VipsImage)(cin), &out, C.int(len(in))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("bandjoin", out)
 	}
 	return out, nil
 }
@@ -435,7 +436,7 @@ func vipsgenBandjoinConst(in *C.VipsImage, c []float64) (*C.VipsImage, error) {
 		defer freeDoubleArray(cc)
 	}
 	if err := C.vipsgen_bandjoin_const(in, &out, cc, C.int(len(c))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("bandjoin_const", out)
 	}
 	return out, nil
 }
@@ -444,7 +445,7 @@ func vipsgenBandjoinConst(in *C.VipsImage, c []float64) (*C.VipsImage, error) {
 func vipsgenBandmean(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_bandmean(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("bandmean", out)
 	}
 	return out, nil
 }
@@ -463,7 +464,7 @@ func vipsgenBandrank(in []*C.VipsImage) (*C.VipsImage, error) {
 		defer freeImageArray(cin)
 	}
 	if err := C.vipsgen_bandrank((**C.VipsImage)(cin), &out, C.int(len(in))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("bandrank", out)
 	}
 	return out, nil
 }
@@ -482,7 +483,7 @@ func vipsgenBandrankWithOptions(in []*C.VipsImage, index int) (*C.VipsImage, err
 		defer freeImageArray(cin)
 	}
 	if err := C.vipsgen_bandrank_with_options(cin, &out, C.int(len(in)), C.gint(index)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("bandrank", out)
 	}
 	return out, nil
 }
@@ -491,7 +492,7 @@ func vipsgenBandrankWithOptions(in []*C.VipsImage, index int) (*C.VipsImage, err
 func vipsgenBandunfold(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_bandunfold(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("bandunfold", out)
 	}
 	return out, nil
 }
@@ -500,7 +501,7 @@ func vipsgenBandunfold(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenBandunfoldWithOptions(in *C.VipsImage, factor int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_bandunfold_with_options(in, &out, C.gint(factor)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("bandunfold", out)
 	}
 	return out, nil
 }
@@ -509,7 +510,7 @@ func vipsgenBandunfoldWithOptions(in *C.VipsImage, factor int) (*C.VipsImage, er
 func vipsgenBlack(width int, height int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_black(&out, C.gint(width), C.gint(height)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("black", out)
 	}
 	return out, nil
 }
@@ -518,7 +519,7 @@ func vipsgenBlack(width int, height int) (*C.VipsImage, error) {
 func vipsgenBlackWithOptions(width int, height int, bands int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_black_with_options(&out, C.gint(width), C.gint(height), C.gint(bands)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("black", out)
 	}
 	return out, nil
 }
@@ -527,7 +528,7 @@ func vipsgenBlackWithOptions(width int, height int, bands int) (*C.VipsImage, er
 func vipsgenBoolean(left *C.VipsImage, right *C.VipsImage, boolean OperationBoolean) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_boolean(left, right, &out, C.VipsOperationBoolean(boolean)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("boolean", out)
 	}
 	return out, nil
 }
@@ -546,7 +547,7 @@ func vipsgenBooleanConst(in *C.VipsImage, boolean OperationBoolean, c []float64)
 		defer freeDoubleArray(cc)
 	}
 	if err := C.vipsgen_boolean_const(in, &out, C.VipsOperationBoolean(boolean), cc, C.int(len(c))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("boolean_const", out)
 	}
 	return out, nil
 }
@@ -555,7 +556,7 @@ func vipsgenBooleanConst(in *C.VipsImage, boolean OperationBoolean, c []float64)
 func vipsgenBuildlut(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_buildlut(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("buildlut", out)
 	}
 	return out, nil
 }
@@ -564,7 +565,7 @@ func vipsgenBuildlut(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenByteswap(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_byteswap(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("byteswap", out)
 	}
 	return out, nil
 }
@@ -573,7 +574,7 @@ func vipsgenByteswap(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenCanny(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_canny(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("canny", out)
 	}
 	return out, nil
 }
@@ -582,7 +583,7 @@ func vipsgenCanny(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenCannyWithOptions(in *C.VipsImage, sigma float64, precision Precision) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_canny_with_options(in, &out, C.double(sigma), C.VipsPrecision(precision)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("canny", out)
 	}
 	return out, nil
 }
@@ -601,7 +602,7 @@ func vipsgenCase(index *C.VipsImage, cases []*C.VipsImage) (*C.VipsImage, error)
 		defer freeImageArray(ccases)
 	}
 	if err := C.vipsgen_case(index, (**C.VipsImage)(ccases), &out, C.int(len(cases))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("case", out)
 	}
 	return out, nil
 }
@@ -610,7 +611,7 @@ func vipsgenCase(index *C.VipsImage, cases []*C.VipsImage) (*C.VipsImage, error)
 func vipsgenCast(in *C.VipsImage, format BandFormat) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_cast(in, &out, C.VipsBandFormat(format)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("cast", out)
 	}
 	return out, nil
 }
@@ -619,7 +620,7 @@ func vipsgenCast(in *C.VipsImage, format BandFormat) (*C.VipsImage, error) {
 func vipsgenCastWithOptions(in *C.VipsImage, format BandFormat, shift bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_cast_with_options(in, &out, C.VipsBandFormat(format), C.int(boolToInt(shift))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("cast", out)
 	}
 	return out, nil
 }
@@ -628,7 +629,7 @@ func vipsgenCastWithOptions(in *C.VipsImage, format BandFormat, shift bool) (*C.
 func vipsgenClamp(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_clamp(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("clamp", out)
 	}
 	return out, nil
 }
@@ -637,7 +638,7 @@ func vipsgenClamp(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenClampWithOptions(in *C.VipsImage, min float64, max float64) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_clamp_with_options(in, &out, C.double(min), C.double(max)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("clamp", out)
 	}
 	return out, nil
 }
@@ -646,7 +647,7 @@ func vipsgenClampWithOptions(in *C.VipsImage, min float64, max float64) (*C.Vips
 func vipsgenColourspace(in *C.VipsImage, space Interpretation) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_colourspace(in, &out, C.VipsInterpretation(space)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("colourspace", out)
 	}
 	return out, nil
 }
@@ -655,7 +656,7 @@ func vipsgenColourspace(in *C.VipsImage, space Interpretation) (*C.VipsImage, er
 func vipsgenColourspaceWithOptions(in *C.VipsImage, space Interpretation, sourceSpace Interpretation) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_colourspace_with_options(in, &out, C.VipsInterpretation(space), C.VipsInterpretation(sourceSpace)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("colourspace", out)
 	}
 	return out, nil
 }
@@ -664,7 +665,7 @@ func vipsgenColourspaceWithOptions(in *C.VipsImage, space Interpretation, source
 func vipsgenCompass(in *C.VipsImage, mask *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_compass(in, &out, mask); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("compass", out)
 	}
 	return out, nil
 }
@@ -673,7 +674,7 @@ func vipsgenCompass(in *C.VipsImage, mask *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenCompassWithOptions(in *C.VipsImage, mask *C.VipsImage, times int, angle Angle45, combine Combine, precision Precision, layers int, cluster int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_compass_with_options(in, &out, mask, C.gint(times), C.VipsAngle45(angle), C.VipsCombine(combine), C.VipsPrecision(precision), C.gint(layers), C.gint(cluster)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("compass", out)
 	}
 	return out, nil
 }
@@ -682,7 +683,7 @@ func vipsgenCompassWithOptions(in *C.VipsImage, mask *C.VipsImage, times int, an
 func vipsgenComplex(in *C.VipsImage, cmplx OperationComplex) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_complex(in, &out, C.VipsOperationComplex(cmplx)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("complex", out)
 	}
 	return out, nil
 }
@@ -691,7 +692,7 @@ func vipsgenComplex(in *C.VipsImage, cmplx OperationComplex) (*C.VipsImage, erro
 func vipsgenComplex2(left *C.VipsImage, right *C.VipsImage, cmplx OperationComplex2) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_complex2(left, right, &out, C.VipsOperationComplex2(cmplx)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("complex2", out)
 	}
 	return out, nil
 }
@@ -700,7 +701,7 @@ func vipsgenComplex2(left *C.VipsImage, right *C.VipsImage, cmplx OperationCompl
 func vipsgenComplexform(left *C.VipsImage, right *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_complexform(left, right, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("complexform", out)
 	}
 	return out, nil
 }
@@ -709,7 +710,7 @@ func vipsgenComplexform(left *C.VipsImage, right *C.VipsImage) (*C.VipsImage, er
 func vipsgenComplexget(in *C.VipsImage, get OperationComplexget) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_complexget(in, &out, C.VipsOperationComplexget(get)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("complexget", out)
 	}
 	return out, nil
 }
@@ -738,7 +739,7 @@ func vipsgenComposite(in []*C.VipsImage, mode []BlendMode) (*C.VipsImage, error)
 		defer freeIntArray(cmode)
 	}
 	if err := C.vipsgen_composite((**C.VipsImage)(cin), &out, C.int(len(in)), cmode); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("composite", out)
 	}
 	return out, nil
 }
@@ -781,7 +782,7 @@ func vipsgenCompositeWithOptions(in []*C.VipsImage, mode []BlendMode, x []int, y
 		defer freeIntArray(cy)
 	}
 	if err := C.vipsgen_composite_with_options(cin, &out, C.int(len(in)), cmode, cx, cxLength, cy, cyLength, C.VipsInterpretation(compositingSpace), C.int(boolToInt(premultiplied))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("composite", out)
 	}
 	return out, nil
 }
@@ -790,7 +791,7 @@ func vipsgenCompositeWithOptions(in []*C.VipsImage, mode []BlendMode, x []int, y
 func vipsgenComposite2(base *C.VipsImage, overlay *C.VipsImage, mode BlendMode) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_composite2(base, overlay, &out, C.VipsBlendMode(mode)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("composite2", out)
 	}
 	return out, nil
 }
@@ -799,7 +800,7 @@ func vipsgenComposite2(base *C.VipsImage, overlay *C.VipsImage, mode BlendMode)
 func vipsgenComposite2WithOptions(base *C.VipsImage, overlay *C.VipsImage, mode BlendMode, x int, y int, compositingSpace Interpretation, premultiplied bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_composite2_with_options(base, overlay, &out, C.VipsBlendMode(mode), C.gint(x), C.gint(y), C.VipsInterpretation(compositingSpace), C.int(boolToInt(premultiplied))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("composite2", out)
 	}
 	return out, nil
 }
@@ -808,7 +809,7 @@ func vipsgenComposite2WithOptions(base *C.VipsImage, overlay *C.VipsImage, mode
 func vipsgenConv(in *C.VipsImage, mask *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_conv(in, &out, mask); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("conv", out)
 	}
 	return out, nil
 }
@@ -817,7 +818,7 @@ func vipsgenConv(in *C.VipsImage, mask *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenConvWithOptions(in *C.VipsImage, mask *C.VipsImage, precision Precision, layers int, cluster int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_conv_with_options(in, &out, mask, C.VipsPrecision(precision), C.gint(layers), C.gint(cluster)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("conv", out)
 	}
 	return out, nil
 }
@@ -826,7 +827,7 @@ func vipsgenConvWithOptions(in *C.VipsImage, mask *C.VipsImage, precision Precis
 func vipsgenConva(in *C.VipsImage, mask *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_conva(in, &out, mask); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("conva", out)
 	}
 	return out, nil
 }
@@ -835,7 +836,7 @@ func vipsgenConva(in *C.VipsImage, mask *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenConvaWithOptions(in *C.VipsImage, mask *C.VipsImage, layers int, cluster int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_conva_with_options(in, &out, mask, C.gint(layers), C.gint(cluster)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("conva", out)
 	}
 	return out, nil
 }
@@ -844,7 +845,7 @@ func vipsgenConvaWithOptions(in *C.VipsImage, mask *C.VipsImage, layers int, clu
 func vipsgenConvasep(in *C.VipsImage, mask *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_convasep(in, &out, mask); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("convasep", out)
 	}
 	return out, nil
 }
@@ -853,7 +854,7 @@ func vipsgenConvasep(in *C.VipsImage, mask *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenConvasepWithOptions(in *C.VipsImage, mask *C.VipsImage, layers int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_convasep_with_options(in, &out, mask, C.gint(layers)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("convasep", out)
 	}
 	return out, nil
 }
@@ -862,7 +863,7 @@ func vipsgenConvasepWithOptions(in *C.VipsImage, mask *C.VipsImage, layers int)
 func vipsgenConvf(in *C.VipsImage, mask *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_convf(in, &out, mask); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("convf", out)
 	}
 	return out, nil
 }
@@ -871,7 +872,7 @@ func vipsgenConvf(in *C.VipsImage, mask *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenConvi(in *C.VipsImage, mask *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_convi(in, &out, mask); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("convi", out)
 	}
 	return out, nil
 }
@@ -880,7 +881,7 @@ func vipsgenConvi(in *C.VipsImage, mask *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenConvsep(in *C.VipsImage, mask *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_convsep(in, &out, mask); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("convsep", out)
 	}
 	return out, nil
 }
@@ -889,7 +890,7 @@ func vipsgenConvsep(in *C.VipsImage, mask *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenConvsepWithOptions(in *C.VipsImage, mask *C.VipsImage, precision Precision, layers int, cluster int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_convsep_with_options(in, &out, mask, C.VipsPrecision(precision), C.gint(layers), C.gint(cluster)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("convsep", out)
 	}
 	return out, nil
 }
@@ -898,7 +899,7 @@ func vipsgenConvsepWithOptions(in *C.VipsImage, mask *C.VipsImage, precision Pre
 func vipsgenCopy(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_copy(in, &out); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("copy")
 	}
 	return out, nil
 }
@@ -907,7 +908,7 @@ func vipsgenCopy(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenCopyWithOptions(in *C.VipsImage, width int, height int, bands int, format BandFormat, coding Coding, interpretation Interpretation, xres float64, yres float64, xoffset int, yoffset int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_copy_with_options(in, &out, C.gint(width), C.gint(height), C.gint(bands), C.VipsBandFormat(format), C.VipsCoding(coding), C.VipsInterpretation(interpretation), C.double(xres), C.double(yres), C.gint(xoffset), C.gint(yoffset)); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("copy")
 	}
 	return out, nil
 }
@@ -917,7 +918,7 @@ func vipsgenCountlines(in *C.VipsImage, direction Direction) (float64, error) {
 	var nolines float64
 	cnolines := new(C.double)
 	if err := C.vipsgen_countlines(in, cnolines, C.VipsDirection(direction)); err != 0 {
-		return 0, handleVipsError()
+		return 0, handleVipsError("countlines")
 	}
 	nolines = float64(*cnolines)
 	return nolines, nil
@@ -929,7 +930,7 @@ func vipsgenCsvload(filename string) (*C.VipsImage, error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_csvload(cfilename, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("csvload", out)
 	}
 	return out, nil
 }
@@ -944,7 +945,7 @@ func vipsgenCsvloadWithOptions(filename string, skip int, lines int, whitespace
 	cseparator := C.CString(separator)
 	defer freeCString(cseparator)
 	if err := C.vipsgen_csvload_with_options(cfilename, &out, C.gint(skip), C.gint(lines), cwhitespace, cseparator, C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("csvload", out)
 	}
 	return out, nil
 }
@@ -953,7 +954,7 @@ func vipsgenCsvloadWithOptions(filename string, skip int, lines int, whitespace
 func vipsgenCsvloadSource(source *C.VipsSourceCustom) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_csvload_source(source, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("csvload_source", out)
 	}
 	return out, nil
 }
@@ -966,7 +967,7 @@ func vipsgenCsvloadSourceWithOptions(source *C.VipsSourceCustom, skip int, lines
 	cseparator := C.CString(separator)
 	defer freeCString(cseparator)
 	if err := C.vipsgen_csvload_source_with_options(source, &out, C.gint(skip), C.gint(lines), cwhitespace, cseparator, C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("csvload_source", out)
 	}
 	return out, nil
 }
@@ -976,7 +977,7 @@ func vipsgenCsvsave(in *C.VipsImage, filename string) (error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_csvsave(in, cfilename); err != 0 {
-		return handleVipsError()
+		return handleVipsError("csvsave")
 	}
 	return nil
 }
@@ -997,7 +998,7 @@ func vipsgenCsvsaveWithOptions(in *C.VipsImage, filename string, separator strin
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_csvsave_with_options(in, cfilename, cseparator, C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return handleVipsError()
+		return handleVipsError("csvsave")
 	}
 	return nil
 }
@@ -1006,7 +1007,7 @@ func vipsgenCsvsaveWithOptions(in *C.VipsImage, filename string, separator strin
 func vipsgenCsvsaveTarget(in *C.VipsImage, target *C.VipsTargetCustom) (error) {
 	
 	if err := C.vipsgen_csvsave_target(in, target); err != 0 {
-		return handleVipsError()
+		return handleVipsError("csvsave_target")
 	}
 	return nil
 }
@@ -1025,7 +1026,7 @@ func vipsgenCsvsaveTargetWithOptions(in *C.VipsImage, target *C.VipsTargetCustom
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_csvsave_target_with_options(in, target, cseparator, C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return handleVipsError()
+		return handleVipsError("csvsave_target")
 	}
 	return nil
 }
@@ -1034,7 +1035,7 @@ func vipsgenCsvsaveTargetWithOptions(in *C.VipsImage, target *C.VipsTargetCustom
 func vipsgenDE00(left *C.VipsImage, right *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_dE00(left, right, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("dE00", out)
 	}
 	return out, nil
 }
@@ -1043,7 +1044,7 @@ func vipsgenDE00(left *C.VipsImage, right *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenDE76(left *C.VipsImage, right *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_dE76(left, right, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("dE76", out)
 	}
 	return out, nil
 }
@@ -1052,7 +1053,7 @@ func vipsgenDE76(left *C.VipsImage, right *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenDECMC(left *C.VipsImage, right *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_dECMC(left, right, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("dECMC", out)
 	}
 	return out, nil
 }
@@ -1062,7 +1063,7 @@ func vipsgenDeviate(in *C.VipsImage) (float64, error) {
 	var out float64
 	cout := new(C.double)
 	if err := C.vipsgen_deviate(in, cout); err != 0 {
-		return 0, handleVipsError()
+		return 0, handleVipsError("deviate")
 	}
 	out = float64(*cout)
 	return out, nil
@@ -1072,7 +1073,7 @@ func vipsgenDeviate(in *C.VipsImage) (float64, error) {
 func vipsgenDivide(left *C.VipsImage, right *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_divide(left, right, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("divide", out)
 	}
 	return out, nil
 }
@@ -1090,7 +1091,7 @@ func vipsgenDrawCircle(image *C.VipsImage, ink []float64, cx int, cy int, radius
 		defer freeDoubleArray(cink)
 	}
 	if err := C.vipsgen_draw_circle(image, cink, C.int(len(ink)), C.gint(cx), C.gint(cy), C.gint(radius)); err != 0 {
-		return handleVipsError()
+		return handleVipsError("draw_circle")
 	}
 	return nil
 }
@@ -1108,7 +1109,7 @@ func vipsgenDrawCircleWithOptions(image *C.VipsImage, ink []float64, cx int, cy
 		defer freeDoubleArray(cink)
 	}
 	if err := C.vipsgen_draw_circle_with_options(image, cink, C.int(len(ink)), C.gint(cx), C.gint(cy), C.gint(radius), C.int(boolToInt(fill))); err != 0 {
-		return handleVipsError()
+		return handleVipsError("draw_circle")
 	}
 	return nil
 }
@@ -1126,7 +1127,7 @@ func vipsgenDrawFlood(image *C.VipsImage, ink []float64, x int, y int) (error) {
 		defer freeDoubleArray(cink)
 	}
 	if err := C.vipsgen_draw_flood(image, cink, C.int(len(ink)), C.gint(x), C.gint(y)); err != 0 {
-		return handleVipsError()
+		return handleVipsError("draw_flood")
 	}
 	return nil
 }
@@ -1164,7 +1165,7 @@ func vipsgenDrawFloodWithOptions(image *C.VipsImage, ink []float64, x int, y int
 		cheight = &cheightValue
 	}
 	if err := C.vipsgen_draw_flood_with_options(image, cink, C.int(len(ink)), C.gint(x), C.gint(y), test, C.int(boolToInt(equal)), cleft, ctop, cwidth, cheight); err != 0 {
-		return handleVipsError()
+		return handleVipsError("draw_flood")
 	}
 	if left != nil {
 		*left = int(cleftValue)
@@ -1185,7 +1186,7 @@ func vipsgenDrawFloodWithOptions(image *C.VipsImage, ink []float64, x int, y int
 func vipsgenDrawImage(image *C.VipsImage, sub *C.VipsImage, x int, y int) (error) {
 	
 	if err := C.vipsgen_draw_image(image, sub, C.gint(x), C.gint(y)); err != 0 {
-		return handleVipsError()
+		return handleVipsError("draw_image")
 	}
 	return nil
 }
@@ -1194,7 +1195,7 @@ func vipsgenDrawImage(image *C.VipsImage, sub *C.VipsImage, x int, y int) (error
 func vipsgenDrawImageWithOptions(image *C.VipsImage, sub *C.VipsImage, x int, y int, mode CombineMode) (error) {
 	
 	if err := C.vipsgen_draw_image_with_options(image, sub, C.gint(x), C.gint(y), C.VipsCombineMode(mode)); err != 0 {
-		return handleVipsError()
+		return handleVipsError("draw_image")
 	}
 	return nil
 }
@@ -1212,7 +1213,7 @@ func vipsgenDrawLine(image *C.VipsImage, ink []float64, x1 int, y1 int, x2 int,
 		defer freeDoubleArray(cink)
 	}
 	if err := C.vipsgen_draw_line(image, cink, C.int(len(ink)), C.gint(x1), C.gint(y1), C.gint(x2), C.gint(y2)); err != 0 {
-		return handleVipsError()
+		return handleVipsError("draw_line")
 	}
 	return nil
 }
@@ -1230,7 +1231,7 @@ func vipsgenDrawMask(image *C.VipsImage, ink []float64, mask *C.VipsImage, x int
 		defer freeDoubleArray(cink)
 	}
 	if err := C.vipsgen_draw_mask(image, cink, C.int(len(ink)), mask, C.gint(x), C.gint(y)); err != 0 {
-		return handleVipsError()
+		return handleVipsError("draw_mask")
 	}
 	return nil
 }
@@ -1248,7 +1249,7 @@ func vipsgenDrawRect(image *C.VipsImage, ink []float64, left int, top int, width
 		defer freeDoubleArray(cink)
 	}
 	if err := C.vipsgen_draw_rect(image, cink, C.int(len(ink)), C.gint(left), C.gint(top), C.gint(width), C.gint(height)); err != 0 {
-		return handleVipsError()
+		return handleVipsError("draw_rect")
 	}
 	return nil
 }
@@ -1266,7 +1267,7 @@ func vipsgenDrawRectWithOptions(image *C.VipsImage, ink []float64, left int, top
 		defer freeDoubleArray(cink)
 	}
 	if err := C.vipsgen_draw_rect_with_options(image, cink, C.int(len(ink)), C.gint(left), C.gint(top), C.gint(width), C.gint(height), C.int(boolToInt(fill))); err != 0 {
-		return handleVipsError()
+		return handleVipsError("draw_rect")
 	}
 	return nil
 }
@@ -1275,7 +1276,7 @@ func vipsgenDrawRectWithOptions(image *C.VipsImage, ink []float64, left int, top
 func vipsgenDrawSmudge(image *C.VipsImage, left int, top int, width int, height int) (error) {
 	
 	if err := C.vipsgen_draw_smudge(image, C.gint(left), C.gint(top), C.gint(width), C.gint(height)); err != 0 {
-		return handleVipsError()
+		return handleVipsError("draw_smudge")
 	}
 	return nil
 }
@@ -1285,7 +1286,7 @@ func vipsgenDzsave(in *C.VipsImage, filename string) (error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_dzsave(in, cfilename); err != 0 {
-		return handleVipsError()
+		return handleVipsError("dzsave")
 	}
 	return nil
 }
@@ -1310,7 +1311,7 @@ func vipsgenDzsaveWithOptions(in *C.VipsImage, filename string, imagename string
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_dzsave_with_options(in, cfilename, cimagename, C.VipsForeignDzLayout(layout), csuffix, C.gint(overlap), C.gint(tileSize), C.int(boolToInt(centre)), C.VipsForeignDzDepth(depth), C.VipsAngle(angle), C.VipsForeignDzContainer(container), C.gint(compression), C.VipsRegionShrink(regionShrink), C.gint(skipBlanks), cid, C.gint(q), C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return handleVipsError()
+		return handleVipsError("dzsave")
 	}
 	return nil
 }
@@ -1320,7 +1321,7 @@ func vipsgenDzsaveBuffer(in *C.VipsImage) ([]byte, error) {
 	var buf unsafe.Pointer
 	var length C.size_t
 	if err := C.vipsgen_dzsave_buffer(in, &buf, &length); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("dzsave_buffer")
 	}
 	return bufferToBytes(buf, length), nil
 }
@@ -1345,7 +1346,7 @@ func vipsgenDzsaveBufferWithOptions(in *C.VipsImage, imagename string, layout Dz
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_dzsave_buffer_with_options(in, &buf, &length, cimagename, C.VipsForeignDzLayout(layout), csuffix, C.gint(overlap), C.gint(tileSize), C.int(boolToInt(centre)), C.VipsForeignDzDepth(depth), C.VipsAngle(angle), C.VipsForeignDzContainer(container), C.gint(compression), C.VipsRegionShrink(regionShrink), C.gint(skipBlanks), cid, C.gint(q), C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("dzsave_buffer")
 	}
 	return bufferToBytes(buf, length), nil
 }
@@ -1354,7 +1355,7 @@ func vipsgenDzsaveBufferWithOptions(in *C.VipsImage, imagename string, layout Dz
 func vipsgenDzsaveTarget(in *C.VipsImage, target *C.VipsTargetCustom) (error) {
 	
 	if err := C.vipsgen_dzsave_target(in, target); err != 0 {
-		return handleVipsError()
+		return handleVipsError("dzsave_target")
 	}
 	return nil
 }
@@ -1377,7 +1378,7 @@ func vipsgenDzsaveTargetWithOptions(in *C.VipsImage, target *C.VipsTargetCustom,
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_dzsave_target_with_options(in, target, cimagename, C.VipsForeignDzLayout(layout), csuffix, C.gint(overlap), C.gint(tileSize), C.int(boolToInt(centre)), C.VipsForeignDzDepth(depth), C.VipsAngle(angle), C.VipsForeignDzContainer(container), C.gint(compression), C.VipsRegionShrink(regionShrink), C.gint(skipBlanks), cid, C.gint(q), C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return handleVipsError()
+		return handleVipsError("dzsave_target")
 	}
 	return nil
 }
@@ -1386,7 +1387,7 @@ func vipsgenDzsaveTargetWithOptions(in *C.VipsImage, target *C.VipsTargetCustom,
 func vipsgenEmbed(in *C.VipsImage, x int, y int, width int, height int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_embed(in, &out, C.gint(x), C.gint(y), C.gint(width), C.gint(height)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("embed", out)
 	}
 	return out, nil
 }
@@ -1402,7 +1403,7 @@ func vipsgenEmbedWithOptions(in *C.VipsImage, x int, y int, width int, height in
 		defer freeDoubleArray(cbackground)
 	}
 	if err := C.vipsgen_embed_with_options(in, &out, C.gint(x), C.gint(y), C.gint(width), C.gint(height), C.VipsExtend(extend), cbackground, cbackgroundLength); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("embed", out)
 	}
 	return out, nil
 }
@@ -1411,7 +1412,7 @@ func vipsgenEmbedWithOptions(in *C.VipsImage, x int, y int, width int, height in
 func vipsgenExtractArea(input *C.VipsImage, left int, top int, width int, height int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_extract_area(input, &out, C.gint(left), C.gint(top), C.gint(width), C.gint(height)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("extract_area", out)
 	}
 	return out, nil
 }
@@ -1420,7 +1421,7 @@ func vipsgenExtractArea(input *C.VipsImage, left int, top int, width int, height
 func vipsgenExtractBand(in *C.VipsImage, band int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_extract_band(in, &out, C.gint(band)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("extract_band", out)
 	}
 	return out, nil
 }
@@ -1429,7 +1430,7 @@ func vipsgenExtractBand(in *C.VipsImage, band int) (*C.VipsImage, error) {
 func vipsgenExtractBandWithOptions(in *C.VipsImage, band int, n int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_extract_band_with_options(in, &out, C.gint(band), C.gint(n)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("extract_band", out)
 	}
 	return out, nil
 }
@@ -1438,7 +1439,7 @@ func vipsgenExtractBandWithOptions(in *C.VipsImage, band int, n int) (*C.VipsIma
 func vipsgenEye(width int, height int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_eye(&out, C.gint(width), C.gint(height)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("eye", out)
 	}
 	return out, nil
 }
@@ -1447,7 +1448,7 @@ func vipsgenEye(width int, height int) (*C.VipsImage, error) {
 func vipsgenEyeWithOptions(width int, height int, uchar bool, factor float64) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_eye_with_options(&out, C.gint(width), C.gint(height), C.int(boolToInt(uchar)), C.double(factor)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("eye", out)
 	}
 	return out, nil
 }
@@ -1456,7 +1457,7 @@ func vipsgenEyeWithOptions(width int, height int, uchar bool, factor float64) (*
 func vipsgenFalsecolour(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_falsecolour(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("falsecolour", out)
 	}
 	return out, nil
 }
@@ -1465,7 +1466,7 @@ func vipsgenFalsecolour(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenFastcor(in *C.VipsImage, ref *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_fastcor(in, ref, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("fastcor", out)
 	}
 	return out, nil
 }
@@ -1474,7 +1475,7 @@ func vipsgenFastcor(in *C.VipsImage, ref *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenFillNearest(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_fill_nearest(in, &out); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("fill_nearest")
 	}
 	return out, nil
 }
@@ -1490,7 +1491,7 @@ func vipsgenFindTrim(in *C.VipsImage) (int, int, int, int, error) {
 	var height int
 	cheight := new(C.gint)
 	if err := C.vipsgen_find_trim(in, cleft, ctop, cwidth, cheight); err != 0 {
-		return 0, 0, 0, 0, handleVipsError()
+		return 0, 0, 0, 0, handleVipsError("find_trim")
 	}
 	left = int(*cleft)
 	top = int(*ctop)
@@ -1517,7 +1518,7 @@ func vipsgenFindTrimWithOptions(in *C.VipsImage, threshold float64, background [
 		defer freeDoubleArray(cbackground)
 	}
 	if err := C.vipsgen_find_trim_with_options(in, cleft, ctop, cwidth, cheight, C.double(threshold), cbackground, cbackgroundLength, C.int(boolToInt(lineArt))); err != 0 {
-		return 0, 0, 0, 0, handleVipsError()
+		return 0, 0, 0, 0, handleVipsError("find_trim")
 	}
 	left = int(*cleft)
 	top = int(*ctop)
@@ -1532,7 +1533,7 @@ func vipsgenFitsload(filename string) (*C.VipsImage, error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_fitsload(cfilename, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("fitsload", out)
 	}
 	return out, nil
 }
@@ -1543,7 +1544,7 @@ func vipsgenFitsloadWithOptions(filename string, memory bool, access Access, fai
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_fitsload_with_options(cfilename, &out, C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("fitsload", out)
 	}
 	return out, nil
 }
@@ -1553,7 +1554,7 @@ func vipsgenFitssave(in *C.VipsImage, filename string) (error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_fitssave(in, cfilename); err != 0 {
-		return handleVipsError()
+		return handleVipsError("fitssave")
 	}
 	return nil
 }
@@ -1572,7 +1573,7 @@ func vipsgenFitssaveWithOptions(in *C.VipsImage, filename string, keep Keep, bac
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_fitssave_with_options(in, cfilename, C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return handleVipsError()
+		return handleVipsError("fitssave")
 	}
 	return nil
 }
@@ -1581,7 +1582,7 @@ func vipsgenFitssaveWithOptions(in *C.VipsImage, filename string, keep Keep, bac
 func vipsgenFlatten(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_flatten(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("flatten", out)
 	}
 	return out, nil
 }
@@ -1597,7 +1598,7 @@ func vipsgenFlattenWithOptions(in *C.VipsImage, background []float64, maxAlpha f
 		defer freeDoubleArray(cbackground)
 	}
 	if err := C.vipsgen_flatten_with_options(in, &out, cbackground, cbackgroundLength, C.double(maxAlpha)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("flatten", out)
 	}
 	return out, nil
 }
@@ -1606,7 +1607,7 @@ func vipsgenFlattenWithOptions(in *C.VipsImage, background []float64, maxAlpha f
 func vipsgenFlip(in *C.VipsImage, direction Direction) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_flip(in, &out, C.VipsDirection(direction)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("flip", out)
 	}
 	return out, nil
 }
@@ -1615,7 +1616,7 @@ func vipsgenFlip(in *C.VipsImage, direction Direction) (*C.VipsImage, error) {
 func vipsgenFloat2rad(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_float2rad(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("float2rad", out)
 	}
 	return out, nil
 }
@@ -1624,7 +1625,7 @@ func vipsgenFloat2rad(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenFractsurf(width int, height int, fractalDimension float64) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_fractsurf(&out, C.gint(width), C.gint(height), C.double(fractalDimension)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("fractsurf", out)
 	}
 	return out, nil
 }
@@ -1633,7 +1634,7 @@ func vipsgenFractsurf(width int, height int, fractalDimension float64) (*C.VipsI
 func vipsgenFreqmult(in *C.VipsImage, mask *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_freqmult(in, mask, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("freqmult", out)
 	}
 	return out, nil
 }
@@ -1642,7 +1643,7 @@ func vipsgenFreqmult(in *C.VipsImage, mask *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenFwfft(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_fwfft(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("fwfft", out)
 	}
 	return out, nil
 }
@@ -1651,7 +1652,7 @@ func vipsgenFwfft(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenGamma(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_gamma(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("gamma", out)
 	}
 	return out, nil
 }
@@ -1660,7 +1661,7 @@ func vipsgenGamma(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenGammaWithOptions(in *C.VipsImage, exponent float64) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_gamma_with_options(in, &out, C.double(exponent)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("gamma", out)
 	}
 	return out, nil
 }
@@ -1669,7 +1670,7 @@ func vipsgenGammaWithOptions(in *C.VipsImage, exponent float64) (*C.VipsImage, e
 func vipsgenGaussblur(in *C.VipsImage, sigma float64) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_gaussblur(in, &out, C.double(sigma)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("gaussblur", out)
 	}
 	return out, nil
 }
@@ -1678,7 +1679,7 @@ func vipsgenGaussblur(in *C.VipsImage, sigma float64) (*C.VipsImage, error) {
 func vipsgenGaussblurWithOptions(in *C.VipsImage, sigma float64, minAmpl float64, precision Precision) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_gaussblur_with_options(in, &out, C.double(sigma), C.double(minAmpl), C.VipsPrecision(precision)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("gaussblur", out)
 	}
 	return out, nil
 }
@@ -1687,7 +1688,7 @@ func vipsgenGaussblurWithOptions(in *C.VipsImage, sigma float64, minAmpl float64
 func vipsgenGaussmat(sigma float64, minAmpl float64) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_gaussmat(&out, C.double(sigma), C.double(minAmpl)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("gaussmat", out)
 	}
 	return out, nil
 }
@@ -1696,7 +1697,7 @@ func vipsgenGaussmat(sigma float64, minAmpl float64) (*C.VipsImage, error) {
 func vipsgenGaussmatWithOptions(sigma float64, minAmpl float64, separable bool, precision Precision) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_gaussmat_with_options(&out, C.double(sigma), C.double(minAmpl), C.int(boolToInt(separable)), C.VipsPrecision(precision)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("gaussmat", out)
 	}
 	return out, nil
 }
@@ -1705,7 +1706,7 @@ func vipsgenGaussmatWithOptions(sigma float64, minAmpl float64, separable bool,
 func vipsgenGaussnoise(width int, height int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_gaussnoise(&out, C.gint(width), C.gint(height)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("gaussnoise", out)
 	}
 	return out, nil
 }
@@ -1714,7 +1715,7 @@ func vipsgenGaussnoise(width int, height int) (*C.VipsImage, error) {
 func vipsgenGaussnoiseWithOptions(width int, height int, sigma float64, mean float64, seed int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_gaussnoise_with_options(&out, C.gint(width), C.gint(height), C.double(sigma), C.double(mean), C.gint(seed)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("gaussnoise", out)
 	}
 	return out, nil
 }
@@ -1725,7 +1726,7 @@ func vipsgenGetpoint(in *C.VipsImage, x int, y int) ([]float64, error) {
 	var n int
 	cn := new(C.int)
 	if err := C.vipsgen_getpoint(in, &out, cn, C.gint(x), C.gint(y)); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("getpoint")
 	}
 	n = int(*cn)
 	result := make([]float64, n)
@@ -1740,7 +1741,7 @@ func vipsgenGetpointWithOptions(in *C.VipsImage, x int, y int, unpackComplex boo
 	var n int
 	cn := new(C.int)
 	if err := C.vipsgen_getpoint_with_options(in, &out, cn, C.gint(x), C.gint(y), C.int(boolToInt(unpackComplex))); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("getpoint")
 	}
 	n = int(*cn)
 	result := make([]float64, n)
@@ -1755,7 +1756,7 @@ func vipsgenGifload(filename string) (*C.VipsImage, error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_gifload(cfilename, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("gifload", out)
 	}
 	return out, nil
 }
@@ -1766,7 +1767,7 @@ func vipsgenGifloadWithOptions(filename string, n int, page int, memory bool, ac
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_gifload_with_options(cfilename, &out, C.gint(n), C.gint(page), C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("gifload", out)
 	}
 	return out, nil
 }
@@ -1778,7 +1779,7 @@ func vipsgenGifloadBuffer(buf []byte) (*C.VipsImage, error) {
 	defer runtime.KeepAlive(src)
 	var out *C.VipsImage
 	if err := C.vipsgen_gifload_buffer(unsafe.Pointer(&src[0]), C.size_t(len(src)), &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("gifload_buffer", out)
 	}
 	return out, nil
 }
@@ -1790,7 +1791,7 @@ func vipsgenGifloadBufferWithOptions(buf []byte, n int, page int, memory bool, a
 	defer runtime.KeepAlive(src)
 	var out *C.VipsImage
 	if err := C.vipsgen_gifload_buffer_with_options(unsafe.Pointer(&src[0]), C.size_t(len(src)), &out, C.gint(n), C.gint(page), C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("gifload_buffer", out)
 	}
 	return out, nil
 }
@@ -1799,7 +1800,7 @@ func vipsgenGifloadBufferWithOptions(buf []byte, n int, page int, memory bool, a
 func vipsgenGifloadSource(source *C.VipsSourceCustom) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_gifload_source(source, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("gifload_source", out)
 	}
 	return out, nil
 }
@@ -1808,7 +1809,7 @@ func vipsgenGifloadSource(source *C.VipsSourceCustom) (*C.VipsImage, error) {
 func vipsgenGifloadSourceWithOptions(source *C.VipsSourceCustom, n int, page int, memory bool, access Access, failOn FailOn, revalidate bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_gifload_source_with_options(source, &out, C.gint(n), C.gint(page), C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("gifload_source", out)
 	}
 	return out, nil
 }
@@ -1818,13 +1819,16 @@ func vipsgenGifsave(in *C.VipsImage, filename string) (error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_gifsave(in, cfilename); err != 0 {
-		return handleVipsError()
+		return handleVipsError("gifsave")
 	}
 	return nil
 }
 
 // vipsgenGifsaveWithOptions vips_gifsave save as gif with optional arguments
 func vipsgenGifsaveWithOptions(in *C.VipsImage, filename string, dither float64, effort int, bitdepth int, interframeMaxerror float64, reuse bool, interpaletteMaxerror float64, interlace bool, keepDuplicateFrames bool, keep Keep, background []float64, pageHeight int, profile string) (error) {
+	if err := checkOptionRange("gifsave", "bitdepth", bitdepth, 1, 8); err != nil {
+		return err
+	}
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	cbackground, cbackgroundLength, err := convertToDoubleArray(background)
@@ -1837,7 +1841,7 @@ func vipsgenGifsaveWithOptions(in *C.VipsImage, filename string, dither float64,
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_gifsave_with_options(in, cfilename, C.double(dither), C.gint(effort), C.gint(bitdepth), C.double(interframeMaxerror), C.int(boolToInt(reuse)), C.double(interpaletteMaxerror), C.int(boolToInt(interlace)), C.int(boolToInt(keepDuplicateFrames)), C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return handleVipsError()
+		return handleVipsError("gifsave")
 	}
 	return nil
 }
@@ -1847,13 +1851,16 @@ func vipsgenGifsaveBuffer(in *C.VipsImage) ([]byte, error) {
 	var buf unsafe.Pointer
 	var length C.size_t
 	if err := C.vipsgen_gifsave_buffer(in, &buf, &length); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("gifsave_buffer")
 	}
 	return bufferToBytes(buf, length), nil
 }
 
 // vipsgenGifsaveBufferWithOptions vips_gifsave_buffer save as gif with optional arguments
 func vipsgenGifsaveBufferWithOptions(in *C.VipsImage, dither float64, effort int, bitdepth int, interframeMaxerror float64, reuse bool, interpaletteMaxerror float64, interlace bool, keepDuplicateFrames bool, keep Keep, background []float64, pageHeight int, profile string) ([]byte, error) {
+	if err := checkOptionRange("gifsave_buffer", "bitdepth", bitdepth, 1, 8); err != nil {
+		return nil, err
+	}
 	var buf unsafe.Pointer
 	var length C.size_t
 	cbackground, cbackgroundLength, err := convertToDoubleArray(background)
@@ -1866,7 +1873,7 @@ func vipsgenGifsaveBufferWithOptions(in *C.VipsImage, dither float64, effort int
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_gifsave_buffer_with_options(in, &buf, &length, C.double(dither), C.gint(effort), C.gint(bitdepth), C.double(interframeMaxerror), C.int(boolToInt(reuse)), C.double(interpaletteMaxerror), C.int(boolToInt(interlace)), C.int(boolToInt(keepDuplicateFrames)), C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("gifsave_buffer")
 	}
 	return bufferToBytes(buf, length), nil
 }
@@ -1875,13 +1882,16 @@ func vipsgenGifsaveBufferWithOptions(in *C.VipsImage, dither float64, effort int
 func vipsgenGifsaveTarget(in *C.VipsImage, target *C.VipsTargetCustom) (error) {
 	
 	if err := C.vipsgen_gifsave_target(in, target); err != 0 {
-		return handleVipsError()
+		return handleVipsError("gifsave_target")
 	}
 	return nil
 }
 
 // vipsgenGifsaveTargetWithOptions vips_gifsave_target save as gif with optional arguments
 func vipsgenGifsaveTargetWithOptions(in *C.VipsImage, target *C.VipsTargetCustom, dither float64, effort int, bitdepth int, interframeMaxerror float64, reuse bool, interpaletteMaxerror float64, interlace bool, keepDuplicateFrames bool, keep Keep, background []float64, pageHeight int, profile string) (error) {
+	if err := checkOptionRange("gifsave_target", "bitdepth", bitdepth, 1, 8); err != nil {
+		return err
+	}
 	cbackground, cbackgroundLength, err := convertToDoubleArray(background)
 	if err != nil {
 		return err
@@ -1892,7 +1902,7 @@ func vipsgenGifsaveTargetWithOptions(in *C.VipsImage, target *C.VipsTargetCustom
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_gifsave_target_with_options(in, target, C.double(dither), C.gint(effort), C.gint(bitdepth), C.double(interframeMaxerror), C.int(boolToInt(reuse)), C.double(interpaletteMaxerror), C.int(boolToInt(interlace)), C.int(boolToInt(keepDuplicateFrames)), C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return handleVipsError()
+		return handleVipsError("gifsave_target")
 	}
 	return nil
 }
@@ -1901,7 +1911,7 @@ func vipsgenGifsaveTargetWithOptions(in *C.VipsImage, target *C.VipsTargetCustom
 func vipsgenGlobalbalance(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_globalbalance(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("globalbalance", out)
 	}
 	return out, nil
 }
@@ -1910,7 +1920,7 @@ func vipsgenGlobalbalance(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenGlobalbalanceWithOptions(in *C.VipsImage, gamma float64, intOutput bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_globalbalance_with_options(in, &out, C.double(gamma), C.int(boolToInt(intOutput))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("globalbalance", out)
 	}
 	return out, nil
 }
@@ -1919,7 +1929,7 @@ func vipsgenGlobalbalanceWithOptions(in *C.VipsImage, gamma float64, intOutput b
 func vipsgenGravity(in *C.VipsImage, direction CompassDirection, width int, height int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_gravity(in, &out, C.VipsCompassDirection(direction), C.gint(width), C.gint(height)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("gravity", out)
 	}
 	return out, nil
 }
@@ -1935,7 +1945,7 @@ func vipsgenGravityWithOptions(in *C.VipsImage, direction CompassDirection, widt
 		defer freeDoubleArray(cbackground)
 	}
 	if err := C.vipsgen_gravity_with_options(in, &out, C.VipsCompassDirection(direction), C.gint(width), C.gint(height), C.VipsExtend(extend), cbackground, cbackgroundLength); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("gravity", out)
 	}
 	return out, nil
 }
@@ -1944,7 +1954,7 @@ func vipsgenGravityWithOptions(in *C.VipsImage, direction CompassDirection, widt
 func vipsgenGrey(width int, height int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_grey(&out, C.gint(width), C.gint(height)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("grey", out)
 	}
 	return out, nil
 }
@@ -1953,7 +1963,7 @@ func vipsgenGrey(width int, height int) (*C.VipsImage, error) {
 func vipsgenGreyWithOptions(width int, height int, uchar bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_grey_with_options(&out, C.gint(width), C.gint(height), C.int(boolToInt(uchar))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("grey", out)
 	}
 	return out, nil
 }
@@ -1962,7 +1972,7 @@ func vipsgenGreyWithOptions(width int, height int, uchar bool) (*C.VipsImage, er
 func vipsgenGrid(in *C.VipsImage, tileHeight int, across int, down int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_grid(in, &out, C.gint(tileHeight), C.gint(across), C.gint(down)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("grid", out)
 	}
 	return out, nil
 }
@@ -1973,7 +1983,7 @@ func vipsgenHeifload(filename string) (*C.VipsImage, error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_heifload(cfilename, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("heifload", out)
 	}
 	return out, nil
 }
@@ -1984,7 +1994,7 @@ func vipsgenHeifloadWithOptions(filename string, page int, n int, thumbnail bool
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_heifload_with_options(cfilename, &out, C.gint(page), C.gint(n), C.int(boolToInt(thumbnail)), C.int(boolToInt(unlimited)), C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("heifload", out)
 	}
 	return out, nil
 }
@@ -1996,7 +2006,7 @@ func vipsgenHeifloadBuffer(buf []byte) (*C.VipsImage, error) {
 	defer runtime.KeepAlive(src)
 	var out *C.VipsImage
 	if err := C.vipsgen_heifload_buffer(unsafe.Pointer(&src[0]), C.size_t(len(src)), &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("heifload_buffer", out)
 	}
 	return out, nil
 }
@@ -2008,7 +2018,7 @@ func vipsgenHeifloadBufferWithOptions(buf []byte, page int, n int, thumbnail boo
 	defer runtime.KeepAlive(src)
 	var out *C.VipsImage
 	if err := C.vipsgen_heifload_buffer_with_options(unsafe.Pointer(&src[0]), C.size_t(len(src)), &out, C.gint(page), C.gint(n), C.int(boolToInt(thumbnail)), C.int(boolToInt(unlimited)), C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("heifload_buffer", out)
 	}
 	return out, nil
 }
@@ -2017,7 +2027,7 @@ func vipsgenHeifloadBufferWithOptions(buf []byte, page int, n int, thumbnail boo
 func vipsgenHeifloadSource(source *C.VipsSourceCustom) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_heifload_source(source, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("heifload_source", out)
 	}
 	return out, nil
 }
@@ -2026,23 +2036,29 @@ func vipsgenHeifloadSource(source *C.VipsSourceCustom) (*C.VipsImage, error) {
 func vipsgenHeifloadSourceWithOptions(source *C.VipsSourceCustom, page int, n int, thumbnail bool, unlimited bool, memory bool, access Access, failOn FailOn, revalidate bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_heifload_source_with_options(source, &out, C.gint(page), C.gint(n), C.int(boolToInt(thumbnail)), C.int(boolToInt(unlimited)), C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("heifload_source", out)
 	}
 	return out, nil
 }
 
 // vipsgenHeifsave vips_heifsave save image in HEIF format
 func vipsgenHeifsave(in *C.VipsImage, filename string) (error) {
+	if err := requireOptionalOperation("heifsave"); err != nil {
+		return err
+	}
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_heifsave(in, cfilename); err != 0 {
-		return handleVipsError()
+		return handleVipsError("heifsave")
 	}
 	return nil
 }
 
 // vipsgenHeifsaveWithOptions vips_heifsave save image in HEIF format with optional arguments
 func vipsgenHeifsaveWithOptions(in *C.VipsImage, filename string, q int, bitdepth int, lossless bool, compression HeifCompression, effort int, subsampleMode Subsample, encoder HeifEncoder, keep Keep, background []float64, pageHeight int, profile string) (error) {
+	if err := requireOptionalOperation("heifsave"); err != nil {
+		return err
+	}
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	cbackground, cbackgroundLength, err := convertToDoubleArray(background)
@@ -2055,23 +2071,29 @@ func vipsgenHeifsaveWithOptions(in *C.VipsImage, filename string, q int, bitdept
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_heifsave_with_options(in, cfilename, C.gint(q), C.gint(bitdepth), C.int(boolToInt(lossless)), C.VipsForeignHeifCompression(compression), C.gint(effort), C.VipsForeignSubsample(subsampleMode), C.VipsForeignHeifEncoder(encoder), C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return handleVipsError()
+		return handleVipsError("heifsave")
 	}
 	return nil
 }
 
 // vipsgenHeifsaveBuffer vips_heifsave_buffer save image in HEIF format
 func vipsgenHeifsaveBuffer(in *C.VipsImage) ([]byte, error) {
+	if err := requireOptionalOperation("heifsave_buffer"); err != nil {
+		return nil, err
+	}
 	var buf unsafe.Pointer
 	var length C.size_t
 	if err := C.vipsgen_heifsave_buffer(in, &buf, &length); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("heifsave_buffer")
 	}
 	return bufferToBytes(buf, length), nil
 }
 
 // vipsgenHeifsaveBufferWithOptions vips_heifsave_buffer save image in HEIF format with optional arguments
 func vipsgenHeifsaveBufferWithOptions(in *C.VipsImage, q int, bitdepth int, lossless bool, compression HeifCompression, effort int, subsampleMode Subsample, encoder HeifEncoder, keep Keep, background []float64, pageHeight int, profile string) ([]byte, error) {
+	if err := requireOptionalOperation("heifsave_buffer"); err != nil {
+		return nil, err
+	}
 	var buf unsafe.Pointer
 	var length C.size_t
 	cbackground, cbackgroundLength, err := convertToDoubleArray(background)
@@ -2084,22 +2106,28 @@ func vipsgenHeifsaveBufferWithOptions(in *C.VipsImage, q int, bitdepth int, loss
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_heifsave_buffer_with_options(in, &buf, &length, C.gint(q), C.gint(bitdepth), C.int(boolToInt(lossless)), C.VipsForeignHeifCompression(compression), C.gint(effort), C.VipsForeignSubsample(subsampleMode), C.VipsForeignHeifEncoder(encoder), C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("heifsave_buffer")
 	}
 	return bufferToBytes(buf, length), nil
 }
 
 // vipsgenHeifsaveTarget vips_heifsave_target save image in HEIF format
 func vipsgenHeifsaveTarget(in *C.VipsImage, target *C.VipsTargetCustom) (error) {
+	if err := requireOptionalOperation("heifsave_target"); err != nil {
+		return err
+	}
 	
 	if err := C.vipsgen_heifsave_target(in, target); err != 0 {
-		return handleVipsError()
+		return handleVipsError("heifsave_target")
 	}
 	return nil
 }
 
 // vipsgenHeifsaveTargetWithOptions vips_heifsave_target save image in HEIF format with optional arguments
 func vipsgenHeifsaveTargetWithOptions(in *C.VipsImage, target *C.VipsTargetCustom, q int, bitdepth int, lossless bool, compression HeifCompression, effort int, subsampleMode Subsample, encoder HeifEncoder, keep Keep, background []float64, pageHeight int, profile string) (error) {
+	if err := requireOptionalOperation("heifsave_target"); err != nil {
+		return err
+	}
 	cbackground, cbackgroundLength, err := convertToDoubleArray(background)
 	if err != nil {
 		return err
@@ -2110,7 +2138,7 @@ func vipsgenHeifsaveTargetWithOptions(in *C.VipsImage, target *C.VipsTargetCusto
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_heifsave_target_with_options(in, target, C.gint(q), C.gint(bitdepth), C.int(boolToInt(lossless)), C.VipsForeignHeifCompression(compression), C.gint(effort), C.VipsForeignSubsample(subsampleMode), C.VipsForeignHeifEncoder(encoder), C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return handleVipsError()
+		return handleVipsError("heifsave_target")
 	}
 	return nil
 }
@@ -2119,7 +2147,7 @@ func vipsgenHeifsaveTargetWithOptions(in *C.VipsImage, target *C.VipsTargetCusto
 func vipsgenHistCum(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_hist_cum(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("hist_cum", out)
 	}
 	return out, nil
 }
@@ -2129,7 +2157,7 @@ func vipsgenHistEntropy(in *C.VipsImage) (float64, error) {
 	var out float64
 	cout := new(C.double)
 	if err := C.vipsgen_hist_entropy(in, cout); err != 0 {
-		return 0, handleVipsError()
+		return 0, handleVipsError("hist_entropy")
 	}
 	out = float64(*cout)
 	return out, nil
@@ -2139,7 +2167,7 @@ func vipsgenHistEntropy(in *C.VipsImage) (float64, error) {
 func vipsgenHistEqual(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_hist_equal(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("hist_equal", out)
 	}
 	return out, nil
 }
@@ -2148,7 +2176,7 @@ func vipsgenHistEqual(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenHistEqualWithOptions(in *C.VipsImage, band int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_hist_equal_with_options(in, &out, C.gint(band)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("hist_equal", out)
 	}
 	return out, nil
 }
@@ -2157,7 +2185,7 @@ func vipsgenHistEqualWithOptions(in *C.VipsImage, band int) (*C.VipsImage, error
 func vipsgenHistFind(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_hist_find(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("hist_find", out)
 	}
 	return out, nil
 }
@@ -2166,7 +2194,7 @@ func vipsgenHistFind(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenHistFindWithOptions(in *C.VipsImage, band int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_hist_find_with_options(in, &out, C.gint(band)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("hist_find", out)
 	}
 	return out, nil
 }
@@ -2175,7 +2203,7 @@ func vipsgenHistFindWithOptions(in *C.VipsImage, band int) (*C.VipsImage, error)
 func vipsgenHistFindIndexed(in *C.VipsImage, index *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_hist_find_indexed(in, index, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("hist_find_indexed", out)
 	}
 	return out, nil
 }
@@ -2184,7 +2212,7 @@ func vipsgenHistFindIndexed(in *C.VipsImage, index *C.VipsImage) (*C.VipsImage,
 func vipsgenHistFindIndexedWithOptions(in *C.VipsImage, index *C.VipsImage, combine Combine) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_hist_find_indexed_with_options(in, index, &out, C.VipsCombine(combine)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("hist_find_indexed", out)
 	}
 	return out, nil
 }
@@ -2193,7 +2221,7 @@ func vipsgenHistFindIndexedWithOptions(in *C.VipsImage, index *C.VipsImage, comb
 func vipsgenHistFindNdim(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_hist_find_ndim(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("hist_find_ndim", out)
 	}
 	return out, nil
 }
@@ -2202,7 +2230,7 @@ func vipsgenHistFindNdim(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenHistFindNdimWithOptions(in *C.VipsImage, bins int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_hist_find_ndim_with_options(in, &out, C.gint(bins)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("hist_find_ndim", out)
 	}
 	return out, nil
 }
@@ -2212,7 +2240,7 @@ func vipsgenHistIsmonotonic(in *C.VipsImage) (bool, error) {
 	var monotonic bool
 	cmonotonic := new(C.gboolean)
 	if err := C.vipsgen_hist_ismonotonic(in, cmonotonic); err != 0 {
-		return false, handleVipsError()
+		return false, handleVipsError("hist_ismonotonic")
 	}
 	monotonic = *cmonotonic != 0
 	return monotonic, nil
@@ -2222,7 +2250,7 @@ func vipsgenHistIsmonotonic(in *C.VipsImage) (bool, error) {
 func vipsgenHistLocal(in *C.VipsImage, width int, height int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_hist_local(in, &out, C.gint(width), C.gint(height)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("hist_local", out)
 	}
 	return out, nil
 }
@@ -2231,7 +2259,7 @@ func vipsgenHistLocal(in *C.VipsImage, width int, height int) (*C.VipsImage, err
 func vipsgenHistLocalWithOptions(in *C.VipsImage, width int, height int, maxSlope int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_hist_local_with_options(in, &out, C.gint(width), C.gint(height), C.gint(maxSlope)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("hist_local", out)
 	}
 	return out, nil
 }
@@ -2240,7 +2268,7 @@ func vipsgenHistLocalWithOptions(in *C.VipsImage, width int, height int, maxSlop
 func vipsgenHistMatch(in *C.VipsImage, ref *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_hist_match(in, ref, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("hist_match", out)
 	}
 	return out, nil
 }
@@ -2249,7 +2277,7 @@ func vipsgenHistMatch(in *C.VipsImage, ref *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenHistNorm(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_hist_norm(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("hist_norm", out)
 	}
 	return out, nil
 }
@@ -2258,7 +2286,7 @@ func vipsgenHistNorm(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenHistPlot(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_hist_plot(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("hist_plot", out)
 	}
 	return out, nil
 }
@@ -2267,7 +2295,7 @@ func vipsgenHistPlot(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenHoughCircle(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_hough_circle(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("hough_circle", out)
 	}
 	return out, nil
 }
@@ -2276,7 +2304,7 @@ func vipsgenHoughCircle(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenHoughCircleWithOptions(in *C.VipsImage, scale int, minRadius int, maxRadius int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_hough_circle_with_options(in, &out, C.gint(scale), C.gint(minRadius), C.gint(maxRadius)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("hough_circle", out)
 	}
 	return out, nil
 }
@@ -2285,7 +2313,7 @@ func vipsgenHoughCircleWithOptions(in *C.VipsImage, scale int, minRadius int, ma
 func vipsgenHoughLine(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_hough_line(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("hough_line", out)
 	}
 	return out, nil
 }
@@ -2294,7 +2322,7 @@ func vipsgenHoughLine(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenHoughLineWithOptions(in *C.VipsImage, width int, height int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_hough_line_with_options(in, &out, C.gint(width), C.gint(height)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("hough_line", out)
 	}
 	return out, nil
 }
@@ -2303,7 +2331,7 @@ func vipsgenHoughLineWithOptions(in *C.VipsImage, width int, height int) (*C.Vip
 func vipsgenIccExport(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_icc_export(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("icc_export", out)
 	}
 	return out, nil
 }
@@ -2314,7 +2342,7 @@ func vipsgenIccExportWithOptions(in *C.VipsImage, pcs PCS, intent Intent, blackP
 	coutputProfile := C.CString(outputProfile)
 	defer freeCString(coutputProfile)
 	if err := C.vipsgen_icc_export_with_options(in, &out, C.VipsPCS(pcs), C.VipsIntent(intent), C.int(boolToInt(blackPointCompensation)), coutputProfile, C.gint(depth)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("icc_export", out)
 	}
 	return out, nil
 }
@@ -2323,7 +2351,7 @@ func vipsgenIccExportWithOptions(in *C.VipsImage, pcs PCS, intent Intent, blackP
 func vipsgenIccImport(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_icc_import(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("icc_import", out)
 	}
 	return out, nil
 }
@@ -2334,7 +2362,7 @@ func vipsgenIccImportWithOptions(in *C.VipsImage, pcs PCS, intent Intent, blackP
 	cinputProfile := C.CString(inputProfile)
 	defer freeCString(cinputProfile)
 	if err := C.vipsgen_icc_import_with_options(in, &out, C.VipsPCS(pcs), C.VipsIntent(intent), C.int(boolToInt(blackPointCompensation)), C.int(boolToInt(embedded)), cinputProfile); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("icc_import", out)
 	}
 	return out, nil
 }
@@ -2345,7 +2373,7 @@ func vipsgenIccTransform(in *C.VipsImage, outputProfile string) (*C.VipsImage, e
 	coutputProfile := C.CString(outputProfile)
 	defer freeCString(coutputProfile)
 	if err := C.vipsgen_icc_transform(in, &out, coutputProfile); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("icc_transform", out)
 	}
 	return out, nil
 }
@@ -2358,7 +2386,7 @@ func vipsgenIccTransformWithOptions(in *C.VipsImage, outputProfile string, pcs P
 	cinputProfile := C.CString(inputProfile)
 	defer freeCString(cinputProfile)
 	if err := C.vipsgen_icc_transform_with_options(in, &out, coutputProfile, C.VipsPCS(pcs), C.VipsIntent(intent), C.int(boolToInt(blackPointCompensation)), C.int(boolToInt(embedded)), cinputProfile, C.gint(depth)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("icc_transform", out)
 	}
 	return out, nil
 }
@@ -2367,7 +2395,7 @@ func vipsgenIccTransformWithOptions(in *C.VipsImage, outputProfile string, pcs P
 func vipsgenIdentity() (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_identity(&out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("identity", out)
 	}
 	return out, nil
 }
@@ -2376,7 +2404,7 @@ func vipsgenIdentity() (*C.VipsImage, error) {
 func vipsgenIdentityWithOptions(bands int, ushort bool, size int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_identity_with_options(&out, C.gint(bands), C.int(boolToInt(ushort)), C.gint(size)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("identity", out)
 	}
 	return out, nil
 }
@@ -2385,7 +2413,7 @@ func vipsgenIdentityWithOptions(bands int, ushort bool, size int) (*C.VipsImage,
 func vipsgenIfthenelse(cond *C.VipsImage, in1 *C.VipsImage, in2 *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_ifthenelse(cond, in1, in2, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("ifthenelse", out)
 	}
 	return out, nil
 }
@@ -2394,7 +2422,7 @@ func vipsgenIfthenelse(cond *C.VipsImage, in1 *C.VipsImage, in2 *C.VipsImage) (*
 func vipsgenIfthenelseWithOptions(cond *C.VipsImage, in1 *C.VipsImage, in2 *C.VipsImage, blend bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_ifthenelse_with_options(cond, in1, in2, &out, C.int(boolToInt(blend))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("ifthenelse", out)
 	}
 	return out, nil
 }
@@ -2403,7 +2431,7 @@ func vipsgenIfthenelseWithOptions(cond *C.VipsImage, in1 *C.VipsImage, in2 *C.Vi
 func vipsgenInsert(main *C.VipsImage, sub *C.VipsImage, x int, y int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_insert(main, sub, &out, C.gint(x), C.gint(y)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("insert", out)
 	}
 	return out, nil
 }
@@ -2419,7 +2447,7 @@ func vipsgenInsertWithOptions(main *C.VipsImage, sub *C.VipsImage, x int, y int,
 		defer freeDoubleArray(cbackground)
 	}
 	if err := C.vipsgen_insert_with_options(main, sub, &out, C.gint(x), C.gint(y), C.int(boolToInt(expand)), cbackground, cbackgroundLength); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("insert", out)
 	}
 	return out, nil
 }
@@ -2428,7 +2456,7 @@ func vipsgenInsertWithOptions(main *C.VipsImage, sub *C.VipsImage, x int, y int,
 func vipsgenInvert(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_invert(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("invert", out)
 	}
 	return out, nil
 }
@@ -2437,7 +2465,7 @@ func vipsgenInvert(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenInvertlut(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_invertlut(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("invertlut", out)
 	}
 	return out, nil
 }
@@ -2446,7 +2474,7 @@ func vipsgenInvertlut(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenInvertlutWithOptions(in *C.VipsImage, size int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_invertlut_with_options(in, &out, C.gint(size)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("invertlut", out)
 	}
 	return out, nil
 }
@@ -2455,7 +2483,7 @@ func vipsgenInvertlutWithOptions(in *C.VipsImage, size int) (*C.VipsImage, error
 func vipsgenInvfft(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_invfft(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("invfft", out)
 	}
 	return out, nil
 }
@@ -2464,7 +2492,7 @@ func vipsgenInvfft(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenInvfftWithOptions(in *C.VipsImage, real bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_invfft_with_options(in, &out, C.int(boolToInt(real))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("invfft", out)
 	}
 	return out, nil
 }
@@ -2473,7 +2501,7 @@ func vipsgenInvfftWithOptions(in *C.VipsImage, real bool) (*C.VipsImage, error)
 func vipsgenJoin(in1 *C.VipsImage, in2 *C.VipsImage, direction Direction) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_join(in1, in2, &out, C.VipsDirection(direction)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("join", out)
 	}
 	return out, nil
 }
@@ -2489,7 +2517,7 @@ func vipsgenJoinWithOptions(in1 *C.VipsImage, in2 *C.VipsImage, direction Direct
 		defer freeDoubleArray(cbackground)
 	}
 	if err := C.vipsgen_join_with_options(in1, in2, &out, C.VipsDirection(direction), C.int(boolToInt(expand)), C.gint(shim), cbackground, cbackgroundLength, C.VipsAlign(align)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("join", out)
 	}
 	return out, nil
 }
@@ -2500,7 +2528,7 @@ func vipsgenJp2kload(filename string) (*C.VipsImage, error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_jp2kload(cfilename, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("jp2kload", out)
 	}
 	return out, nil
 }
@@ -2511,7 +2539,7 @@ func vipsgenJp2kloadWithOptions(filename string, page int, oneshot bool, memory
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_jp2kload_with_options(cfilename, &out, C.gint(page), C.int(boolToInt(oneshot)), C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("jp2kload", out)
 	}
 	return out, nil
 }
@@ -2523,7 +2551,7 @@ func vipsgenJp2kloadBuffer(buf []byte) (*C.VipsImage, error) {
 	defer runtime.KeepAlive(src)
 	var out *C.VipsImage
 	if err := C.vipsgen_jp2kload_buffer(unsafe.Pointer(&src[0]), C.size_t(len(src)), &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("jp2kload_buffer", out)
 	}
 	return out, nil
 }
@@ -2535,7 +2563,7 @@ func vipsgenJp2kloadBufferWithOptions(buf []byte, page int, oneshot bool, memory
 	defer runtime.KeepAlive(src)
 	var out *C.VipsImage
 	if err := C.vipsgen_jp2kload_buffer_with_options(unsafe.Pointer(&src[0]), C.size_t(len(src)), &out, C.gint(page), C.int(boolToInt(oneshot)), C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("jp2kload_buffer", out)
 	}
 	return out, nil
 }
@@ -2544,7 +2572,7 @@ func vipsgenJp2kloadBufferWithOptions(buf []byte, page int, oneshot bool, memory
 func vipsgenJp2kloadSource(source *C.VipsSourceCustom) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_jp2kload_source(source, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("jp2kload_source", out)
 	}
 	return out, nil
 }
@@ -2553,7 +2581,7 @@ func vipsgenJp2kloadSource(source *C.VipsSourceCustom) (*C.VipsImage, error) {
 func vipsgenJp2kloadSourceWithOptions(source *C.VipsSourceCustom, page int, oneshot bool, memory bool, access Access, failOn FailOn, revalidate bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_jp2kload_source_with_options(source, &out, C.gint(page), C.int(boolToInt(oneshot)), C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("jp2kload_source", out)
 	}
 	return out, nil
 }
@@ -2563,7 +2591,7 @@ func vipsgenJp2ksave(in *C.VipsImage, filename string) (error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_jp2ksave(in, cfilename); err != 0 {
-		return handleVipsError()
+		return handleVipsError("jp2ksave")
 	}
 	return nil
 }
@@ -2582,7 +2610,7 @@ func vipsgenJp2ksaveWithOptions(in *C.VipsImage, filename string, tileWidth int,
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_jp2ksave_with_options(in, cfilename, C.gint(tileWidth), C.gint(tileHeight), C.int(boolToInt(lossless)), C.gint(q), C.VipsForeignSubsample(subsampleMode), C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return handleVipsError()
+		return handleVipsError("jp2ksave")
 	}
 	return nil
 }
@@ -2592,7 +2620,7 @@ func vipsgenJp2ksaveBuffer(in *C.VipsImage) ([]byte, error) {
 	var buf unsafe.Pointer
 	var length C.size_t
 	if err := C.vipsgen_jp2ksave_buffer(in, &buf, &length); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("jp2ksave_buffer")
 	}
 	return bufferToBytes(buf, length), nil
 }
@@ -2611,7 +2639,7 @@ func vipsgenJp2ksaveBufferWithOptions(in *C.VipsImage, tileWidth int, tileHeight
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_jp2ksave_buffer_with_options(in, &buf, &length, C.gint(tileWidth), C.gint(tileHeight), C.int(boolToInt(lossless)), C.gint(q), C.VipsForeignSubsample(subsampleMode), C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("jp2ksave_buffer")
 	}
 	return bufferToBytes(buf, length), nil
 }
@@ -2620,7 +2648,7 @@ func vipsgenJp2ksaveBufferWithOptions(in *C.VipsImage, tileWidth int, tileHeight
 func vipsgenJp2ksaveTarget(in *C.VipsImage, target *C.VipsTargetCustom) (error) {
 	
 	if err := C.vipsgen_jp2ksave_target(in, target); err != 0 {
-		return handleVipsError()
+		return handleVipsError("jp2ksave_target")
 	}
 	return nil
 }
@@ -2637,7 +2665,7 @@ func vipsgenJp2ksaveTargetWithOptions(in *C.VipsImage, target *C.VipsTargetCusto
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_jp2ksave_target_with_options(in, target, C.gint(tileWidth), C.gint(tileHeight), C.int(boolToInt(lossless)), C.gint(q), C.VipsForeignSubsample(subsampleMode), C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return handleVipsError()
+		return handleVipsError("jp2ksave_target")
 	}
 	return nil
 }
@@ -2648,7 +2676,7 @@ func vipsgenJpegload(filename string) (*C.VipsImage, error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_jpegload(cfilename, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("jpegload", out)
 	}
 	return out, nil
 }
@@ -2659,7 +2687,7 @@ func vipsgenJpegloadWithOptions(filename string, shrink int, autorotate bool, un
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_jpegload_with_options(cfilename, &out, C.gint(shrink), C.int(boolToInt(autorotate)), C.int(boolToInt(unlimited)), C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("jpegload", out)
 	}
 	return out, nil
 }
@@ -2671,7 +2699,7 @@ func vipsgenJpegloadBuffer(buf []byte) (*C.VipsImage, error) {
 	defer runtime.KeepAlive(src)
 	var out *C.VipsImage
 	if err := C.vipsgen_jpegload_buffer(unsafe.Pointer(&src[0]), C.size_t(len(src)), &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("jpegload_buffer", out)
 	}
 	return out, nil
 }
@@ -2683,7 +2711,7 @@ func vipsgenJpegloadBufferWithOptions(buf []byte, shrink int, autorotate bool, u
 	defer runtime.KeepAlive(src)
 	var out *C.VipsImage
 	if err := C.vipsgen_jpegload_buffer_with_options(unsafe.Pointer(&src[0]), C.size_t(len(src)), &out, C.gint(shrink), C.int(boolToInt(autorotate)), C.int(boolToInt(unlimited)), C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("jpegload_buffer", out)
 	}
 	return out, nil
 }
@@ -2692,7 +2720,7 @@ func vipsgenJpegloadBufferWithOptions(buf []byte, shrink int, autorotate bool, u
 func vipsgenJpegloadSource(source *C.VipsSourceCustom) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_jpegload_source(source, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("jpegload_source", out)
 	}
 	return out, nil
 }
@@ -2701,7 +2729,7 @@ func vipsgenJpegloadSource(source *C.VipsSourceCustom) (*C.VipsImage, error) {
 func vipsgenJpegloadSourceWithOptions(source *C.VipsSourceCustom, shrink int, autorotate bool, unlimited bool, memory bool, access Access, failOn FailOn, revalidate bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_jpegload_source_with_options(source, &out, C.gint(shrink), C.int(boolToInt(autorotate)), C.int(boolToInt(unlimited)), C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("jpegload_source", out)
 	}
 	return out, nil
 }
@@ -2711,7 +2739,7 @@ func vipsgenJpegsave(in *C.VipsImage, filename string) (error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_jpegsave(in, cfilename); err != 0 {
-		return handleVipsError()
+		return handleVipsError("jpegsave")
 	}
 	return nil
 }
@@ -2730,7 +2758,7 @@ func vipsgenJpegsaveWithOptions(in *C.VipsImage, filename string, q int, optimiz
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_jpegsave_with_options(in, cfilename, C.gint(q), C.int(boolToInt(optimizeCoding)), C.int(boolToInt(interlace)), C.int(boolToInt(trellisQuant)), C.int(boolToInt(overshootDeringing)), C.int(boolToInt(optimizeScans)), C.gint(quantTable), C.VipsForeignSubsample(subsampleMode), C.gint(restartInterval), C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return handleVipsError()
+		return handleVipsError("jpegsave")
 	}
 	return nil
 }
@@ -2740,7 +2768,7 @@ func vipsgenJpegsaveBuffer(in *C.VipsImage) ([]byte, error) {
 	var buf unsafe.Pointer
 	var length C.size_t
 	if err := C.vipsgen_jpegsave_buffer(in, &buf, &length); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("jpegsave_buffer")
 	}
 	return bufferToBytes(buf, length), nil
 }
@@ -2759,7 +2787,7 @@ func vipsgenJpegsaveBufferWithOptions(in *C.VipsImage, q int, optimizeCoding boo
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_jpegsave_buffer_with_options(in, &buf, &length, C.gint(q), C.int(boolToInt(optimizeCoding)), C.int(boolToInt(interlace)), C.int(boolToInt(trellisQuant)), C.int(boolToInt(overshootDeringing)), C.int(boolToInt(optimizeScans)), C.gint(quantTable), C.VipsForeignSubsample(subsampleMode), C.gint(restartInterval), C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("jpegsave_buffer")
 	}
 	return bufferToBytes(buf, length), nil
 }
@@ -2768,7 +2796,7 @@ func vipsgenJpegsaveBufferWithOptions(in *C.VipsImage, q int, optimizeCoding boo
 func vipsgenJpegsaveTarget(in *C.VipsImage, target *C.VipsTargetCustom) (error) {
 	
 	if err := C.vipsgen_jpegsave_target(in, target); err != 0 {
-		return handleVipsError()
+		return handleVipsError("jpegsave_target")
 	}
 	return nil
 }
@@ -2785,7 +2813,7 @@ func vipsgenJpegsaveTargetWithOptions(in *C.VipsImage, target *C.VipsTargetCusto
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_jpegsave_target_with_options(in, target, C.gint(q), C.int(boolToInt(optimizeCoding)), C.int(boolToInt(interlace)), C.int(boolToInt(trellisQuant)), C.int(boolToInt(overshootDeringing)), C.int(boolToInt(optimizeScans)), C.gint(quantTable), C.VipsForeignSubsample(subsampleMode), C.gint(restartInterval), C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return handleVipsError()
+		return handleVipsError("jpegsave_target")
 	}
 	return nil
 }
@@ -2796,7 +2824,7 @@ func vipsgenJxlload(filename string) (*C.VipsImage, error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_jxlload(cfilename, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("jxlload", out)
 	}
 	return out, nil
 }
@@ -2807,7 +2835,7 @@ func vipsgenJxlloadWithOptions(filename string, page int, n int, memory bool, ac
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_jxlload_with_options(cfilename, &out, C.gint(page), C.gint(n), C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("jxlload", out)
 	}
 	return out, nil
 }
@@ -2819,7 +2847,7 @@ func vipsgenJxlloadBuffer(buf []byte) (*C.VipsImage, error) {
 	defer runtime.KeepAlive(src)
 	var out *C.VipsImage
 	if err := C.vipsgen_jxlload_buffer(unsafe.Pointer(&src[0]), C.size_t(len(src)), &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("jxlload_buffer", out)
 	}
 	return out, nil
 }
@@ -2831,7 +2859,7 @@ func vipsgenJxlloadBufferWithOptions(buf []byte, page int, n int, memory bool, a
 	defer runtime.KeepAlive(src)
 	var out *C.VipsImage
 	if err := C.vipsgen_jxlload_buffer_with_options(unsafe.Pointer(&src[0]), C.size_t(len(src)), &out, C.gint(page), C.gint(n), C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("jxlload_buffer", out)
 	}
 	return out, nil
 }
@@ -2840,7 +2868,7 @@ func vipsgenJxlloadBufferWithOptions(buf []byte, page int, n int, memory bool, a
 func vipsgenJxlloadSource(source *C.VipsSourceCustom) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_jxlload_source(source, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("jxlload_source", out)
 	}
 	return out, nil
 }
@@ -2849,23 +2877,29 @@ func vipsgenJxlloadSource(source *C.VipsSourceCustom) (*C.VipsImage, error) {
 func vipsgenJxlloadSourceWithOptions(source *C.VipsSourceCustom, page int, n int, memory bool, access Access, failOn FailOn, revalidate bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_jxlload_source_with_options(source, &out, C.gint(page), C.gint(n), C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("jxlload_source", out)
 	}
 	return out, nil
 }
 
 // vipsgenJxlsave vips_jxlsave save image in JPEG-XL format
 func vipsgenJxlsave(in *C.VipsImage, filename string) (error) {
+	if err := requireOptionalOperation("jxlsave"); err != nil {
+		return err
+	}
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_jxlsave(in, cfilename); err != 0 {
-		return handleVipsError()
+		return handleVipsError("jxlsave")
 	}
 	return nil
 }
 
 // vipsgenJxlsaveWithOptions vips_jxlsave save image in JPEG-XL format with optional arguments
 func vipsgenJxlsaveWithOptions(in *C.VipsImage, filename string, tier int, distance float64, effort int, lossless bool, q int, keep Keep, background []float64, pageHeight int, profile string) (error) {
+	if err := requireOptionalOperation("jxlsave"); err != nil {
+		return err
+	}
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	cbackground, cbackgroundLength, err := convertToDoubleArray(background)
@@ -2878,23 +2912,29 @@ func vipsgenJxlsaveWithOptions(in *C.VipsImage, filename string, tier int, dista
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_jxlsave_with_options(in, cfilename, C.gint(tier), C.double(distance), C.gint(effort), C.int(boolToInt(lossless)), C.gint(q), C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return handleVipsError()
+		return handleVipsError("jxlsave")
 	}
 	return nil
 }
 
 // vipsgenJxlsaveBuffer vips_jxlsave_buffer save image in JPEG-XL format
 func vipsgenJxlsaveBuffer(in *C.VipsImage) ([]byte, error) {
+	if err := requireOptionalOperation("jxlsave_buffer"); err != nil {
+		return nil, err
+	}
 	var buf unsafe.Pointer
 	var length C.size_t
 	if err := C.vipsgen_jxlsave_buffer(in, &buf, &length); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("jxlsave_buffer")
 	}
 	return bufferToBytes(buf, length), nil
 }
 
 // vipsgenJxlsaveBufferWithOptions vips_jxlsave_buffer save image in JPEG-XL format with optional arguments
 func vipsgenJxlsaveBufferWithOptions(in *C.VipsImage, tier int, distance float64, effort int, lossless bool, q int, keep Keep, background []float64, pageHeight int, profile string) ([]byte, error) {
+	if err := requireOptionalOperation("jxlsave_buffer"); err != nil {
+		return nil, err
+	}
 	var buf unsafe.Pointer
 	var length C.size_t
 	cbackground, cbackgroundLength, err := convertToDoubleArray(background)
@@ -2907,22 +2947,28 @@ func vipsgenJxlsaveBufferWithOptions(in *C.VipsImage, tier int, distance float64
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_jxlsave_buffer_with_options(in, &buf, &length, C.gint(tier), C.double(distance), C.gint(effort), C.int(boolToInt(lossless)), C.gint(q), C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("jxlsave_buffer")
 	}
 	return bufferToBytes(buf, length), nil
 }
 
 // vipsgenJxlsaveTarget vips_jxlsave_target save image in JPEG-XL format
 func vipsgenJxlsaveTarget(in *C.VipsImage, target *C.VipsTargetCustom) (error) {
+	if err := requireOptionalOperation("jxlsave_target"); err != nil {
+		return err
+	}
 	
 	if err := C.vipsgen_jxlsave_target(in, target); err != 0 {
-		return handleVipsError()
+		return handleVipsError("jxlsave_target")
 	}
 	return nil
 }
 
 // vipsgenJxlsaveTargetWithOptions vips_jxlsave_target save image in JPEG-XL format with optional arguments
 func vipsgenJxlsaveTargetWithOptions(in *C.VipsImage, target *C.VipsTargetCustom, tier int, distance float64, effort int, lossless bool, q int, keep Keep, background []float64, pageHeight int, profile string) (error) {
+	if err := requireOptionalOperation("jxlsave_target"); err != nil {
+		return err
+	}
 	cbackground, cbackgroundLength, err := convertToDoubleArray(background)
 	if err != nil {
 		return err
@@ -2933,7 +2979,7 @@ func vipsgenJxlsaveTargetWithOptions(in *C.VipsImage, target *C.VipsTargetCustom
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_jxlsave_target_with_options(in, target, C.gint(tier), C.double(distance), C.gint(effort), C.int(boolToInt(lossless)), C.gint(q), C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return handleVipsError()
+		return handleVipsError("jxlsave_target")
 	}
 	return nil
 }
@@ -2942,7 +2988,7 @@ func vipsgenJxlsaveTargetWithOptions(in *C.VipsImage, target *C.VipsTargetCustom
 func vipsgenLabelregions(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_labelregions(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("labelregions", out)
 	}
 	return out, nil
 }
@@ -2956,7 +3002,7 @@ func vipsgenLabelregionsWithOptions(in *C.VipsImage, segments *int) (*C.VipsImag
 		csegments = &csegmentsValue
 	}
 	if err := C.vipsgen_labelregions_with_options(in, &out, csegments); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("labelregions", out)
 	}
 	if segments != nil {
 		*segments = int(csegmentsValue)
@@ -2988,7 +3034,7 @@ func vipsgenLinear(in *C.VipsImage, a []float64, b []float64) (*C.VipsImage, err
 		defer freeDoubleArray(cb)
 	}
 	if err := C.vipsgen_linear(in, &out, ca, cb, C.int(len(a))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("linear", out)
 	}
 	return out, nil
 }
@@ -3017,7 +3063,7 @@ func vipsgenLinearWithOptions(in *C.VipsImage, a []float64, b []float64, uchar b
 		defer freeDoubleArray(cb)
 	}
 	if err := C.vipsgen_linear_with_options(in, &out, ca, cb, C.int(len(a)), C.int(boolToInt(uchar))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("linear", out)
 	}
 	return out, nil
 }
@@ -3026,7 +3072,7 @@ func vipsgenLinearWithOptions(in *C.VipsImage, a []float64, b []float64, uchar b
 func vipsgenLinecache(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_linecache(in, &out); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("linecache")
 	}
 	return out, nil
 }
@@ -3035,7 +3081,7 @@ func vipsgenLinecache(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenLinecacheWithOptions(in *C.VipsImage, tileHeight int, access Access, threaded bool, persistent bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_linecache_with_options(in, &out, C.gint(tileHeight), C.VipsAccess(access), C.int(boolToInt(threaded)), C.int(boolToInt(persistent))); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("linecache")
 	}
 	return out, nil
 }
@@ -3044,7 +3090,7 @@ func vipsgenLinecacheWithOptions(in *C.VipsImage, tileHeight int, access Access,
 func vipsgenLogmat(sigma float64, minAmpl float64) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_logmat(&out, C.double(sigma), C.double(minAmpl)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("logmat", out)
 	}
 	return out, nil
 }
@@ -3053,7 +3099,7 @@ func vipsgenLogmat(sigma float64, minAmpl float64) (*C.VipsImage, error) {
 func vipsgenLogmatWithOptions(sigma float64, minAmpl float64, separable bool, precision Precision) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_logmat_with_options(&out, C.double(sigma), C.double(minAmpl), C.int(boolToInt(separable)), C.VipsPrecision(precision)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("logmat", out)
 	}
 	return out, nil
 }
@@ -3064,7 +3110,7 @@ func vipsgenMagickload(filename string) (*C.VipsImage, error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_magickload(cfilename, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("magickload", out)
 	}
 	return out, nil
 }
@@ -3077,7 +3123,7 @@ func vipsgenMagickloadWithOptions(filename string, density string, page int, n i
 	cdensity := C.CString(density)
 	defer freeCString(cdensity)
 	if err := C.vipsgen_magickload_with_options(cfilename, &out, cdensity, C.gint(page), C.gint(n), C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("magickload", out)
 	}
 	return out, nil
 }
@@ -3089,7 +3135,7 @@ func vipsgenMagickloadBuffer(buf []byte) (*C.VipsImage, error) {
 	defer runtime.KeepAlive(src)
 	var out *C.VipsImage
 	if err := C.vipsgen_magickload_buffer(unsafe.Pointer(&src[0]), C.size_t(len(src)), &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("magickload_buffer", out)
 	}
 	return out, nil
 }
@@ -3103,7 +3149,7 @@ func vipsgenMagickloadBufferWithOptions(buf []byte, density string, page int, n
 	cdensity := C.CString(density)
 	defer freeCString(cdensity)
 	if err := C.vipsgen_magickload_buffer_with_options(unsafe.Pointer(&src[0]), C.size_t(len(src)), &out, cdensity, C.gint(page), C.gint(n), C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("magickload_buffer", out)
 	}
 	return out, nil
 }
@@ -3113,7 +3159,7 @@ func vipsgenMagicksave(in *C.VipsImage, filename string) (error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_magicksave(in, cfilename); err != 0 {
-		return handleVipsError()
+		return handleVipsError("magicksave")
 	}
 	return nil
 }
@@ -3134,7 +3180,7 @@ func vipsgenMagicksaveWithOptions(in *C.VipsImage, filename string, format strin
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_magicksave_with_options(in, cfilename, cformat, C.gint(quality), C.int(boolToInt(optimizeGifFrames)), C.int(boolToInt(optimizeGifTransparency)), C.gint(bitdepth), C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return handleVipsError()
+		return handleVipsError("magicksave")
 	}
 	return nil
 }
@@ -3144,7 +3190,7 @@ func vipsgenMagicksaveBuffer(in *C.VipsImage) ([]byte, error) {
 	var buf unsafe.Pointer
 	var length C.size_t
 	if err := C.vipsgen_magicksave_buffer(in, &buf, &length); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("magicksave_buffer")
 	}
 	return bufferToBytes(buf, length), nil
 }
@@ -3165,7 +3211,7 @@ func vipsgenMagicksaveBufferWithOptions(in *C.VipsImage, format string, quality
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_magicksave_buffer_with_options(in, &buf, &length, cformat, C.gint(quality), C.int(boolToInt(optimizeGifFrames)), C.int(boolToInt(optimizeGifTransparency)), C.gint(bitdepth), C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("magicksave_buffer")
 	}
 	return bufferToBytes(buf, length), nil
 }
@@ -3174,7 +3220,7 @@ func vipsgenMagicksaveBufferWithOptions(in *C.VipsImage, format string, quality
 func vipsgenMapim(in *C.VipsImage, index *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_mapim(in, &out, index); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("mapim", out)
 	}
 	return out, nil
 }
@@ -3190,7 +3236,7 @@ func vipsgenMapimWithOptions(in *C.VipsImage, index *C.VipsImage, interpolate *I
 		defer freeDoubleArray(cbackground)
 	}
 	if err := C.vipsgen_mapim_with_options(in, &out, index, vipsInterpolateToC(interpolate), cbackground, cbackgroundLength, C.int(boolToInt(premultiplied)), C.VipsExtend(extend)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("mapim", out)
 	}
 	return out, nil
 }
@@ -3199,7 +3245,7 @@ func vipsgenMapimWithOptions(in *C.VipsImage, index *C.VipsImage, interpolate *I
 func vipsgenMaplut(in *C.VipsImage, lut *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_maplut(in, &out, lut); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("maplut", out)
 	}
 	return out, nil
 }
@@ -3208,7 +3254,7 @@ func vipsgenMaplut(in *C.VipsImage, lut *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenMaplutWithOptions(in *C.VipsImage, lut *C.VipsImage, band int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_maplut_with_options(in, &out, lut, C.gint(band)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("maplut", out)
 	}
 	return out, nil
 }
@@ -3217,7 +3263,7 @@ func vipsgenMaplutWithOptions(in *C.VipsImage, lut *C.VipsImage, band int) (*C.V
 func vipsgenMaskButterworth(width int, height int, order float64, frequencyCutoff float64, amplitudeCutoff float64) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_mask_butterworth(&out, C.gint(width), C.gint(height), C.double(order), C.double(frequencyCutoff), C.double(amplitudeCutoff)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("mask_butterworth", out)
 	}
 	return out, nil
 }
@@ -3226,7 +3272,7 @@ func vipsgenMaskButterworth(width int, height int, order float64, frequencyCutof
 func vipsgenMaskButterworthWithOptions(width int, height int, order float64, frequencyCutoff float64, amplitudeCutoff float64, uchar bool, nodc bool, reject bool, optical bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_mask_butterworth_with_options(&out, C.gint(width), C.gint(height), C.double(order), C.double(frequencyCutoff), C.double(amplitudeCutoff), C.int(boolToInt(uchar)), C.int(boolToInt(nodc)), C.int(boolToInt(reject)), C.int(boolToInt(optical))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("mask_butterworth", out)
 	}
 	return out, nil
 }
@@ -3235,7 +3281,7 @@ func vipsgenMaskButterworthWithOptions(width int, height int, order float64, fre
 func vipsgenMaskButterworthBand(width int, height int, order float64, frequencyCutoffX float64, frequencyCutoffY float64, radius float64, amplitudeCutoff float64) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_mask_butterworth_band(&out, C.gint(width), C.gint(height), C.double(order), C.double(frequencyCutoffX), C.double(frequencyCutoffY), C.double(radius), C.double(amplitudeCutoff)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("mask_butterworth_band", out)
 	}
 	return out, nil
 }
@@ -3244,7 +3290,7 @@ func vipsgenMaskButterworthBand(width int, height int, order float64, frequencyC
 func vipsgenMaskButterworthBandWithOptions(width int, height int, order float64, frequencyCutoffX float64, frequencyCutoffY float64, radius float64, amplitudeCutoff float64, uchar bool, nodc bool, reject bool, optical bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_mask_butterworth_band_with_options(&out, C.gint(width), C.gint(height), C.double(order), C.double(frequencyCutoffX), C.double(frequencyCutoffY), C.double(radius), C.double(amplitudeCutoff), C.int(boolToInt(uchar)), C.int(boolToInt(nodc)), C.int(boolToInt(reject)), C.int(boolToInt(optical))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("mask_butterworth_band", out)
 	}
 	return out, nil
 }
@@ -3253,7 +3299,7 @@ func vipsgenMaskButterworthBandWithOptions(width int, height int, order float64,
 func vipsgenMaskButterworthRing(width int, height int, order float64, frequencyCutoff float64, amplitudeCutoff float64, ringwidth float64) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_mask_butterworth_ring(&out, C.gint(width), C.gint(height), C.double(order), C.double(frequencyCutoff), C.double(amplitudeCutoff), C.double(ringwidth)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("mask_butterworth_ring", out)
 	}
 	return out, nil
 }
@@ -3262,7 +3308,7 @@ func vipsgenMaskButterworthRing(width int, height int, order float64, frequencyC
 func vipsgenMaskButterworthRingWithOptions(width int, height int, order float64, frequencyCutoff float64, amplitudeCutoff float64, ringwidth float64, uchar bool, nodc bool, reject bool, optical bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_mask_butterworth_ring_with_options(&out, C.gint(width), C.gint(height), C.double(order), C.double(frequencyCutoff), C.double(amplitudeCutoff), C.double(ringwidth), C.int(boolToInt(uchar)), C.int(boolToInt(nodc)), C.int(boolToInt(reject)), C.int(boolToInt(optical))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("mask_butterworth_ring", out)
 	}
 	return out, nil
 }
@@ -3271,7 +3317,7 @@ func vipsgenMaskButterworthRingWithOptions(width int, height int, order float64,
 func vipsgenMaskFractal(width int, height int, fractalDimension float64) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_mask_fractal(&out, C.gint(width), C.gint(height), C.double(fractalDimension)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("mask_fractal", out)
 	}
 	return out, nil
 }
@@ -3280,7 +3326,7 @@ func vipsgenMaskFractal(width int, height int, fractalDimension float64) (*C.Vip
 func vipsgenMaskFractalWithOptions(width int, height int, fractalDimension float64, uchar bool, nodc bool, reject bool, optical bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_mask_fractal_with_options(&out, C.gint(width), C.gint(height), C.double(fractalDimension), C.int(boolToInt(uchar)), C.int(boolToInt(nodc)), C.int(boolToInt(reject)), C.int(boolToInt(optical))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("mask_fractal", out)
 	}
 	return out, nil
 }
@@ -3289,7 +3335,7 @@ func vipsgenMaskFractalWithOptions(width int, height int, fractalDimension float
 func vipsgenMaskGaussian(width int, height int, frequencyCutoff float64, amplitudeCutoff float64) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_mask_gaussian(&out, C.gint(width), C.gint(height), C.double(frequencyCutoff), C.double(amplitudeCutoff)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("mask_gaussian", out)
 	}
 	return out, nil
 }
@@ -3298,7 +3344,7 @@ func vipsgenMaskGaussian(width int, height int, frequencyCutoff float64, amplitu
 func vipsgenMaskGaussianWithOptions(width int, height int, frequencyCutoff float64, amplitudeCutoff float64, uchar bool, nodc bool, reject bool, optical bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_mask_gaussian_with_options(&out, C.gint(width), C.gint(height), C.double(frequencyCutoff), C.double(amplitudeCutoff), C.int(boolToInt(uchar)), C.int(boolToInt(nodc)), C.int(boolToInt(reject)), C.int(boolToInt(optical))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("mask_gaussian", out)
 	}
 	return out, nil
 }
@@ -3307,7 +3353,7 @@ func vipsgenMaskGaussianWithOptions(width int, height int, frequencyCutoff float
 func vipsgenMaskGaussianBand(width int, height int, frequencyCutoffX float64, frequencyCutoffY float64, radius float64, amplitudeCutoff float64) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_mask_gaussian_band(&out, C.gint(width), C.gint(height), C.double(frequencyCutoffX), C.double(frequencyCutoffY), C.double(radius), C.double(amplitudeCutoff)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("mask_gaussian_band", out)
 	}
 	return out, nil
 }
@@ -3316,7 +3362,7 @@ func vipsgenMaskGaussianBand(width int, height int, frequencyCutoffX float64, fr
 func vipsgenMaskGaussianBandWithOptions(width int, height int, frequencyCutoffX float64, frequencyCutoffY float64, radius float64, amplitudeCutoff float64, uchar bool, nodc bool, reject bool, optical bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_mask_gaussian_band_with_options(&out, C.gint(width), C.gint(height), C.double(frequencyCutoffX), C.double(frequencyCutoffY), C.double(radius), C.double(amplitudeCutoff), C.int(boolToInt(uchar)), C.int(boolToInt(nodc)), C.int(boolToInt(reject)), C.int(boolToInt(optical))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("mask_gaussian_band", out)
 	}
 	return out, nil
 }
@@ -3325,7 +3371,7 @@ func vipsgenMaskGaussianBandWithOptions(width int, height int, frequencyCutoffX
 func vipsgenMaskGaussianRing(width int, height int, frequencyCutoff float64, amplitudeCutoff float64, ringwidth float64) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_mask_gaussian_ring(&out, C.gint(width), C.gint(height), C.double(frequencyCutoff), C.double(amplitudeCutoff), C.double(ringwidth)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("mask_gaussian_ring", out)
 	}
 	return out, nil
 }
@@ -3334,7 +3380,7 @@ func vipsgenMaskGaussianRing(width int, height int, frequencyCutoff float64, amp
 func vipsgenMaskGaussianRingWithOptions(width int, height int, frequencyCutoff float64, amplitudeCutoff float64, ringwidth float64, uchar bool, nodc bool, reject bool, optical bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_mask_gaussian_ring_with_options(&out, C.gint(width), C.gint(height), C.double(frequencyCutoff), C.double(amplitudeCutoff), C.double(ringwidth), C.int(boolToInt(uchar)), C.int(boolToInt(nodc)), C.int(boolToInt(reject)), C.int(boolToInt(optical))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("mask_gaussian_ring", out)
 	}
 	return out, nil
 }
@@ -3343,7 +3389,7 @@ func vipsgenMaskGaussianRingWithOptions(width int, height int, frequencyCutoff f
 func vipsgenMaskIdeal(width int, height int, frequencyCutoff float64) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_mask_ideal(&out, C.gint(width), C.gint(height), C.double(frequencyCutoff)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("mask_ideal", out)
 	}
 	return out, nil
 }
@@ -3352,7 +3398,7 @@ func vipsgenMaskIdeal(width int, height int, frequencyCutoff float64) (*C.VipsIm
 func vipsgenMaskIdealWithOptions(width int, height int, frequencyCutoff float64, uchar bool, nodc bool, reject bool, optical bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_mask_ideal_with_options(&out, C.gint(width), C.gint(height), C.double(frequencyCutoff), C.int(boolToInt(uchar)), C.int(boolToInt(nodc)), C.int(boolToInt(reject)), C.int(boolToInt(optical))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("mask_ideal", out)
 	}
 	return out, nil
 }
@@ -3361,7 +3407,7 @@ func vipsgenMaskIdealWithOptions(width int, height int, frequencyCutoff float64,
 func vipsgenMaskIdealBand(width int, height int, frequencyCutoffX float64, frequencyCutoffY float64, radius float64) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_mask_ideal_band(&out, C.gint(width), C.gint(height), C.double(frequencyCutoffX), C.double(frequencyCutoffY), C.double(radius)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("mask_ideal_band", out)
 	}
 	return out, nil
 }
@@ -3370,7 +3416,7 @@ func vipsgenMaskIdealBand(width int, height int, frequencyCutoffX float64, frequ
 func vipsgenMaskIdealBandWithOptions(width int, height int, frequencyCutoffX float64, frequencyCutoffY float64, radius float64, uchar bool, nodc bool, reject bool, optical bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_mask_ideal_band_with_options(&out, C.gint(width), C.gint(height), C.double(frequencyCutoffX), C.double(frequencyCutoffY), C.double(radius), C.int(boolToInt(uchar)), C.int(boolToInt(nodc)), C.int(boolToInt(reject)), C.int(boolToInt(optical))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("mask_ideal_band", out)
 	}
 	return out, nil
 }
@@ -3379,7 +3425,7 @@ func vipsgenMaskIdealBandWithOptions(width int, height int, frequencyCutoffX flo
 func vipsgenMaskIdealRing(width int, height int, frequencyCutoff float64, ringwidth float64) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_mask_ideal_ring(&out, C.gint(width), C.gint(height), C.double(frequencyCutoff), C.double(ringwidth)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("mask_ideal_ring", out)
 	}
 	return out, nil
 }
@@ -3388,7 +3434,7 @@ func vipsgenMaskIdealRing(width int, height int, frequencyCutoff float64, ringwi
 func vipsgenMaskIdealRingWithOptions(width int, height int, frequencyCutoff float64, ringwidth float64, uchar bool, nodc bool, reject bool, optical bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_mask_ideal_ring_with_options(&out, C.gint(width), C.gint(height), C.double(frequencyCutoff), C.double(ringwidth), C.int(boolToInt(uchar)), C.int(boolToInt(nodc)), C.int(boolToInt(reject)), C.int(boolToInt(optical))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("mask_ideal_ring", out)
 	}
 	return out, nil
 }
@@ -3397,7 +3443,7 @@ func vipsgenMaskIdealRingWithOptions(width int, height int, frequencyCutoff floa
 func vipsgenMatch(ref *C.VipsImage, sec *C.VipsImage, xr1 int, yr1 int, xs1 int, ys1 int, xr2 int, yr2 int, xs2 int, ys2 int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_match(ref, sec, &out, C.gint(xr1), C.gint(yr1), C.gint(xs1), C.gint(ys1), C.gint(xr2), C.gint(yr2), C.gint(xs2), C.gint(ys2)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("match", out)
 	}
 	return out, nil
 }
@@ -3406,7 +3452,7 @@ func vipsgenMatch(ref *C.VipsImage, sec *C.VipsImage, xr1 int, yr1 int, xs1 int,
 func vipsgenMatchWithOptions(ref *C.VipsImage, sec *C.VipsImage, xr1 int, yr1 int, xs1 int, ys1 int, xr2 int, yr2 int, xs2 int, ys2 int, hwindow int, harea int, search bool, interpolate *Interpolate) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_match_with_options(ref, sec, &out, C.gint(xr1), C.gint(yr1), C.gint(xs1), C.gint(ys1), C.gint(xr2), C.gint(yr2), C.gint(xs2), C.gint(ys2), C.gint(hwindow), C.gint(harea), C.int(boolToInt(search)), vipsInterpolateToC(interpolate)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("match", out)
 	}
 	return out, nil
 }
@@ -3415,7 +3461,7 @@ func vipsgenMatchWithOptions(ref *C.VipsImage, sec *C.VipsImage, xr1 int, yr1 in
 func vipsgenMath(in *C.VipsImage, math OperationMath) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_math(in, &out, C.VipsOperationMath(math)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("math", out)
 	}
 	return out, nil
 }
@@ -3424,7 +3470,7 @@ func vipsgenMath(in *C.VipsImage, math OperationMath) (*C.VipsImage, error) {
 func vipsgenMath2(left *C.VipsImage, right *C.VipsImage, math2 OperationMath2) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_math2(left, right, &out, C.VipsOperationMath2(math2)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("math2", out)
 	}
 	return out, nil
 }
@@ -3443,7 +3489,7 @@ func vipsgenMath2Const(in *C.VipsImage, math2 OperationMath2, c []float64) (*C.V
 		defer freeDoubleArray(cc)
 	}
 	if err := C.vipsgen_math2_const(in, &out, C.VipsOperationMath2(math2), cc, C.int(len(c))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("math2_const", out)
 	}
 	return out, nil
 }
@@ -3454,7 +3500,7 @@ func vipsgenMatload(filename string) (*C.VipsImage, error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_matload(cfilename, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("matload", out)
 	}
 	return out, nil
 }
@@ -3465,7 +3511,7 @@ func vipsgenMatloadWithOptions(filename string, memory bool, access Access, fail
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_matload_with_options(cfilename, &out, C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("matload", out)
 	}
 	return out, nil
 }
@@ -3474,7 +3520,7 @@ func vipsgenMatloadWithOptions(filename string, memory bool, access Access, fail
 func vipsgenMatrixinvert(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_matrixinvert(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("matrixinvert", out)
 	}
 	return out, nil
 }
@@ -3485,7 +3531,7 @@ func vipsgenMatrixload(filename string) (*C.VipsImage, error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_matrixload(cfilename, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("matrixload", out)
 	}
 	return out, nil
 }
@@ -3496,7 +3542,7 @@ func vipsgenMatrixloadWithOptions(filename string, memory bool, access Access, f
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_matrixload_with_options(cfilename, &out, C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("matrixload", out)
 	}
 	return out, nil
 }
@@ -3505,7 +3551,7 @@ func vipsgenMatrixloadWithOptions(filename string, memory bool, access Access, f
 func vipsgenMatrixloadSource(source *C.VipsSourceCustom) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_matrixload_source(source, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("matrixload_source", out)
 	}
 	return out, nil
 }
@@ -3514,7 +3560,7 @@ func vipsgenMatrixloadSource(source *C.VipsSourceCustom) (*C.VipsImage, error) {
 func vipsgenMatrixloadSourceWithOptions(source *C.VipsSourceCustom, memory bool, access Access, failOn FailOn, revalidate bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_matrixload_source_with_options(source, &out, C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("matrixload_source", out)
 	}
 	return out, nil
 }
@@ -3523,7 +3569,7 @@ func vipsgenMatrixloadSourceWithOptions(source *C.VipsSourceCustom, memory bool,
 func vipsgenMatrixmultiply(left *C.VipsImage, right *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_matrixmultiply(left, right, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("matrixmultiply", out)
 	}
 	return out, nil
 }
@@ -3532,7 +3578,7 @@ func vipsgenMatrixmultiply(left *C.VipsImage, right *C.VipsImage) (*C.VipsImage,
 func vipsgenMatrixprint(in *C.VipsImage) (error) {
 	
 	if err := C.vipsgen_matrixprint(in); err != 0 {
-		return handleVipsError()
+		return handleVipsError("matrixprint")
 	}
 	return nil
 }
@@ -3549,7 +3595,7 @@ func vipsgenMatrixprintWithOptions(in *C.VipsImage, keep Keep, background []floa
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_matrixprint_with_options(in, C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return handleVipsError()
+		return handleVipsError("matrixprint")
 	}
 	return nil
 }
@@ -3559,7 +3605,7 @@ func vipsgenMatrixsave(in *C.VipsImage, filename string) (error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_matrixsave(in, cfilename); err != 0 {
-		return handleVipsError()
+		return handleVipsError("matrixsave")
 	}
 	return nil
 }
@@ -3578,7 +3624,7 @@ func vipsgenMatrixsaveWithOptions(in *C.VipsImage, filename string, keep Keep, b
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_matrixsave_with_options(in, cfilename, C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return handleVipsError()
+		return handleVipsError("matrixsave")
 	}
 	return nil
 }
@@ -3587,7 +3633,7 @@ func vipsgenMatrixsaveWithOptions(in *C.VipsImage, filename string, keep Keep, b
 func vipsgenMatrixsaveTarget(in *C.VipsImage, target *C.VipsTargetCustom) (error) {
 	
 	if err := C.vipsgen_matrixsave_target(in, target); err != 0 {
-		return handleVipsError()
+		return handleVipsError("matrixsave_target")
 	}
 	return nil
 }
@@ -3604,7 +3650,7 @@ func vipsgenMatrixsaveTargetWithOptions(in *C.VipsImage, target *C.VipsTargetCus
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_matrixsave_target_with_options(in, target, C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return handleVipsError()
+		return handleVipsError("matrixsave_target")
 	}
 	return nil
 }
@@ -3614,7 +3660,7 @@ func vipsgenMax(in *C.VipsImage) (float64, error) {
 	var out float64
 	cout := new(C.double)
 	if err := C.vipsgen_max(in, cout); err != 0 {
-		return 0, handleVipsError()
+		return 0, handleVipsError("max")
 	}
 	out = float64(*cout)
 	return out, nil
@@ -3635,7 +3681,7 @@ func vipsgenMaxWithOptions(in *C.VipsImage, size int, x *int, y *int) (float64,
 		cy = &cyValue
 	}
 	if err := C.vipsgen_max_with_options(in, cout, C.gint(size), cx, cy); err != 0 {
-		return 0, handleVipsError()
+		return 0, handleVipsError("max")
 	}
 	out = float64(*cout)
 	if x != nil {
@@ -3651,7 +3697,7 @@ func vipsgenMaxWithOptions(in *C.VipsImage, size int, x *int, y *int) (float64,
 func vipsgenMaxpair(left *C.VipsImage, right *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_maxpair(left, right, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("maxpair", out)
 	}
 	return out, nil
 }
@@ -3660,7 +3706,7 @@ func vipsgenMaxpair(left *C.VipsImage, right *C.VipsImage) (*C.VipsImage, error)
 func vipsgenMeasure(in *C.VipsImage, h int, v int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_measure(in, &out, C.gint(h), C.gint(v)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("measure", out)
 	}
 	return out, nil
 }
@@ -3669,7 +3715,7 @@ func vipsgenMeasure(in *C.VipsImage, h int, v int) (*C.VipsImage, error) {
 func vipsgenMeasureWithOptions(in *C.VipsImage, h int, v int, left int, top int, width int, height int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_measure_with_options(in, &out, C.gint(h), C.gint(v), C.gint(left), C.gint(top), C.gint(width), C.gint(height)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("measure", out)
 	}
 	return out, nil
 }
@@ -3678,7 +3724,7 @@ func vipsgenMeasureWithOptions(in *C.VipsImage, h int, v int, left int, top int,
 func vipsgenMerge(ref *C.VipsImage, sec *C.VipsImage, direction Direction, dx int, dy int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_merge(ref, sec, &out, C.VipsDirection(direction), C.gint(dx), C.gint(dy)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("merge", out)
 	}
 	return out, nil
 }
@@ -3687,7 +3733,7 @@ func vipsgenMerge(ref *C.VipsImage, sec *C.VipsImage, direction Direction, dx in
 func vipsgenMergeWithOptions(ref *C.VipsImage, sec *C.VipsImage, direction Direction, dx int, dy int, mblend int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_merge_with_options(ref, sec, &out, C.VipsDirection(direction), C.gint(dx), C.gint(dy), C.gint(mblend)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("merge", out)
 	}
 	return out, nil
 }
@@ -3697,7 +3743,7 @@ func vipsgenMin(in *C.VipsImage) (float64, error) {
 	var out float64
 	cout := new(C.double)
 	if err := C.vipsgen_min(in, cout); err != 0 {
-		return 0, handleVipsError()
+		return 0, handleVipsError("min")
 	}
 	out = float64(*cout)
 	return out, nil
@@ -3718,7 +3764,7 @@ func vipsgenMinWithOptions(in *C.VipsImage, size int, x *int, y *int) (float64,
 		cy = &cyValue
 	}
 	if err := C.vipsgen_min_with_options(in, cout, C.gint(size), cx, cy); err != 0 {
-		return 0, handleVipsError()
+		return 0, handleVipsError("min")
 	}
 	out = float64(*cout)
 	if x != nil {
@@ -3734,7 +3780,7 @@ func vipsgenMinWithOptions(in *C.VipsImage, size int, x *int, y *int) (float64,
 func vipsgenMinpair(left *C.VipsImage, right *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_minpair(left, right, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("minpair", out)
 	}
 	return out, nil
 }
@@ -3743,7 +3789,7 @@ func vipsgenMinpair(left *C.VipsImage, right *C.VipsImage) (*C.VipsImage, error)
 func vipsgenMorph(in *C.VipsImage, mask *C.VipsImage, morph OperationMorphology) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_morph(in, &out, mask, C.VipsOperationMorphology(morph)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("morph", out)
 	}
 	return out, nil
 }
@@ -3752,7 +3798,7 @@ func vipsgenMorph(in *C.VipsImage, mask *C.VipsImage, morph OperationMorphology)
 func vipsgenMosaic(ref *C.VipsImage, sec *C.VipsImage, direction Direction, xref int, yref int, xsec int, ysec int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_mosaic(ref, sec, &out, C.VipsDirection(direction), C.gint(xref), C.gint(yref), C.gint(xsec), C.gint(ysec)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("mosaic", out)
 	}
 	return out, nil
 }
@@ -3791,7 +3837,7 @@ func vipsgenMosaicWithOptions(ref *C.VipsImage, sec *C.VipsImage, direction Dire
 		cdx1 = &cdx1Value
 	}
 	if err := C.vipsgen_mosaic_with_options(ref, sec, &out, C.VipsDirection(direction), C.gint(xref), C.gint(yref), C.gint(xsec), C.gint(ysec), C.gint(hwindow), C.gint(harea), C.gint(mblend), C.gint(bandno), cdx0, cdy0, cscale1, cangle1, cdy1, cdx1); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("mosaic", out)
 	}
 	if dx0 != nil {
 		*dx0 = int(cdx0Value)
@@ -3818,7 +3864,7 @@ func vipsgenMosaicWithOptions(ref *C.VipsImage, sec *C.VipsImage, direction Dire
 func vipsgenMosaic1(ref *C.VipsImage, sec *C.VipsImage, direction Direction, xr1 int, yr1 int, xs1 int, ys1 int, xr2 int, yr2 int, xs2 int, ys2 int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_mosaic1(ref, sec, &out, C.VipsDirection(direction), C.gint(xr1), C.gint(yr1), C.gint(xs1), C.gint(ys1), C.gint(xr2), C.gint(yr2), C.gint(xs2), C.gint(ys2)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("mosaic1", out)
 	}
 	return out, nil
 }
@@ -3827,7 +3873,7 @@ func vipsgenMosaic1(ref *C.VipsImage, sec *C.VipsImage, direction Direction, xr1
 func vipsgenMosaic1WithOptions(ref *C.VipsImage, sec *C.VipsImage, direction Direction, xr1 int, yr1 int, xs1 int, ys1 int, xr2 int, yr2 int, xs2 int, ys2 int, hwindow int, harea int, search bool, interpolate *Interpolate, mblend int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_mosaic1_with_options(ref, sec, &out, C.VipsDirection(direction), C.gint(xr1), C.gint(yr1), C.gint(xs1), C.gint(ys1), C.gint(xr2), C.gint(yr2), C.gint(xs2), C.gint(ys2), C.gint(hwindow), C.gint(harea), C.int(boolToInt(search)), vipsInterpolateToC(interpolate), C.gint(mblend)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("mosaic1", out)
 	}
 	return out, nil
 }
@@ -3836,7 +3882,7 @@ func vipsgenMosaic1WithOptions(ref *C.VipsImage, sec *C.VipsImage, direction Dir
 func vipsgenMsb(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_msb(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("msb", out)
 	}
 	return out, nil
 }
@@ -3845,7 +3891,7 @@ func vipsgenMsb(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenMsbWithOptions(in *C.VipsImage, band int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_msb_with_options(in, &out, C.gint(band)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("msb", out)
 	}
 	return out, nil
 }
@@ -3854,7 +3900,7 @@ func vipsgenMsbWithOptions(in *C.VipsImage, band int) (*C.VipsImage, error) {
 func vipsgenMultiply(left *C.VipsImage, right *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_multiply(left, right, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("multiply", out)
 	}
 	return out, nil
 }
@@ -3865,7 +3911,7 @@ func vipsgenNiftiload(filename string) (*C.VipsImage, error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_niftiload(cfilename, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("niftiload", out)
 	}
 	return out, nil
 }
@@ -3876,7 +3922,7 @@ func vipsgenNiftiloadWithOptions(filename string, memory bool, access Access, fa
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_niftiload_with_options(cfilename, &out, C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("niftiload", out)
 	}
 	return out, nil
 }
@@ -3885,7 +3931,7 @@ func vipsgenNiftiloadWithOptions(filename string, memory bool, access Access, fa
 func vipsgenNiftiloadSource(source *C.VipsSourceCustom) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_niftiload_source(source, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("niftiload_source", out)
 	}
 	return out, nil
 }
@@ -3894,7 +3940,7 @@ func vipsgenNiftiloadSource(source *C.VipsSourceCustom) (*C.VipsImage, error) {
 func vipsgenNiftiloadSourceWithOptions(source *C.VipsSourceCustom, memory bool, access Access, failOn FailOn, revalidate bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_niftiload_source_with_options(source, &out, C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("niftiload_source", out)
 	}
 	return out, nil
 }
@@ -3904,7 +3950,7 @@ func vipsgenNiftisave(in *C.VipsImage, filename string) (error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_niftisave(in, cfilename); err != 0 {
-		return handleVipsError()
+		return handleVipsError("niftisave")
 	}
 	return nil
 }
@@ -3923,7 +3969,7 @@ func vipsgenNiftisaveWithOptions(in *C.VipsImage, filename string, keep Keep, ba
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_niftisave_with_options(in, cfilename, C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return handleVipsError()
+		return handleVipsError("niftisave")
 	}
 	return nil
 }
@@ -3934,7 +3980,7 @@ func vipsgenOpenexrload(filename string) (*C.VipsImage, error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_openexrload(cfilename, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("openexrload", out)
 	}
 	return out, nil
 }
@@ -3945,7 +3991,7 @@ func vipsgenOpenexrloadWithOptions(filename string, memory bool, access Access,
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_openexrload_with_options(cfilename, &out, C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("openexrload", out)
 	}
 	return out, nil
 }
@@ -3956,7 +4002,7 @@ func vipsgenOpenslideload(filename string) (*C.VipsImage, error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_openslideload(cfilename, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("openslideload", out)
 	}
 	return out, nil
 }
@@ -3969,7 +4015,7 @@ func vipsgenOpenslideloadWithOptions(filename string, level int, autocrop bool,
 	cassociated := C.CString(associated)
 	defer freeCString(cassociated)
 	if err := C.vipsgen_openslideload_with_options(cfilename, &out, C.gint(level), C.int(boolToInt(autocrop)), cassociated, C.int(boolToInt(attachAssociated)), C.int(boolToInt(rgb)), C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("openslideload", out)
 	}
 	return out, nil
 }
@@ -3978,7 +4024,7 @@ func vipsgenOpenslideloadWithOptions(filename string, level int, autocrop bool,
 func vipsgenOpenslideloadSource(source *C.VipsSourceCustom) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_openslideload_source(source, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("openslideload_source", out)
 	}
 	return out, nil
 }
@@ -3989,7 +4035,7 @@ func vipsgenOpenslideloadSourceWithOptions(source *C.VipsSourceCustom, level int
 	cassociated := C.CString(associated)
 	defer freeCString(cassociated)
 	if err := C.vipsgen_openslideload_source_with_options(source, &out, C.gint(level), C.int(boolToInt(autocrop)), cassociated, C.int(boolToInt(attachAssociated)), C.int(boolToInt(rgb)), C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("openslideload_source", out)
 	}
 	return out, nil
 }
@@ -4000,7 +4046,7 @@ func vipsgenPdfload(filename string) (*C.VipsImage, error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_pdfload(cfilename, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("pdfload", out)
 	}
 	return out, nil
 }
@@ -4020,7 +4066,7 @@ func vipsgenPdfloadWithOptions(filename string, page int, n int, dpi float64, sc
 	cpassword := C.CString(password)
 	defer freeCString(cpassword)
 	if err := C.vipsgen_pdfload_with_options(cfilename, &out, C.gint(page), C.gint(n), C.double(dpi), C.double(scale), cbackground, cbackgroundLength, cpassword, C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("pdfload", out)
 	}
 	return out, nil
 }
@@ -4032,7 +4078,7 @@ func vipsgenPdfloadBuffer(buf []byte) (*C.VipsImage, error) {
 	defer runtime.KeepAlive(src)
 	var out *C.VipsImage
 	if err := C.vipsgen_pdfload_buffer(unsafe.Pointer(&src[0]), C.size_t(len(src)), &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("pdfload_buffer", out)
 	}
 	return out, nil
 }
@@ -4053,7 +4099,7 @@ func vipsgenPdfloadBufferWithOptions(buf []byte, page int, n int, dpi float64, s
 	cpassword := C.CString(password)
 	defer freeCString(cpassword)
 	if err := C.vipsgen_pdfload_buffer_with_options(unsafe.Pointer(&src[0]), C.size_t(len(src)), &out, C.gint(page), C.gint(n), C.double(dpi), C.double(scale), cbackground, cbackgroundLength, cpassword, C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("pdfload_buffer", out)
 	}
 	return out, nil
 }
@@ -4062,7 +4108,7 @@ func vipsgenPdfloadBufferWithOptions(buf []byte, page int, n int, dpi float64, s
 func vipsgenPdfloadSource(source *C.VipsSourceCustom) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_pdfload_source(source, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("pdfload_source", out)
 	}
 	return out, nil
 }
@@ -4080,7 +4126,7 @@ func vipsgenPdfloadSourceWithOptions(source *C.VipsSourceCustom, page int, n int
 	cpassword := C.CString(password)
 	defer freeCString(cpassword)
 	if err := C.vipsgen_pdfload_source_with_options(source, &out, C.gint(page), C.gint(n), C.double(dpi), C.double(scale), cbackground, cbackgroundLength, cpassword, C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("pdfload_source", out)
 	}
 	return out, nil
 }
@@ -4090,7 +4136,7 @@ func vipsgenPercent(in *C.VipsImage, percent float64) (int, error) {
 	var threshold int
 	cthreshold := new(C.gint)
 	if err := C.vipsgen_percent(in, C.double(percent), cthreshold); err != 0 {
-		return 0, handleVipsError()
+		return 0, handleVipsError("percent")
 	}
 	threshold = int(*cthreshold)
 	return threshold, nil
@@ -4100,7 +4146,7 @@ func vipsgenPercent(in *C.VipsImage, percent float64) (int, error) {
 func vipsgenPerlin(width int, height int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_perlin(&out, C.gint(width), C.gint(height)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("perlin", out)
 	}
 	return out, nil
 }
@@ -4109,7 +4155,7 @@ func vipsgenPerlin(width int, height int) (*C.VipsImage, error) {
 func vipsgenPerlinWithOptions(width int, height int, cellSize int, uchar bool, seed int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_perlin_with_options(&out, C.gint(width), C.gint(height), C.gint(cellSize), C.int(boolToInt(uchar)), C.gint(seed)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("perlin", out)
 	}
 	return out, nil
 }
@@ -4118,7 +4164,7 @@ func vipsgenPerlinWithOptions(width int, height int, cellSize int, uchar bool, s
 func vipsgenPhasecor(in *C.VipsImage, in2 *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_phasecor(in, in2, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("phasecor", out)
 	}
 	return out, nil
 }
@@ -4129,7 +4175,7 @@ func vipsgenPngload(filename string) (*C.VipsImage, error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_pngload(cfilename, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("pngload", out)
 	}
 	return out, nil
 }
@@ -4140,7 +4186,7 @@ func vipsgenPngloadWithOptions(filename string, unlimited bool, memory bool, acc
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_pngload_with_options(cfilename, &out, C.int(boolToInt(unlimited)), C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("pngload", out)
 	}
 	return out, nil
 }
@@ -4152,7 +4198,7 @@ func vipsgenPngloadBuffer(buf []byte) (*C.VipsImage, error) {
 	defer runtime.KeepAlive(src)
 	var out *C.VipsImage
 	if err := C.vipsgen_pngload_buffer(unsafe.Pointer(&src[0]), C.size_t(len(src)), &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("pngload_buffer", out)
 	}
 	return out, nil
 }
@@ -4164,7 +4210,7 @@ func vipsgenPngloadBufferWithOptions(buf []byte, unlimited bool, memory bool, ac
 	defer runtime.KeepAlive(src)
 	var out *C.VipsImage
 	if err := C.vipsgen_pngload_buffer_with_options(unsafe.Pointer(&src[0]), C.size_t(len(src)), &out, C.int(boolToInt(unlimited)), C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("pngload_buffer", out)
 	}
 	return out, nil
 }
@@ -4173,7 +4219,7 @@ func vipsgenPngloadBufferWithOptions(buf []byte, unlimited bool, memory bool, ac
 func vipsgenPngloadSource(source *C.VipsSourceCustom) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_pngload_source(source, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("pngload_source", out)
 	}
 	return out, nil
 }
@@ -4182,7 +4228,7 @@ func vipsgenPngloadSource(source *C.VipsSourceCustom) (*C.VipsImage, error) {
 func vipsgenPngloadSourceWithOptions(source *C.VipsSourceCustom, unlimited bool, memory bool, access Access, failOn FailOn, revalidate bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_pngload_source_with_options(source, &out, C.int(boolToInt(unlimited)), C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("pngload_source", out)
 	}
 	return out, nil
 }
@@ -4192,7 +4238,7 @@ func vipsgenPngsave(in *C.VipsImage, filename string) (error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_pngsave(in, cfilename); err != 0 {
-		return handleVipsError()
+		return handleVipsError("pngsave")
 	}
 	return nil
 }
@@ -4211,7 +4257,7 @@ func vipsgenPngsaveWithOptions(in *C.VipsImage, filename string, compression int
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_pngsave_with_options(in, cfilename, C.gint(compression), C.int(boolToInt(interlace)), C.VipsForeignPngFilter(filter), C.int(boolToInt(palette)), C.gint(q), C.double(dither), C.gint(bitdepth), C.gint(effort), C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return handleVipsError()
+		return handleVipsError("pngsave")
 	}
 	return nil
 }
@@ -4221,7 +4267,7 @@ func vipsgenPngsaveBuffer(in *C.VipsImage) ([]byte, error) {
 	var buf unsafe.Pointer
 	var length C.size_t
 	if err := C.vipsgen_pngsave_buffer(in, &buf, &length); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("pngsave_buffer")
 	}
 	return bufferToBytes(buf, length), nil
 }
@@ -4240,7 +4286,7 @@ func vipsgenPngsaveBufferWithOptions(in *C.VipsImage, compression int, interlace
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_pngsave_buffer_with_options(in, &buf, &length, C.gint(compression), C.int(boolToInt(interlace)), C.VipsForeignPngFilter(filter), C.int(boolToInt(palette)), C.gint(q), C.double(dither), C.gint(bitdepth), C.gint(effort), C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("pngsave_buffer")
 	}
 	return bufferToBytes(buf, length), nil
 }
@@ -4249,7 +4295,7 @@ func vipsgenPngsaveBufferWithOptions(in *C.VipsImage, compression int, interlace
 func vipsgenPngsaveTarget(in *C.VipsImage, target *C.VipsTargetCustom) (error) {
 	
 	if err := C.vipsgen_pngsave_target(in, target); err != 0 {
-		return handleVipsError()
+		return handleVipsError("pngsave_target")
 	}
 	return nil
 }
@@ -4266,7 +4312,7 @@ func vipsgenPngsaveTargetWithOptions(in *C.VipsImage, target *C.VipsTargetCustom
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_pngsave_target_with_options(in, target, C.gint(compression), C.int(boolToInt(interlace)), C.VipsForeignPngFilter(filter), C.int(boolToInt(palette)), C.gint(q), C.double(dither), C.gint(bitdepth), C.gint(effort), C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return handleVipsError()
+		return handleVipsError("pngsave_target")
 	}
 	return nil
 }
@@ -4277,7 +4323,7 @@ func vipsgenPpmload(filename string) (*C.VipsImage, error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_ppmload(cfilename, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("ppmload", out)
 	}
 	return out, nil
 }
@@ -4288,7 +4334,7 @@ func vipsgenPpmloadWithOptions(filename string, memory bool, access Access, fail
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_ppmload_with_options(cfilename, &out, C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("ppmload", out)
 	}
 	return out, nil
 }
@@ -4300,7 +4346,7 @@ func vipsgenPpmloadBuffer(buf []byte) (*C.VipsImage, error) {
 	defer runtime.KeepAlive(src)
 	var out *C.VipsImage
 	if err := C.vipsgen_ppmload_buffer(unsafe.Pointer(&src[0]), C.size_t(len(src)), &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("ppmload_buffer", out)
 	}
 	return out, nil
 }
@@ -4312,7 +4358,7 @@ func vipsgenPpmloadBufferWithOptions(buf []byte, memory bool, access Access, fai
 	defer runtime.KeepAlive(src)
 	var out *C.VipsImage
 	if err := C.vipsgen_ppmload_buffer_with_options(unsafe.Pointer(&src[0]), C.size_t(len(src)), &out, C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("ppmload_buffer", out)
 	}
 	return out, nil
 }
@@ -4321,7 +4367,7 @@ func vipsgenPpmloadBufferWithOptions(buf []byte, memory bool, access Access, fai
 func vipsgenPpmloadSource(source *C.VipsSourceCustom) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_ppmload_source(source, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("ppmload_source", out)
 	}
 	return out, nil
 }
@@ -4330,7 +4376,7 @@ func vipsgenPpmloadSource(source *C.VipsSourceCustom) (*C.VipsImage, error) {
 func vipsgenPpmloadSourceWithOptions(source *C.VipsSourceCustom, memory bool, access Access, failOn FailOn, revalidate bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_ppmload_source_with_options(source, &out, C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("ppmload_source", out)
 	}
 	return out, nil
 }
@@ -4340,7 +4386,7 @@ func vipsgenPpmsave(in *C.VipsImage, filename string) (error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_ppmsave(in, cfilename); err != 0 {
-		return handleVipsError()
+		return handleVipsError("ppmsave")
 	}
 	return nil
 }
@@ -4359,7 +4405,7 @@ func vipsgenPpmsaveWithOptions(in *C.VipsImage, filename string, format PpmForma
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_ppmsave_with_options(in, cfilename, C.VipsForeignPpmFormat(format), C.int(boolToInt(ascii)), C.gint(bitdepth), C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return handleVipsError()
+		return handleVipsError("ppmsave")
 	}
 	return nil
 }
@@ -4368,7 +4414,7 @@ func vipsgenPpmsaveWithOptions(in *C.VipsImage, filename string, format PpmForma
 func vipsgenPpmsaveTarget(in *C.VipsImage, target *C.VipsTargetCustom) (error) {
 	
 	if err := C.vipsgen_ppmsave_target(in, target); err != 0 {
-		return handleVipsError()
+		return handleVipsError("ppmsave_target")
 	}
 	return nil
 }
@@ -4385,7 +4431,7 @@ func vipsgenPpmsaveTargetWithOptions(in *C.VipsImage, target *C.VipsTargetCustom
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_ppmsave_target_with_options(in, target, C.VipsForeignPpmFormat(format), C.int(boolToInt(ascii)), C.gint(bitdepth), C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return handleVipsError()
+		return handleVipsError("ppmsave_target")
 	}
 	return nil
 }
@@ -4394,7 +4440,7 @@ func vipsgenPpmsaveTargetWithOptions(in *C.VipsImage, target *C.VipsTargetCustom
 func vipsgenPremultiply(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_premultiply(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("premultiply", out)
 	}
 	return out, nil
 }
@@ -4403,7 +4449,7 @@ func vipsgenPremultiply(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenPremultiplyWithOptions(in *C.VipsImage, maxAlpha float64) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_premultiply_with_options(in, &out, C.double(maxAlpha)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("premultiply", out)
 	}
 	return out, nil
 }
@@ -4412,7 +4458,7 @@ func vipsgenPremultiplyWithOptions(in *C.VipsImage, maxAlpha float64) (*C.VipsIm
 func vipsgenPrewitt(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_prewitt(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("prewitt", out)
 	}
 	return out, nil
 }
@@ -4422,7 +4468,7 @@ func vipsgenProfile(in *C.VipsImage) (*C.VipsImage, *C.VipsImage, error) {
 	var columns *C.VipsImage
 	var rows *C.VipsImage
 	if err := C.vipsgen_profile(in, &columns, &rows); err != 0 {
-		return nil, nil, handleVipsError()
+		return nil, nil, handleVipsError("profile")
 	}
 	return columns, rows, nil
 }
@@ -4433,7 +4479,7 @@ func vipsgenProfileLoad(name string) ([]byte, error) {
 	cname := C.CString(name)
 	defer freeCString(cname)
 	if err := C.vipsgen_profile_load(cname, &profile); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("profile_load")
 	}
 	return vipsBlobToBytes(profile), nil
 }
@@ -4443,7 +4489,7 @@ func vipsgenProject(in *C.VipsImage) (*C.VipsImage, *C.VipsImage, error) {
 	var columns *C.VipsImage
 	var rows *C.VipsImage
 	if err := C.vipsgen_project(in, &columns, &rows); err != 0 {
-		return nil, nil, handleVipsError()
+		return nil, nil, handleVipsError("project")
 	}
 	return columns, rows, nil
 }
@@ -4452,7 +4498,7 @@ func vipsgenProject(in *C.VipsImage) (*C.VipsImage, *C.VipsImage, error) {
 func vipsgenQuadratic(in *C.VipsImage, coeff *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_quadratic(in, &out, coeff); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("quadratic", out)
 	}
 	return out, nil
 }
@@ -4461,7 +4507,7 @@ func vipsgenQuadratic(in *C.VipsImage, coeff *C.VipsImage) (*C.VipsImage, error)
 func vipsgenQuadraticWithOptions(in *C.VipsImage, coeff *C.VipsImage, interpolate *Interpolate) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_quadratic_with_options(in, &out, coeff, vipsInterpolateToC(interpolate)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("quadratic", out)
 	}
 	return out, nil
 }
@@ -4470,7 +4516,7 @@ func vipsgenQuadraticWithOptions(in *C.VipsImage, coeff *C.VipsImage, interpolat
 func vipsgenRad2float(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_rad2float(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("rad2float", out)
 	}
 	return out, nil
 }
@@ -4481,7 +4527,7 @@ func vipsgenRadload(filename string) (*C.VipsImage, error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_radload(cfilename, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("radload", out)
 	}
 	return out, nil
 }
@@ -4492,7 +4538,7 @@ func vipsgenRadloadWithOptions(filename string, memory bool, access Access, fail
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_radload_with_options(cfilename, &out, C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("radload", out)
 	}
 	return out, nil
 }
@@ -4504,7 +4550,7 @@ func vipsgenRadloadBuffer(buf []byte) (*C.VipsImage, error) {
 	defer runtime.KeepAlive(src)
 	var out *C.VipsImage
 	if err := C.vipsgen_radload_buffer(unsafe.Pointer(&src[0]), C.size_t(len(src)), &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("radload_buffer", out)
 	}
 	return out, nil
 }
@@ -4516,7 +4562,7 @@ func vipsgenRadloadBufferWithOptions(buf []byte, memory bool, access Access, fai
 	defer runtime.KeepAlive(src)
 	var out *C.VipsImage
 	if err := C.vipsgen_radload_buffer_with_options(unsafe.Pointer(&src[0]), C.size_t(len(src)), &out, C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("radload_buffer", out)
 	}
 	return out, nil
 }
@@ -4525,7 +4571,7 @@ func vipsgenRadloadBufferWithOptions(buf []byte, memory bool, access Access, fai
 func vipsgenRadloadSource(source *C.VipsSourceCustom) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_radload_source(source, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("radload_source", out)
 	}
 	return out, nil
 }
@@ -4534,7 +4580,7 @@ func vipsgenRadloadSource(source *C.VipsSourceCustom) (*C.VipsImage, error) {
 func vipsgenRadloadSourceWithOptions(source *C.VipsSourceCustom, memory bool, access Access, failOn FailOn, revalidate bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_radload_source_with_options(source, &out, C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("radload_source", out)
 	}
 	return out, nil
 }
@@ -4544,7 +4590,7 @@ func vipsgenRadsave(in *C.VipsImage, filename string) (error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_radsave(in, cfilename); err != 0 {
-		return handleVipsError()
+		return handleVipsError("radsave")
 	}
 	return nil
 }
@@ -4563,7 +4609,7 @@ func vipsgenRadsaveWithOptions(in *C.VipsImage, filename string, keep Keep, back
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_radsave_with_options(in, cfilename, C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return handleVipsError()
+		return handleVipsError("radsave")
 	}
 	return nil
 }
@@ -4573,7 +4619,7 @@ func vipsgenRadsaveBuffer(in *C.VipsImage) ([]byte, error) {
 	var buf unsafe.Pointer
 	var length C.size_t
 	if err := C.vipsgen_radsave_buffer(in, &buf, &length); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("radsave_buffer")
 	}
 	return bufferToBytes(buf, length), nil
 }
@@ -4592,7 +4638,7 @@ func vipsgenRadsaveBufferWithOptions(in *C.VipsImage, keep Keep, background []fl
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_radsave_buffer_with_options(in, &buf, &length, C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("radsave_buffer")
 	}
 	return bufferToBytes(buf, length), nil
 }
@@ -4601,7 +4647,7 @@ func vipsgenRadsaveBufferWithOptions(in *C.VipsImage, keep Keep, background []fl
 func vipsgenRadsaveTarget(in *C.VipsImage, target *C.VipsTargetCustom) (error) {
 	
 	if err := C.vipsgen_radsave_target(in, target); err != 0 {
-		return handleVipsError()
+		return handleVipsError("radsave_target")
 	}
 	return nil
 }
@@ -4618,7 +4664,7 @@ func vipsgenRadsaveTargetWithOptions(in *C.VipsImage, target *C.VipsTargetCustom
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_radsave_target_with_options(in, target, C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return handleVipsError()
+		return handleVipsError("radsave_target")
 	}
 	return nil
 }
@@ -4627,7 +4673,7 @@ func vipsgenRadsaveTargetWithOptions(in *C.VipsImage, target *C.VipsTargetCustom
 func vipsgenRank(in *C.VipsImage, width int, height int, index int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_rank(in, &out, C.gint(width), C.gint(height), C.gint(index)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("rank", out)
 	}
 	return out, nil
 }
@@ -4638,7 +4684,7 @@ func vipsgenRawload(filename string, width int, height int, bands int) (*C.VipsI
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_rawload(cfilename, &out, C.gint(width), C.gint(height), C.gint(bands)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("rawload", out)
 	}
 	return out, nil
 }
@@ -4649,7 +4695,7 @@ func vipsgenRawloadWithOptions(filename string, width int, height int, bands int
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_rawload_with_options(cfilename, &out, C.gint(width), C.gint(height), C.gint(bands), C.guint64(offset), C.VipsBandFormat(format), C.VipsInterpretation(interpretation), C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("rawload", out)
 	}
 	return out, nil
 }
@@ -4659,7 +4705,7 @@ func vipsgenRawsave(in *C.VipsImage, filename string) (error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_rawsave(in, cfilename); err != 0 {
-		return handleVipsError()
+		return handleVipsError("rawsave")
 	}
 	return nil
 }
@@ -4678,7 +4724,7 @@ func vipsgenRawsaveWithOptions(in *C.VipsImage, filename string, keep Keep, back
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_rawsave_with_options(in, cfilename, C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return handleVipsError()
+		return handleVipsError("rawsave")
 	}
 	return nil
 }
@@ -4688,7 +4734,7 @@ func vipsgenRawsaveBuffer(in *C.VipsImage) ([]byte, error) {
 	var buf unsafe.Pointer
 	var length C.size_t
 	if err := C.vipsgen_rawsave_buffer(in, &buf, &length); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("rawsave_buffer")
 	}
 	return bufferToBytes(buf, length), nil
 }
@@ -4707,7 +4753,7 @@ func vipsgenRawsaveBufferWithOptions(in *C.VipsImage, keep Keep, background []fl
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_rawsave_buffer_with_options(in, &buf, &length, C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("rawsave_buffer")
 	}
 	return bufferToBytes(buf, length), nil
 }
@@ -4716,7 +4762,7 @@ func vipsgenRawsaveBufferWithOptions(in *C.VipsImage, keep Keep, background []fl
 func vipsgenRawsaveTarget(in *C.VipsImage, target *C.VipsTargetCustom) (error) {
 	
 	if err := C.vipsgen_rawsave_target(in, target); err != 0 {
-		return handleVipsError()
+		return handleVipsError("rawsave_target")
 	}
 	return nil
 }
@@ -4733,7 +4779,7 @@ func vipsgenRawsaveTargetWithOptions(in *C.VipsImage, target *C.VipsTargetCustom
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_rawsave_target_with_options(in, target, C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return handleVipsError()
+		return handleVipsError("rawsave_target")
 	}
 	return nil
 }
@@ -4742,7 +4788,7 @@ func vipsgenRawsaveTargetWithOptions(in *C.VipsImage, target *C.VipsTargetCustom
 func vipsgenRecomb(in *C.VipsImage, m *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_recomb(in, &out, m); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("recomb", out)
 	}
 	return out, nil
 }
@@ -4751,7 +4797,7 @@ func vipsgenRecomb(in *C.VipsImage, m *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenReduce(in *C.VipsImage, hshrink float64, vshrink float64) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_reduce(in, &out, C.double(hshrink), C.double(vshrink)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("reduce", out)
 	}
 	return out, nil
 }
@@ -4760,7 +4806,7 @@ func vipsgenReduce(in *C.VipsImage, hshrink float64, vshrink float64) (*C.VipsIm
 func vipsgenReduceWithOptions(in *C.VipsImage, hshrink float64, vshrink float64, kernel Kernel, gap float64) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_reduce_with_options(in, &out, C.double(hshrink), C.double(vshrink), C.VipsKernel(kernel), C.double(gap)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("reduce", out)
 	}
 	return out, nil
 }
@@ -4769,7 +4815,7 @@ func vipsgenReduceWithOptions(in *C.VipsImage, hshrink float64, vshrink float64,
 func vipsgenReduceh(in *C.VipsImage, hshrink float64) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_reduceh(in, &out, C.double(hshrink)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("reduceh", out)
 	}
 	return out, nil
 }
@@ -4778,7 +4824,7 @@ func vipsgenReduceh(in *C.VipsImage, hshrink float64) (*C.VipsImage, error) {
 func vipsgenReducehWithOptions(in *C.VipsImage, hshrink float64, kernel Kernel, gap float64) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_reduceh_with_options(in, &out, C.double(hshrink), C.VipsKernel(kernel), C.double(gap)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("reduceh", out)
 	}
 	return out, nil
 }
@@ -4787,7 +4833,7 @@ func vipsgenReducehWithOptions(in *C.VipsImage, hshrink float64, kernel Kernel,
 func vipsgenReducev(in *C.VipsImage, vshrink float64) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_reducev(in, &out, C.double(vshrink)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("reducev", out)
 	}
 	return out, nil
 }
@@ -4796,7 +4842,7 @@ func vipsgenReducev(in *C.VipsImage, vshrink float64) (*C.VipsImage, error) {
 func vipsgenReducevWithOptions(in *C.VipsImage, vshrink float64, kernel Kernel, gap float64) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_reducev_with_options(in, &out, C.double(vshrink), C.VipsKernel(kernel), C.double(gap)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("reducev", out)
 	}
 	return out, nil
 }
@@ -4805,7 +4851,7 @@ func vipsgenReducevWithOptions(in *C.VipsImage, vshrink float64, kernel Kernel,
 func vipsgenRelational(left *C.VipsImage, right *C.VipsImage, relational OperationRelational) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_relational(left, right, &out, C.VipsOperationRelational(relational)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("relational", out)
 	}
 	return out, nil
 }
@@ -4824,7 +4870,7 @@ func vipsgenRelationalConst(in *C.VipsImage, relational OperationRelational, c [
 		defer freeDoubleArray(cc)
 	}
 	if err := C.vipsgen_relational_const(in, &out, C.VipsOperationRelational(relational), cc, C.int(len(c))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("relational_const", out)
 	}
 	return out, nil
 }
@@ -4833,7 +4879,7 @@ func vipsgenRelationalConst(in *C.VipsImage, relational OperationRelational, c [
 func vipsgenRemainder(left *C.VipsImage, right *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_remainder(left, right, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("remainder", out)
 	}
 	return out, nil
 }
@@ -4852,7 +4898,7 @@ func vipsgenRemainderConst(in *C.VipsImage, c []float64) (*C.VipsImage, error) {
 		defer freeDoubleArray(cc)
 	}
 	if err := C.vipsgen_remainder_const(in, &out, cc, C.int(len(c))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("remainder_const", out)
 	}
 	return out, nil
 }
@@ -4865,7 +4911,7 @@ func vipsgenRemosaic(in *C.VipsImage, oldStr string, newStr string) (*C.VipsImag
 	cnewStr := C.CString(newStr)
 	defer freeCString(cnewStr)
 	if err := C.vipsgen_remosaic(in, &out, coldStr, cnewStr); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("remosaic", out)
 	}
 	return out, nil
 }
@@ -4874,7 +4920,7 @@ func vipsgenRemosaic(in *C.VipsImage, oldStr string, newStr string) (*C.VipsImag
 func vipsgenReplicate(in *C.VipsImage, across int, down int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_replicate(in, &out, C.gint(across), C.gint(down)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("replicate", out)
 	}
 	return out, nil
 }
@@ -4883,7 +4929,7 @@ func vipsgenReplicate(in *C.VipsImage, across int, down int) (*C.VipsImage, erro
 func vipsgenResize(in *C.VipsImage, scale float64) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_resize(in, &out, C.double(scale)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("resize", out)
 	}
 	return out, nil
 }
@@ -4892,7 +4938,7 @@ func vipsgenResize(in *C.VipsImage, scale float64) (*C.VipsImage, error) {
 func vipsgenResizeWithOptions(in *C.VipsImage, scale float64, kernel Kernel, gap float64, vscale float64) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_resize_with_options(in, &out, C.double(scale), C.VipsKernel(kernel), C.double(gap), C.double(vscale)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("resize", out)
 	}
 	return out, nil
 }
@@ -4901,7 +4947,7 @@ func vipsgenResizeWithOptions(in *C.VipsImage, scale float64, kernel Kernel, gap
 func vipsgenRot(in *C.VipsImage, angle Angle) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_rot(in, &out, C.VipsAngle(angle)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("rot", out)
 	}
 	return out, nil
 }
@@ -4910,7 +4956,7 @@ func vipsgenRot(in *C.VipsImage, angle Angle) (*C.VipsImage, error) {
 func vipsgenRot45(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_rot45(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("rot45", out)
 	}
 	return out, nil
 }
@@ -4919,7 +4965,7 @@ func vipsgenRot45(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenRot45WithOptions(in *C.VipsImage, angle Angle45) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_rot45_with_options(in, &out, C.VipsAngle45(angle)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("rot45", out)
 	}
 	return out, nil
 }
@@ -4928,7 +4974,7 @@ func vipsgenRot45WithOptions(in *C.VipsImage, angle Angle45) (*C.VipsImage, erro
 func vipsgenRotate(in *C.VipsImage, angle float64) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_rotate(in, &out, C.double(angle)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("rotate", out)
 	}
 	return out, nil
 }
@@ -4944,7 +4990,7 @@ func vipsgenRotateWithOptions(in *C.VipsImage, angle float64, interpolate *Inter
 		defer freeDoubleArray(cbackground)
 	}
 	if err := C.vipsgen_rotate_with_options(in, &out, C.double(angle), vipsInterpolateToC(interpolate), cbackground, cbackgroundLength, C.double(odx), C.double(ody), C.double(idx), C.double(idy)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("rotate", out)
 	}
 	return out, nil
 }
@@ -4953,7 +4999,7 @@ func vipsgenRotateWithOptions(in *C.VipsImage, angle float64, interpolate *Inter
 func vipsgenRound(in *C.VipsImage, round OperationRound) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_round(in, &out, C.VipsOperationRound(round)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("round", out)
 	}
 	return out, nil
 }
@@ -4962,7 +5008,7 @@ func vipsgenRound(in *C.VipsImage, round OperationRound) (*C.VipsImage, error) {
 func vipsgenSRGB2HSV(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_sRGB2HSV(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("sRGB2HSV", out)
 	}
 	return out, nil
 }
@@ -4971,7 +5017,7 @@ func vipsgenSRGB2HSV(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenSRGB2scRGB(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_sRGB2scRGB(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("sRGB2scRGB", out)
 	}
 	return out, nil
 }
@@ -4980,7 +5026,7 @@ func vipsgenSRGB2scRGB(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenScRGB2BW(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_scRGB2BW(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("scRGB2BW", out)
 	}
 	return out, nil
 }
@@ -4989,7 +5035,7 @@ func vipsgenScRGB2BW(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenScRGB2BWWithOptions(in *C.VipsImage, depth int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_scRGB2BW_with_options(in, &out, C.gint(depth)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("scRGB2BW", out)
 	}
 	return out, nil
 }
@@ -4998,7 +5044,7 @@ func vipsgenScRGB2BWWithOptions(in *C.VipsImage, depth int) (*C.VipsImage, error
 func vipsgenScRGB2XYZ(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_scRGB2XYZ(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("scRGB2XYZ", out)
 	}
 	return out, nil
 }
@@ -5007,7 +5053,7 @@ func vipsgenScRGB2XYZ(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenScRGB2sRGB(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_scRGB2sRGB(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("scRGB2sRGB", out)
 	}
 	return out, nil
 }
@@ -5016,7 +5062,7 @@ func vipsgenScRGB2sRGB(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenScRGB2sRGBWithOptions(in *C.VipsImage, depth int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_scRGB2sRGB_with_options(in, &out, C.gint(depth)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("scRGB2sRGB", out)
 	}
 	return out, nil
 }
@@ -5025,7 +5071,7 @@ func vipsgenScRGB2sRGBWithOptions(in *C.VipsImage, depth int) (*C.VipsImage, err
 func vipsgenScale(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_scale(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("scale", out)
 	}
 	return out, nil
 }
@@ -5034,7 +5080,7 @@ func vipsgenScale(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenScaleWithOptions(in *C.VipsImage, exp float64, log bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_scale_with_options(in, &out, C.double(exp), C.int(boolToInt(log))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("scale", out)
 	}
 	return out, nil
 }
@@ -5043,7 +5089,7 @@ func vipsgenScaleWithOptions(in *C.VipsImage, exp float64, log bool) (*C.VipsIma
 func vipsgenScharr(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_scharr(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("scharr", out)
 	}
 	return out, nil
 }
@@ -5052,7 +5098,7 @@ func vipsgenScharr(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenSdf(width int, height int, shape SdfShape) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_sdf(&out, C.gint(width), C.gint(height), C.VipsSdfShape(shape)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("sdf", out)
 	}
 	return out, nil
 }
@@ -5082,7 +5128,7 @@ func vipsgenSdfWithOptions(width int, height int, shape SdfShape, r float64, a [
 		defer freeDoubleArray(ccorners)
 	}
 	if err := C.vipsgen_sdf_with_options(&out, C.gint(width), C.gint(height), C.VipsSdfShape(shape), C.double(r), ca, caLength, cb, cbLength, ccorners, ccornersLength); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("sdf", out)
 	}
 	return out, nil
 }
@@ -5091,7 +5137,7 @@ func vipsgenSdfWithOptions(width int, height int, shape SdfShape, r float64, a [
 func vipsgenSequential(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_sequential(in, &out); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("sequential")
 	}
 	return out, nil
 }
@@ -5100,7 +5146,7 @@ func vipsgenSequential(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenSequentialWithOptions(in *C.VipsImage, tileHeight int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_sequential_with_options(in, &out, C.gint(tileHeight)); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("sequential")
 	}
 	return out, nil
 }
@@ -5109,7 +5155,7 @@ func vipsgenSequentialWithOptions(in *C.VipsImage, tileHeight int) (*C.VipsImage
 func vipsgenSharpen(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_sharpen(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("sharpen", out)
 	}
 	return out, nil
 }
@@ -5118,7 +5164,7 @@ func vipsgenSharpen(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenSharpenWithOptions(in *C.VipsImage, sigma float64, x1 float64, y2 float64, y3 float64, m1 float64, m2 float64) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_sharpen_with_options(in, &out, C.double(sigma), C.double(x1), C.double(y2), C.double(y3), C.double(m1), C.double(m2)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("sharpen", out)
 	}
 	return out, nil
 }
@@ -5127,7 +5173,7 @@ func vipsgenSharpenWithOptions(in *C.VipsImage, sigma float64, x1 float64, y2 fl
 func vipsgenShrink(in *C.VipsImage, hshrink float64, vshrink float64) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_shrink(in, &out, C.double(hshrink), C.double(vshrink)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("shrink", out)
 	}
 	return out, nil
 }
@@ -5136,7 +5182,7 @@ func vipsgenShrink(in *C.VipsImage, hshrink float64, vshrink float64) (*C.VipsIm
 func vipsgenShrinkWithOptions(in *C.VipsImage, hshrink float64, vshrink float64, ceil bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_shrink_with_options(in, &out, C.double(hshrink), C.double(vshrink), C.int(boolToInt(ceil))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("shrink", out)
 	}
 	return out, nil
 }
@@ -5145,7 +5191,7 @@ func vipsgenShrinkWithOptions(in *C.VipsImage, hshrink float64, vshrink float64,
 func vipsgenShrinkh(in *C.VipsImage, hshrink int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_shrinkh(in, &out, C.gint(hshrink)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("shrinkh", out)
 	}
 	return out, nil
 }
@@ -5154,7 +5200,7 @@ func vipsgenShrinkh(in *C.VipsImage, hshrink int) (*C.VipsImage, error) {
 func vipsgenShrinkhWithOptions(in *C.VipsImage, hshrink int, ceil bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_shrinkh_with_options(in, &out, C.gint(hshrink), C.int(boolToInt(ceil))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("shrinkh", out)
 	}
 	return out, nil
 }
@@ -5163,7 +5209,7 @@ func vipsgenShrinkhWithOptions(in *C.VipsImage, hshrink int, ceil bool) (*C.Vips
 func vipsgenShrinkv(in *C.VipsImage, vshrink int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_shrinkv(in, &out, C.gint(vshrink)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("shrinkv", out)
 	}
 	return out, nil
 }
@@ -5172,7 +5218,7 @@ func vipsgenShrinkv(in *C.VipsImage, vshrink int) (*C.VipsImage, error) {
 func vipsgenShrinkvWithOptions(in *C.VipsImage, vshrink int, ceil bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_shrinkv_with_options(in, &out, C.gint(vshrink), C.int(boolToInt(ceil))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("shrinkv", out)
 	}
 	return out, nil
 }
@@ -5181,7 +5227,7 @@ func vipsgenShrinkvWithOptions(in *C.VipsImage, vshrink int, ceil bool) (*C.Vips
 func vipsgenSign(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_sign(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("sign", out)
 	}
 	return out, nil
 }
@@ -5190,7 +5236,7 @@ func vipsgenSign(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenSimilarity(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_similarity(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("similarity", out)
 	}
 	return out, nil
 }
@@ -5206,7 +5252,7 @@ func vipsgenSimilarityWithOptions(in *C.VipsImage, scale float64, angle float64,
 		defer freeDoubleArray(cbackground)
 	}
 	if err := C.vipsgen_similarity_with_options(in, &out, C.double(scale), C.double(angle), vipsInterpolateToC(interpolate), cbackground, cbackgroundLength, C.double(odx), C.double(ody), C.double(idx), C.double(idy)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("similarity", out)
 	}
 	return out, nil
 }
@@ -5215,7 +5261,7 @@ func vipsgenSimilarityWithOptions(in *C.VipsImage, scale float64, angle float64,
 func vipsgenSines(width int, height int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_sines(&out, C.gint(width), C.gint(height)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("sines", out)
 	}
 	return out, nil
 }
@@ -5224,7 +5270,7 @@ func vipsgenSines(width int, height int) (*C.VipsImage, error) {
 func vipsgenSinesWithOptions(width int, height int, uchar bool, hfreq float64, vfreq float64) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_sines_with_options(&out, C.gint(width), C.gint(height), C.int(boolToInt(uchar)), C.double(hfreq), C.double(vfreq)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("sines", out)
 	}
 	return out, nil
 }
@@ -5233,7 +5279,7 @@ func vipsgenSinesWithOptions(width int, height int, uchar bool, hfreq float64, v
 func vipsgenSmartcrop(input *C.VipsImage, width int, height int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_smartcrop(input, &out, C.gint(width), C.gint(height)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("smartcrop", out)
 	}
 	return out, nil
 }
@@ -5252,7 +5298,7 @@ func vipsgenSmartcropWithOptions(input *C.VipsImage, width int, height int, inte
 		cattentionY = &cattentionYValue
 	}
 	if err := C.vipsgen_smartcrop_with_options(input, &out, C.gint(width), C.gint(height), C.VipsInteresting(interesting), C.int(boolToInt(premultiplied)), cattentionX, cattentionY); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("smartcrop", out)
 	}
 	if attentionX != nil {
 		*attentionX = int(cattentionXValue)
@@ -5267,7 +5313,7 @@ func vipsgenSmartcropWithOptions(input *C.VipsImage, width int, height int, inte
 func vipsgenSobel(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_sobel(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("sobel", out)
 	}
 	return out, nil
 }
@@ -5276,7 +5322,7 @@ func vipsgenSobel(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenSpcor(in *C.VipsImage, ref *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_spcor(in, ref, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("spcor", out)
 	}
 	return out, nil
 }
@@ -5285,7 +5331,7 @@ func vipsgenSpcor(in *C.VipsImage, ref *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenSpectrum(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_spectrum(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("spectrum", out)
 	}
 	return out, nil
 }
@@ -5294,7 +5340,7 @@ func vipsgenSpectrum(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenStats(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_stats(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("stats", out)
 	}
 	return out, nil
 }
@@ -5303,7 +5349,7 @@ func vipsgenStats(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenStdif(in *C.VipsImage, width int, height int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_stdif(in, &out, C.gint(width), C.gint(height)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("stdif", out)
 	}
 	return out, nil
 }
@@ -5312,7 +5358,7 @@ func vipsgenStdif(in *C.VipsImage, width int, height int) (*C.VipsImage, error)
 func vipsgenStdifWithOptions(in *C.VipsImage, width int, height int, s0 float64, b float64, m0 float64, a float64) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_stdif_with_options(in, &out, C.gint(width), C.gint(height), C.double(s0), C.double(b), C.double(m0), C.double(a)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("stdif", out)
 	}
 	return out, nil
 }
@@ -5321,7 +5367,7 @@ func vipsgenStdifWithOptions(in *C.VipsImage, width int, height int, s0 float64,
 func vipsgenSubsample(input *C.VipsImage, xfac int, yfac int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_subsample(input, &out, C.gint(xfac), C.gint(yfac)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("subsample", out)
 	}
 	return out, nil
 }
@@ -5330,7 +5376,7 @@ func vipsgenSubsample(input *C.VipsImage, xfac int, yfac int) (*C.VipsImage, err
 func vipsgenSubsampleWithOptions(input *C.VipsImage, xfac int, yfac int, point bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_subsample_with_options(input, &out, C.gint(xfac), C.gint(yfac), C.int(boolToInt(point))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("subsample", out)
 	}
 	return out, nil
 }
@@ -5339,7 +5385,7 @@ func vipsgenSubsampleWithOptions(input *C.VipsImage, xfac int, yfac int, point b
 func vipsgenSubtract(left *C.VipsImage, right *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_subtract(left, right, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("subtract", out)
 	}
 	return out, nil
 }
@@ -5358,7 +5404,7 @@ func vipsgenSum(in []*C.VipsImage) (*C.VipsImage, error) {
 		defer freeImageArray(cin)
 	}
 	if err := C.vipsgen_sum((**C.VipsImage)(cin), &out, C.int(len(in))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("sum", out)
 	}
 	return out, nil
 }
@@ -5369,7 +5415,7 @@ func vipsgenSvgload(filename string) (*C.VipsImage, error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_svgload(cfilename, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("svgload", out)
 	}
 	return out, nil
 }
@@ -5382,7 +5428,7 @@ func vipsgenSvgloadWithOptions(filename string, dpi float64, scale float64, unli
 	cstylesheet := C.CString(stylesheet)
 	defer freeCString(cstylesheet)
 	if err := C.vipsgen_svgload_with_options(cfilename, &out, C.double(dpi), C.double(scale), C.int(boolToInt(unlimited)), cstylesheet, C.int(boolToInt(highBitdepth)), C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("svgload", out)
 	}
 	return out, nil
 }
@@ -5394,7 +5440,7 @@ func vipsgenSvgloadBuffer(buf []byte) (*C.VipsImage, error) {
 	defer runtime.KeepAlive(src)
 	var out *C.VipsImage
 	if err := C.vipsgen_svgload_buffer(unsafe.Pointer(&src[0]), C.size_t(len(src)), &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("svgload_buffer", out)
 	}
 	return out, nil
 }
@@ -5408,7 +5454,7 @@ func vipsgenSvgloadBufferWithOptions(buf []byte, dpi float64, scale float64, unl
 	cstylesheet := C.CString(stylesheet)
 	defer freeCString(cstylesheet)
 	if err := C.vipsgen_svgload_buffer_with_options(unsafe.Pointer(&src[0]), C.size_t(len(src)), &out, C.double(dpi), C.double(scale), C.int(boolToInt(unlimited)), cstylesheet, C.int(boolToInt(highBitdepth)), C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("svgload_buffer", out)
 	}
 	return out, nil
 }
@@ -5417,7 +5463,7 @@ func vipsgenSvgloadBufferWithOptions(buf []byte, dpi float64, scale float64, unl
 func vipsgenSvgloadSource(source *C.VipsSourceCustom) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_svgload_source(source, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("svgload_source", out)
 	}
 	return out, nil
 }
@@ -5428,7 +5474,7 @@ func vipsgenSvgloadSourceWithOptions(source *C.VipsSourceCustom, dpi float64, sc
 	cstylesheet := C.CString(stylesheet)
 	defer freeCString(cstylesheet)
 	if err := C.vipsgen_svgload_source_with_options(source, &out, C.double(dpi), C.double(scale), C.int(boolToInt(unlimited)), cstylesheet, C.int(boolToInt(highBitdepth)), C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("svgload_source", out)
 	}
 	return out, nil
 }
@@ -5447,7 +5493,7 @@ func vipsgenSwitch(tests []*C.VipsImage) (*C.VipsImage, error) {
 		defer freeImageArray(ctests)
 	}
 	if err := C.vipsgen_switch((**C.VipsImage)(ctests), &out, C.int(len(tests))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("switch", out)
 	}
 	return out, nil
 }
@@ -5458,7 +5504,7 @@ func vipsgenSystem(cmdFormat string) (*C.VipsImage, error) {
 	ccmdFormat := C.CString(cmdFormat)
 	defer freeCString(ccmdFormat)
 	if err := C.vipsgen_system(ccmdFormat); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("system", out)
 	}
 	return out, nil
 }
@@ -5480,7 +5526,7 @@ func vipsgenSystemWithOptions(cmdFormat string, in []*C.VipsImage, outFormat str
 	cinFormat := C.CString(inFormat)
 	defer freeCString(cinFormat)
 	if err := C.vipsgen_system_with_options(ccmdFormat, cin, cinLength, coutFormat, cinFormat); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("system", out)
 	}
 	return out, nil
 }
@@ -5491,7 +5537,7 @@ func vipsgenText(text string) (*C.VipsImage, error) {
 	ctext := C.CString(text)
 	defer freeCString(ctext)
 	if err := C.vipsgen_text(&out, ctext); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("text", out)
 	}
 	return out, nil
 }
@@ -5511,7 +5557,7 @@ func vipsgenTextWithOptions(text string, font string, width int, height int, ali
 		cautofitDpi = &cautofitDpiValue
 	}
 	if err := C.vipsgen_text_with_options(&out, ctext, cfont, C.gint(width), C.gint(height), C.VipsAlign(align), C.int(boolToInt(justify)), C.gint(dpi), C.gint(spacing), cfontfile, C.int(boolToInt(rgba)), C.VipsTextWrap(wrap), cautofitDpi); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("text", out)
 	}
 	if autofitDpi != nil {
 		*autofitDpi = int(cautofitDpiValue)
@@ -5525,7 +5571,7 @@ func vipsgenThumbnail(filename string, width int) (*C.VipsImage, error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_thumbnail(cfilename, &out, C.gint(width)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("thumbnail", out)
 	}
 	return out, nil
 }
@@ -5540,7 +5586,7 @@ func vipsgenThumbnailWithOptions(filename string, width int, height int, size Si
 	coutputProfile := C.CString(outputProfile)
 	defer freeCString(coutputProfile)
 	if err := C.vipsgen_thumbnail_with_options(cfilename, &out, C.gint(width), C.gint(height), C.VipsSize(size), C.int(boolToInt(noRotate)), C.VipsInteresting(crop), C.int(boolToInt(linear)), cinputProfile, coutputProfile, C.VipsIntent(intent), C.VipsFailOn(failOn)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("thumbnail", out)
 	}
 	return out, nil
 }
@@ -5552,7 +5598,7 @@ func vipsgenThumbnailBuffer(buf []byte, width int) (*C.VipsImage, error) {
 	defer runtime.KeepAlive(src)
 	var out *C.VipsImage
 	if err := C.vipsgen_thumbnail_buffer(unsafe.Pointer(&src[0]), C.size_t(len(src)), &out, C.gint(width)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("thumbnail_buffer", out)
 	}
 	return out, nil
 }
@@ -5570,7 +5616,7 @@ func vipsgenThumbnailBufferWithOptions(buf []byte, width int, optionString strin
 	coutputProfile := C.CString(outputProfile)
 	defer freeCString(coutputProfile)
 	if err := C.vipsgen_thumbnail_buffer_with_options(unsafe.Pointer(&src[0]), C.size_t(len(src)), &out, C.gint(width), coptionString, C.gint(height), C.VipsSize(size), C.int(boolToInt(noRotate)), C.VipsInteresting(crop), C.int(boolToInt(linear)), cinputProfile, coutputProfile, C.VipsIntent(intent), C.VipsFailOn(failOn)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("thumbnail_buffer", out)
 	}
 	return out, nil
 }
@@ -5579,7 +5625,7 @@ func vipsgenThumbnailBufferWithOptions(buf []byte, width int, optionString strin
 func vipsgenThumbnailImage(in *C.VipsImage, width int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_thumbnail_image(in, &out, C.gint(width)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("thumbnail_image", out)
 	}
 	return out, nil
 }
@@ -5592,7 +5638,7 @@ func vipsgenThumbnailImageWithOptions(in *C.VipsImage, width int, height int, si
 	coutputProfile := C.CString(outputProfile)
 	defer freeCString(coutputProfile)
 	if err := C.vipsgen_thumbnail_image_with_options(in, &out, C.gint(width), C.gint(height), C.VipsSize(size), C.int(boolToInt(noRotate)), C.VipsInteresting(crop), C.int(boolToInt(linear)), cinputProfile, coutputProfile, C.VipsIntent(intent), C.VipsFailOn(failOn)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("thumbnail_image", out)
 	}
 	return out, nil
 }
@@ -5601,7 +5647,7 @@ func vipsgenThumbnailImageWithOptions(in *C.VipsImage, width int, height int, si
 func vipsgenThumbnailSource(source *C.VipsSourceCustom, width int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_thumbnail_source(source, &out, C.gint(width)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("thumbnail_source", out)
 	}
 	return out, nil
 }
@@ -5616,7 +5662,7 @@ func vipsgenThumbnailSourceWithOptions(source *C.VipsSourceCustom, width int, op
 	coutputProfile := C.CString(outputProfile)
 	defer freeCString(coutputProfile)
 	if err := C.vipsgen_thumbnail_source_with_options(source, &out, C.gint(width), coptionString, C.gint(height), C.VipsSize(size), C.int(boolToInt(noRotate)), C.VipsInteresting(crop), C.int(boolToInt(linear)), cinputProfile, coutputProfile, C.VipsIntent(intent), C.VipsFailOn(failOn)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("thumbnail_source", out)
 	}
 	return out, nil
 }
@@ -5627,7 +5673,7 @@ func vipsgenTiffload(filename string) (*C.VipsImage, error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_tiffload(cfilename, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("tiffload", out)
 	}
 	return out, nil
 }
@@ -5638,7 +5684,7 @@ func vipsgenTiffloadWithOptions(filename string, page int, n int, autorotate boo
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_tiffload_with_options(cfilename, &out, C.gint(page), C.gint(n), C.int(boolToInt(autorotate)), C.gint(subifd), C.int(boolToInt(unlimited)), C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("tiffload", out)
 	}
 	return out, nil
 }
@@ -5650,7 +5696,7 @@ func vipsgenTiffloadBuffer(buf []byte) (*C.VipsImage, error) {
 	defer runtime.KeepAlive(src)
 	var out *C.VipsImage
 	if err := C.vipsgen_tiffload_buffer(unsafe.Pointer(&src[0]), C.size_t(len(src)), &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("tiffload_buffer", out)
 	}
 	return out, nil
 }
@@ -5662,7 +5708,7 @@ func vipsgenTiffloadBufferWithOptions(buf []byte, page int, n int, autorotate bo
 	defer runtime.KeepAlive(src)
 	var out *C.VipsImage
 	if err := C.vipsgen_tiffload_buffer_with_options(unsafe.Pointer(&src[0]), C.size_t(len(src)), &out, C.gint(page), C.gint(n), C.int(boolToInt(autorotate)), C.gint(subifd), C.int(boolToInt(unlimited)), C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("tiffload_buffer", out)
 	}
 	return out, nil
 }
@@ -5671,7 +5717,7 @@ func vipsgenTiffloadBufferWithOptions(buf []byte, page int, n int, autorotate bo
 func vipsgenTiffloadSource(source *C.VipsSourceCustom) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_tiffload_source(source, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("tiffload_source", out)
 	}
 	return out, nil
 }
@@ -5680,7 +5726,7 @@ func vipsgenTiffloadSource(source *C.VipsSourceCustom) (*C.VipsImage, error) {
 func vipsgenTiffloadSourceWithOptions(source *C.VipsSourceCustom, page int, n int, autorotate bool, subifd int, unlimited bool, memory bool, access Access, failOn FailOn, revalidate bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_tiffload_source_with_options(source, &out, C.gint(page), C.gint(n), C.int(boolToInt(autorotate)), C.gint(subifd), C.int(boolToInt(unlimited)), C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("tiffload_source", out)
 	}
 	return out, nil
 }
@@ -5690,7 +5736,7 @@ func vipsgenTiffsave(in *C.VipsImage, filename string) (error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_tiffsave(in, cfilename); err != 0 {
-		return handleVipsError()
+		return handleVipsError("tiffsave")
 	}
 	return nil
 }
@@ -5709,7 +5755,7 @@ func vipsgenTiffsaveWithOptions(in *C.VipsImage, filename string, compression Ti
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_tiffsave_with_options(in, cfilename, C.VipsForeignTiffCompression(compression), C.gint(q), C.VipsForeignTiffPredictor(predictor), C.int(boolToInt(tile)), C.gint(tileWidth), C.gint(tileHeight), C.int(boolToInt(pyramid)), C.int(boolToInt(miniswhite)), C.gint(bitdepth), C.VipsForeignTiffResunit(resunit), C.double(xres), C.double(yres), C.int(boolToInt(bigtiff)), C.int(boolToInt(properties)), C.VipsRegionShrink(regionShrink), C.gint(level), C.int(boolToInt(lossless)), C.VipsForeignDzDepth(depth), C.int(boolToInt(subifd)), C.int(boolToInt(premultiply)), C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return handleVipsError()
+		return handleVipsError("tiffsave")
 	}
 	return nil
 }
@@ -5719,7 +5765,7 @@ func vipsgenTiffsaveBuffer(in *C.VipsImage) ([]byte, error) {
 	var buf unsafe.Pointer
 	var length C.size_t
 	if err := C.vipsgen_tiffsave_buffer(in, &buf, &length); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("tiffsave_buffer")
 	}
 	return bufferToBytes(buf, length), nil
 }
@@ -5738,7 +5784,7 @@ func vipsgenTiffsaveBufferWithOptions(in *C.VipsImage, compression TiffCompressi
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_tiffsave_buffer_with_options(in, &buf, &length, C.VipsForeignTiffCompression(compression), C.gint(q), C.VipsForeignTiffPredictor(predictor), C.int(boolToInt(tile)), C.gint(tileWidth), C.gint(tileHeight), C.int(boolToInt(pyramid)), C.int(boolToInt(miniswhite)), C.gint(bitdepth), C.VipsForeignTiffResunit(resunit), C.double(xres), C.double(yres), C.int(boolToInt(bigtiff)), C.int(boolToInt(properties)), C.VipsRegionShrink(regionShrink), C.gint(level), C.int(boolToInt(lossless)), C.VipsForeignDzDepth(depth), C.int(boolToInt(subifd)), C.int(boolToInt(premultiply)), C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("tiffsave_buffer")
 	}
 	return bufferToBytes(buf, length), nil
 }
@@ -5747,7 +5793,7 @@ func vipsgenTiffsaveBufferWithOptions(in *C.VipsImage, compression TiffCompressi
 func vipsgenTiffsaveTarget(in *C.VipsImage, target *C.VipsTargetCustom) (error) {
 	
 	if err := C.vipsgen_tiffsave_target(in, target); err != 0 {
-		return handleVipsError()
+		return handleVipsError("tiffsave_target")
 	}
 	return nil
 }
@@ -5764,7 +5810,7 @@ func vipsgenTiffsaveTargetWithOptions(in *C.VipsImage, target *C.VipsTargetCusto
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_tiffsave_target_with_options(in, target, C.VipsForeignTiffCompression(compression), C.gint(q), C.VipsForeignTiffPredictor(predictor), C.int(boolToInt(tile)), C.gint(tileWidth), C.gint(tileHeight), C.int(boolToInt(pyramid)), C.int(boolToInt(miniswhite)), C.gint(bitdepth), C.VipsForeignTiffResunit(resunit), C.double(xres), C.double(yres), C.int(boolToInt(bigtiff)), C.int(boolToInt(properties)), C.VipsRegionShrink(regionShrink), C.gint(level), C.int(boolToInt(lossless)), C.VipsForeignDzDepth(depth), C.int(boolToInt(subifd)), C.int(boolToInt(premultiply)), C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return handleVipsError()
+		return handleVipsError("tiffsave_target")
 	}
 	return nil
 }
@@ -5773,7 +5819,7 @@ func vipsgenTiffsaveTargetWithOptions(in *C.VipsImage, target *C.VipsTargetCusto
 func vipsgenTilecache(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_tilecache(in, &out); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("tilecache")
 	}
 	return out, nil
 }
@@ -5782,7 +5828,7 @@ func vipsgenTilecache(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenTilecacheWithOptions(in *C.VipsImage, tileWidth int, tileHeight int, maxTiles int, access Access, threaded bool, persistent bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_tilecache_with_options(in, &out, C.gint(tileWidth), C.gint(tileHeight), C.gint(maxTiles), C.VipsAccess(access), C.int(boolToInt(threaded)), C.int(boolToInt(persistent))); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("tilecache")
 	}
 	return out, nil
 }
@@ -5791,7 +5837,7 @@ func vipsgenTilecacheWithOptions(in *C.VipsImage, tileWidth int, tileHeight int,
 func vipsgenTonelut() (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_tonelut(&out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("tonelut", out)
 	}
 	return out, nil
 }
@@ -5800,7 +5846,7 @@ func vipsgenTonelut() (*C.VipsImage, error) {
 func vipsgenTonelutWithOptions(inMax int, outMax int, lb float64, lw float64, ps float64, pm float64, ph float64, s float64, m float64, h float64) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_tonelut_with_options(&out, C.gint(inMax), C.gint(outMax), C.double(lb), C.double(lw), C.double(ps), C.double(pm), C.double(ph), C.double(s), C.double(m), C.double(h)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("tonelut", out)
 	}
 	return out, nil
 }
@@ -5809,7 +5855,7 @@ func vipsgenTonelutWithOptions(inMax int, outMax int, lb float64, lw float64, ps
 func vipsgenTranspose3d(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_transpose3d(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("transpose3d", out)
 	}
 	return out, nil
 }
@@ -5818,7 +5864,7 @@ func vipsgenTranspose3d(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenTranspose3dWithOptions(in *C.VipsImage, pageHeight int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_transpose3d_with_options(in, &out, C.gint(pageHeight)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("transpose3d", out)
 	}
 	return out, nil
 }
@@ -5827,7 +5873,7 @@ func vipsgenTranspose3dWithOptions(in *C.VipsImage, pageHeight int) (*C.VipsImag
 func vipsgenUnpremultiply(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_unpremultiply(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("unpremultiply", out)
 	}
 	return out, nil
 }
@@ -5836,7 +5882,7 @@ func vipsgenUnpremultiply(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenUnpremultiplyWithOptions(in *C.VipsImage, maxAlpha float64, alphaBand int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_unpremultiply_with_options(in, &out, C.double(maxAlpha), C.gint(alphaBand)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("unpremultiply", out)
 	}
 	return out, nil
 }
@@ -5847,7 +5893,7 @@ func vipsgenVipsload(filename string) (*C.VipsImage, error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_vipsload(cfilename, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("vipsload", out)
 	}
 	return out, nil
 }
@@ -5858,7 +5904,7 @@ func vipsgenVipsloadWithOptions(filename string, memory bool, access Access, fai
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_vipsload_with_options(cfilename, &out, C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("vipsload", out)
 	}
 	return out, nil
 }
@@ -5867,7 +5913,7 @@ func vipsgenVipsloadWithOptions(filename string, memory bool, access Access, fai
 func vipsgenVipsloadSource(source *C.VipsSourceCustom) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_vipsload_source(source, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("vipsload_source", out)
 	}
 	return out, nil
 }
@@ -5876,7 +5922,7 @@ func vipsgenVipsloadSource(source *C.VipsSourceCustom) (*C.VipsImage, error) {
 func vipsgenVipsloadSourceWithOptions(source *C.VipsSourceCustom, memory bool, access Access, failOn FailOn, revalidate bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_vipsload_source_with_options(source, &out, C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("vipsload_source", out)
 	}
 	return out, nil
 }
@@ -5886,7 +5932,7 @@ func vipsgenVipssave(in *C.VipsImage, filename string) (error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_vipssave(in, cfilename); err != 0 {
-		return handleVipsError()
+		return handleVipsError("vipssave")
 	}
 	return nil
 }
@@ -5905,7 +5951,7 @@ func vipsgenVipssaveWithOptions(in *C.VipsImage, filename string, keep Keep, bac
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_vipssave_with_options(in, cfilename, C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return handleVipsError()
+		return handleVipsError("vipssave")
 	}
 	return nil
 }
@@ -5914,7 +5960,7 @@ func vipsgenVipssaveWithOptions(in *C.VipsImage, filename string, keep Keep, bac
 func vipsgenVipssaveTarget(in *C.VipsImage, target *C.VipsTargetCustom) (error) {
 	
 	if err := C.vipsgen_vipssave_target(in, target); err != 0 {
-		return handleVipsError()
+		return handleVipsError("vipssave_target")
 	}
 	return nil
 }
@@ -5931,7 +5977,7 @@ func vipsgenVipssaveTargetWithOptions(in *C.VipsImage, target *C.VipsTargetCusto
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_vipssave_target_with_options(in, target, C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return handleVipsError()
+		return handleVipsError("vipssave_target")
 	}
 	return nil
 }
@@ -5942,7 +5988,7 @@ func vipsgenWebpload(filename string) (*C.VipsImage, error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_webpload(cfilename, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("webpload", out)
 	}
 	return out, nil
 }
@@ -5953,7 +5999,7 @@ func vipsgenWebploadWithOptions(filename string, page int, n int, scale float64,
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_webpload_with_options(cfilename, &out, C.gint(page), C.gint(n), C.double(scale), C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("webpload", out)
 	}
 	return out, nil
 }
@@ -5965,7 +6011,7 @@ func vipsgenWebploadBuffer(buf []byte) (*C.VipsImage, error) {
 	defer runtime.KeepAlive(src)
 	var out *C.VipsImage
 	if err := C.vipsgen_webpload_buffer(unsafe.Pointer(&src[0]), C.size_t(len(src)), &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("webpload_buffer", out)
 	}
 	return out, nil
 }
@@ -5977,7 +6023,7 @@ func vipsgenWebploadBufferWithOptions(buf []byte, page int, n int, scale float64
 	defer runtime.KeepAlive(src)
 	var out *C.VipsImage
 	if err := C.vipsgen_webpload_buffer_with_options(unsafe.Pointer(&src[0]), C.size_t(len(src)), &out, C.gint(page), C.gint(n), C.double(scale), C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("webpload_buffer", out)
 	}
 	return out, nil
 }
@@ -5986,7 +6032,7 @@ func vipsgenWebploadBufferWithOptions(buf []byte, page int, n int, scale float64
 func vipsgenWebploadSource(source *C.VipsSourceCustom) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_webpload_source(source, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("webpload_source", out)
 	}
 	return out, nil
 }
@@ -5995,7 +6041,7 @@ func vipsgenWebploadSource(source *C.VipsSourceCustom) (*C.VipsImage, error) {
 func vipsgenWebploadSourceWithOptions(source *C.VipsSourceCustom, page int, n int, scale float64, memory bool, access Access, failOn FailOn, revalidate bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_webpload_source_with_options(source, &out, C.gint(page), C.gint(n), C.double(scale), C.int(boolToInt(memory)), C.VipsAccess(access), C.VipsFailOn(failOn), C.int(boolToInt(revalidate))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("webpload_source", out)
 	}
 	return out, nil
 }
@@ -6005,7 +6051,7 @@ func vipsgenWebpsave(in *C.VipsImage, filename string) (error) {
 	cfilename := C.CString(filename)
 	defer freeCString(cfilename)
 	if err := C.vipsgen_webpsave(in, cfilename); err != 0 {
-		return handleVipsError()
+		return handleVipsError("webpsave")
 	}
 	return nil
 }
@@ -6024,7 +6070,7 @@ func vipsgenWebpsaveWithOptions(in *C.VipsImage, filename string, q int, lossles
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_webpsave_with_options(in, cfilename, C.gint(q), C.int(boolToInt(lossless)), C.VipsForeignWebpPreset(preset), C.int(boolToInt(smartSubsample)), C.int(boolToInt(nearLossless)), C.gint(alphaQ), C.int(boolToInt(minSize)), C.gint(kmin), C.gint(kmax), C.gint(effort), C.gint(targetSize), C.int(boolToInt(mixed)), C.int(boolToInt(smartDeblock)), C.gint(passes), C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return handleVipsError()
+		return handleVipsError("webpsave")
 	}
 	return nil
 }
@@ -6034,7 +6080,7 @@ func vipsgenWebpsaveBuffer(in *C.VipsImage) ([]byte, error) {
 	var buf unsafe.Pointer
 	var length C.size_t
 	if err := C.vipsgen_webpsave_buffer(in, &buf, &length); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("webpsave_buffer")
 	}
 	return bufferToBytes(buf, length), nil
 }
@@ -6053,7 +6099,7 @@ func vipsgenWebpsaveBufferWithOptions(in *C.VipsImage, q int, lossless bool, pre
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_webpsave_buffer_with_options(in, &buf, &length, C.gint(q), C.int(boolToInt(lossless)), C.VipsForeignWebpPreset(preset), C.int(boolToInt(smartSubsample)), C.int(boolToInt(nearLossless)), C.gint(alphaQ), C.int(boolToInt(minSize)), C.gint(kmin), C.gint(kmax), C.gint(effort), C.gint(targetSize), C.int(boolToInt(mixed)), C.int(boolToInt(smartDeblock)), C.gint(passes), C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("webpsave_buffer")
 	}
 	return bufferToBytes(buf, length), nil
 }
@@ -6062,7 +6108,7 @@ func vipsgenWebpsaveBufferWithOptions(in *C.VipsImage, q int, lossless bool, pre
 func vipsgenWebpsaveTarget(in *C.VipsImage, target *C.VipsTargetCustom) (error) {
 	
 	if err := C.vipsgen_webpsave_target(in, target); err != 0 {
-		return handleVipsError()
+		return handleVipsError("webpsave_target")
 	}
 	return nil
 }
@@ -6079,7 +6125,7 @@ func vipsgenWebpsaveTargetWithOptions(in *C.VipsImage, target *C.VipsTargetCusto
 	cprofile := C.CString(profile)
 	defer freeCString(cprofile)
 	if err := C.vipsgen_webpsave_target_with_options(in, target, C.gint(q), C.int(boolToInt(lossless)), C.VipsForeignWebpPreset(preset), C.int(boolToInt(smartSubsample)), C.int(boolToInt(nearLossless)), C.gint(alphaQ), C.int(boolToInt(minSize)), C.gint(kmin), C.gint(kmax), C.gint(effort), C.gint(targetSize), C.int(boolToInt(mixed)), C.int(boolToInt(smartDeblock)), C.gint(passes), C.VipsForeignKeep(keep), cbackground, cbackgroundLength, C.gint(pageHeight), cprofile); err != 0 {
-		return handleVipsError()
+		return handleVipsError("webpsave_target")
 	}
 	return nil
 }
@@ -6088,7 +6134,7 @@ func vipsgenWebpsaveTargetWithOptions(in *C.VipsImage, target *C.VipsTargetCusto
 func vipsgenWorley(width int, height int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_worley(&out, C.gint(width), C.gint(height)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("worley", out)
 	}
 	return out, nil
 }
@@ -6097,7 +6143,7 @@ func vipsgenWorley(width int, height int) (*C.VipsImage, error) {
 func vipsgenWorleyWithOptions(width int, height int, cellSize int, seed int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_worley_with_options(&out, C.gint(width), C.gint(height), C.gint(cellSize), C.gint(seed)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("worley", out)
 	}
 	return out, nil
 }
@@ -6106,7 +6152,7 @@ func vipsgenWorleyWithOptions(width int, height int, cellSize int, seed int) (*C
 func vipsgenWrap(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_wrap(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("wrap", out)
 	}
 	return out, nil
 }
@@ -6115,7 +6161,7 @@ func vipsgenWrap(in *C.VipsImage) (*C.VipsImage, error) {
 func vipsgenWrapWithOptions(in *C.VipsImage, x int, y int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_wrap_with_options(in, &out, C.gint(x), C.gint(y)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("wrap", out)
 	}
 	return out, nil
 }
@@ -6124,7 +6170,7 @@ func vipsgenWrapWithOptions(in *C.VipsImage, x int, y int) (*C.VipsImage, error)
 func vipsgenXyz(width int, height int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_xyz(&out, C.gint(width), C.gint(height)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("xyz", out)
 	}
 	return out, nil
 }
@@ -6133,7 +6179,7 @@ func vipsgenXyz(width int, height int) (*C.VipsImage, error) {
 func vipsgenXyzWithOptions(width int, height int, csize int, dsize int, esize int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_xyz_with_options(&out, C.gint(width), C.gint(height), C.gint(csize), C.gint(dsize), C.gint(esize)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("xyz", out)
 	}
 	return out, nil
 }
@@ -6142,7 +6188,7 @@ func vipsgenXyzWithOptions(width int, height int, csize int, dsize int, esize in
 func vipsgenZone(width int, height int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_zone(&out, C.gint(width), C.gint(height)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("zone", out)
 	}
 	return out, nil
 }
@@ -6151,7 +6197,7 @@ func vipsgenZone(width int, height int) (*C.VipsImage, error) {
 func vipsgenZoneWithOptions(width int, height int, uchar bool) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_zone_with_options(&out, C.gint(width), C.gint(height), C.int(boolToInt(uchar))); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("zone", out)
 	}
 	return out, nil
 }
@@ -6160,7 +6206,7 @@ func vipsgenZoneWithOptions(width int, height int, uchar bool) (*C.VipsImage, er
 func vipsgenZoom(input *C.VipsImage, xfac int, yfac int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_zoom(input, &out, C.gint(xfac), C.gint(yfac)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("zoom", out)
 	}
 	return out, nil
 }
@@ -6189,7 +6235,7 @@ func vipsgenImageFromSource(src *C.VipsSourceCustom, params *LoadOptions) (*C.Vi
 		code = C.vipsgen_image_new_from_source_with_option(src, &out, cOptionString)
 	}
 	if code != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("image_new_from_source", out)
 	}
 	return out, nil
 }
@@ -6215,7 +6261,7 @@ func vipsgenImageFromBuffer(buf []byte, params *LoadOptions) (*C.VipsImage, erro
 		code = C.vipsgen_image_new_from_buffer_with_option(unsafe.Pointer(&src[0]), C.size_t(len(src)), &out, cOptionString)
 	}
 	if code != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("image_new_from_buffer", out)
 	}
 	return out, nil
 }
@@ -6242,11 +6288,96 @@ func vipsgenImageFromFile(path string, params *LoadOptions) (*C.VipsImage, error
 	}
 
 	if code != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("image_new_from_file", out)
 	}
 	return out, nil
 }
 
+// vipsgenImageWriteToFile saves with the saver picked from the filename extension
+func vipsgenImageWriteToFile(in *C.VipsImage, path string, optionString string) error {
+	cPath := C.CString(path)
+	defer freeCString(cPath)
+	cOptionString := C.CString(optionString)
+	defer freeCString(cOptionString)
+	if C.vipsgen_image_write_to_file(in, cPath, cOptionString) != 0 {
+		return handleVipsError("image_write_to_file")
+	}
+	return nil
+}
+
+func vipsgenImageWriteToBuffer(in *C.VipsImage, suffix string) ([]byte, error) {
+	cSuffix := C.CString(suffix)
+	defer freeCString(cSuffix)
+	var buf unsafe.Pointer
+	var bufSize C.size_t
+	if C.vipsgen_image_write_to_buffer(in, cSuffix, &buf, &bufSize) != 0 {
+		return nil, handleVipsError("image_write_to_buffer")
+	}
+	defer C.g_free(C.gpointer(buf))
+	return C.GoBytes(buf, C.int(bufSize)), nil
+}
+
+func vipsgenGetpointMany(in *C.VipsImage, xy []C.int, bands int) ([]float64, error) {
+	n := len(xy) / 2
+	out := make([]float64, n*bands)
+	if n == 0 {
+		return out, nil
+	}
+	if C.vipsgen_getpoint_many(in, &xy[0], C.int(n), (*C.double)(unsafe.Pointer(&out[0]))) != 0 {
+		return nil, handleVipsError("getpoint_many")
+	}
+	return out, nil
+}
+
+// vipsForeignFindSave reports whether libvips has a saver for the filename extension
+func vipsForeignFindSave(path string) bool {
+	cPath := C.CString(path)
+	defer freeCString(cPath)
+	if C.vips_foreign_find_save(cPath) == nil {
+		clearVipsError()
+		return false
+	}
+	return true
+}
+
+// vipsForeignFindSaveBuffer reports whether libvips can save to memory in the format of the suffix,
+// through a target or a buffer saver as vips_image_write_to_buffer picks them
+func vipsForeignFindSaveBuffer(suffix string) bool {
+	cSuffix := C.CString(suffix)
+	defer freeCString(cSuffix)
+	if C.vips_foreign_find_save_target(cSuffix) != nil {
+		return true
+	}
+	found := C.vips_foreign_find_save_buffer(cSuffix) != nil
+	clearVipsError()
+	return found
+}
+
+// vipsForeignGetSuffixes returns the file suffixes of all libvips savers
+func vipsForeignGetSuffixes() (suffixes []string) {
+	const maxSuffixes = 1024
+	rawSuffixes := C.vips_foreign_get_suffixes()
+	defer C.g_strfreev(rawSuffixes)
+	cSuffixes := (*[maxSuffixes]*C.char)(unsafe.Pointer(rawSuffixes))[:maxSuffixes:maxSuffixes]
+	for _, suffix := range cSuffixes {
+		if suffix == nil {
+			break
+		}
+		suffixes = append(suffixes, C.GoString(suffix))
+	}
+	return
+}
+
+// vipsForeignFindLoadBuffer returns the name of the loader that recognises the buffer header
+func vipsForeignFindLoadBuffer(buf []byte) (string, error) {
+	defer runtime.KeepAlive(buf)
+	name := C.vips_foreign_find_load_buffer(unsafe.Pointer(&buf[0]), C.size_t(len(buf)))
+	if name == nil {
+		return "", handleVipsError("foreign_find_load_buffer")
+	}
+	return C.GoString(name), nil
+}
+
 // vipsgenImageFromMemory vips_image_new_memory
 func vipsgenImageFromMemory(buf []byte, width, height, bands int) (*C.VipsImage, error) {
 	src := buf
@@ -6257,7 +6388,41 @@ func vipsgenImageFromMemory(buf []byte, width, height, bands int) (*C.VipsImage,
 	var code C.int
 	code = C.vipsgen_image_new_from_memory(unsafe.Pointer(&src[0]), C.size_t(len(src)), C.int(width), C.int(height), C.int(bands), &out)
 	if code != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("image_new_from_memory", out)
+	}
+	return out, nil
+}
+
+// vipsgenImageFromMemoryLike copies buf into a new image with the header and metadata of like,
+// for pixels computed in Go from the output of vipsgenImageWriteToMemory
+func vipsgenImageFromMemoryLike(like *C.VipsImage, buf []byte) (*C.VipsImage, error) {
+	var out *C.VipsImage
+	if C.vipsgen_image_new_from_memory_like(like, unsafe.Pointer(&buf[0]), C.size_t(len(buf)), &out) != 0 {
+		return nil, handleImageError("image_new_from_memory_like", out)
+	}
+	for _, name := range vipsImageGetFields(like) {
+		if imageHeaderFields[name] {
+			continue
+		}
+		if err := vipsImageCopyField(like, out, name); err != nil {
+			clearImage(out)
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// vipsgenImageNewMatrixFromArray vips_image_new_matrix_from_array
+func vipsgenImageNewMatrixFromArray(width, height int, array []float64) (*C.VipsImage, error) {
+	cArray, size, err := convertToDoubleArray(array)
+	if err != nil {
+		return nil, err
+	}
+	defer freeDoubleArray(cArray)
+
+	var out *C.VipsImage
+	if code := C.vipsgen_image_new_matrix_from_array(C.int(width), C.int(height), cArray, size, &out); code != 0 {
+		return nil, handleImageError("image_new_matrix_from_array", out)
 	}
 	return out, nil
 }
@@ -6267,20 +6432,46 @@ func vipsgenImageWriteToMemory(in *C.VipsImage) ([]byte, error) {
 	var buf unsafe.Pointer
 	var bufSize C.size_t
 	if C.vipsgen_image_write_to_memory(in, &buf, &bufSize) != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("image_write_to_memory")
 	}
 	defer C.free(buf)
 	return C.GoBytes(buf, C.int(bufSize)), nil
 }
 
+func vipsgenImageCopyMemory(in *C.VipsImage) (*C.VipsImage, error) {
+	var out *C.VipsImage
+	if err := C.vipsgen_image_copy_memory(in, &out); err != 0 {
+		return nil, handleImageError("image_copy_memory", out)
+	}
+	return out, nil
+}
+
 func vipsHasAlpha(in *C.VipsImage) bool {
 	return int(C.vips_image_hasalpha(in)) > 0
 }
 
+func vipsFormatSizeof(format BandFormat) int {
+	return int(C.vips_format_sizeof(C.VipsBandFormat(format)))
+}
+
 func vipsIsColorSpaceSupported(in *C.VipsImage) bool {
 	return int(C.vips_colourspace_issupported(in)) != 0
 }
 
+func vipsBlendModes() []BlendMode {
+	var values [64]C.int
+	n := int(C.vipsgen_blend_modes(&values[0], C.int(len(values))))
+	modes := make([]BlendMode, 0, n)
+	for i := 0; i < n && i < len(values); i++ {
+		modes = append(modes, BlendMode(values[i]))
+	}
+	return modes
+}
+
+func vipsTrellisQuantSupported() bool {
+	return int(C.vipsgen_trellis_quant_supported()) != 0
+}
+
 func vipsImageGetFields(in *C.VipsImage) (fields []string) {
 	const maxFields = 1024
 	rawFields := C.vips_image_get_fields(in)
@@ -6301,12 +6492,27 @@ func vipsImageHasField(in *C.VipsImage, name string) bool {
 	return int(C.vips_image_get_typeof(in, cName)) != 0
 }
 
+func vipsImageGetMetaType(in *C.VipsImage, name string) MetaType {
+	cName := C.CString(name)
+	defer freeCString(cName)
+	return MetaType(C.vipsgen_image_get_meta_type(in, cName))
+}
+
 func vipsImageRemoveField(in *C.VipsImage, name string) {
 	cName := C.CString(name)
 	defer freeCString(cName)
 	C.vips_image_remove(in, cName)
 }
 
+func vipsImageCopyField(from, to *C.VipsImage, name string) error {
+	cName := C.CString(name)
+	defer freeCString(cName)
+	if C.vipsgen_image_copy_field(from, to, cName) != 0 {
+		return handleVipsError("image_copy_field")
+	}
+	return nil
+}
+
 func vipsImageSetArrayInt(in *C.VipsImage, name string, values []int) error {
 	cName := C.CString(name)
 	defer freeCString(cName)
@@ -6325,7 +6531,7 @@ func vipsImageGetArrayInt(in *C.VipsImage, name string) ([]int, error) {
 	cName := C.CString(name)
 	defer freeCString(cName)
 	if err := C.vips_image_get_array_int(in, cName, &out, &n); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("image_get_array_int")
 	}
 	result := fromCArrayInt(out, int(n))
 	// Do not free 'out' - it points to libvips-managed memory that will be
@@ -6351,7 +6557,7 @@ func vipsImageGetArrayDouble(in *C.VipsImage, name string) ([]float64, error) {
 	cName := C.CString(name)
 	defer freeCString(cName)
 	if err := C.vips_image_get_array_double(in, cName, &out, &n); err != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("image_get_array_double")
 	}
 	result := fromCArrayDouble(out, int(n))
 	// Do not free 'out' - it points to libvips-managed memory that will be
@@ -6359,6 +6565,36 @@ func vipsImageGetArrayDouble(in *C.VipsImage, name string) ([]float64, error) {
 	return result, nil
 }
 
+func vipsImageSetArrayImage(in *C.VipsImage, name string, images []*C.VipsImage) error {
+	cName := C.CString(name)
+	defer freeCString(cName)
+	cArray, length, err := convertToImageArray(images)
+	if err != nil {
+		return err
+	}
+	defer freeImageArray(cArray)
+	if err := C.vipsgen_image_set_array_image(in, cName, cArray, length); err != 0 {
+		return handleVipsError("image_set_array_image")
+	}
+	return nil
+}
+
+func vipsImageGetArrayImage(in *C.VipsImage, name string) ([]*C.VipsImage, error) {
+	var out **C.VipsImage
+	var n C.int
+	cName := C.CString(name)
+	defer freeCString(cName)
+	if err := C.vipsgen_image_get_array_image(in, cName, &out, &n); err != 0 {
+		return nil, handleVipsError("image_get_array_image")
+	}
+	defer gFreePointer(unsafe.Pointer(out))
+	images := make([]*C.VipsImage, int(n))
+	for i := range images {
+		images[i] = *(**C.VipsImage)(unsafe.Pointer(uintptr(unsafe.Pointer(out)) + uintptr(i)*unsafe.Sizeof((*C.VipsImage)(nil))))
+	}
+	return images, nil
+}
+
 func vipsImageSetBlob(in *C.VipsImage, name string, data []byte) {
 	cData := unsafe.Pointer(&data[0])
 	cDataLength := C.size_t(len(data))
@@ -6373,9 +6609,11 @@ func vipsImageGetBlob(in *C.VipsImage, name string) ([]byte, error) {
 	cField := C.CString(name)
 	defer freeCString(cField)
 	if int(C.vips_image_get_blob(in, cField, &bufPtr, &dataLength)) != 0 {
-		return nil, handleVipsError()
+		return nil, handleVipsError("image_get_blob")
 	}
-	return bufferToBytes(bufPtr, dataLength), nil
+	// Do not free bufPtr - it points to libvips-managed memory that will be
+	// automatically freed when the VipsImage is unreferenced
+	return C.GoBytes(bufPtr, C.int(dataLength)), nil
 }
 
 func vipsHasICCProfile(in *C.VipsImage) bool {
@@ -6476,7 +6714,7 @@ func vipsImageGetString(in *C.VipsImage, name string) (string, error) {
 	if int(C.vips_image_get_string(in, cField, &cFieldValue)) == 0 {
 		return C.GoString(cFieldValue), nil
 	}
-	return "", handleVipsError()
+	return "", handleVipsError("image_get_string")
 }
 
 func vipsImageGetAsString(in *C.VipsImage, name string) (string, error) {
@@ -6490,7 +6728,7 @@ func vipsImageGetAsString(in *C.VipsImage, name string) (string, error) {
 	if int(C.vips_image_get_as_string(in, cField, &cFieldValue)) == 0 {
 		return C.GoString(cFieldValue), nil
 	}
-	return "", handleVipsError()
+	return "", handleVipsError("image_get_as_string")
 }
 
 func vipsImageSetDouble(in *C.VipsImage, name string, f float64) {
@@ -6507,7 +6745,7 @@ func vipsImageGetDouble(in *C.VipsImage, name string) (float64, error) {
 	if int(C.vips_image_get_double(in, cField, &cDouble)) == 0 {
 		return float64(cDouble), nil
 	}
-	return 0, handleVipsError()
+	return 0, handleVipsError("image_get_double")
 }
 
 func vipsImageSetInt(in *C.VipsImage, name string, i int) {
@@ -6524,7 +6762,7 @@ func vipsImageGetInt(in *C.VipsImage, name string) (int, error) {
 	if int(C.vips_image_get_int(in, cField, &cInt)) == 0 {
 		return int(cInt), nil
 	}
-	return 0, handleVipsError()
+	return 0, handleVipsError("image_get_int")
 }
 
 func vipsImageGetMetaLoader(in *C.VipsImage) (string, bool) {
@@ -6542,7 +6780,7 @@ func vipsImageGetMetaLoader(in *C.VipsImage) (string, bool) {
 func vipsgenEmbedMultiPage(in *C.VipsImage, left, top, width, height int, extend Extend) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_embed_multi_page(in, &out, C.int(left), C.int(top), C.int(width), C.int(height), C.int(extend)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("embed", out)
 	}
 	return out, nil
 }
@@ -6553,7 +6791,7 @@ func vipsgenEmbedMultiPageBackground(in *C.VipsImage, left, top, width, height,
 	if err := C.vipsgen_embed_multi_page_background(in, &out, C.int(left), C.int(top), C.int(width),
 		C.int(height), C.double(backgroundColorR),
 		C.double(backgroundColorG), C.double(backgroundColorB), C.double(backgroundColorA)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("embed", out)
 	}
 	return out, nil
 }
@@ -6561,7 +6799,7 @@ func vipsgenEmbedMultiPageBackground(in *C.VipsImage, left, top, width, height,
 func vipsgenExtractAreaMultiPage(in *C.VipsImage, left, top, width, height int) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_extract_area_multi_page(in, &out, C.int(left), C.int(top), C.int(width), C.int(height)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("extract_area", out)
 	}
 	return out, nil
 }
@@ -6569,15 +6807,40 @@ func vipsgenExtractAreaMultiPage(in *C.VipsImage, left, top, width, height int)
 func vipsgenRotMultiPage(in *C.VipsImage, angle Angle) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_rot_multi_page(in, &out, C.VipsAngle(angle)); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("rot", out)
+	}
+	return out, nil
+}
+
+func vipsgenResizeMultiPage(in *C.VipsImage, scale, vscale float64, kernel Kernel, gap float64) (*C.VipsImage, error) {
+	var out *C.VipsImage
+	if err := C.vipsgen_resize_multi_page(in, &out, C.double(scale), C.double(vscale), C.VipsKernel(kernel), C.double(gap)); err != 0 {
+		return nil, handleImageError("resize", out)
 	}
 	return out, nil
 }
 
+func vipsSetEvalLimit(in *C.VipsImage, deadline time.Time, timeout time.Duration) {
+	var cDeadline C.gint64
+	if !deadline.IsZero() {
+		cDeadline = C.gint64(deadline.UnixMicro())
+	}
+	C.vipsgen_set_eval_limit(in, cDeadline, C.double(timeout.Seconds()))
+}
+
+// vipsSetCancel returns a function that aborts evaluation of images derived from in.
+// It must not be called once in has been freed.
+func vipsSetCancel(in *C.VipsImage) func() {
+	cancelled := C.vipsgen_set_cancel(in)
+	return func() {
+		C.vipsgen_cancel(cancelled)
+	}
+}
+
 func vipsgenRemoveExif(in *C.VipsImage) (*C.VipsImage, error) {
 	var out *C.VipsImage
 	if err := C.vipsgen_remove_exif(in, &out); err != 0 {
-		return nil, handleImageError(out)
+		return nil, handleImageError("remove_exif", out)
 	}
 	return out, nil
 }